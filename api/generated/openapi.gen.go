@@ -24,34 +24,313 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AuditEntityType.
+const (
+	AuditEntityTypeBook              AuditEntityType = "book"
+	AuditEntityTypeBranch            AuditEntityType = "branch"
+	AuditEntityTypeClosedDate        AuditEntityType = "closed_date"
+	AuditEntityTypeCopy              AuditEntityType = "copy"
+	AuditEntityTypeDueDateAdjustment AuditEntityType = "due_date_adjustment"
+	AuditEntityTypeFine              AuditEntityType = "fine"
+	AuditEntityTypeHold              AuditEntityType = "hold"
+	AuditEntityTypeLoan              AuditEntityType = "loan"
+	AuditEntityTypeLoanPolicy        AuditEntityType = "loan_policy"
+	AuditEntityTypeOpeningHours      AuditEntityType = "opening_hours"
+	AuditEntityTypeTransfer          AuditEntityType = "transfer"
+	AuditEntityTypeUser              AuditEntityType = "user"
+	AuditEntityTypeWebhook           AuditEntityType = "webhook"
+)
+
+// Defines values for BookCopyCondition.
+const (
+	Damaged BookCopyCondition = "damaged"
+	Fair    BookCopyCondition = "fair"
+	Good    BookCopyCondition = "good"
+	New     BookCopyCondition = "new"
+	Poor    BookCopyCondition = "poor"
+)
+
+// Defines values for BookCopyStatus.
+const (
+	BookCopyStatusAvailable BookCopyStatus = "available"
+	BookCopyStatusInRepair  BookCopyStatus = "in_repair"
+	BookCopyStatusInTransit BookCopyStatus = "in_transit"
+	BookCopyStatusOnHold    BookCopyStatus = "on_hold"
+	BookCopyStatusOnLoan    BookCopyStatus = "on_loan"
+	BookCopyStatusWithdrawn BookCopyStatus = "withdrawn"
+)
+
+// Defines values for EventType.
+const (
+	BookCreated        EventType = "book.created"
+	BookDeleted        EventType = "book.deleted"
+	BookUpdated        EventType = "book.updated"
+	BookWithdrawn      EventType = "book.withdrawn"
+	FineAssessed       EventType = "fine.assessed"
+	FinePaid           EventType = "fine.paid"
+	FineWaived         EventType = "fine.waived"
+	HoldCancelled      EventType = "hold.cancelled"
+	HoldExpired        EventType = "hold.expired"
+	HoldFulfilled      EventType = "hold.fulfilled"
+	HoldPlaced         EventType = "hold.placed"
+	HoldReady          EventType = "hold.ready"
+	LoanBorrowed       EventType = "loan.borrowed"
+	LoanDamaged        EventType = "loan.damaged"
+	LoanDueDateChanged EventType = "loan.due_date_changed"
+	LoanLost           EventType = "loan.lost"
+	LoanOverdue        EventType = "loan.overdue"
+	LoanRenewed        EventType = "loan.renewed"
+	LoanReturned       EventType = "loan.returned"
+	UserBlocked        EventType = "user.blocked"
+	UserCreated        EventType = "user.created"
+	UserDisabled       EventType = "user.disabled"
+	UserUnblocked      EventType = "user.unblocked"
+	UserUpdated        EventType = "user.updated"
+)
+
+// Defines values for FineReason.
+const (
+	FineReasonDamaged FineReason = "damaged"
+	FineReasonLost    FineReason = "lost"
+	FineReasonOverdue FineReason = "overdue"
+)
+
+// Defines values for FineStatus.
+const (
+	Open   FineStatus = "open"
+	Paid   FineStatus = "paid"
+	Waived FineStatus = "waived"
+)
+
+// Defines values for HoldStatus.
+const (
+	HoldStatusCancelled HoldStatus = "cancelled"
+	HoldStatusExpired   HoldStatus = "expired"
+	HoldStatusFulfilled HoldStatus = "fulfilled"
+	HoldStatusReady     HoldStatus = "ready"
+	HoldStatusWaiting   HoldStatus = "waiting"
+)
+
+// Defines values for LoanEscalationAction.
+const (
+	LoanEscalationActionBlock  LoanEscalationAction = "block"
+	LoanEscalationActionLost   LoanEscalationAction = "lost"
+	LoanEscalationActionNotice LoanEscalationAction = "notice"
+)
+
 // Defines values for LoanStatus.
 const (
 	LoanStatusActive   LoanStatus = "active"
+	LoanStatusDamaged  LoanStatus = "damaged"
+	LoanStatusLost     LoanStatus = "lost"
+	LoanStatusOverdue  LoanStatus = "overdue"
 	LoanStatusReturned LoanStatus = "returned"
 )
 
+// Defines values for TransferStatus.
+const (
+	TransferStatusCancelled TransferStatus = "cancelled"
+	TransferStatusInTransit TransferStatus = "in_transit"
+	TransferStatusReceived  TransferStatus = "received"
+	TransferStatusRequested TransferStatus = "requested"
+)
+
+// Defines values for UpdateBookCopyRequestStatus.
+const (
+	UpdateBookCopyRequestStatusAvailable UpdateBookCopyRequestStatus = "available"
+	UpdateBookCopyRequestStatusInRepair  UpdateBookCopyRequestStatus = "in_repair"
+	UpdateBookCopyRequestStatusWithdrawn UpdateBookCopyRequestStatus = "withdrawn"
+)
+
+// Defines values for UserRole.
+const (
+	Admin     UserRole = "admin"
+	Librarian UserRole = "librarian"
+	Member    UserRole = "member"
+)
+
+// Defines values for Weekday.
+const (
+	Friday    Weekday = "friday"
+	Monday    Weekday = "monday"
+	Saturday  Weekday = "saturday"
+	Sunday    Weekday = "sunday"
+	Thursday  Weekday = "thursday"
+	Tuesday   Weekday = "tuesday"
+	Wednesday Weekday = "wednesday"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Delivered WebhookDeliveryStatus = "delivered"
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
 // Defines values for ListLoansParamsStatus.
 const (
 	ListLoansParamsStatusActive   ListLoansParamsStatus = "active"
+	ListLoansParamsStatusDamaged  ListLoansParamsStatus = "damaged"
+	ListLoansParamsStatusLost     ListLoansParamsStatus = "lost"
+	ListLoansParamsStatusOverdue  ListLoansParamsStatus = "overdue"
 	ListLoansParamsStatusReturned ListLoansParamsStatus = "returned"
 )
 
+// Defines values for ListMyLoansParamsStatus.
+const (
+	ListMyLoansParamsStatusActive   ListMyLoansParamsStatus = "active"
+	ListMyLoansParamsStatusDamaged  ListMyLoansParamsStatus = "damaged"
+	ListMyLoansParamsStatusLost     ListMyLoansParamsStatus = "lost"
+	ListMyLoansParamsStatusOverdue  ListMyLoansParamsStatus = "overdue"
+	ListMyLoansParamsStatusReturned ListMyLoansParamsStatus = "returned"
+)
+
+// AdjustDueDatesRequest defines model for AdjustDueDatesRequest.
+type AdjustDueDatesRequest struct {
+	// BookId Apenas empréstimos deste livro
+	BookId *openapi_types.UUID `json:"book_id,omitempty"`
+
+	// BranchId Apenas empréstimos de cópias desta unidade
+	BranchId *openapi_types.UUID `json:"branch_id,omitempty"`
+
+	// DueFrom Primeiro dia de vencimento afetado
+	DueFrom openapi_types.Date `json:"due_from"`
+
+	// DueTo Último dia de vencimento afetado
+	DueTo openapi_types.Date `json:"due_to"`
+
+	// NewDueDate Novo dia de vencimento de todos os empréstimos afetados
+	NewDueDate *openapi_types.Date `json:"new_due_date,omitempty"`
+	Reason     *string             `json:"reason,omitempty"`
+
+	// ShiftDays Dias a somar a cada vencimento
+	ShiftDays *int `json:"shift_days,omitempty"`
+}
+
+// AuditChange defines model for AuditChange.
+type AuditChange struct {
+	// After Valor depois da alteração
+	After *interface{} `json:"after,omitempty"`
+
+	// Before Valor antes da alteração; nulo na criação
+	Before *interface{} `json:"before,omitempty"`
+}
+
+// AuditEntityType defines model for AuditEntityType.
+type AuditEntityType string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action *string `json:"action,omitempty"`
+
+	// ActorId Usuário que fez a alteração; ausente para tarefas em segundo plano
+	ActorId *openapi_types.UUID `json:"actor_id,omitempty"`
+
+	// Changes Campos alterados, pelo nome
+	Changes    *map[string]AuditChange `json:"changes,omitempty"`
+	CreatedAt  *time.Time              `json:"created_at,omitempty"`
+	EntityId   *openapi_types.UUID     `json:"entity_id,omitempty"`
+	EntityType *AuditEntityType        `json:"entity_type,omitempty"`
+
+	// Hash SHA-256 do conteúdo da entrada e de prev_hash
+	Hash *string             `json:"hash,omitempty"`
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+
+	// PrevHash Hash da entrada anterior; vazio na primeira
+	PrevHash *string `json:"prev_hash,omitempty"`
+
+	// RequestId Valor do cabeçalho X-Request-ID da requisição que fez a alteração
+	RequestId *string `json:"request_id,omitempty"`
+
+	// Sequence Posição da entrada na cadeia
+	Sequence *int64 `json:"sequence,omitempty"`
+}
+
+// AuditEntryListResponse defines model for AuditEntryListResponse.
+type AuditEntryListResponse struct {
+	Data       *[]AuditEntry `json:"data,omitempty"`
+	Pagination *Pagination   `json:"pagination,omitempty"`
+}
+
+// AuditVerification defines model for AuditVerification.
+type AuditVerification struct {
+	// BrokenAt Sequência da primeira entrada que quebra a cadeia
+	BrokenAt *int64 `json:"broken_at,omitempty"`
+
+	// Checked Entradas íntegras conferidas antes da primeira falha, ou todas
+	Checked *int    `json:"checked,omitempty"`
+	Reason  *string `json:"reason,omitempty"`
+	Valid   *bool   `json:"valid,omitempty"`
+}
+
+// AuditVerificationResponse defines model for AuditVerificationResponse.
+type AuditVerificationResponse struct {
+	Data *AuditVerification `json:"data,omitempty"`
+}
+
 // Book defines model for Book.
 type Book struct {
 	Author *string `json:"author,omitempty"`
 
 	// AvailabilityStatus Disponível ou Indisponível - todas as cópias emprestadas
-	AvailabilityStatus *string             `json:"availability_status,omitempty"`
-	AvailableCopies    *int                `json:"available_copies,omitempty"`
-	CreatedAt          *time.Time          `json:"created_at,omitempty"`
-	Id                 *openapi_types.UUID `json:"id,omitempty"`
-	Isbn               *string             `json:"isbn,omitempty"`
-	PublishedYear      *int                `json:"published_year,omitempty"`
-	Title              *string             `json:"title,omitempty"`
-	TotalCopies        *int                `json:"total_copies,omitempty"`
-	UpdatedAt          *time.Time          `json:"updated_at,omitempty"`
+	AvailabilityStatus *string `json:"availability_status,omitempty"`
+
+	// AvailableCopies Cópias com status `available`
+	AvailableCopies *int `json:"available_copies,omitempty"`
+
+	// Branches Cópias do livro em cada unidade, sem contar as baixadas
+	Branches  *[]BranchAvailability `json:"branches,omitempty"`
+	Category  *string               `json:"category,omitempty"`
+	CreatedAt *time.Time            `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID   `json:"id,omitempty"`
+	Isbn      *string               `json:"isbn,omitempty"`
+
+	// Match Presente apenas na busca com `q`
+	Match         *BookSearchMatch `json:"match,omitempty"`
+	PublishedYear *int             `json:"published_year,omitempty"`
+	Title         *string          `json:"title,omitempty"`
+
+	// TotalCopies Cópias do acervo, sem contar as baixadas (`withdrawn`)
+	TotalCopies *int       `json:"total_copies,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// WithdrawnAt Quando o livro foi baixado do acervo; ausente para livros do acervo
+	WithdrawnAt *time.Time `json:"withdrawn_at,omitempty"`
+}
+
+// BookCopy defines model for BookCopy.
+type BookCopy struct {
+	Barcode *string             `json:"barcode,omitempty"`
+	BookId  *openapi_types.UUID `json:"book_id,omitempty"`
+
+	// BranchId Unidade que guarda a cópia
+	BranchId  *openapi_types.UUID `json:"branch_id,omitempty"`
+	Condition *BookCopyCondition  `json:"condition,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+
+	// Location Estante onde a cópia fica
+	Location  *string         `json:"location,omitempty"`
+	Status    *BookCopyStatus `json:"status,omitempty"`
+	UpdatedAt *time.Time      `json:"updated_at,omitempty"`
+}
+
+// BookCopyCondition defines model for BookCopyCondition.
+type BookCopyCondition string
+
+// BookCopyListResponse defines model for BookCopyListResponse.
+type BookCopyListResponse struct {
+	Data *[]BookCopy `json:"data,omitempty"`
+}
+
+// BookCopyResponse defines model for BookCopyResponse.
+type BookCopyResponse struct {
+	Data *BookCopy `json:"data,omitempty"`
 }
 
+// BookCopyStatus defines model for BookCopyStatus.
+type BookCopyStatus string
+
 // BookListResponse defines model for BookListResponse.
 type BookListResponse struct {
 	Data       *[]Book     `json:"data,omitempty"`
@@ -63,29 +342,222 @@ type BookResponse struct {
 	Data *Book `json:"data,omitempty"`
 }
 
+// BookSearchMatch Presente apenas na busca com `q`
+type BookSearchMatch struct {
+	// AuthorHighlight Autor com as palavras encontradas entre `<mark>` e `</mark>`
+	AuthorHighlight *string `json:"author_highlight,omitempty"`
+
+	// Score Relevância do livro para a busca; só compara livros da mesma busca
+	Score *float64 `json:"score,omitempty"`
+
+	// TitleHighlight Título com as palavras encontradas entre `<mark>` e `</mark>`
+	TitleHighlight *string `json:"title_highlight,omitempty"`
+}
+
+// BookSuggestions defines model for BookSuggestions.
+type BookSuggestions struct {
+	Authors []string `json:"authors"`
+	Titles  []string `json:"titles"`
+}
+
+// BookSuggestionsResponse defines model for BookSuggestionsResponse.
+type BookSuggestionsResponse struct {
+	Data *BookSuggestions `json:"data,omitempty"`
+}
+
 // BorrowBookRequest defines model for BorrowBookRequest.
 type BorrowBookRequest struct {
 	BookId openapi_types.UUID `json:"book_id"`
 
-	// DueDate Data de devolução prevista (padrão 14 dias)
+	// DueDate Data de devolução prevista, só para a equipe (padrão definido pela política de empréstimo)
 	DueDate *openapi_types.Date `json:"due_date,omitempty"`
 	UserId  openapi_types.UUID  `json:"user_id"`
 }
 
+// BorrowForMeRequest defines model for BorrowForMeRequest.
+type BorrowForMeRequest struct {
+	BookId openapi_types.UUID `json:"book_id"`
+}
+
+// Branch defines model for Branch.
+type Branch struct {
+	Address   *string             `json:"address,omitempty"`
+	Code      *string             `json:"code,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+}
+
+// BranchAvailability defines model for BranchAvailability.
+type BranchAvailability struct {
+	// AvailableCopies Cópias na unidade com status `available`
+	AvailableCopies *int                `json:"available_copies,omitempty"`
+	BranchId        *openapi_types.UUID `json:"branch_id,omitempty"`
+
+	// TotalCopies Cópias na unidade, sem contar as baixadas (`withdrawn`)
+	TotalCopies *int `json:"total_copies,omitempty"`
+}
+
+// BranchListResponse defines model for BranchListResponse.
+type BranchListResponse struct {
+	Data *[]Branch `json:"data,omitempty"`
+}
+
+// BranchResponse defines model for BranchResponse.
+type BranchResponse struct {
+	Data *Branch `json:"data,omitempty"`
+}
+
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// CheckInRequest defines model for CheckInRequest.
+type CheckInRequest struct {
+	Barcode string `json:"barcode"`
+
+	// DamageChargeCents Valor cobrado pelo conserto, em centavos
+	DamageChargeCents *int64 `json:"damage_charge_cents,omitempty"`
+
+	// Damaged Cópia devolvida danificada
+	Damaged *bool `json:"damaged,omitempty"`
+
+	// ReturnedAt Momento da devolução, entre a data do empréstimo e agora (padrão agora)
+	ReturnedAt *time.Time `json:"returned_at,omitempty"`
+}
+
+// CheckOutRequest defines model for CheckOutRequest.
+type CheckOutRequest struct {
+	Barcode    string `json:"barcode"`
+	CardNumber string `json:"card_number"`
+
+	// DueDate Data de devolução prevista (padrão definido pela política de empréstimo)
+	DueDate *openapi_types.Date `json:"due_date,omitempty"`
+}
+
+// ClosedDate defines model for ClosedDate.
+type ClosedDate struct {
+	BranchId  *openapi_types.UUID `json:"branch_id,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Date      *openapi_types.Date `json:"date,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Reason    *string             `json:"reason,omitempty"`
+}
+
+// ClosedDateListResponse defines model for ClosedDateListResponse.
+type ClosedDateListResponse struct {
+	Data *[]ClosedDate `json:"data,omitempty"`
+}
+
+// ClosedDateResponse defines model for ClosedDateResponse.
+type ClosedDateResponse struct {
+	Data *ClosedDate `json:"data,omitempty"`
+}
+
+// CreateBookCopyRequest defines model for CreateBookCopyRequest.
+type CreateBookCopyRequest struct {
+	Barcode string `json:"barcode"`
+
+	// BranchId Unidade que recebe a cópia (padrão a unidade `MAIN`)
+	BranchId  *openapi_types.UUID `json:"branch_id,omitempty"`
+	Condition *BookCopyCondition  `json:"condition,omitempty"`
+	Location  *string             `json:"location,omitempty"`
+}
+
 // CreateBookRequest defines model for CreateBookRequest.
 type CreateBookRequest struct {
-	Author        string `json:"author"`
-	Isbn          string `json:"isbn"`
-	PublishedYear *int   `json:"published_year,omitempty"`
-	Title         string `json:"title"`
-	TotalCopies   int    `json:"total_copies"`
+	Author string `json:"author"`
+
+	// BranchId Unidade que recebe as cópias (padrão a unidade `MAIN`)
+	BranchId *openapi_types.UUID `json:"branch_id,omitempty"`
+
+	// Category Categoria do item usada para escolher a política de empréstimo (padrão `general`)
+	Category      *string `json:"category,omitempty"`
+	Isbn          string  `json:"isbn"`
+	PublishedYear *int    `json:"published_year,omitempty"`
+	Title         string  `json:"title"`
+
+	// TotalCopies Quantidade de cópias cadastradas junto com o livro, com códigos de barras `<isbn>-001`, `<isbn>-002`, ...
+	TotalCopies int `json:"total_copies"`
+}
+
+// CreateBranchRequest defines model for CreateBranchRequest.
+type CreateBranchRequest struct {
+	Address *string `json:"address,omitempty"`
+	Code    string  `json:"code"`
+	Name    string  `json:"name"`
+}
+
+// CreateClosedDateRequest defines model for CreateClosedDateRequest.
+type CreateClosedDateRequest struct {
+	Date   openapi_types.Date `json:"date"`
+	Reason *string            `json:"reason,omitempty"`
+}
+
+// CreateLoanPolicyRequest defines model for CreateLoanPolicyRequest.
+type CreateLoanPolicyRequest struct {
+	ItemCategory   string `json:"item_category"`
+	LoanDays       int    `json:"loan_days"`
+	MaxLoans       int    `json:"max_loans"`
+	MaxRenewals    int    `json:"max_renewals"`
+	PatronCategory string `json:"patron_category"`
 }
 
 // CreateUserRequest defines model for CreateUserRequest.
 type CreateUserRequest struct {
+	// CardNumber Número da carteirinha da biblioteca (gerado automaticamente quando omitido)
+	CardNumber *string `json:"card_number,omitempty"`
+
+	// Category Categoria do usuário usada para escolher a política de empréstimo (padrão `standard`)
+	Category *string             `json:"category,omitempty"`
 	Email    openapi_types.Email `json:"email"`
 	Name     string              `json:"name"`
 	Password string              `json:"password"`
+
+	// Role admin gerencia usuários, librarian gerencia livros e empréstimos, member age apenas sobre os próprios dados
+	Role *UserRole `json:"role,omitempty"`
+}
+
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	Events []EventType `json:"events"`
+
+	// Secret Chave do HMAC-SHA256 que assina cada entrega
+	Secret string `json:"secret"`
+
+	// Url URL http ou https que recebe os eventos
+	Url string `json:"url"`
+}
+
+// DeclareLostRequest defines model for DeclareLostRequest.
+type DeclareLostRequest struct {
+	// ReplacementCents Valor da reposição em centavos (padrão configurado; 0 não cobra)
+	ReplacementCents *int64 `json:"replacement_cents,omitempty"`
+}
+
+// DueDateAdjustment defines model for DueDateAdjustment.
+type DueDateAdjustment struct {
+	AdjustedBy *openapi_types.UUID `json:"adjusted_by,omitempty"`
+	BookId     *openapi_types.UUID `json:"book_id,omitempty"`
+	BranchId   *openapi_types.UUID `json:"branch_id,omitempty"`
+	CreatedAt  *time.Time          `json:"created_at,omitempty"`
+	DueFrom    *openapi_types.Date `json:"due_from,omitempty"`
+	DueTo      *openapi_types.Date `json:"due_to,omitempty"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+
+	// LoansAdjusted Quantidade de empréstimos com o vencimento alterado
+	LoansAdjusted *int                `json:"loans_adjusted,omitempty"`
+	NewDueDate    *openapi_types.Date `json:"new_due_date,omitempty"`
+	Reason        *string             `json:"reason,omitempty"`
+	ShiftDays     *int                `json:"shift_days,omitempty"`
+}
+
+// DueDateAdjustmentResponse defines model for DueDateAdjustmentResponse.
+type DueDateAdjustmentResponse struct {
+	Data *DueDateAdjustment `json:"data,omitempty"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
@@ -95,33 +567,168 @@ type ErrorResponse struct {
 	Error   *string   `json:"error,omitempty"`
 }
 
+// EventType defines model for EventType.
+type EventType string
+
+// Fine defines model for Fine.
+type Fine struct {
+	// AmountCents Valor da multa em centavos
+	AmountCents *int64 `json:"amount_cents,omitempty"`
+
+	// BalanceCents Saldo a pagar em centavos
+	BalanceCents *int64              `json:"balance_cents,omitempty"`
+	CreatedAt    *time.Time          `json:"created_at,omitempty"`
+	Id           *openapi_types.UUID `json:"id,omitempty"`
+	LoanId       *openapi_types.UUID `json:"loan_id,omitempty"`
+
+	// PaidCents Total já pago em centavos
+	PaidCents *int64      `json:"paid_cents,omitempty"`
+	Reason    *FineReason `json:"reason,omitempty"`
+
+	// Status `open` ainda tem saldo a pagar, `paid` foi quitada e `waived` foi perdoada.
+	Status    *FineStatus         `json:"status,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+	UserId    *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// FineReason defines model for Fine.Reason.
+type FineReason string
+
+// FineListResponse defines model for FineListResponse.
+type FineListResponse struct {
+	Data       *[]Fine     `json:"data,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// FineResponse defines model for FineResponse.
+type FineResponse struct {
+	Data *Fine `json:"data,omitempty"`
+}
+
+// FineStatus `open` ainda tem saldo a pagar, `paid` foi quitada e `waived` foi perdoada.
+type FineStatus string
+
 // HelloWorldResponse defines model for HelloWorldResponse.
 type HelloWorldResponse struct {
 	Title string `json:"title"`
 }
 
+// Hold defines model for Hold.
+type Hold struct {
+	BookId    *openapi_types.UUID `json:"book_id,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+
+	// PickupDeadline Prazo para retirar a cópia separada
+	PickupDeadline *time.Time `json:"pickup_deadline"`
+
+	// QueuePosition Posição na fila do livro (apenas reservas em espera)
+	QueuePosition *int       `json:"queue_position,omitempty"`
+	ReadyAt       *time.Time `json:"ready_at"`
+
+	// Status `waiting` aguarda uma cópia, `ready` tem uma cópia separada até `pickup_deadline`, `fulfilled` virou empréstimo, `cancelled` foi cancelada e `expired` não foi retirada no prazo.
+	Status *HoldStatus         `json:"status,omitempty"`
+	UserId *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// HoldListResponse defines model for HoldListResponse.
+type HoldListResponse struct {
+	Data       *[]Hold     `json:"data,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// HoldResponse defines model for HoldResponse.
+type HoldResponse struct {
+	Data *Hold `json:"data,omitempty"`
+}
+
+// HoldStatus `waiting` aguarda uma cópia, `ready` tem uma cópia separada até `pickup_deadline`, `fulfilled` virou empréstimo, `cancelled` foi cancelada e `expired` não foi retirada no prazo.
+type HoldStatus string
+
 // Loan defines model for Loan.
 type Loan struct {
 	BookId     *openapi_types.UUID `json:"book_id,omitempty"`
 	BookTitle  *string             `json:"book_title,omitempty"`
 	BorrowedAt *time.Time          `json:"borrowed_at,omitempty"`
-	DueDate    *time.Time          `json:"due_date,omitempty"`
-	Id         *openapi_types.UUID `json:"id,omitempty"`
-	ReturnedAt *time.Time          `json:"returned_at"`
-	Status     *LoanStatus         `json:"status,omitempty"`
-	UserId     *openapi_types.UUID `json:"user_id,omitempty"`
-	UserName   *string             `json:"user_name,omitempty"`
+
+	// CopyId Cópia emprestada (nula em empréstimos anteriores ao controle de cópias)
+	CopyId *openapi_types.UUID `json:"copy_id"`
+
+	// DaysOverdue Dias de atraso até a devolução (ou até agora, se ainda não devolvido)
+	DaysOverdue *int                `json:"days_overdue,omitempty"`
+	DueDate     *time.Time          `json:"due_date,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+
+	// RenewalCount Quantas vezes o empréstimo foi renovado
+	RenewalCount *int                `json:"renewal_count,omitempty"`
+	ReturnedAt   *time.Time          `json:"returned_at"`
+	Status       *LoanStatus         `json:"status,omitempty"`
+	UserId       *openapi_types.UUID `json:"user_id,omitempty"`
+	UserName     *string             `json:"user_name,omitempty"`
 }
 
 // LoanStatus defines model for Loan.Status.
 type LoanStatus string
 
+// LoanEscalation defines model for LoanEscalation.
+type LoanEscalation struct {
+	Action    *LoanEscalationAction `json:"action,omitempty"`
+	CreatedAt *time.Time            `json:"created_at,omitempty"`
+
+	// DaysOverdue Dias de atraso quando o passo foi aplicado
+	DaysOverdue *int                `json:"days_overdue,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+
+	// Level Posição do passo na escada de cobrança, a partir de 1
+	Level  *int                `json:"level,omitempty"`
+	LoanId *openapi_types.UUID `json:"loan_id,omitempty"`
+	UserId *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// LoanEscalationAction defines model for LoanEscalation.Action.
+type LoanEscalationAction string
+
+// LoanEscalationListResponse defines model for LoanEscalationListResponse.
+type LoanEscalationListResponse struct {
+	Data *[]LoanEscalation `json:"data,omitempty"`
+}
+
 // LoanListResponse defines model for LoanListResponse.
 type LoanListResponse struct {
 	Data       *[]Loan     `json:"data,omitempty"`
 	Pagination *Pagination `json:"pagination,omitempty"`
 }
 
+// LoanPolicy defines model for LoanPolicy.
+type LoanPolicy struct {
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+
+	// ItemCategory Categoria do item, ou `*` para qualquer uma
+	ItemCategory *string `json:"item_category,omitempty"`
+
+	// LoanDays Prazo do empréstimo e de cada renovação, em dias
+	LoanDays *int `json:"loan_days,omitempty"`
+
+	// MaxLoans Máximo de empréstimos simultâneos do usuário; `0` impede o empréstimo de itens da categoria
+	MaxLoans    *int `json:"max_loans,omitempty"`
+	MaxRenewals *int `json:"max_renewals,omitempty"`
+
+	// PatronCategory Categoria do usuário, ou `*` para qualquer uma
+	PatronCategory *string    `json:"patron_category,omitempty"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// LoanPolicyListResponse defines model for LoanPolicyListResponse.
+type LoanPolicyListResponse struct {
+	Data *[]LoanPolicy `json:"data,omitempty"`
+}
+
+// LoanPolicyResponse defines model for LoanPolicyResponse.
+type LoanPolicyResponse struct {
+	Data *LoanPolicy `json:"data,omitempty"`
+}
+
 // LoanResponse defines model for LoanResponse.
 type LoanResponse struct {
 	Data *Loan `json:"data,omitempty"`
@@ -145,6 +752,21 @@ type MessageResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// OpeningHours defines model for OpeningHours.
+type OpeningHours struct {
+	// ClosesAt Horário de fechamento (HH:MM) no fuso horário da biblioteca, quando vencem os empréstimos do dia
+	ClosesAt string `json:"closes_at"`
+
+	// OpensAt Horário de abertura (HH:MM) no fuso horário da biblioteca
+	OpensAt string  `json:"opens_at"`
+	Weekday Weekday `json:"weekday"`
+}
+
+// OpeningHoursResponse defines model for OpeningHoursResponse.
+type OpeningHoursResponse struct {
+	Data *[]OpeningHours `json:"data,omitempty"`
+}
+
 // Pagination defines model for Pagination.
 type Pagination struct {
 	Limit      *int `json:"limit,omitempty"`
@@ -153,20 +775,149 @@ type Pagination struct {
 	TotalPages *int `json:"total_pages,omitempty"`
 }
 
-// UpdateUserRequest defines model for UpdateUserRequest.
-type UpdateUserRequest struct {
+// PayFineRequest defines model for PayFineRequest.
+type PayFineRequest struct {
+	// AmountCents Valor pago em centavos
+	AmountCents int64 `json:"amount_cents"`
+}
+
+// PlaceHoldRequest defines model for PlaceHoldRequest.
+type PlaceHoldRequest struct {
+	BookId openapi_types.UUID `json:"book_id"`
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+// RequestTransferRequest defines model for RequestTransferRequest.
+type RequestTransferRequest struct {
+	CopyId     openapi_types.UUID `json:"copy_id"`
+	ToBranchId openapi_types.UUID `json:"to_branch_id"`
+}
+
+// ReturnBookRequest defines model for ReturnBookRequest.
+type ReturnBookRequest struct {
+	// DamageChargeCents Valor cobrado pelo conserto, em centavos
+	DamageChargeCents *int64 `json:"damage_charge_cents,omitempty"`
+
+	// Damaged Cópia devolvida danificada (somente equipe)
+	Damaged *bool `json:"damaged,omitempty"`
+}
+
+// SetOpeningHoursRequest defines model for SetOpeningHoursRequest.
+type SetOpeningHoursRequest struct {
+	Hours []OpeningHours `json:"hours"`
+}
+
+// Transfer defines model for Transfer.
+type Transfer struct {
+	BookId       *openapi_types.UUID `json:"book_id,omitempty"`
+	CopyId       *openapi_types.UUID `json:"copy_id,omitempty"`
+	FromBranchId *openapi_types.UUID `json:"from_branch_id,omitempty"`
+	Id           *openapi_types.UUID `json:"id,omitempty"`
+	ReceivedAt   *time.Time          `json:"received_at,omitempty"`
+	RequestedAt  *time.Time          `json:"requested_at,omitempty"`
+	ShippedAt    *time.Time          `json:"shipped_at,omitempty"`
+	Status       *TransferStatus     `json:"status,omitempty"`
+	ToBranchId   *openapi_types.UUID `json:"to_branch_id,omitempty"`
+	UpdatedAt    *time.Time          `json:"updated_at,omitempty"`
+}
+
+// TransferListResponse defines model for TransferListResponse.
+type TransferListResponse struct {
+	Data       *[]Transfer `json:"data,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// TransferResponse defines model for TransferResponse.
+type TransferResponse struct {
+	Data *Transfer `json:"data,omitempty"`
+}
+
+// TransferStatus defines model for TransferStatus.
+type TransferStatus string
+
+// UpdateBookCopyRequest defines model for UpdateBookCopyRequest.
+type UpdateBookCopyRequest struct {
+	Condition *BookCopyCondition           `json:"condition,omitempty"`
+	Location  *string                      `json:"location,omitempty"`
+	Status    *UpdateBookCopyRequestStatus `json:"status,omitempty"`
+}
+
+// UpdateBookCopyRequestStatus defines model for UpdateBookCopyRequest.Status.
+type UpdateBookCopyRequestStatus string
+
+// UpdateBookRequest defines model for UpdateBookRequest.
+type UpdateBookRequest struct {
+	Author *string `json:"author,omitempty"`
+
+	// BranchId Unidade que recebe as cópias novas (padrão a unidade `MAIN`)
+	BranchId      *openapi_types.UUID `json:"branch_id,omitempty"`
+	Category      *string             `json:"category,omitempty"`
+	Isbn          *string             `json:"isbn,omitempty"`
+	PublishedYear *int                `json:"published_year,omitempty"`
+	Title         *string             `json:"title,omitempty"`
+
+	// TotalCopies Quantidade de cópias do acervo, sem contar as baixadas
+	TotalCopies *int `json:"total_copies,omitempty"`
+}
+
+// UpdateBranchRequest defines model for UpdateBranchRequest.
+type UpdateBranchRequest struct {
+	Address *string `json:"address,omitempty"`
+	Name    *string `json:"name,omitempty"`
+}
+
+// UpdateLoanPolicyRequest defines model for UpdateLoanPolicyRequest.
+type UpdateLoanPolicyRequest struct {
+	LoanDays    *int `json:"loan_days,omitempty"`
+	MaxLoans    *int `json:"max_loans,omitempty"`
+	MaxRenewals *int `json:"max_renewals,omitempty"`
+}
+
+// UpdateProfileRequest defines model for UpdateProfileRequest.
+type UpdateProfileRequest struct {
 	Email *openapi_types.Email `json:"email,omitempty"`
 	Name  *string              `json:"name,omitempty"`
 }
 
+// UpdateUserRequest defines model for UpdateUserRequest.
+type UpdateUserRequest struct {
+	// CardNumber Apenas administradores podem alterar a carteirinha
+	CardNumber *string `json:"card_number,omitempty"`
+
+	// Category Apenas administradores podem alterar a categoria
+	Category *string              `json:"category,omitempty"`
+	Email    *openapi_types.Email `json:"email,omitempty"`
+	Name     *string              `json:"name,omitempty"`
+
+	// Role admin gerencia usuários, librarian gerencia livros e empréstimos, member age apenas sobre os próprios dados
+	Role *UserRole `json:"role,omitempty"`
+}
+
+// UpdateWebhookRequest defines model for UpdateWebhookRequest.
+type UpdateWebhookRequest struct {
+	// Active Assinaturas inativas não recebem novos eventos
+	Active *bool        `json:"active,omitempty"`
+	Events *[]EventType `json:"events,omitempty"`
+	Secret *string      `json:"secret,omitempty"`
+	Url    *string      `json:"url,omitempty"`
+}
+
 // User defines model for User.
 type User struct {
-	Active    *bool                `json:"active,omitempty"`
-	CreatedAt *time.Time           `json:"created_at,omitempty"`
-	Email     *openapi_types.Email `json:"email,omitempty"`
-	Id        *openapi_types.UUID  `json:"id,omitempty"`
-	Name      *string              `json:"name,omitempty"`
-	UpdatedAt *time.Time           `json:"updated_at,omitempty"`
+	Active *bool `json:"active,omitempty"`
+
+	// Blocked Bloqueado para novos empréstimos pela escada de cobrança de atrasos
+	Blocked    *bool                `json:"blocked,omitempty"`
+	CardNumber *string              `json:"card_number,omitempty"`
+	Category   *string              `json:"category,omitempty"`
+	CreatedAt  *time.Time           `json:"created_at,omitempty"`
+	Email      *openapi_types.Email `json:"email,omitempty"`
+	Id         *openapi_types.UUID  `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+
+	// Role admin gerencia usuários, librarian gerencia livros e empréstimos, member age apenas sobre os próprios dados
+	Role      *UserRole  `json:"role,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
 // UserListResponse defines model for UserListResponse.
@@ -180,6 +931,77 @@ type UserResponse struct {
 	Data *User `json:"data,omitempty"`
 }
 
+// UserRole admin gerencia usuários, librarian gerencia livros e empréstimos, member age apenas sobre os próprios dados
+type UserRole string
+
+// Webhook O segredo nunca é devolvido.
+type Webhook struct {
+	Active    *bool               `json:"active,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Events    *[]EventType        `json:"events,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+	Url       *string             `json:"url,omitempty"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    *int                `json:"attempts,omitempty"`
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	DeliveredAt *time.Time          `json:"delivered_at,omitempty"`
+	EventId     *openapi_types.UUID `json:"event_id,omitempty"`
+	EventType   *EventType          `json:"event_type,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+	LastError   *string             `json:"last_error,omitempty"`
+
+	// NextAttemptAt Próxima tentativa, enquanto a entrega está pendente
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+
+	// ResponseStatus Status HTTP da última resposta do destino
+	ResponseStatus *int                   `json:"response_status,omitempty"`
+	Status         *WebhookDeliveryStatus `json:"status,omitempty"`
+	SubscriptionId *openapi_types.UUID    `json:"subscription_id,omitempty"`
+}
+
+// WebhookDeliveryListResponse defines model for WebhookDeliveryListResponse.
+type WebhookDeliveryListResponse struct {
+	Data       *[]WebhookDelivery `json:"data,omitempty"`
+	Pagination *Pagination        `json:"pagination,omitempty"`
+}
+
+// WebhookDeliveryResponse defines model for WebhookDeliveryResponse.
+type WebhookDeliveryResponse struct {
+	Data *WebhookDelivery `json:"data,omitempty"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// WebhookListResponse defines model for WebhookListResponse.
+type WebhookListResponse struct {
+	Data *[]Webhook `json:"data,omitempty"`
+}
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse struct {
+	Data *Webhook `json:"data,omitempty"`
+}
+
+// Weekday defines model for Weekday.
+type Weekday string
+
+// ListAuditEntriesParams defines parameters for ListAuditEntries.
+type ListAuditEntriesParams struct {
+	Page       *int                `form:"page,omitempty" json:"page,omitempty"`
+	Limit      *int                `form:"limit,omitempty" json:"limit,omitempty"`
+	ActorId    *openapi_types.UUID `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+	Action     *string             `form:"action,omitempty" json:"action,omitempty"`
+	EntityType *AuditEntityType    `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+	EntityId   *openapi_types.UUID `form:"entity_id,omitempty" json:"entity_id,omitempty"`
+	From       *time.Time          `form:"from,omitempty" json:"from,omitempty"`
+	To         *time.Time          `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // ListBooksParams defines parameters for ListBooks.
 type ListBooksParams struct {
 	Page  *int `form:"page,omitempty" json:"page,omitempty"`
@@ -187,23 +1009,130 @@ type ListBooksParams struct {
 
 	// Available Filtrar por disponibilidade
 	Available *bool `form:"available,omitempty" json:"available,omitempty"`
-}
 
-// ListLoansParams defines parameters for ListLoans.
-type ListLoansParams struct {
-	Page   *int                   `form:"page,omitempty" json:"page,omitempty"`
+	// BranchId Listar apenas livros com cópias na unidade; com `available`, livros com cópias disponíveis na unidade
+	BranchId *openapi_types.UUID `form:"branch_id,omitempty" json:"branch_id,omitempty"`
+
+	// Q Palavras do título ou do autor, ou o ISBN
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Author Apenas livros cujo autor contém o texto, sem diferenciar maiúsculas nem acentos
+	Author *string `form:"author,omitempty" json:"author,omitempty"`
+
+	// PublishedFrom Apenas livros publicados a partir deste ano
+	PublishedFrom *int `form:"published_from,omitempty" json:"published_from,omitempty"`
+
+	// PublishedTo Apenas livros publicados até este ano
+	PublishedTo *int `form:"published_to,omitempty" json:"published_to,omitempty"`
+
+	// Sort Campos separados por vírgula, cada um precedido de `-` para ordem decrescente, entre `title`, `author`, `published_year` e `created_at` (padrão `title`). Não se combina com `q`.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListBranchClosedDatesParams defines parameters for ListBranchClosedDates.
+type ListBranchClosedDatesParams struct {
+	// From Primeiro dia listado (padrão hoje)
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Último dia listado (padrão sem limite)
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListFinesParams defines parameters for ListFines.
+type ListFinesParams struct {
+	Page   *int                `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int                `form:"limit,omitempty" json:"limit,omitempty"`
+	UserId *openapi_types.UUID `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Status *FineStatus         `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListHoldsParams defines parameters for ListHolds.
+type ListHoldsParams struct {
+	Page   *int                `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int                `form:"limit,omitempty" json:"limit,omitempty"`
+	UserId *openapi_types.UUID `form:"user_id,omitempty" json:"user_id,omitempty"`
+	BookId *openapi_types.UUID `form:"book_id,omitempty" json:"book_id,omitempty"`
+	Status *HoldStatus         `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListLoansParams defines parameters for ListLoans.
+type ListLoansParams struct {
+	Page   *int                   `form:"page,omitempty" json:"page,omitempty"`
 	Limit  *int                   `form:"limit,omitempty" json:"limit,omitempty"`
 	UserId *openapi_types.UUID    `form:"user_id,omitempty" json:"user_id,omitempty"`
 	Status *ListLoansParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	BookId *openapi_types.UUID    `form:"book_id,omitempty" json:"book_id,omitempty"`
+
+	// BorrowedFrom Apenas empréstimos feitos a partir deste instante
+	BorrowedFrom *time.Time `form:"borrowed_from,omitempty" json:"borrowed_from,omitempty"`
+
+	// BorrowedTo Apenas empréstimos feitos até este instante
+	BorrowedTo *time.Time `form:"borrowed_to,omitempty" json:"borrowed_to,omitempty"`
+
+	// ReturnedFrom Apenas empréstimos devolvidos a partir deste instante
+	ReturnedFrom *time.Time `form:"returned_from,omitempty" json:"returned_from,omitempty"`
+
+	// ReturnedTo Apenas empréstimos devolvidos até este instante
+	ReturnedTo *time.Time `form:"returned_to,omitempty" json:"returned_to,omitempty"`
+
+	// DueBefore Apenas empréstimos com vencimento antes deste instante
+	DueBefore *time.Time `form:"due_before,omitempty" json:"due_before,omitempty"`
+
+	// Sort Campos separados por vírgula, cada um precedido de `-` para ordem decrescente, entre `borrowed_at`, `due_date` e `returned_at` (padrão `-borrowed_at`)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // ListLoansParamsStatus defines parameters for ListLoans.
 type ListLoansParamsStatus string
 
+// ListMyLoansParams defines parameters for ListMyLoans.
+type ListMyLoansParams struct {
+	Page   *int                     `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int                     `form:"limit,omitempty" json:"limit,omitempty"`
+	Status *ListMyLoansParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListMyLoansParamsStatus defines parameters for ListMyLoans.
+type ListMyLoansParamsStatus string
+
+// ListTransfersParams defines parameters for ListTransfers.
+type ListTransfersParams struct {
+	Page  *int `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// BranchId Transferências que saem da unidade ou chegam a ela
+	BranchId *openapi_types.UUID `form:"branch_id,omitempty" json:"branch_id,omitempty"`
+	Status   *TransferStatus     `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // ListUsersParams defines parameters for ListUsers.
 type ListUsersParams struct {
 	Page  *int `form:"page,omitempty" json:"page,omitempty"`
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Active Filtrar por usuários ativos ou desativados
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+
+	// Q Apenas usuários cujo nome ou email contém o texto, sem diferenciar maiúsculas
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Sort Campos separados por vírgula, cada um precedido de `-` para ordem decrescente, entre `name`, `email` e `created_at` (padrão `-created_at`)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	Page  *int `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// SuggestBooksParams defines parameters for SuggestBooks.
+type SuggestBooksParams struct {
+	// Prefix Início do título ou do autor, com ao menos 2 caracteres
+	Prefix string `form:"prefix" json:"prefix"`
+
+	// Limit Máximo de títulos e de autores sugeridos
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
@@ -212,17 +1141,89 @@ type LoginJSONRequestBody = LoginRequest
 // CreateBookJSONRequestBody defines body for CreateBook for application/json ContentType.
 type CreateBookJSONRequestBody = CreateBookRequest
 
+// UpdateBookJSONRequestBody defines body for UpdateBook for application/json ContentType.
+type UpdateBookJSONRequestBody = UpdateBookRequest
+
+// CreateBookCopyJSONRequestBody defines body for CreateBookCopy for application/json ContentType.
+type CreateBookCopyJSONRequestBody = CreateBookCopyRequest
+
+// UpdateBookCopyJSONRequestBody defines body for UpdateBookCopy for application/json ContentType.
+type UpdateBookCopyJSONRequestBody = UpdateBookCopyRequest
+
+// CreateBranchJSONRequestBody defines body for CreateBranch for application/json ContentType.
+type CreateBranchJSONRequestBody = CreateBranchRequest
+
+// UpdateBranchJSONRequestBody defines body for UpdateBranch for application/json ContentType.
+type UpdateBranchJSONRequestBody = UpdateBranchRequest
+
+// AddBranchClosedDateJSONRequestBody defines body for AddBranchClosedDate for application/json ContentType.
+type AddBranchClosedDateJSONRequestBody = CreateClosedDateRequest
+
+// SetBranchOpeningHoursJSONRequestBody defines body for SetBranchOpeningHours for application/json ContentType.
+type SetBranchOpeningHoursJSONRequestBody = SetOpeningHoursRequest
+
+// CheckInJSONRequestBody defines body for CheckIn for application/json ContentType.
+type CheckInJSONRequestBody = CheckInRequest
+
+// CheckOutJSONRequestBody defines body for CheckOut for application/json ContentType.
+type CheckOutJSONRequestBody = CheckOutRequest
+
+// PayFineJSONRequestBody defines body for PayFine for application/json ContentType.
+type PayFineJSONRequestBody = PayFineRequest
+
+// PlaceHoldJSONRequestBody defines body for PlaceHold for application/json ContentType.
+type PlaceHoldJSONRequestBody = PlaceHoldRequest
+
+// CreateLoanPolicyJSONRequestBody defines body for CreateLoanPolicy for application/json ContentType.
+type CreateLoanPolicyJSONRequestBody = CreateLoanPolicyRequest
+
+// UpdateLoanPolicyJSONRequestBody defines body for UpdateLoanPolicy for application/json ContentType.
+type UpdateLoanPolicyJSONRequestBody = UpdateLoanPolicyRequest
+
 // BorrowBookJSONRequestBody defines body for BorrowBook for application/json ContentType.
 type BorrowBookJSONRequestBody = BorrowBookRequest
 
+// AdjustLoanDueDatesJSONRequestBody defines body for AdjustLoanDueDates for application/json ContentType.
+type AdjustLoanDueDatesJSONRequestBody = AdjustDueDatesRequest
+
+// DeclareLoanLostJSONRequestBody defines body for DeclareLoanLost for application/json ContentType.
+type DeclareLoanLostJSONRequestBody = DeclareLostRequest
+
+// ReturnBookJSONRequestBody defines body for ReturnBook for application/json ContentType.
+type ReturnBookJSONRequestBody = ReturnBookRequest
+
+// UpdateMeJSONRequestBody defines body for UpdateMe for application/json ContentType.
+type UpdateMeJSONRequestBody = UpdateProfileRequest
+
+// BorrowForMeJSONRequestBody defines body for BorrowForMe for application/json ContentType.
+type BorrowForMeJSONRequestBody = BorrowForMeRequest
+
+// ChangeMyPasswordJSONRequestBody defines body for ChangeMyPassword for application/json ContentType.
+type ChangeMyPasswordJSONRequestBody = ChangePasswordRequest
+
+// RequestTransferJSONRequestBody defines body for RequestTransfer for application/json ContentType.
+type RequestTransferJSONRequestBody = RequestTransferRequest
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = CreateUserRequest
 
 // UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
 type UpdateUserJSONRequestBody = UpdateUserRequest
 
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = CreateWebhookRequest
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = UpdateWebhookRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Listar trilha de auditoria
+	// (GET /audit)
+	ListAuditEntries(c *gin.Context, params ListAuditEntriesParams)
+	// Verificar a cadeia de auditoria
+	// (GET /audit/verify)
+	VerifyAuditChain(c *gin.Context)
 	// Autenticar usuário
 	// (POST /auth/login)
 	Login(c *gin.Context)
@@ -232,21 +1233,168 @@ type ServerInterface interface {
 	// Criar novo livro
 	// (POST /books)
 	CreateBook(c *gin.Context)
+	// Sugerir títulos e autores
+	// (GET /books/suggest)
+	SuggestBooks(c *gin.Context, params SuggestBooksParams)
+	// Remover livro
+	// (DELETE /books/{id})
+	DeleteBook(c *gin.Context, id openapi_types.UUID)
 	// Buscar livro por ID
 	// (GET /books/{id})
 	GetBookById(c *gin.Context, id openapi_types.UUID)
+	// Atualizar livro
+	// (PUT /books/{id})
+	UpdateBook(c *gin.Context, id openapi_types.UUID)
+	// Listar cópias do livro
+	// (GET /books/{id}/copies)
+	ListBookCopies(c *gin.Context, id openapi_types.UUID)
+	// Cadastrar cópia do livro
+	// (POST /books/{id}/copies)
+	CreateBookCopy(c *gin.Context, id openapi_types.UUID)
+	// Remover cópia
+	// (DELETE /books/{id}/copies/{copyId})
+	DeleteBookCopy(c *gin.Context, id openapi_types.UUID, copyId openapi_types.UUID)
+	// Buscar cópia por ID
+	// (GET /books/{id}/copies/{copyId})
+	GetBookCopy(c *gin.Context, id openapi_types.UUID, copyId openapi_types.UUID)
+	// Atualizar cópia
+	// (PUT /books/{id}/copies/{copyId})
+	UpdateBookCopy(c *gin.Context, id openapi_types.UUID, copyId openapi_types.UUID)
+	// Baixar livro do acervo
+	// (PATCH /books/{id}/withdraw)
+	WithdrawBook(c *gin.Context, id openapi_types.UUID)
+	// Listar unidades
+	// (GET /branches)
+	ListBranches(c *gin.Context)
+	// Criar unidade
+	// (POST /branches)
+	CreateBranch(c *gin.Context)
+	// Remover unidade
+	// (DELETE /branches/{id})
+	DeleteBranch(c *gin.Context, id openapi_types.UUID)
+	// Buscar unidade por ID
+	// (GET /branches/{id})
+	GetBranchById(c *gin.Context, id openapi_types.UUID)
+	// Atualizar unidade
+	// (PUT /branches/{id})
+	UpdateBranch(c *gin.Context, id openapi_types.UUID)
+	// Listar dias sem expediente
+	// (GET /branches/{id}/closed-dates)
+	ListBranchClosedDates(c *gin.Context, id openapi_types.UUID, params ListBranchClosedDatesParams)
+	// Cadastrar dia sem expediente
+	// (POST /branches/{id}/closed-dates)
+	AddBranchClosedDate(c *gin.Context, id openapi_types.UUID)
+	// Remover dia sem expediente
+	// (DELETE /branches/{id}/closed-dates/{closedDateId})
+	RemoveBranchClosedDate(c *gin.Context, id openapi_types.UUID, closedDateId openapi_types.UUID)
+	// Consultar horário de funcionamento
+	// (GET /branches/{id}/hours)
+	GetBranchOpeningHours(c *gin.Context, id openapi_types.UUID)
+	// Definir horário de funcionamento
+	// (PUT /branches/{id}/hours)
+	SetBranchOpeningHours(c *gin.Context, id openapi_types.UUID)
+	// Devolver cópia no balcão
+	// (POST /circulation/checkin)
+	CheckIn(c *gin.Context)
+	// Emprestar cópia no balcão
+	// (POST /circulation/checkout)
+	CheckOut(c *gin.Context)
+	// Listar multas
+	// (GET /fines)
+	ListFines(c *gin.Context, params ListFinesParams)
+	// Buscar multa por ID
+	// (GET /fines/{id})
+	GetFineById(c *gin.Context, id openapi_types.UUID)
+	// Registrar pagamento de multa
+	// (POST /fines/{id}/payments)
+	PayFine(c *gin.Context, id openapi_types.UUID)
+	// Perdoar multa
+	// (PATCH /fines/{id}/waive)
+	WaiveFine(c *gin.Context, id openapi_types.UUID)
 	// Exemplo de novo handler
 	// (GET /hello-world)
 	MyHelloWorld(c *gin.Context)
+	// Listar reservas
+	// (GET /holds)
+	ListHolds(c *gin.Context, params ListHoldsParams)
+	// Reservar livro indisponível
+	// (POST /holds)
+	PlaceHold(c *gin.Context)
+	// Cancelar reserva
+	// (DELETE /holds/{id})
+	CancelHold(c *gin.Context, id openapi_types.UUID)
+	// Buscar reserva por ID
+	// (GET /holds/{id})
+	GetHoldById(c *gin.Context, id openapi_types.UUID)
+	// Listar políticas de empréstimo
+	// (GET /loan-policies)
+	ListLoanPolicies(c *gin.Context)
+	// Criar política de empréstimo
+	// (POST /loan-policies)
+	CreateLoanPolicy(c *gin.Context)
+	// Remover política de empréstimo
+	// (DELETE /loan-policies/{id})
+	DeleteLoanPolicy(c *gin.Context, id openapi_types.UUID)
+	// Buscar política de empréstimo por ID
+	// (GET /loan-policies/{id})
+	GetLoanPolicyById(c *gin.Context, id openapi_types.UUID)
+	// Atualizar política de empréstimo
+	// (PUT /loan-policies/{id})
+	UpdateLoanPolicy(c *gin.Context, id openapi_types.UUID)
 	// Listar empréstimos
 	// (GET /loans)
 	ListLoans(c *gin.Context, params ListLoansParams)
 	// Emprestar livro para usuário
 	// (POST /loans/borrow)
 	BorrowBook(c *gin.Context)
+	// Ajustar vencimentos em massa
+	// (POST /loans/due-date-adjustments)
+	AdjustLoanDueDates(c *gin.Context)
+	// Histórico de cobrança do empréstimo
+	// (GET /loans/{id}/escalations)
+	ListLoanEscalations(c *gin.Context, id openapi_types.UUID)
+	// Declarar livro perdido
+	// (PATCH /loans/{id}/lost)
+	DeclareLoanLost(c *gin.Context, id openapi_types.UUID)
+	// Renovar empréstimo
+	// (PATCH /loans/{id}/renew)
+	RenewLoan(c *gin.Context, id openapi_types.UUID)
 	// Devolver livro
 	// (PATCH /loans/{id}/return)
 	ReturnBook(c *gin.Context, id openapi_types.UUID)
+	// Consultar o próprio perfil
+	// (GET /me)
+	GetMe(c *gin.Context)
+	// Atualizar o próprio perfil
+	// (PATCH /me)
+	UpdateMe(c *gin.Context)
+	// Listar os próprios empréstimos
+	// (GET /me/loans)
+	ListMyLoans(c *gin.Context, params ListMyLoansParams)
+	// Emprestar livro para si mesmo
+	// (POST /me/loans)
+	BorrowForMe(c *gin.Context)
+	// Alterar a própria senha
+	// (POST /me/password)
+	ChangeMyPassword(c *gin.Context)
+	// Listar transferências
+	// (GET /transfers)
+	ListTransfers(c *gin.Context, params ListTransfersParams)
+	// Solicitar transferência de cópia
+	// (POST /transfers)
+	RequestTransfer(c *gin.Context)
+	// Buscar transferência por ID
+	// (GET /transfers/{id})
+	GetTransferById(c *gin.Context, id openapi_types.UUID)
+	// Cancelar transferência
+	// (PATCH /transfers/{id}/cancel)
+	CancelTransfer(c *gin.Context, id openapi_types.UUID)
+	// Receber cópia
+	// (PATCH /transfers/{id}/receive)
+	ReceiveTransfer(c *gin.Context, id openapi_types.UUID)
+	// Enviar cópia
+	// (PATCH /transfers/{id}/ship)
+	ShipTransfer(c *gin.Context, id openapi_types.UUID)
 	// Listar todos os usuários
 	// (GET /users)
 	ListUsers(c *gin.Context, params ListUsersParams)
@@ -262,6 +1410,30 @@ type ServerInterface interface {
 	// Desabilitar usuário
 	// (PATCH /users/{id}/disable)
 	DisableUser(c *gin.Context, id openapi_types.UUID)
+	// Desbloquear usuário
+	// (PATCH /users/{id}/unblock)
+	UnblockUser(c *gin.Context, id openapi_types.UUID)
+	// Listar assinaturas de webhook
+	// (GET /webhooks)
+	ListWebhooks(c *gin.Context)
+	// Criar assinatura de webhook
+	// (POST /webhooks)
+	CreateWebhook(c *gin.Context)
+	// Remover assinatura de webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(c *gin.Context, id openapi_types.UUID)
+	// Buscar assinatura de webhook por ID
+	// (GET /webhooks/{id})
+	GetWebhookById(c *gin.Context, id openapi_types.UUID)
+	// Atualizar assinatura de webhook
+	// (PUT /webhooks/{id})
+	UpdateWebhook(c *gin.Context, id openapi_types.UUID)
+	// Listar entregas da assinatura
+	// (GET /webhooks/{id}/deliveries)
+	ListWebhookDeliveries(c *gin.Context, id openapi_types.UUID, params ListWebhookDeliveriesParams)
+	// Reenviar entrega
+	// (POST /webhooks/{id}/deliveries/{deliveryId}/redeliver)
+	RedeliverWebhook(c *gin.Context, id openapi_types.UUID, deliveryId openapi_types.UUID)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -273,6 +1445,105 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// ListAuditEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditEntries(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEntriesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "actor_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", c.Request.URL.Query(), &params.ActorId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "entity_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_type", c.Request.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter entity_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "entity_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_id", c.Request.URL.Query(), &params.EntityId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter entity_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListAuditEntries(c, params)
+}
+
+// VerifyAuditChain operation middleware
+func (siw *ServerInterfaceWrapper) VerifyAuditChain(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.VerifyAuditChain(c)
+}
+
 // Login operation middleware
 func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
 
@@ -283,42 +1554,1522 @@ func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.Login(c)
+	siw.Handler.Login(c)
+}
+
+// ListBooks operation middleware
+func (siw *ServerInterfaceWrapper) ListBooks(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBooksParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "available" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "available", c.Request.URL.Query(), &params.Available)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter available: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "branch_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch_id", c.Request.URL.Query(), &params.BranchId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter branch_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "author" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author", c.Request.URL.Query(), &params.Author)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter author: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "published_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "published_from", c.Request.URL.Query(), &params.PublishedFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter published_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "published_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "published_to", c.Request.URL.Query(), &params.PublishedTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter published_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListBooks(c, params)
+}
+
+// CreateBook operation middleware
+func (siw *ServerInterfaceWrapper) CreateBook(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateBook(c)
+}
+
+// SuggestBooks operation middleware
+func (siw *ServerInterfaceWrapper) SuggestBooks(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SuggestBooksParams
+
+	// ------------- Required query parameter "prefix" -------------
+
+	if paramValue := c.Query("prefix"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument prefix is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "prefix", c.Request.URL.Query(), &params.Prefix)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter prefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SuggestBooks(c, params)
+}
+
+// DeleteBook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteBook(c, id)
+}
+
+// GetBookById operation middleware
+func (siw *ServerInterfaceWrapper) GetBookById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetBookById(c, id)
+}
+
+// UpdateBook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateBook(c, id)
+}
+
+// ListBookCopies operation middleware
+func (siw *ServerInterfaceWrapper) ListBookCopies(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListBookCopies(c, id)
+}
+
+// CreateBookCopy operation middleware
+func (siw *ServerInterfaceWrapper) CreateBookCopy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateBookCopy(c, id)
+}
+
+// DeleteBookCopy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBookCopy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "copyId" -------------
+	var copyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "copyId", c.Param("copyId"), &copyId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter copyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteBookCopy(c, id, copyId)
+}
+
+// GetBookCopy operation middleware
+func (siw *ServerInterfaceWrapper) GetBookCopy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "copyId" -------------
+	var copyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "copyId", c.Param("copyId"), &copyId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter copyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetBookCopy(c, id, copyId)
+}
+
+// UpdateBookCopy operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBookCopy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "copyId" -------------
+	var copyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "copyId", c.Param("copyId"), &copyId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter copyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateBookCopy(c, id, copyId)
+}
+
+// WithdrawBook operation middleware
+func (siw *ServerInterfaceWrapper) WithdrawBook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.WithdrawBook(c, id)
+}
+
+// ListBranches operation middleware
+func (siw *ServerInterfaceWrapper) ListBranches(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListBranches(c)
+}
+
+// CreateBranch operation middleware
+func (siw *ServerInterfaceWrapper) CreateBranch(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateBranch(c)
+}
+
+// DeleteBranch operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBranch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteBranch(c, id)
+}
+
+// GetBranchById operation middleware
+func (siw *ServerInterfaceWrapper) GetBranchById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetBranchById(c, id)
+}
+
+// UpdateBranch operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBranch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateBranch(c, id)
+}
+
+// ListBranchClosedDates operation middleware
+func (siw *ServerInterfaceWrapper) ListBranchClosedDates(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBranchClosedDatesParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListBranchClosedDates(c, id, params)
+}
+
+// AddBranchClosedDate operation middleware
+func (siw *ServerInterfaceWrapper) AddBranchClosedDate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddBranchClosedDate(c, id)
+}
+
+// RemoveBranchClosedDate operation middleware
+func (siw *ServerInterfaceWrapper) RemoveBranchClosedDate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "closedDateId" -------------
+	var closedDateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "closedDateId", c.Param("closedDateId"), &closedDateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter closedDateId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveBranchClosedDate(c, id, closedDateId)
+}
+
+// GetBranchOpeningHours operation middleware
+func (siw *ServerInterfaceWrapper) GetBranchOpeningHours(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetBranchOpeningHours(c, id)
+}
+
+// SetBranchOpeningHours operation middleware
+func (siw *ServerInterfaceWrapper) SetBranchOpeningHours(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetBranchOpeningHours(c, id)
+}
+
+// CheckIn operation middleware
+func (siw *ServerInterfaceWrapper) CheckIn(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CheckIn(c)
+}
+
+// CheckOut operation middleware
+func (siw *ServerInterfaceWrapper) CheckOut(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CheckOut(c)
+}
+
+// ListFines operation middleware
+func (siw *ServerInterfaceWrapper) ListFines(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFinesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListFines(c, params)
+}
+
+// GetFineById operation middleware
+func (siw *ServerInterfaceWrapper) GetFineById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetFineById(c, id)
+}
+
+// PayFine operation middleware
+func (siw *ServerInterfaceWrapper) PayFine(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PayFine(c, id)
+}
+
+// WaiveFine operation middleware
+func (siw *ServerInterfaceWrapper) WaiveFine(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.WaiveFine(c, id)
+}
+
+// MyHelloWorld operation middleware
+func (siw *ServerInterfaceWrapper) MyHelloWorld(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MyHelloWorld(c)
+}
+
+// ListHolds operation middleware
+func (siw *ServerInterfaceWrapper) ListHolds(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListHoldsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "book_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "book_id", c.Request.URL.Query(), &params.BookId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter book_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListHolds(c, params)
+}
+
+// PlaceHold operation middleware
+func (siw *ServerInterfaceWrapper) PlaceHold(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PlaceHold(c)
+}
+
+// CancelHold operation middleware
+func (siw *ServerInterfaceWrapper) CancelHold(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CancelHold(c, id)
+}
+
+// GetHoldById operation middleware
+func (siw *ServerInterfaceWrapper) GetHoldById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHoldById(c, id)
+}
+
+// ListLoanPolicies operation middleware
+func (siw *ServerInterfaceWrapper) ListLoanPolicies(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLoanPolicies(c)
+}
+
+// CreateLoanPolicy operation middleware
+func (siw *ServerInterfaceWrapper) CreateLoanPolicy(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateLoanPolicy(c)
+}
+
+// DeleteLoanPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLoanPolicy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteLoanPolicy(c, id)
+}
+
+// GetLoanPolicyById operation middleware
+func (siw *ServerInterfaceWrapper) GetLoanPolicyById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLoanPolicyById(c, id)
+}
+
+// UpdateLoanPolicy operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLoanPolicy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateLoanPolicy(c, id)
+}
+
+// ListLoans operation middleware
+func (siw *ServerInterfaceWrapper) ListLoans(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLoansParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "book_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "book_id", c.Request.URL.Query(), &params.BookId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter book_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "borrowed_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "borrowed_from", c.Request.URL.Query(), &params.BorrowedFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter borrowed_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "borrowed_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "borrowed_to", c.Request.URL.Query(), &params.BorrowedTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter borrowed_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "returned_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "returned_from", c.Request.URL.Query(), &params.ReturnedFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter returned_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "returned_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "returned_to", c.Request.URL.Query(), &params.ReturnedTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter returned_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "due_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_before", c.Request.URL.Query(), &params.DueBefore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter due_before: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLoans(c, params)
+}
+
+// BorrowBook operation middleware
+func (siw *ServerInterfaceWrapper) BorrowBook(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BorrowBook(c)
+}
+
+// AdjustLoanDueDates operation middleware
+func (siw *ServerInterfaceWrapper) AdjustLoanDueDates(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AdjustLoanDueDates(c)
+}
+
+// ListLoanEscalations operation middleware
+func (siw *ServerInterfaceWrapper) ListLoanEscalations(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLoanEscalations(c, id)
+}
+
+// DeclareLoanLost operation middleware
+func (siw *ServerInterfaceWrapper) DeclareLoanLost(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeclareLoanLost(c, id)
+}
+
+// RenewLoan operation middleware
+func (siw *ServerInterfaceWrapper) RenewLoan(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RenewLoan(c, id)
+}
+
+// ReturnBook operation middleware
+func (siw *ServerInterfaceWrapper) ReturnBook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReturnBook(c, id)
+}
+
+// GetMe operation middleware
+func (siw *ServerInterfaceWrapper) GetMe(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMe(c)
+}
+
+// UpdateMe operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMe(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateMe(c)
+}
+
+// ListMyLoans operation middleware
+func (siw *ServerInterfaceWrapper) ListMyLoans(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMyLoansParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListMyLoans(c, params)
+}
+
+// BorrowForMe operation middleware
+func (siw *ServerInterfaceWrapper) BorrowForMe(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BorrowForMe(c)
+}
+
+// ChangeMyPassword operation middleware
+func (siw *ServerInterfaceWrapper) ChangeMyPassword(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ChangeMyPassword(c)
+}
+
+// ListTransfers operation middleware
+func (siw *ServerInterfaceWrapper) ListTransfers(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTransfersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "branch_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch_id", c.Request.URL.Query(), &params.BranchId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter branch_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListTransfers(c, params)
 }
 
-// ListBooks operation middleware
-func (siw *ServerInterfaceWrapper) ListBooks(c *gin.Context) {
+// RequestTransfer operation middleware
+func (siw *ServerInterfaceWrapper) RequestTransfer(c *gin.Context) {
 
-	var err error
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
 
-	c.Set(BearerAuthScopes, []string{})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListBooksParams
+	siw.Handler.RequestTransfer(c)
+}
 
-	// ------------- Optional query parameter "page" -------------
+// GetTransferById operation middleware
+func (siw *ServerInterfaceWrapper) GetTransferById(c *gin.Context) {
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
-	}
+	var err error
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "available" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "available", c.Request.URL.Query(), &params.Available)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter available: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -327,13 +3078,24 @@ func (siw *ServerInterfaceWrapper) ListBooks(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListBooks(c, params)
+	siw.Handler.GetTransferById(c, id)
 }
 
-// CreateBook operation middleware
-func (siw *ServerInterfaceWrapper) CreateBook(c *gin.Context) {
+// CancelTransfer operation middleware
+func (siw *ServerInterfaceWrapper) CancelTransfer(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -342,11 +3104,11 @@ func (siw *ServerInterfaceWrapper) CreateBook(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateBook(c)
+	siw.Handler.CancelTransfer(c, id)
 }
 
-// GetBookById operation middleware
-func (siw *ServerInterfaceWrapper) GetBookById(c *gin.Context) {
+// ReceiveTransfer operation middleware
+func (siw *ServerInterfaceWrapper) ReceiveTransfer(c *gin.Context) {
 
 	var err error
 
@@ -359,7 +3121,7 @@ func (siw *ServerInterfaceWrapper) GetBookById(c *gin.Context) {
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -368,11 +3130,24 @@ func (siw *ServerInterfaceWrapper) GetBookById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetBookById(c, id)
+	siw.Handler.ReceiveTransfer(c, id)
 }
 
-// MyHelloWorld operation middleware
-func (siw *ServerInterfaceWrapper) MyHelloWorld(c *gin.Context) {
+// ShipTransfer operation middleware
+func (siw *ServerInterfaceWrapper) ShipTransfer(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -381,18 +3156,18 @@ func (siw *ServerInterfaceWrapper) MyHelloWorld(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.MyHelloWorld(c)
+	siw.Handler.ShipTransfer(c, id)
 }
 
-// ListLoans operation middleware
-func (siw *ServerInterfaceWrapper) ListLoans(c *gin.Context) {
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLoansParams
+	var params ListUsersParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -410,19 +3185,27 @@ func (siw *ServerInterfaceWrapper) ListLoans(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "user_id" -------------
+	// ------------- Optional query parameter "active" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	err = runtime.BindQueryParameter("form", true, false, "active", c.Request.URL.Query(), &params.Active)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter active: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Optional query parameter "q" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "q", c.Request.URL.Query(), &params.Q)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -433,13 +3216,13 @@ func (siw *ServerInterfaceWrapper) ListLoans(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListLoans(c, params)
+	siw.Handler.ListUsers(c, params)
 }
 
-// BorrowBook operation middleware
-func (siw *ServerInterfaceWrapper) BorrowBook(c *gin.Context) {
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	c.Set(BearerAuthScopes, []string{"admin"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -448,11 +3231,11 @@ func (siw *ServerInterfaceWrapper) BorrowBook(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.BorrowBook(c)
+	siw.Handler.CreateUser(c)
 }
 
-// ReturnBook operation middleware
-func (siw *ServerInterfaceWrapper) ReturnBook(c *gin.Context) {
+// GetUserById operation middleware
+func (siw *ServerInterfaceWrapper) GetUserById(c *gin.Context) {
 
 	var err error
 
@@ -474,35 +3257,77 @@ func (siw *ServerInterfaceWrapper) ReturnBook(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ReturnBook(c, id)
+	siw.Handler.GetUserById(c, id)
 }
 
-// ListUsers operation middleware
-func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
+// UpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListUsersParams
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Optional query parameter "page" -------------
+	siw.Handler.UpdateUser(c, id)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+// DisableUser operation middleware
+func (siw *ServerInterfaceWrapper) DisableUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	c.Set(BearerAuthScopes, []string{"admin"})
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DisableUser(c, id)
+}
+
+// UnblockUser operation middleware
+func (siw *ServerInterfaceWrapper) UnblockUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{"admin", "librarian"})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -510,13 +3335,13 @@ func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListUsers(c, params)
+	siw.Handler.UnblockUser(c, id)
 }
 
-// CreateUser operation middleware
-func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	c.Set(BearerAuthScopes, []string{"admin"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -525,11 +3350,26 @@ func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateUser(c)
+	siw.Handler.ListWebhooks(c)
 }
 
-// GetUserById operation middleware
-func (siw *ServerInterfaceWrapper) GetUserById(c *gin.Context) {
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateWebhook(c)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(c *gin.Context) {
 
 	var err error
 
@@ -542,7 +3382,7 @@ func (siw *ServerInterfaceWrapper) GetUserById(c *gin.Context) {
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	c.Set(BearerAuthScopes, []string{"admin"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -551,11 +3391,11 @@ func (siw *ServerInterfaceWrapper) GetUserById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUserById(c, id)
+	siw.Handler.DeleteWebhook(c, id)
 }
 
-// UpdateUser operation middleware
-func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
+// GetWebhookById operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookById(c *gin.Context) {
 
 	var err error
 
@@ -568,7 +3408,7 @@ func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	c.Set(BearerAuthScopes, []string{"admin"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -577,11 +3417,11 @@ func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateUser(c, id)
+	siw.Handler.GetWebhookById(c, id)
 }
 
-// DisableUser operation middleware
-func (siw *ServerInterfaceWrapper) DisableUser(c *gin.Context) {
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(c *gin.Context) {
 
 	var err error
 
@@ -594,7 +3434,7 @@ func (siw *ServerInterfaceWrapper) DisableUser(c *gin.Context) {
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	c.Set(BearerAuthScopes, []string{"admin"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -603,7 +3443,87 @@ func (siw *ServerInterfaceWrapper) DisableUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DisableUser(c, id)
+	siw.Handler.UpdateWebhook(c, id)
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListWebhookDeliveries(c, id, params)
+}
+
+// RedeliverWebhook operation middleware
+func (siw *ServerInterfaceWrapper) RedeliverWebhook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", c.Param("deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter deliveryId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RedeliverWebhook(c, id, deliveryId)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -633,59 +3553,293 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/audit", wrapper.ListAuditEntries)
+	router.GET(options.BaseURL+"/audit/verify", wrapper.VerifyAuditChain)
 	router.POST(options.BaseURL+"/auth/login", wrapper.Login)
 	router.GET(options.BaseURL+"/books", wrapper.ListBooks)
 	router.POST(options.BaseURL+"/books", wrapper.CreateBook)
+	router.GET(options.BaseURL+"/books/suggest", wrapper.SuggestBooks)
+	router.DELETE(options.BaseURL+"/books/:id", wrapper.DeleteBook)
 	router.GET(options.BaseURL+"/books/:id", wrapper.GetBookById)
+	router.PUT(options.BaseURL+"/books/:id", wrapper.UpdateBook)
+	router.GET(options.BaseURL+"/books/:id/copies", wrapper.ListBookCopies)
+	router.POST(options.BaseURL+"/books/:id/copies", wrapper.CreateBookCopy)
+	router.DELETE(options.BaseURL+"/books/:id/copies/:copyId", wrapper.DeleteBookCopy)
+	router.GET(options.BaseURL+"/books/:id/copies/:copyId", wrapper.GetBookCopy)
+	router.PUT(options.BaseURL+"/books/:id/copies/:copyId", wrapper.UpdateBookCopy)
+	router.PATCH(options.BaseURL+"/books/:id/withdraw", wrapper.WithdrawBook)
+	router.GET(options.BaseURL+"/branches", wrapper.ListBranches)
+	router.POST(options.BaseURL+"/branches", wrapper.CreateBranch)
+	router.DELETE(options.BaseURL+"/branches/:id", wrapper.DeleteBranch)
+	router.GET(options.BaseURL+"/branches/:id", wrapper.GetBranchById)
+	router.PUT(options.BaseURL+"/branches/:id", wrapper.UpdateBranch)
+	router.GET(options.BaseURL+"/branches/:id/closed-dates", wrapper.ListBranchClosedDates)
+	router.POST(options.BaseURL+"/branches/:id/closed-dates", wrapper.AddBranchClosedDate)
+	router.DELETE(options.BaseURL+"/branches/:id/closed-dates/:closedDateId", wrapper.RemoveBranchClosedDate)
+	router.GET(options.BaseURL+"/branches/:id/hours", wrapper.GetBranchOpeningHours)
+	router.PUT(options.BaseURL+"/branches/:id/hours", wrapper.SetBranchOpeningHours)
+	router.POST(options.BaseURL+"/circulation/checkin", wrapper.CheckIn)
+	router.POST(options.BaseURL+"/circulation/checkout", wrapper.CheckOut)
+	router.GET(options.BaseURL+"/fines", wrapper.ListFines)
+	router.GET(options.BaseURL+"/fines/:id", wrapper.GetFineById)
+	router.POST(options.BaseURL+"/fines/:id/payments", wrapper.PayFine)
+	router.PATCH(options.BaseURL+"/fines/:id/waive", wrapper.WaiveFine)
 	router.GET(options.BaseURL+"/hello-world", wrapper.MyHelloWorld)
+	router.GET(options.BaseURL+"/holds", wrapper.ListHolds)
+	router.POST(options.BaseURL+"/holds", wrapper.PlaceHold)
+	router.DELETE(options.BaseURL+"/holds/:id", wrapper.CancelHold)
+	router.GET(options.BaseURL+"/holds/:id", wrapper.GetHoldById)
+	router.GET(options.BaseURL+"/loan-policies", wrapper.ListLoanPolicies)
+	router.POST(options.BaseURL+"/loan-policies", wrapper.CreateLoanPolicy)
+	router.DELETE(options.BaseURL+"/loan-policies/:id", wrapper.DeleteLoanPolicy)
+	router.GET(options.BaseURL+"/loan-policies/:id", wrapper.GetLoanPolicyById)
+	router.PUT(options.BaseURL+"/loan-policies/:id", wrapper.UpdateLoanPolicy)
 	router.GET(options.BaseURL+"/loans", wrapper.ListLoans)
 	router.POST(options.BaseURL+"/loans/borrow", wrapper.BorrowBook)
+	router.POST(options.BaseURL+"/loans/due-date-adjustments", wrapper.AdjustLoanDueDates)
+	router.GET(options.BaseURL+"/loans/:id/escalations", wrapper.ListLoanEscalations)
+	router.PATCH(options.BaseURL+"/loans/:id/lost", wrapper.DeclareLoanLost)
+	router.PATCH(options.BaseURL+"/loans/:id/renew", wrapper.RenewLoan)
 	router.PATCH(options.BaseURL+"/loans/:id/return", wrapper.ReturnBook)
+	router.GET(options.BaseURL+"/me", wrapper.GetMe)
+	router.PATCH(options.BaseURL+"/me", wrapper.UpdateMe)
+	router.GET(options.BaseURL+"/me/loans", wrapper.ListMyLoans)
+	router.POST(options.BaseURL+"/me/loans", wrapper.BorrowForMe)
+	router.POST(options.BaseURL+"/me/password", wrapper.ChangeMyPassword)
+	router.GET(options.BaseURL+"/transfers", wrapper.ListTransfers)
+	router.POST(options.BaseURL+"/transfers", wrapper.RequestTransfer)
+	router.GET(options.BaseURL+"/transfers/:id", wrapper.GetTransferById)
+	router.PATCH(options.BaseURL+"/transfers/:id/cancel", wrapper.CancelTransfer)
+	router.PATCH(options.BaseURL+"/transfers/:id/receive", wrapper.ReceiveTransfer)
+	router.PATCH(options.BaseURL+"/transfers/:id/ship", wrapper.ShipTransfer)
 	router.GET(options.BaseURL+"/users", wrapper.ListUsers)
 	router.POST(options.BaseURL+"/users", wrapper.CreateUser)
 	router.GET(options.BaseURL+"/users/:id", wrapper.GetUserById)
 	router.PUT(options.BaseURL+"/users/:id", wrapper.UpdateUser)
 	router.PATCH(options.BaseURL+"/users/:id/disable", wrapper.DisableUser)
+	router.PATCH(options.BaseURL+"/users/:id/unblock", wrapper.UnblockUser)
+	router.GET(options.BaseURL+"/webhooks", wrapper.ListWebhooks)
+	router.POST(options.BaseURL+"/webhooks", wrapper.CreateWebhook)
+	router.DELETE(options.BaseURL+"/webhooks/:id", wrapper.DeleteWebhook)
+	router.GET(options.BaseURL+"/webhooks/:id", wrapper.GetWebhookById)
+	router.PUT(options.BaseURL+"/webhooks/:id", wrapper.UpdateWebhook)
+	router.GET(options.BaseURL+"/webhooks/:id/deliveries", wrapper.ListWebhookDeliveries)
+	router.POST(options.BaseURL+"/webhooks/:id/deliveries/:deliveryId/redeliver", wrapper.RedeliverWebhook)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xazW4bORJ+FYI7h11Atlq2M+vRaRLbM+NBMgicGHMIvEapWZGYdJMdkq1Ea+hhBntY",
-	"zGFP+wh+sQXJVv9IbP3EkmLvyVaLXSxWffXVD3VHY5lmUqAwmvbvqI5HmIL794WUH+3fTMkMleHonkJu",
-	"RlLZ/8wkQ9qn2iguhnTaoTAGnsCAJ9xMbrUBk7s3GOpY8cxwKWifnnOdSXH/nzEmRObkUrDagwNiJANN",
-	"QJP4/r8ZB00wzRRqAww07bTumeBtLLNCxWIRFwaHqOyqWCEYZLdg7PfvpUrtf5SBwQPDUwxJ5qyxNs85",
-	"Cy7TAxG0RpYPEq5HyG4nCCqsl+EmweDbRhpIlp4pz9iGZ5qWT+TgA8bGSrFOfsm1uULrBY2LDmdgwP7l",
-	"BlP34DuF72mf/qVbIadbwKbrMFPtA0rBxBkDhlyAh8ByCa+rla0Kr1Z2tY5h2UrJz36HTzlqs7jBQMqP",
-	"t2tCg+V4a90RCAIwQBgShmOZ5Pf/vv+XJJnCMdcGyF8zYMo+6Z0QxkH/jXaa7g3tlWtU6+k17VCFn3Ku",
-	"kNH+u/LFTnm0m4BlzlwALbVMRQz4BdLM4ppeyQEqQ84OyStQhgvaoSl8eYliaEa034uiDk25KD8vCa9K",
-	"5g9/P416x0fHz6LT0xNqoWUMKmvVf7yLDn64uetFnd7x9LuQlRZjspR7FEWnTjue5intH82U8x97kf24",
-	"JIAr/c4SBEHOJMPmaY/WOO181JdSn9V1WVRkzqdeq87MJYUV58S3u/lao2p1M6bAk+aJP0iQP7rnh7FM",
-	"62j1iwMHFZDOme1XaSH/hidjWI6S45BfQevPUrGmSI1iBL2j47pG5cqGzO9XRYnTt1Oep5QSMuKFUlK1",
-	"U1RskRGifIYGeKIbXLuIkDliRbtZYGWI3n7BJJG/S5Wwdu3aMlIQYaHTv5QgHkabbm17Zhw4kt4wmdep",
-	"eKvpX6HJlViujcgTV6LQvlE5BoRUtRIKG+HvKMSGj+27M/k1W38N6RdrZ2G3BlisH7dYGDhY7LQwsDs8",
-	"rDDwOoZlD7nYhBKBpVz8aJE8ygdrs2KYxnpHxyfPvt8Cia3FXsVR2+yIXzKuUG8UfEZ+xHCNbEG5yis2",
-	"G4W98gq1hiG2K5v6BWsi/nUDik1JCU+5CdfhWXOHenFg0+2Sr27tq8HqPqTetav318vN6yfgjRJtUK3C",
-	"hXPFoOev6mgDKW1d9LXN2AYnW5MPW6hwW32VNcsW6dMHwS7p0wPrIfTZFqg2wWGcK24mb+zSoiJAUKie",
-	"5xZps08/zYz96+9vacfPIhx43LeV4UfGZHRqBXPxXvqiShiITS0IZo/mSNi73XWRv+QD8hYh9bVXvT17",
-	"/vqSXF28eUsyUECGqFDEHFIURtquDdNM3f+pDU+ltp8TPlbSTSd80VJKf/76knboGJX2cnuH0WFkt5MZ",
-	"Csg47dPjw+jw2HcxI2eXri3au4llYecG6QPdOsN575LRvidp6tkdtXkh2WRmBRRuPWRZwmP3RveD9vDw",
-	"jlqdBWu5btrMIbZ+cQ88TpzCR1G07b0LFLrNm55xC8gA0wOdx8g4k9acJ1Fvayo0q/eACmcKmcMD14SL",
-	"8f0fCWegPczzNAU1sQjKrSY8BkVynd//obi0+IChdtWdRf2NfaNr0enMOMSQn7l1rl1hEaIgRYPKirij",
-	"Fh70U45qUqHapaJO7aAM30OemJaeMSzEp7qwlCgspmmgn3hiFCiSSUX8cI8PuDWS64hDW5ZDvMa285lj",
-	"erND5C3MwULgc+OZKuD3jbzfbIcMuZGK/xMs8mvM6jBR59R3N9ZeFSKd8ooYyaQmUtdIqwClR+KNTSpB",
-	"zqlGQDsinsUZ01rs09sqBpb7f6wkiRUHJkksU2I5SOuCgqL9AeEcrBNn5CP1Zkg4UxwUEXIsPQgCGCiZ",
-	"qXvH2bSVnn5Gx04vJpeshaBsWqvi3FViTX/WA37V4HLX8b/a9yjsZgpmaedkfz73CghLAXUtNnH8i1zb",
-	"hOSc7tj58rzF9yNMEnnwWaqEtTr/1aSaJ9EdeiYwtQqY5wqNVAJIisK2fKml6YEETcZcMNCHc/n54gum",
-	"WeKqORcIIxAs8SVmYQ4hx1BYI5Eglufol27Fo87RITHV7H/9IGwRVYyw6pI2mWXtNLYXRlnLcnu9uP+q",
-	"DNsQUOHJg6gGqK4fZLYX+dVt1I4S7uJ1154TbmNkF3DKRWVKohASV/Z808zrSrD7P0kmtfZX1oVeDb9/",
-	"o+Qg87LZeFiiuCju3MtcYdvgQB+ziGlbLnR9nDtgg4lHi8i+cgsKZD/psmEVgr1j3DXvmD867Hq9UJFZ",
-	"Jbhv3NYD/EGIPZ+dZL6irUPUprvlefzarXi8eXyXUF6YWC7LkjMyeOJNcHWMCjEeJav6YDfs3GUfXB/0",
-	"7zktN0bBARdcz5LMo2yFnxIea614IL3OkFhy18pu3Hru/6EbXxuB37Ahv95KqVX05GXdttCW1+goDzi9",
-	"uhXcm8+3z3iLV5t7vndYG29g8qINeSRM9+QA/7ywoFqf8bqMa//7mdaO4tyv2Gsc7AiL879qWOYJhtr/",
-	"3vrpEuB5eYTliHAy1Xjm0/lrwRhcO4OJzFIUhvi1tENzlRQ3xv1uN7HrRlKb/ml0GnUh491xj1qFiv3m",
-	"BZf3eO43urUbK3uWxauvn+eviusFZm3optd5t7yhKV70A9rFFy/arqNnTYZrgRbf+02OgcRgcCgVd1V9",
-	"MQWtveumoNOb6f8CAAD//ytCaaYnMAAA",
+	"H4sIAAAAAAAC/+y93XLbRpowfCtd/PbAnqJkyY6zGedkFdkZeyuOtbaz2fomfsUm8IjsBEDD3Q3aStYX",
+	"8N7CHm1mD6Y8VTlK7cmc8sbeevoHaAANEhRJ0dLwSCIJ9O/z//vLIOJpzjPIlBw8+mUgoymkVP97Ev9Y",
+	"SPW4gMdUgXwJbwuQCn/IBc9BKAb6sTHnP52zGP+NQUaC5YrxbPBocJJDRiWBNBfzj1KxlEsSg1RAEjYT",
+	"fDAcXHCRUjV4NCgKFg+GA3WZw+DRQCrBssngw3AwFjSLpiuMTqL57zmjZiJKiozFNIY+U8UFnF8InrZn",
+	"OhMsBSY4iRnFKWaQRSyFTHFCL0DRuLaVmCroGl/x9ujz/0pw8esNnsG7c5xA/96a4ls+C40fA1E85pLw",
+	"xjHaiWWfmQVQiZP8MoD3NM0T/PU7c+rkAqIpjSnJuSAXNFF6AZCBmDA6GA5S+v4byCZqOnh0/+HDwNhy",
+	"yi7UeUwvZXtPj/GSKZE8pYJQEuE81d5wdJaxtEgHj47LkVmmYAJi8EGv+23BBMSDR3+urr68pTflO3z8",
+	"I0QKV3NSxEydTmk2gTYS0AsFor3Kf6cJFySGnDNJYkpookDQ+V/n/8M1eMMFF9D1Gs0UNN/6kmRFwklG",
+	"SSSYG+hD12qfZIqpy9f6t18GkOFx/HlQSBCDocbbwXAQ8fxyMBwknGaD4WDKE8SOC5aB/fI85wmLLgcO",
+	"GQfDgRI0kxd6kHcwnppxeA4ZyybnU14IhJwo4RLicws3DjjPqSYqeEmDN4Erd8sWl4EzjhRrghru5TBm",
+	"ko4TCKI1jRQXQQLynSzmvwrGydsCQfVn0jhoWkjIFJCcCkoUFXChyQ2RMCmymJM8oVkvIhZpmDFbiGOG",
+	"09PkrLa1fxJwMXg0+P/uVcT4nqXE93y4+zBsbOKUpjmXduExl0OSA8IHT2EQgIpIAFUQn1NNx2vIfaBY",
+	"GsRw0EBkj3DpZu3TysLc0o15IPphOJhSOW3f1KunJwf3H35OYk4inimY/z3miBeQKYF4D0hXcgGzc/1+",
+	"YFU9F1+N0VrDUyqn/pyInIJx8SWZ0Z+Zxsjc8AkaJpOaewYB0RIJTiI6hvlfaTLl5D8OLL89ePYYp9Xk",
+	"SjINmmGADc0qcYwsClCYM+4G8/aUaToKmjiXZ8Uy9flngyAN7SQ64vIbJtVLkDnPZIBaxlRR/MsUpLIv",
+	"mIjLQTUnFYLqzzmdsIw6wrBonLPqye7F/zsIdsGicsCGqCP4T5BZ7GnAKLwt5n/LIuSyFSiUR4tX9raA",
+	"saBklUNG4gHRTxCAmidmZEnmv+HTgkpEjgsQDL8seUe5kguaTOmQ8AKZPpXBySpm3gKlGU1Y7P0y5jwB",
+	"2vcol4PCUgio3Uxw1q+QEbUmoIWachHcE51RltAxS5BiSUVVERQ0cOnz32aQ4OE9y2LviwNzmITKUvBE",
+	"SQqkorUzbs2ZwHnEcwaBCU/tQBFPiVkUGZVvjYL3ZjjzosFiboRuZGBaVrKS8ZBI/IZnigrcxZiy93bp",
+	"vZDzKz3ziXeQISSNqIIJF5d13j2BDARNgizzCnyqJ41nchyG8JSqaLp0v5z/9AqoiKbP9eNIgYpxwuQU",
+	"4vNLoD6geRekmEogOKviiiZLYSHmhEYgZrzrvsid0TumprGg77LR3SCQFHm88pmWYwZp3r8VFAUhB1oX",
+	"nNn18GrBDTlKP+ntZzDstZIudD9F4bVNpqmIeBw+b09bXUf7dCoO0vVJQUWs6bq+rV4iIc+MINgH3nCT",
+	"p+UL28WOhFe8r8FvpEKmQngWQ7lXghQ5NE5FTfvs7pV5+kpAugg0Tv1jdupPBu8Gw8GEc63oUCYGw0HO",
+	"Of6JaUonEAc1EzfmBiUbN2SbZC7a1Hq8tJpz0Ryvyutzp1ZyoMFwwLNzqy7y7NxqjCw712ohU+aDgNwc",
+	"bUlCOk91wye6XSkRZ1j/BrrH9plLwBIFhpZSY/zKKBkXMqJaVhi9ReEgJPycT9lkmrDJNEDDTwrFhX6f",
+	"SpLThM5QkoQs4k6+hEwJIKMfiqOjB1FKxU/6PxiR8st73rdBYhAFrRwvIYHZ/C9GZnZMRHMJu60viZz/",
+	"jmursQ5KUpCpfaTGP3hh4NMuICvSscd+F53C6/lvCu0q2zyHzhsvJhOQuBDZJb3KGgK0ZYgGtOvtrvRO",
+	"wyBmBxiW079Zvvb1kcI/iI7TEoK/Mxi43Bjdy+IbNpg+psZWGcOMJ4XRk9E2wKSiQw2UFkzxzHIgd3Ia",
+	"C3wohguWMTQOQUJJzpP5b4pFeizPwnq3j2EVLVv9dtK4PPdiJeu86TzLr7l4Dhs4zMYSFk5srIhtSI9j",
+	"ATIMrE6aq3SH5yfPvr1mxSGjaVik3JDM0tak2mfUW3nMSg1vdT2yL/70U12ypbrmUt2l+7g2KTroAXuK",
+	"YvrZNSmenS80vrH2nlEp33ERd+JnVAgBmTrP7YO1Wyu/7HAXLXspZZnzzny+DN9bC2lM8Sa4R4h+epZ1",
+	"Ex8q2mj/x3/+4uj4wf0HD4+++OKzg6Oj4yBd11L8eTSlAv84z2bI6BrxsaCGXGvbsgSh+FAbSSBTdKZ9",
+	"YOX0xw+PjgJGu9LTdBTCKadTdCCI4TEzFlMS00xbuGJPoyrNbHjgqhBZSWfqgz3n1qdHfaY1tEILjq20",
+	"kOVxIQKETrigFffSH+/2V8lrJN9eV+dVvyjUFu46oiI+t4Je7e2jo6PPvrh//McH/7w51r8FPt9EJG87",
+	"w8Vnqt1rj+0eGse5Ehm/Cst0Z7dUium5hpAX+VuqQsbBDwsPY4MMoRq0H1Oonl+PMfjzBufR91VZA1bB",
+	"qa+eHhwdHR3ffzAYDnKqFIhs8Gjwf/58cvD/04Ofjw7+ePDml+Phw6MP/7SGPUxABGPPRlTRl1ImGaH8",
+	"Nrq7fVOZb8+qjuHk4EE9/uD46GgtAldeSed1VG6Iahkv+RiEIqeH5DkVimWBNXlc+Hj9G6m8FGveiWfP",
+	"b/qj9S9Go0c0I4XUISBUUAIy4skUBOkmmdXCRtY9oFdUnZmACxDap1mHYAO+5wvh15n+O3hMY8Sjgz++",
+	"+eX4aHj8IDxa2+5fjnv/6OgLfZdGLrjvrtJ8PD46CkoKpZOgWt8pMn9yymOow8b9HrCxWDxH270yF+9F",
+	"TaHwIa2948cCBQrUHqxtZqg/RPPfYzYxwVZjKgSVzvyBx6v/A+TWo2Hw+/ujITk8PPTv9OFKwTrmlJxp",
+	"YmBvtbHdBUhqRfcuNK200KXhSW3y+u2Ll6+ftkmroav3h/c74NJplu0Iqm+5ULCYLNxfKlMY6NGTdJ+L",
+	"z706zsYx/WqZ94/uPzw4vn9w/+FqoWJLjraxAT1e98q/4TQ70zFKnStHQnQedkL+oX5dd5qE5D9/+OEP",
+	"d/8p7CqhWRmcVg74z4uBWV+ltp7XX3uwTI3A1wRk8I4m9TePl72ZUyV41rF9qYoYMnXFQ2hcVHOmYePg",
+	"/c3759fYXfdVfydBdGvDdVWgEQI5/3sKQutHERUKmGDZVIdqjNk4YVxBRMmdiQ6iIrRQPKXInVJtbX9r",
+	"/ZwpUyzmdX5U0zO6RKrPOlG/JyctXKzalbmpVDSLqYgb7DR4/72YKaSUJXVg+pFT/i/6+8OIpz5JMA/3",
+	"In3/ynG9r1gyo4sJ34MQT/aMGt4mIZtSI/SubOkYDgRPloayacDE55ooofc3LPe/2CKiYfx7E1HZCeYw",
+	"cwaNXlrME3zchdelLHtmXjpuew0kRAICpoXTKZ0BAuHT5yenB6+enmAoHkqVVEqW2dBbbWeYtKJ6P69L",
+	"KaHjLUQSEF5ffkOmSuUYcYN/pS/Gckn0IXC53BYu8NTtkZVbDB3+Y4gSKuAbLrvNFALyhEaARGGxWUkH",
+	"7OVliJ1nTqowEoO12KRAgvMlOSKZ+W5sDDAl7H5xtLLJKaQz2iD+kyr4NiD54G8Qn48v+wVHXDWQYjsG",
+	"CS96f4VQ/E3ZLzRPO3dHuEzargXbGwHbD/u3sbxBC30z0H8FoWtJeP2VwGg9S0druPCsT4TgonumzlCf",
+	"GBRlyYq+U8DJAk8GF1bSVi9QAkHhcKy9azoiXX/Wso3/0Zhy3WdnI7YfEy5V+ZMLnDdR5OUzfAYiLsB6",
+	"+Q4tzriP1iXlPlYxGPaLGBIwv+sA+upt/bF6W38cJzz6qfpYZI0vvPB7DAY51ESy/CSAxpfuw0WRXLDE",
+	"ezaiWQT+F/A+18Tb5CAcUilByupzTln5/zvKZh3BOl+zLAApNOVFD9KdFomiDR9Aj0jdMU1wM13Dv6IJ",
+	"ipYkpxMqVh99qzFfNOtLm/H8u3b4GjVw8uP8V9wjX32LnhHY4lIF5BYlFgVo9Qs5Q8hYJ9xsRcd8i2bg",
+	"/Bs0UuNw2w14whnWI/NmjV1jv+qIux7xHLIRoSyLKVGQEukj0JCMEBRHOuj0bcGUyQMZGZpgvs5BxJzG",
+	"9PCHbDCsYCqHbGAAGaPTumnIU0gS/j0XSdy9/a643qDNKiR2PuVJvF4UyxYJQ86in4r8PAYaJ5agNuPR",
+	"6M82ZEuAYsKk4hmrvwT8PqZd7sSsSEw84SMlCgjM/raAAs5Rig4HpFYpLBnGoSZeENkdGx8nQIKYmbQt",
+	"kDkY4TpIeeLLRSe4dLH9iA/etkd81iIkONYGCQkOt11CgjOsR0jMGrvG7iQk7yhTLJuMCLWR2kXqoHRI",
+	"RvruR5rCFGkLeglV849k1MAEtG2XosyIzJjghS/VD8moFGwMLTIfLZGyMs7IKH34s8GemJKMkxyRqk6z",
+	"7A4GFlIRpTxBypeh7NBBgoaG0vVojX62O5fBSb0r0SLMPz1n3fERVT4NuZMVCdW4XMtWtpl4IAk1CYKC",
+	"J75XI+TSWorQqBadO/kjnH0cA6FKUMkNkNQiL8gdXtivJ1zQIZFgWZm+cxf0wcP0qFPDW5eiWxvreYSC",
+	"cIeaSiWZwc8gST1axIBpxmddqmkjPmVdOupgn0aKzfDdShisqU9L5cL+ZNY+2xHoF6I7iFJPZESTjoRB",
+	"L2XZbifjimnnpVai3A7ebCwuYwWodWZtglZJc8E0T1jUdcF91QmYQbIw49TNmGkjNtUxS8b2lc3/Soda",
+	"xhOKCfz6OLiUVVSW9dhs/YY3yHDrA/cLMcF3NryE7fL8yjfXXuo2c/ya/r4lQQo6LXb0h5ERZd8WNHlb",
+	"gEB5YKnjLyQQt+LsELxpTC39dKF5KYlZRyJuzUnYiPSb//oeR23aESVDu8X8Lxlw6buMviSjoxFhaQ4x",
+	"NEh6DLj7TBqvmD2TQR/vYy8vYw9/1moHv5lA6womN4xKZtD+mOyc1uuIxP68XfOsP0PX2BPWHb8b8A/S",
+	"OGXZv6AQOS3GvV2EYZ/e8f0Hnz38/CoevYZu3ss1Z7fadY5G6pYrkTKFpQTC+QQSxLJbQYdj+Faeg5R0",
+	"ssBkk5oHeko4L0xZl6e6qkubhidclvtuFMzgwrisXSEg49248/Tpo+fP76Kic1FITqblY74rfuiEE/SL",
+	"QNqqUBTrekY1T/bxF4+OjppxDEfHb3Qc13/e//PRwYM3dx/9+ejgofkq6NTmOWTLt0PHIFQhaM/N1OMF",
+	"/riBZb4D+Cmml8uA5Hv7WBPk3evefofeVb5ZAgYbIpn+kP2I5llNLqlPnbCUqS7WNIHwLzpmbMFP5/hq",
+	"b+/YGb00xtKu2LIe7oc+lvMVguVqU4bu9Qx9NcYys4GMui3nrNk1vrblpzqX7JkUeiQxna/inW7F1pmZ",
+	"GuOEF49K68IY4ZufuELuSG5ipkxm5N1AJksIdV6BqlOYjhOaOja0GQrjX6UZOnRzDt7WtNOvAJQYyXC+",
+	"WtBEbwNQBOjxWElUscWrVnxLTlmer/pOLzO6u5DKlL4iFm9KlXAL2aAi4Ybcrl5ekdB1VINqrYvmaNeS",
+	"KOGpWTfCQWfNqB2yjX2nr29pDszmckeW5IoEDZdexYy+BTFC51jttUdyyXXlj6AdY5NZJFdN5VgvYePK",
+	"GRrXkIqxtOTTckmwC5Q2lwLhTOWr5id0rKxHKH/N+LZCpP1q0fWrxlea5Z8JfsESWG4S6R8XvVL8c/fK",
+	"rh44b8ssa9MN06lB2tOW8xhSG6ooSC2ofsNx8L0XUNkvrxzKvqV7uUIMecc9LgsOt86y9iHqUG1VCCoJ",
+	"/sOQemtPpCHtKRL0UFy1l39+DYHnV4wd74MGEsSi42pv1wU5ts7yq4S/LUArX1RQd3C+dUonhQc8W5Xv",
+	"LXzCV0xm78rsMQkfm4oZWgFJ1i2oshq2bEqY/05uVJA3FtptCvGGqK8jwHdbkcvjbYG/JsRkopNwGS09",
+	"OXJIEjYWVDDq/WprdtVdVUOSAsI4oZOykpnkY6EzO3Ix/z3H8UhsS9CX8jROPBgOymkGw4EZKKgiWFLZ",
+	"3sALImEiIOYkK7KIkvnHKhzjcDBcgURcCY3WoKJNUNqarrsKZbXn/BgSNoNg2XalIM1Vh9/wKmcYm7mu",
+	"cvK9y5jrh/tUMa/dUM/REyrVeVemwXCQwXt1bo8t6Iw4E/Pf37OUEgWZ0tx8SCBDl4nipEzGIiAVBmND",
+	"FkOmoGddl+FAWJrSWRLZaPTk6evXZ+jpmP89UbgY/Z40dWZikIpl4TiSfkaeBlxVth5ZjMvFXD2uozH8",
+	"Bgl/Y+Tt8oDGZOuxg9bKe8zYtu4gvJlgxRJPTaHVLmOOHXHzV9DPq1TK1Bs4uq4ZSnedOyRZZLH2wKXc",
+	"/qMKkOa/dxBn7n81LYT990Iw849EQR7/DdqPJESFYOryFa7MmqmBChAnhZpWn752KPOv378eNLtKvJA6",
+	"uzi3LXVQjsUDMZErhGUxi6h2yuY0n39kWMENZbbRXZ06LdjPyLq/1HYLO87QC+5wucy0QPKl482Q7+qj",
+	"1BxWL7BCY0wAHXzAvbHsglu7nqKR8nRq91UjusCImLpU5dNiTF4DTds9NE7OnpGXT169NvK8k13KHjnN",
+	"DkNGphmU1qBy9JOzZ4PhYAZCmnGPD48Oj5xXmeZs8Gjw4PDo0NbemeqruUeL2PgtJxAMyHRKLi8IRuzN",
+	"/zYkrhiprtKRUia1EqcL/usduG9pptiEykPyguQg5r/xGO8uSgqGVxdzJgm8VwJSLg+NL1hoSvMsHjwa",
+	"IDaWnRcQEXDRgqagQMjBoz//MmC4QLzRy+qgtavVXiU127mgRaI6LFThQYwrNzzKUf9hys4v/khLOcWi",
+	"5jIds+BV+XMExgy96XdK8V9fqWXKksFX331oMNsbKTDOQhUrPJjiqw/1phJKNNbcPzpyZMDmMtNcR67i",
+	"Xdz70WaRrXakDRlA05s6Mn6ja8DFUCIf4vZnRw82tpR6omtgBScR6LhZmJTmB/yTQ+IH1dXYgEZVnwE4",
+	"ReoNnqos0pSKS7c5QZRgyVRvUhMmFxBIJ1K/id8N3uD4hnDdm4FgF5ed9OslRDSJMGqfk6nuoANVvQD9",
+	"1zYt0aGJWURjsIRXV2IuY/sPyUmOBJ4EWqvQuDAZ03RIkJhplzQvyAUXeiNcxJC2CZzuJ3LpmitpzXK7",
+	"MBbshxK45JcgMQHVtDia2ZfKlmG3Bd7caYiyH04/oFPTewkG42kxjRsbaINz8Ym9TW0k/YrHlxs7sVrI",
+	"44d6CIESBXzYIhDVYxBD9AkfIGNID2QRQcxiCzDH1wcwpwJiLT0xtDPP5r8mTNPJD/7Vnzi5r5IFa9et",
+	"pva2UZaTnbTl1NS8H9oS+NbUhLZXr4B7zImytd11DRMtnyJ10IEzz1599a1xr8Xswsp9AoWn+d8lUi1J",
+	"MpS/Im0UPyTfmCnQIxpRSVNNpEq5NvbnlSgoJ0hTdVM2XgpqCcxMbyZLx/iQAFGC/qzTmshId6EZEUqE",
+	"Vx1fW8aUgGhqC0Z01ahPqdAl3PRD7VL1h+QV2EPj0p0Y9rPDXRpCiVg4klyoUVgm/ErfySctDNbB5GuW",
+	"KEGFbkJpWjcxLK9t24KGpvQd9y2pzgtj+iUkHwhn0bSna0vmNUphf2kuqCrHPQw9X3WaYv7LHcuufPkr",
+	"inwN21IAdXhR4o6O2DeY07GOt7X5m57ypfOf1I+v+NEhLUL5/GNKOFHwXvHeeNt1y65+YHCtx1dYq44v",
+	"QG1W+hlMUgExjSKDOFLGJLSE7OWg3T09Jh72nlnxFee1TSdtjixOzQWZzX8TkyKhQ9tfLCW5AMOGNE05",
+	"sEkejspEAqTWWl2R6pHWpzG11lwN/lcP2dCtNiqD8cgrb2bevXtIvsWPUle9H+vqVIZLHHacAlK62u4r",
+	"7e+gPvnQFZ68TuWk1R5nkVpi4MDw/KPr4/lfafaLAjdSWl4xfi6vXf7Qt1/ZoMz8n13f/C5oSrvWK8a8",
+	"RELuUMVcb+bK4GRFJCMXvfkw7JCAq3LEWxKD2/WOe8nCxxvFi8U4gWUgIsFobFRJlIil5NeOHI9pHMCI",
+	"T1CFuxGIEvABN3DnVKAkgDEZZZP5JtaUmsU9aRoOLdIw8gSUPjEBF+y9gSUrFaFbW5Ma8MzCpo2vmv+a",
+	"8ImJxzf8jiYXdDz/qGt0Qn+N4wwy6d0bThfZRelSVVr1aQvqtpNSh6xe3+SzbP5bxHinuIc7ppykkHFJ",
+	"7pOIChopENAlV5mTGjSpwVpylpfz6h1+XJ2/LCYgWNwp7C3RIqo40JBKsW0GH2qiFUCZVwU+Nf9fuH4W",
+	"f2ahv6Rju2fsqzDUVxo6RABxF5KHX1j8wYBKAqFWIa/mv2PwSM6lNN2AtfURBEkqO4EJMUFTZFpVMuEG",
+	"y211Iu0q80KrpQnLM4ZMW4a9tD0gNk6ZVPPfBYt0HRHdkV64xqte3DC5Mzo7eX36lHjbueeCz0d321Tj",
+	"sd6nFRtC+j16rSqcYvFCJF+meG4Tq5o5sJ0Sgj3m65cLyuI2RIn5XzLJFN+LBv7N1AUDu4o/XvcqIm7Q",
+	"tvT/QmqyfzWTbCFj41mH4fJKFvKXPjUJSv9WaKlj8Z9A8/2vLp/FNx2N+0n5TSDZPaiuwpu09ixc81Mu",
+	"yLPHYU2vCDVv1Y4v4oIlywJ4h+QEuWUKOmdk5KefjIY2d6fGbvy+H0CoggyFVmeiJq6+XgXRX6JMGLOU",
+	"ZQUTQzNI2c8vaL+EIYkh50xqqVhATnGdp+2u+cOy+Jp0pQX1nIQXNXppzr02c5unVclT14YMm1e32xlg",
+	"1+x66oeIVBU08ewuO1SzNXV2UAja9QIpiZhAHcs2dTP8TquKGkH2/Ne/TF6UKX2fBiu2Am4l365tKzix",
+	"8Cp6mAq08Fwl8AU5r3OPnZrHbgHzbfW7X2R+tvh2E5mwtbh6qZfdQpczuTZ0QSBTXqC41q45OyQ6S6is",
+	"LTr/WJUXtX2ky6CW2LJaSLEIIx4rE+4h5I+ZgjaTq3cEvMGMLtzacAe2ZTP9UvWtEpv2Ruabx8notXOy",
+	"U9M7z2udh9XySyhan6Wd2qEcLVtEyoLM7d4vEc8vny02fK0gtA/rIrtVmpUtDTH/mwlvKZVqfUEmtVeC",
+	"cOYZjGE+w0FTHQ9IuKd1D41pXkOc63JedRNfZOS6Llo5DA5qjvkmW9AsDXR3tEMTmi/X72lf7XQ27V9z",
+	"RilDXla2Su1xbjOCR5OHfeqAFTZ6WR51RatXwiOtvrlyutLETIMth2br7BKwbOGQvJAlh+CZrgyCIUY8",
+	"O8fGQzq2qCpGNCLS7/Nuc/prBgSwsaZ1ZobmLb+RQAZSQjlxyd9IWsRUF7i2q1tgubodSLNNs9jK2sJO",
+	"kLa0j9FPyD6255/Xyj8rm1MnB23I5U6YtdWuommgIgmaxlyXbgJEcRTD0bZfeNZ4cE1IiN8Zx2WH6zRB",
+	"M0AMOBySJu19MnQVPPf1kKQ4Ls8UywpKKN6g8YAbUj60TQ0X+Mby0vndpnzf2x3fBk90P8u5tW3uvdB7",
+	"L/TqXmg0IZQAtCZ50pTEeSMrS3sHkdIJB8sM4u6hbSKZnqO3odpag+SVbMTly96ZuC12m4dfkMiafhhS",
+	"W5355hVNBE8wRKLrWsBaj2mnxVdPvN0Y31qtwOu2xNrJl4eR6kjfvRG2NyHdjc3TgftVjJ7BON8qISqA",
+	"iz6Junosn8N3U4SzFKUkNK2YKFNNmA2iOCQn5W5djsodWxq1gevOftZpqnRIftsj8hwu78yg2L6y8E3t",
+	"RaQlMfzXTGFKJuClTvJiEXquFY+3kOgsMH/qh25FWF5vtrxDM+VGMrGsnbIkCy1DZU3yKwIX71dcvvkR",
+	"aKsLg7uAuk/IzrZnFRtI9+o0oa0m/t3THY7iA4TkPjrrqX78sX76eqzvzaQbG30b60KqxgxXZjxP+Y+6",
+	"u8rq9Zr6zD3/r8R0CAxNjbKvTqjqXIDiK02/TWZV3WJv+0Csw6IhJfA+h5hBpuBGJxKH9rOS9eJr0Im0",
+	"qPfwQqd4xyb8Q6c40Xp4DR0LGNriS15HNAkpzWjS1nJO4riJbzc+gq3ayo4sJ/4CFnArRhtwUVPL97xz",
+	"r2YF1WO035jawrqxY2y93EgVrmbMKaPW4hZEXoW53/slKuG/FcpWpz5GqdsFAerwtnsLv8nGnABpqeda",
+	"7vHa4TVm27dPa7V49sUWi6tjVdlvL1gfwfSTp5a3W55vBUYbP7NYTjgk3/nm1VJYqLiQffbHQiqaEhze",
+	"ymhYsb9w/eTr/TfaMkZpfql1BLzhZphgS9YAnNUa8RZZxHjmihuXV3Iz5dtTnulSmYJMu/a4xFjTrLAw",
+	"loqpgunaO6QtvXondkieNHN9bW/3/9V1mKXU8Kr7FwuS8foSq37ISJF8Ra+FLBpP2hU+dgrRm5ebOxqA",
+	"XrONaX2U+sRyIBGiKgItIAe158BbsUo91pGiK1MiZLk2/E7vMJpC9FO9um3jlnWrGl0uuaI/BHuAaHpu",
+	"g/oSFlPbG7iZcnJIRkK3IrY19HIQKVNQukeE9qNoPitACY5DU8IUZNJEhiFoZZRElL1vseJIV22uWp87",
+	"BeFLQkmKpFrb0E0PLPS32urQMa1VTJSGzx8SXeLV9iEeDUm5vRllBopc52MC7rla9+QRmYCgOhzXzu63",
+	"Sz78IWsHVuD5P9tW+WA7+s4KCNNsebBR2Qtpp9EUtfBLrWpWyUaasAXkQEf69g7aznSzTyOerQxvynUt",
+	"ZqXTxd4WTDJzkZIhts7/kgEd6hZHoPNnkYzC2iFuloCW2QcZhl8m0fx/fArtkeQuIs0L1U2ln1hgJX0o",
+	"MqEVjHjxYaa7e6215tAVf05BpsalbSv1+szAuCkPyXdVFkI939gV1JDz3210A3VZxkVaLQXnco/mgmeK",
+	"YksXok095UNYz9pdop0sLlOVhyS0AqTvZd6zbWTl5rH5z52k+UWhtkmbXxRqRxbbZcTZ0zaIACtj7pRE",
+	"65JsjZgp65jzoHFPiy0trrDYJl/sSXOTNDuquSptvmAZdFupnkM6FlySGUDqirPTstEklUY0lIfk32mi",
+	"i0lCSlB6pLOuvkhf6/luXEOkQoLYUEcg28Kvb9siPDDXwG+rNjGcqLef19z7zWyqEvb02h1VuGIww8OS",
+	"MhB2Iao4wUC3nbcmthDKhAyteAW3IcoN97HoGp9rhbLpj9szOnMumwi7qwwGtaC7MEzfy+ll6lrrhoXy",
+	"l9bCoXsT0Im12OnaX7ojDBURowmGbpuZ5x/J24JpjRNbq2qLsKQJil1TmKD4+jOIQP7aGb1E4LnB1li7",
+	"gx3ZKZZh3ll5d2VI724lYS04VJZXBCYDQdpRnkUg9gRiPQLRpzCFM19WqO14/HLi8Y7aJt8dGbZnIGJO",
+	"S/wXVr9FdVXPEEhixRGvlQzslA/m+oB2nIL1fI9014t0Bi3EQiybQpLwg3dcJHFn0O3zy6f41Pf6oS3C",
+	"cjXL4gaMiouMkhQySSemJdqYU0lmLNNFZuvt7J68hzRPNLXRxUSnNIsTEN5xoIbsToMn8RqqqkvbPyQv",
+	"W2UGXRc5FJmcup5Zc1pQjX2q1/KPrMZiNvNuNGI8+2vRiHGi3hpxVa/89ujE5Z4qfDRI2B3vfMoTHlFS",
+	"TYyGqAuWltU5qzrbgbxRPZ2ouiuG+/kdkn8zOoVXK2j+sfS+UYKmf50m3lkrtGEzN13fOMkF/Zmb21QM",
+	"Xzwkbb2+XKYeVDLtUeAyZHc/S2gET7mlzFtQNtz4O7K8m6kX9uPVx/wJ5JiHTO72Hi1E7uUdG9rhMJcX",
+	"5mDWKxD8sobVhGUVKicBwlJy+qV556emJI/QZKDubHO+OsU8v1lJK5Y50AI4H7m5wiJF2+Gmn7eYf6N1",
+	"mN4obk5ox1juVrNXZBYczq6zIbbuLmvlSFjktfgaFGfWM+p3U4I/gVYWboNZvy8p2Bv2e2PeFUz7Jadr",
+	"Gvd9Dppwmh3kPGHRsq4LGDxx5h7ccgSdnqd/V4ScJ/PfFIt0I5IbERixpk3Iql3d+64uu37B3frYdxLI",
+	"6A8jZMq6uyZMuHAi0IwmYLWYshN/9UjsRSph1CAQpiA1apuOfIL3TCpmxK9yyRosc1OetRyrswpXBRRb",
+	"rcRVTbPDCCW3gAXOmfIQ9zW5PtmaXP86/9VAPjQBH1cIUlLpAf4aBbqqkXsTgRblD+hQoTpZNTS87bWy",
+	"KizbF6XqOplNZHW4DMorwHF3JagKUm+DQL0qW9gL1hsG2UXlXI203QW9bfE7IJEFK/D77MELWTfOp/Km",
+	"m+1crLbc3adxBzR8W7Wyriiu7Q4v91Wzbjkvq+pmrSGVrea75qV5aWmKvlPj93HVDS8yZEWq7zRSGKM0",
+	"HKBAEhegSZ/J40QWwCXuxOZKDt5s2wXewB9z3f4NkwtgistaXqcCwjITOdVROGzMheDvID5fXMLsQLEU",
+	"1l0YektXWpPiW1pRmXK56nGVibxbPC5/cX2PrFzX1o4MrQpYW4HZtPtMgex3ZnEB52O44AI2sLRTmuba",
+	"ya9d9EjuuCCz+W9iUiSYrUdjE20sIIKYmfZEo4ORZTEi1sFFkQAZgU6Xg0wJIKMS5KjCFkW4ZFyW7lFU",
+	"S94ua/KNDvx3uurySS7q9A7e0zRP3LHsoDwfkvzetlQfAq5dSHkhYsjoTck0vkKgTO1065JATQK4ZyCt",
+	"O86+7XCCMrHLRsXUo05sPfXYllCo4P2QOD++wXiTduK1v6ARS204ji5iEPHsgk0K7ZLjBcnKHxrQ4znm",
+	"uC5h1KkieSpMuYu29PKVPhLbsGYbSkU1wT4/dZ+futVeuWXM221PSO3MPjVBPiYf3t3HIoIYF6ALDx7Q",
+	"GAuVLUlDOokZHZIMDe/zv2dIcpSgmXR9DLkv1zRLm3mkD6tE4ZOQOqkBySYKgiNiPyg+Ind0CTaMXyyk",
+	"X4TKr9Jyd0h4rivVJPrcNOXWop92DxReoTr8xsU+PstQaAIyklN2oc5jeilH+NAog3fnHg1/gfPPuPQ2",
+	"JomESQEp4VgCBrK4XFXVEseWfQMTBeml+uiLoUXMFBeMhirK4ntIsB4X4Oo3b4Mom4ncJDsizHb6kxL0",
+	"FhIfc6LVYdqKEjrBTZmTb7JKh4Fybxf69CpHXiW2scM8hKBBRQ1JISUplZIupH46gQpkRE1OfreFSMuZ",
+	"uiG41nyG2jxEpTQSGI4QU9O/dSxoNv+riYCj+hi1+tmseSWopDF/ROiMSS6HZJzwtwUw7l8OQYCOEiqs",
+	"6B6DzlUyfulqplhXR3N5E4dkUdBUt1UrED3t7FpPvAO6BR6fajvLtLczc8Nd17jH6bb8vFao8tNa000P",
+	"wvkCU28AoxMrvHRkRD4xgamNOnQ6QmeEr46IS0CoYpbLhoJDIiAufmYmp9pkYMdgH5NeesX8/5odxH4R",
+	"txh8XEXZZqYTcD3lb4i0EVK0luSYXoD0zJWEs7ncTEsvurHB6GhkzlzPdTeExo81DdFenW+MqfWmuqfK",
+	"nUi/1NEu6875oO/inS0oIalmO6il6S/JD8PWGtKsnu69p2A9KNg/Yp05LXWU2qSF5KV0V0AGi5o9P5EK",
+	"shg6amHjTlPKpLY6g5j/xuNm82UscFEWKzYmEgFRIWt1LiqzWa2u8QVnhCqWTRjS2PJpnwWYao1OOCM0",
+	"mX/UiXOKJ4BNhiOmC2C5dwslPGGNTgqKwlnJAEKZa7ieFaWwl3ioSIdug+zV3wyHJ/UJWuHsBe7FwE+b",
+	"iL4I46Z2stlkw9Vy6My9rySFGm/XAnLYJhCxK/DZTSHISb2BgLZqhXTLVv1gbropxGAe4UNDKZF2UieH",
+	"pvNf3xthuJRHD3/IGrWM9Xp16WV0C87/myC5yWHYoKbzj57sYaRrNwaBRQWR74xYdi4gp0yM7qIoPIOf",
+	"cdkzrnXY+X8TW0dPH8GXGyqf/FJf17U27t+8hFxt4hMRkD+lwsxtel7h2z4F+R9WHu4qsmwgIkzmU+hM",
+	"6voTqOewzUyu7yQsDr0DccFq8ENogdOyqLy/42vGOlooLmxviau1SeElQ0Tec8H83PUUTLyvY7Oh+Fx7",
+	"J9uKmT0T/IIluyo71xMkPqEOHzcLDKv406VgaKhDK+C0bVl/fnlDY0a3GOj5SYZr3Q5KaiOlOhWLADkN",
+	"hgC88H38M50dkeYCFgUiHZLnRWws+SzDjVOlxa9HP2ScRDTNuR8niI1ajLte511EwBQnMNTl+7MZ0xby",
+	"+UfCJhm36klHSNPXXGyN6Hsz7IOathDUtEus2kn00j5iyYuq7OCpOZXyHRfxgt4l79kECCUSsqlNCwqk",
+	"vk9pNoHnl2duuG015cBp3CQ7Egx75OO+Mmdlbv76E6heVVeluYMQoKgJgJ25i2zELN8YqVGfqSC0LFRj",
+	"9hMCbx1HdwFiscz4unzqk5Ya62foFj3/WxYxKnX0n6SQ+iFzvDCFyVOiqwd2pbPo5nO7qXrpdnEtlS/d",
+	"ZL3lVVU/41teoKW52wqfKjTqlmBPnOUZj4ZlBSVZ2fXJh0kES8GwiK6tToniZ6BmviXt7s4G27Ls1mbZ",
+	"kdRZTd8NC3V8J1JnYqpd8Jbypquu71ntfmOQimU6MgLdw2PsC7lP7m80MuRFeWS77+PvXaiCVPt36tSg",
+	"CjNfm9q8spDbJDhVvFcH5akx9Gbbmpa12OHLbaiocQX6sC+qseSAtlRZowHTrXoaiwH6nqk3usC7jXXK",
+	"VEP0oyyLyw2hLYdKr8ZGWcK0s6Crx2P/0dCkPJxr56KNhZiYQn15OlJ1X+X1WrG3LKZax63+iCsggsUd",
+	"Y06qQs2mbnMOQul7xtiLtvh0SF4BmfJiBmV1TK+3w1CXg59/7C4G74rAV5EerqVrKZeHpG69jX8kimDl",
+	"MbzAMds9Iag1uCYKAyQlU/sYiuuhAwj/47IVdH/0l1OW98F9SZnXDTmsF9v8RgzX0rMwLPmgG+1nsU3h",
+	"yJTpys+9DhKJKWLqUiY87buSBZx40Eb8V1OW/wNifRvFPj0RYGjbA+N6L7jw22nvqcK1UIUneCs9iEIh",
+	"l5mav5M3zMz8NUtMDz0uyuuVhCqG6dvaoSzxg84GDpuXy+iFag122jHnCdBsQYWfasao+JGTjKdg8rgo",
+	"SzQZxFQCThS8V3xoSoawCxCAUKDTHuZ/lxjS2rW2t7VlpfT9N5BNECiOj452V+wHF4dVfvQ2de5+JICq",
+	"doUf7+srFPjRT1wvZUbw7219Ly//U6rss2sX+u31PnBEIO7hvEdoC9l0PoTqriN0bbXiugkI3ImLYFks",
+	"YtneSBdZ559gkfU95lwJc4JV1HVbyUAlmkI2hJGrd3dvhWQGm8B8J2+Hhb03ejWDifay93ed5aGu0AbG",
+	"7aJtOPdYQNELlGl3dPEhsfKlRjFb+EaAs5pTG2HiTvfOSPAERne7ilRbvnOzy1OvzNt2gHyfXKT9Hvs3",
+	"g/1VJkBvpnYvZpKOk7rFvVGiwzxxrei5u4jD8iZikHTMEqb2TGpdMA3LYI/LA14FXotsnPDopwVW4m/Y",
+	"GIR15Jj6eLWEiSKt2KMpbKWPGJJwqawyIznUXMGs5TZgRm/mEYMsT22PFsvQ4ppDkBoL0e4v77bWrrzi",
+	"rn4pwr6D8ZTznxZbcb93D20Rru0cva1lVEqWUVWIGx6nGrYOebvDzdpb8u6wvLcFTdyRRMJMp1jNP1pX",
+	"i9E0zl68eq1NJpxEdAzzv9JkysnoPw4w5/9pMT54xSZ6eji4//Dz0ZBw8vT5yenBq6cn9x9+TrS9ReTc",
+	"DiFhIiDWxUurdXc1C/y+3Mr27FZ2jh2ZrsrZFwBQeUz7JoHXYT+qwHIpNvlEcWnzctMdjSiajo1nZlqr",
+	"Paj9GxMql2GG6d9XYcZtF9098N937+s8mk2271sBAbrb9lkAvQ32z9Vo9D64eMtgaq2hQShtm0brsk+h",
+	"uop1XDdB3Zap8irizK5QZd9E77bjamW7XEequhdDwmYg6o3tmxWWrfik63kK0FEj0kX88nALPQuuj6vh",
+	"rwH9h59QSNM1sE17uJf9C6HYm9yj5FZQ0vWvCqobqyPkvV/s/5fPdKS//bSgocsEMlugXOfb24XgEetK",
+	"DNYKMbT2AvOdtiB8SaqnMRyYZTQx4cCZr0yFAvftqq6RyQ+Dg1ZntWFp93hbaLswlMS/P6rvdS/1BtCW",
+	"F+UpbUZPAxNzawftQFo9spg5EG9QWx7pLgIzSHieQqaIeXYwHBQiGTwaTJXKH927l+BzUy7Voy+Ovji6",
+	"R3N2b3Y8+PCmnLKF3q5alY4QrCCf4o7aUaJ/sgGptoET1CLcvI6xss+7pl1evVtr6MUn9Y6drfdMJbVg",
+	"vK/tp2Azjsp3CcuqZAPmDTXlSRwa6iuaRLaYbrPFeALMiUkRFQqYYNmUEp0AHLOJfmdMhaDeNLY6rR68",
+	"Pdlz0wgQB3d1eHM6oa5tja62jgXLq/EuWAahZZc9lmVo5WWXc/8idUsZwhSk9QOu+iW3p7Ete2S98Vaz",
+	"Kkfw1WbpD69Rhokgtjkl3marSPVArHXdtg4zd2Zh4101aImJoSWyZKrPqGzLRWLqWkeZ2vE+4sRMha7C",
+	"FFjsWTiuHC6FwFiakNdub0qzODHh+PZFZNaDD28+/L8BAJAjl+0DewEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
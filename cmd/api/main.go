@@ -10,11 +10,16 @@ import (
 	"time"
 
 	"bookhub/internal/config"
+	"bookhub/internal/domain/entity"
 	"bookhub/internal/infrastructure/auth"
 	"bookhub/internal/infrastructure/database"
+	"bookhub/internal/infrastructure/eventbus"
 	apphttp "bookhub/internal/infrastructure/http"
 	"bookhub/internal/infrastructure/http/handler"
+	"bookhub/internal/infrastructure/job"
+	"bookhub/internal/infrastructure/notification"
 	"bookhub/internal/infrastructure/repository"
+	"bookhub/internal/infrastructure/webhook"
 	"bookhub/internal/usecase"
 )
 
@@ -40,11 +45,162 @@ func main() {
 	log.Println("Connected to postgres successfully")
 	userRepo := repository.NewPostgresUserRepository(db)
 	bookRepo := repository.NewPostgresBookRepository(db)
+	bookCopyRepo := repository.NewPostgresBookCopyRepository(db)
 	loanRepo := repository.NewPostgresLoanRepository(db)
+	holdRepo := repository.NewPostgresHoldRepository(db)
+	fineRepo := repository.NewPostgresFineRepository(db)
+	loanPolicyRepo := repository.NewPostgresLoanPolicyRepository(db)
+	branchRepo := repository.NewPostgresBranchRepository(db)
+	transferRepo := repository.NewPostgresTransferRepository(db)
+	calendarRepo := repository.NewPostgresCalendarRepository(db)
+	dueDateAdjustmentRepo := repository.NewPostgresDueDateAdjustmentRepository(db)
+	loanNoticeRepo := repository.NewPostgresLoanNoticeRepository(db)
+	loanEscalationRepo := repository.NewPostgresLoanEscalationRepository(db)
+	webhookRepo := repository.NewPostgresWebhookRepository(db)
+	outboxRepo := repository.NewPostgresOutboxRepository(db)
+	auditRepo := repository.NewPostgresAuditRepository(db)
+	txManager := repository.NewPostgresTxManager(db)
 
-	userUseCase := usecase.NewUserUseCase(userRepo)
-	bookUseCase := usecase.NewBookUseCase(bookRepo)
-	loanUseCase := usecase.NewLoanUseCase(loanRepo, bookRepo, userRepo)
+	escalationLadder, err := entity.ParseEscalationLadder(cfg.Loan.Escalation)
+	if err != nil {
+		log.Fatalf("Invalid LOAN_ESCALATION: %v", err)
+	}
+	fineRules := usecase.FineRules{
+		DailyRateCents:       cfg.Fine.DailyRateCents,
+		MaxAmountCents:       cfg.Fine.MaxAmountCents,
+		BlockThresholdCents:  cfg.Fine.BlockThresholdCents,
+		ReplacementCostCents: cfg.Fine.ReplacementCostCents,
+	}
+
+	auditUseCase := usecase.NewAuditUseCase(auditRepo, txManager)
+	webhookUseCase := usecase.NewWebhookUseCase(webhookRepo, txManager, auditUseCase, webhook.NewSender(nil), usecase.WebhookRules{
+		Retry: entity.WebhookRetryPolicy{
+			MaxAttempts: cfg.Webhook.MaxAttempts,
+			BaseDelay:   cfg.Webhook.RetryBaseDelay,
+			MaxDelay:    cfg.Webhook.RetryMaxDelay,
+		},
+		Timeout:   cfg.Webhook.Timeout,
+		BatchSize: cfg.Webhook.BatchSize,
+	})
+	eventBus, err := eventbus.New(context.Background(), eventbus.Config{
+		Publisher: cfg.EventBus.Publisher,
+		Source:    cfg.EventBus.Source,
+		NATS: eventbus.NATSConfig{
+			URL:           cfg.EventBus.NATSURL,
+			Stream:        cfg.EventBus.NATSStream,
+			SubjectPrefix: cfg.EventBus.NATSSubjectPrefix,
+			Timeout:       cfg.EventBus.Timeout,
+		},
+	})
+	if err != nil {
+		log.Fatalf("Failed to set up the event publisher: %v", err)
+	}
+	defer eventBus.Close()
+	outboxUseCase := usecase.NewOutboxUseCase(outboxRepo, usecase.NewFanOutPublisher(webhookUseCase, eventBus), usecase.OutboxRules{
+		BatchSize:  cfg.Outbox.BatchSize,
+		Lease:      cfg.Outbox.Lease,
+		RetryDelay: cfg.Outbox.RetryDelay,
+		Retention:  cfg.Outbox.Retention,
+	})
+	userUseCase := usecase.NewUserUseCase(userRepo, txManager, outboxUseCase, auditUseCase)
+	bookUseCase := usecase.NewBookUseCase(bookRepo, bookCopyRepo, branchRepo, loanRepo, holdRepo, txManager, outboxUseCase, auditUseCase, cfg.Loan.HoldPickupWindow)
+	loanUseCase := usecase.NewLoanUseCase(loanRepo, bookRepo, bookCopyRepo, userRepo, holdRepo, fineRepo, loanPolicyRepo, calendarRepo, txManager, outboxUseCase, auditUseCase, usecase.LoanRules{
+		MaxLoans:           cfg.Loan.MaxLoans,
+		LoanDays:           cfg.Loan.LoanDays,
+		MaxRenewals:        cfg.Loan.MaxRenewals,
+		RenewalGracePeriod: cfg.Loan.RenewalGracePeriod,
+		HoldPickupWindow:   cfg.Loan.HoldPickupWindow,
+		Location:           cfg.Loan.TimeZone,
+		Fines:              fineRules,
+	})
+	holdUseCase := usecase.NewHoldUseCase(holdRepo, bookRepo, bookCopyRepo, userRepo, loanRepo, txManager, outboxUseCase, auditUseCase, cfg.Loan.HoldPickupWindow)
+	fineUseCase := usecase.NewFineUseCase(fineRepo, txManager, outboxUseCase, auditUseCase)
+	loanPolicyUseCase := usecase.NewLoanPolicyUseCase(loanPolicyRepo, txManager, auditUseCase)
+	bookCopyUseCase := usecase.NewBookCopyUseCase(bookCopyRepo, bookRepo, holdRepo, branchRepo, transferRepo, txManager, outboxUseCase, auditUseCase, cfg.Loan.HoldPickupWindow)
+	branchUseCase := usecase.NewBranchUseCase(branchRepo, bookCopyRepo, transferRepo, txManager, auditUseCase)
+	transferUseCase := usecase.NewTransferUseCase(transferRepo, bookCopyRepo, bookRepo, branchRepo, holdRepo, txManager, outboxUseCase, auditUseCase, cfg.Loan.HoldPickupWindow)
+	calendarUseCase := usecase.NewCalendarUseCase(calendarRepo, branchRepo, txManager, auditUseCase, cfg.Loan.TimeZone)
+	dueDateAdjustmentUseCase := usecase.NewDueDateAdjustmentUseCase(dueDateAdjustmentRepo, loanRepo, bookCopyRepo, bookRepo, branchRepo, calendarRepo, txManager, outboxUseCase, auditUseCase, cfg.Loan.TimeZone)
+
+	notifier, err := notification.New(notification.Config{
+		Channel: cfg.Notification.Channel,
+		SMTP: notification.SMTPConfig{
+			Host:     cfg.Notification.SMTPHost,
+			Port:     cfg.Notification.SMTPPort,
+			Username: cfg.Notification.SMTPUsername,
+			Password: cfg.Notification.SMTPPassword,
+			From:     cfg.Notification.SMTPFrom,
+		},
+		WebhookURL: cfg.Notification.WebhookURL,
+		FilePath:   cfg.Notification.FilePath,
+	})
+	if err != nil {
+		log.Fatalf("Failed to set up notifications: %v", err)
+	}
+	noticeTemplates, err := notification.LoadTemplates()
+	if err != nil {
+		log.Fatalf("Failed to load notice templates: %v", err)
+	}
+	reminder := notification.NewReminder(loanNoticeRepo, loanRepo, userRepo, bookRepo, notifier, noticeTemplates, cfg.Notification.ReminderDays, cfg.Loan.TimeZone)
+	escalationUseCase := usecase.NewEscalationUseCase(loanEscalationRepo, loanRepo, userRepo, bookRepo, bookCopyRepo, fineRepo, txManager, outboxUseCase, auditUseCase, reminder, escalationLadder, fineRules)
+
+	scheduler := job.NewScheduler()
+	scheduler.Every("expire-hold-pickups", cfg.Loan.HoldSweepInterval, func(ctx context.Context) error {
+		expired, err := holdUseCase.ExpirePickups(ctx)
+		if expired > 0 {
+			log.Printf("Expired %d hold pickups", expired)
+		}
+		return err
+	})
+	scheduler.Every("mark-overdue-loans", cfg.Loan.OverdueSweepInterval, func(ctx context.Context) error {
+		marked, err := loanUseCase.MarkOverdueLoans(ctx)
+		if marked > 0 {
+			log.Printf("Marked %d loans as overdue", marked)
+		}
+		return err
+	})
+	scheduler.Every("send-due-reminders", cfg.Notification.SweepInterval, func(ctx context.Context) error {
+		sent, err := reminder.SendDueReminders(ctx)
+		if sent > 0 {
+			log.Printf("Sent %d due date reminders", sent)
+		}
+		return err
+	})
+	scheduler.Every("send-overdue-notices", cfg.Notification.SweepInterval, func(ctx context.Context) error {
+		sent, err := reminder.SendOverdueNotices(ctx)
+		if sent > 0 {
+			log.Printf("Sent %d overdue notices", sent)
+		}
+		return err
+	})
+	scheduler.Every("escalate-overdue-loans", cfg.Loan.EscalationSweepInterval, func(ctx context.Context) error {
+		taken, err := escalationUseCase.EscalateOverdueLoans(ctx)
+		if taken > 0 {
+			log.Printf("Took %d overdue escalation steps", taken)
+		}
+		return err
+	})
+	scheduler.Every("relay-outbox", cfg.Outbox.RelayInterval, func(ctx context.Context) error {
+		published, err := outboxUseCase.Relay(ctx)
+		if published > 0 {
+			log.Printf("Published %d outbox events", published)
+		}
+		return err
+	})
+	scheduler.Every("purge-outbox", time.Hour, func(ctx context.Context) error {
+		purged, err := outboxUseCase.Purge(ctx)
+		if purged > 0 {
+			log.Printf("Purged %d published outbox events", purged)
+		}
+		return err
+	})
+	scheduler.Every("deliver-webhooks", cfg.Webhook.SweepInterval, func(ctx context.Context) error {
+		attempted, err := webhookUseCase.DeliverPending(ctx)
+		if attempted > 0 {
+			log.Printf("Attempted %d webhook deliveries", attempted)
+		}
+		return err
+	})
 
 	jwtService := auth.NewJWTService(auth.JWTConfig{
 		SecretKey:     cfg.JWT.SecretKey,
@@ -52,7 +208,7 @@ func main() {
 		Issuer:        cfg.JWT.Issuer,
 	})
 
-	h := handler.NewHandler(userUseCase, bookUseCase, loanUseCase, jwtService)
+	h := handler.NewHandler(userUseCase, bookUseCase, loanUseCase, holdUseCase, fineUseCase, loanPolicyUseCase, bookCopyUseCase, branchUseCase, transferUseCase, calendarUseCase, dueDateAdjustmentUseCase, escalationUseCase, webhookUseCase, auditUseCase, jwtService)
 	router := apphttp.NewRouter(h)
 
 	server := &http.Server{
@@ -79,6 +235,8 @@ func main() {
 
 	log.Println("Shutting down server...")
 
+	scheduler.Stop()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -7,10 +7,16 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	MongoDB  MongoDBConfig
-	JWT      JWTConfig
+	Server       ServerConfig
+	Database     DatabaseConfig
+	MongoDB      MongoDBConfig
+	JWT          JWTConfig
+	Loan         LoanConfig
+	Fine         FineConfig
+	Notification NotificationConfig
+	Webhook      WebhookConfig
+	Outbox       OutboxConfig
+	EventBus     EventBusConfig
 }
 
 type ServerConfig struct {
@@ -38,6 +44,85 @@ type JWTConfig struct {
 	Issuer        string
 }
 
+type LoanConfig struct {
+	// MaxLoans, LoanDays and MaxRenewals are used when no loan policy
+	// matches a loan.
+	MaxLoans             int
+	LoanDays             int
+	MaxRenewals          int
+	RenewalGracePeriod   time.Duration
+	HoldPickupWindow     time.Duration
+	HoldSweepInterval    time.Duration
+	OverdueSweepInterval time.Duration
+	// Escalation is the overdue escalation ladder as comma separated
+	// days:action steps; empty turns escalation off.
+	Escalation              string
+	EscalationSweepInterval time.Duration
+	// TimeZone is the library's local time, in which opening hours and
+	// closed dates are read.
+	TimeZone *time.Location
+}
+
+// Fine amounts are in cents.
+type FineConfig struct {
+	DailyRateCents      int64
+	MaxAmountCents      int64
+	BlockThresholdCents int64
+	// ReplacementCostCents is billed for a book declared lost.
+	ReplacementCostCents int64
+}
+
+type NotificationConfig struct {
+	// Channel is smtp, webhook, file or log.
+	Channel string
+	// ReminderDays is how many days before the due date patrons are
+	// reminded.
+	ReminderDays  int
+	SweepInterval time.Duration
+	FilePath      string
+	WebhookURL    string
+	SMTPHost      string
+	SMTPPort      int
+	SMTPUsername  string
+	SMTPPassword  string
+	SMTPFrom      string
+}
+
+// WebhookConfig sets how events are delivered to webhook subscriptions. The
+// wait after the nth failed attempt is RetryBaseDelay doubled n-1 times, up
+// to RetryMaxDelay.
+type WebhookConfig struct {
+	MaxAttempts    int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// Timeout bounds each attempt.
+	Timeout       time.Duration
+	BatchSize     int
+	SweepInterval time.Duration
+}
+
+// OutboxConfig sets how the relay publishes the events waiting in the
+// outbox. Lease bounds how long a relay run may hold an aggregate before
+// another replica takes it over.
+type OutboxConfig struct {
+	BatchSize     int
+	Lease         time.Duration
+	RetryDelay    time.Duration
+	Retention     time.Duration
+	RelayInterval time.Duration
+}
+
+// EventBusConfig selects the message broker domain events are streamed to
+// besides the webhooks. Publisher is none or nats.
+type EventBusConfig struct {
+	Publisher         string
+	Source            string
+	Timeout           time.Duration
+	NATSURL           string
+	NATSStream        string
+	NATSSubjectPrefix string
+}
+
 type MongoDBConfig struct {
 	URI         string
 	Database    string
@@ -65,7 +150,7 @@ func Load() *Config {
 			MaxLifetime:  getDurationEnv("DB_MAX_LIFETIME", 5*time.Minute),
 		},
 		MongoDB: MongoDBConfig{
-			URI:         getEnv("MONGO_URI", "mongodb://localhost:27017"),
+			URI:         getEnv("MONGO_URI", "mongodb://localhost:27017/?directConnection=true"),
 			Database:    getEnv("MONGO_DATABASE", "bookhub"),
 			MaxPoolSize: getUint64Env("MONGO_MAX_POOL_SIZE", 100),
 			MinPoolSize: getUint64Env("MONGO_MIN_POOL_SIZE", 10),
@@ -76,6 +161,59 @@ func Load() *Config {
 			TokenDuration: getDurationEnv("JWT_TOKEN_DURATION", 24*time.Hour),
 			Issuer:        getEnv("JWT_ISSUER", "bookhub"),
 		},
+		Loan: LoanConfig{
+			MaxLoans:                getIntEnv("LOAN_MAX_LOANS", 5),
+			LoanDays:                getIntEnv("LOAN_DAYS", 14),
+			MaxRenewals:             getIntEnv("LOAN_MAX_RENEWALS", 2),
+			RenewalGracePeriod:      getDurationEnv("LOAN_RENEWAL_GRACE_PERIOD", 72*time.Hour),
+			HoldPickupWindow:        getDurationEnv("HOLD_PICKUP_WINDOW", 72*time.Hour),
+			HoldSweepInterval:       getDurationEnv("HOLD_SWEEP_INTERVAL", 5*time.Minute),
+			OverdueSweepInterval:    getDurationEnv("LOAN_OVERDUE_SWEEP_INTERVAL", 15*time.Minute),
+			Escalation:              getEnv("LOAN_ESCALATION", "7:notice,14:notice,21:block,45:lost"),
+			EscalationSweepInterval: getDurationEnv("LOAN_ESCALATION_SWEEP_INTERVAL", time.Hour),
+			TimeZone:                getLocationEnv("LIBRARY_TIME_ZONE", time.UTC),
+		},
+		Fine: FineConfig{
+			DailyRateCents:       getInt64Env("FINE_DAILY_RATE_CENTS", 100),
+			MaxAmountCents:       getInt64Env("FINE_MAX_AMOUNT_CENTS", 5000),
+			BlockThresholdCents:  getInt64Env("FINE_BLOCK_THRESHOLD_CENTS", 1000),
+			ReplacementCostCents: getInt64Env("FINE_REPLACEMENT_COST_CENTS", 8000),
+		},
+		Notification: NotificationConfig{
+			Channel:       getEnv("NOTIFY_CHANNEL", "log"),
+			ReminderDays:  getIntEnv("NOTIFY_REMINDER_DAYS", 3),
+			SweepInterval: getDurationEnv("NOTIFY_SWEEP_INTERVAL", time.Hour),
+			FilePath:      getEnv("NOTIFY_FILE_PATH", "notifications.log"),
+			WebhookURL:    getEnv("NOTIFY_WEBHOOK_URL", ""),
+			SMTPHost:      getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:      getIntEnv("SMTP_PORT", 1025),
+			SMTPUsername:  getEnv("SMTP_USERNAME", ""),
+			SMTPPassword:  getEnv("SMTP_PASSWORD", ""),
+			SMTPFrom:      getEnv("SMTP_FROM", "noreply@bookhub.local"),
+		},
+		Webhook: WebhookConfig{
+			MaxAttempts:    getIntEnv("WEBHOOK_MAX_ATTEMPTS", 8),
+			RetryBaseDelay: getDurationEnv("WEBHOOK_RETRY_BASE_DELAY", 30*time.Second),
+			RetryMaxDelay:  getDurationEnv("WEBHOOK_RETRY_MAX_DELAY", time.Hour),
+			Timeout:        getDurationEnv("WEBHOOK_TIMEOUT", 10*time.Second),
+			BatchSize:      getIntEnv("WEBHOOK_BATCH_SIZE", 50),
+			SweepInterval:  getDurationEnv("WEBHOOK_SWEEP_INTERVAL", 10*time.Second),
+		},
+		Outbox: OutboxConfig{
+			BatchSize:     getIntEnv("OUTBOX_BATCH_SIZE", 100),
+			Lease:         getDurationEnv("OUTBOX_LEASE", time.Minute),
+			RetryDelay:    getDurationEnv("OUTBOX_RETRY_DELAY", 30*time.Second),
+			Retention:     getDurationEnv("OUTBOX_RETENTION", 7*24*time.Hour),
+			RelayInterval: getDurationEnv("OUTBOX_RELAY_INTERVAL", 2*time.Second),
+		},
+		EventBus: EventBusConfig{
+			Publisher:         getEnv("EVENT_PUBLISHER", "none"),
+			Source:            getEnv("EVENT_SOURCE", "/bookhub"),
+			Timeout:           getDurationEnv("EVENT_PUBLISH_TIMEOUT", 5*time.Second),
+			NATSURL:           getEnv("NATS_URL", "nats://localhost:4222"),
+			NATSStream:        getEnv("NATS_STREAM", "BOOKHUB_EVENTS"),
+			NATSSubjectPrefix: getEnv("NATS_SUBJECT_PREFIX", "bookhub.events"),
+		},
 	}
 }
 
@@ -104,6 +242,15 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+func getLocationEnv(key string, defaultValue *time.Location) *time.Location {
+	if value := os.Getenv(key); value != "" {
+		if location, err := time.LoadLocation(value); err == nil {
+			return location
+		}
+	}
+	return defaultValue
+}
+
 func getUint64Env(key string, defaultValue uint64) uint64 {
 	if value := os.Getenv(key); value != "" {
 		if intValue, err := strconv.ParseUint(value, 10, 64); err == nil {
@@ -112,3 +259,12 @@ func getUint64Env(key string, defaultValue uint64) uint64 {
 	}
 	return defaultValue
 }
+
+func getInt64Env(key string, defaultValue int64) int64 {
+	if value := os.Getenv(key); value != "" {
+		if intValue, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return intValue
+		}
+	}
+	return defaultValue
+}
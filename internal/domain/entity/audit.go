@@ -0,0 +1,223 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAuditHashMismatch = errors.New("audit entry hash does not match its contents")
+	ErrAuditChainBroken  = errors.New("audit entry does not follow the previous entry")
+)
+
+// Audited actions, named after the entity type and what happened to it.
+const (
+	AuditUserCreated           = "user.created"
+	AuditUserUpdated           = "user.updated"
+	AuditUserDisabled          = "user.disabled"
+	AuditUserBlocked           = "user.blocked"
+	AuditUserUnblocked         = "user.unblocked"
+	AuditUserPasswordChanged   = "user.password_changed"
+	AuditBookCreated           = "book.created"
+	AuditBookUpdated           = "book.updated"
+	AuditBookWithdrawn         = "book.withdrawn"
+	AuditBookDeleted           = "book.deleted"
+	AuditCopyCreated           = "copy.created"
+	AuditCopyUpdated           = "copy.updated"
+	AuditCopyDeleted           = "copy.deleted"
+	AuditLoanBorrowed          = "loan.borrowed"
+	AuditLoanReturned          = "loan.returned"
+	AuditLoanLost              = "loan.lost"
+	AuditLoanRenewed           = "loan.renewed"
+	AuditLoanDueDateChanged    = "loan.due_date_changed"
+	AuditLoanOverdue           = "loan.overdue"
+	AuditHoldPlaced            = "hold.placed"
+	AuditHoldReady             = "hold.ready"
+	AuditHoldFulfilled         = "hold.fulfilled"
+	AuditHoldCancelled         = "hold.cancelled"
+	AuditHoldExpired           = "hold.expired"
+	AuditFineAssessed          = "fine.assessed"
+	AuditFinePaid              = "fine.paid"
+	AuditFineWaived            = "fine.waived"
+	AuditLoanPolicyCreated     = "loan_policy.created"
+	AuditLoanPolicyUpdated     = "loan_policy.updated"
+	AuditLoanPolicyDeleted     = "loan_policy.deleted"
+	AuditBranchCreated         = "branch.created"
+	AuditBranchUpdated         = "branch.updated"
+	AuditBranchDeleted         = "branch.deleted"
+	AuditTransferRequested     = "transfer.requested"
+	AuditTransferShipped       = "transfer.shipped"
+	AuditTransferReceived      = "transfer.received"
+	AuditTransferCancelled     = "transfer.cancelled"
+	AuditWebhookCreated        = "webhook.created"
+	AuditWebhookUpdated        = "webhook.updated"
+	AuditWebhookDeleted        = "webhook.deleted"
+	AuditWebhookRedelivered    = "webhook.redelivered"
+	AuditOpeningHoursSet       = "opening_hours.set"
+	AuditClosedDateAdded       = "closed_date.added"
+	AuditClosedDateRemoved     = "closed_date.removed"
+	AuditDueDateAdjustmentMade = "due_date_adjustment.made"
+)
+
+const (
+	AuditEntityUser              = "user"
+	AuditEntityBook              = "book"
+	AuditEntityCopy              = "copy"
+	AuditEntityLoan              = "loan"
+	AuditEntityHold              = "hold"
+	AuditEntityFine              = "fine"
+	AuditEntityLoanPolicy        = "loan_policy"
+	AuditEntityBranch            = "branch"
+	AuditEntityTransfer          = "transfer"
+	AuditEntityWebhook           = "webhook"
+	AuditEntityOpeningHours      = "opening_hours"
+	AuditEntityClosedDate        = "closed_date"
+	AuditEntityDueDateAdjustment = "due_date_adjustment"
+)
+
+// AuditChange is the value of one field before and after a change. Before
+// is nil for created entities.
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditEntry records who did what to which entity. Entries form a hash
+// chain: each one's Hash covers its contents and the previous entry's Hash,
+// so editing, removing or reordering entries breaks the chain after them.
+type AuditEntry struct {
+	ID       uuid.UUID
+	Sequence int64
+	// ActorID is the authenticated user behind the change, or nil for
+	// changes made by background jobs.
+	ActorID    *uuid.UUID
+	Action     string
+	EntityType string
+	EntityID   uuid.UUID
+	// Changes holds the fields that changed, by name.
+	Changes   map[string]AuditChange
+	RequestID string
+	CreatedAt time.Time
+	PrevHash  string
+	Hash      string
+}
+
+// NewAuditEntry creates an unchained entry. CreatedAt is kept to the
+// millisecond, the precision both databases store, so the hash computed now
+// still matches once the entry is read back.
+func NewAuditEntry(actorID *uuid.UUID, action, entityType string, entityID uuid.UUID, changes map[string]AuditChange, requestID string) *AuditEntry {
+	return &AuditEntry{
+		ID:         uuid.New(),
+		ActorID:    actorID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Changes:    changes,
+		RequestID:  requestID,
+		CreatedAt:  time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+// Chain places the entry after the one with prevSequence and prevHash, or
+// first when prevSequence is 0, and seals it.
+func (e *AuditEntry) Chain(prevSequence int64, prevHash string) {
+	e.Sequence = prevSequence + 1
+	e.PrevHash = prevHash
+	e.Hash = e.ComputeHash()
+}
+
+// ComputeHash is the hex SHA-256 of the entry's contents and PrevHash.
+func (e *AuditEntry) ComputeHash() string {
+	// A struct marshals its fields in order and maps marshal with sorted
+	// keys, so the encoding is the same every time.
+	body, _ := json.Marshal(struct {
+		Sequence   int64                  `json:"sequence"`
+		PrevHash   string                 `json:"prev_hash"`
+		ID         uuid.UUID              `json:"id"`
+		ActorID    *uuid.UUID             `json:"actor_id"`
+		Action     string                 `json:"action"`
+		EntityType string                 `json:"entity_type"`
+		EntityID   uuid.UUID              `json:"entity_id"`
+		Changes    map[string]AuditChange `json:"changes"`
+		RequestID  string                 `json:"request_id"`
+		CreatedAt  string                 `json:"created_at"`
+	}{
+		Sequence:   e.Sequence,
+		PrevHash:   e.PrevHash,
+		ID:         e.ID,
+		ActorID:    e.ActorID,
+		Action:     e.Action,
+		EntityType: e.EntityType,
+		EntityID:   e.EntityID,
+		Changes:    e.Changes,
+		RequestID:  e.RequestID,
+		CreatedAt:  e.CreatedAt.UTC().Format(time.RFC3339Nano),
+	})
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyAfter checks that the entry is intact and directly follows prev, or
+// starts the chain when prev is nil.
+func (e *AuditEntry) VerifyAfter(prev *AuditEntry) error {
+	if e.ComputeHash() != e.Hash {
+		return ErrAuditHashMismatch
+	}
+	prevSequence, prevHash := int64(0), ""
+	if prev != nil {
+		prevSequence, prevHash = prev.Sequence, prev.Hash
+	}
+	if e.Sequence != prevSequence+1 || e.PrevHash != prevHash {
+		return ErrAuditChainBroken
+	}
+	return nil
+}
+
+// DiffAuditStates compares two snapshots of an entity field by field, using
+// their JSON encoding, and returns the fields that differ. A nil before,
+// or a nil pointer, reports every field of after.
+func DiffAuditStates(before, after any) (map[string]AuditChange, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]AuditChange)
+	for name, value := range afterFields {
+		if old, ok := beforeFields[name]; !ok || !reflect.DeepEqual(old, value) {
+			changes[name] = AuditChange{Before: beforeFields[name], After: value}
+		}
+	}
+	for name, old := range beforeFields {
+		if _, ok := afterFields[name]; !ok {
+			changes[name] = AuditChange{Before: old}
+		}
+	}
+	return changes, nil
+}
+
+func auditFields(state any) (map[string]any, error) {
+	if state == nil {
+		return nil, nil
+	}
+	body, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("audit state must encode as a JSON object: %w", err)
+	}
+	return fields, nil
+}
@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type testAuditState struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+	Copies int    `json:"copies"`
+}
+
+func TestDiffAuditStates(t *testing.T) {
+	changes, err := DiffAuditStates(
+		testAuditState{Name: "Dom Casmurro", Active: true, Copies: 2},
+		testAuditState{Name: "Dom Casmurro", Active: false, Copies: 3},
+	)
+	if err != nil {
+		t.Fatalf("DiffAuditStates() unexpected error = %v", err)
+	}
+
+	if len(changes) != 2 {
+		t.Fatalf("DiffAuditStates() returned %d changes, want 2: %v", len(changes), changes)
+	}
+	if changes["active"] != (AuditChange{Before: true, After: false}) {
+		t.Errorf("changes[active] = %v, want true -> false", changes["active"])
+	}
+	if changes["copies"] != (AuditChange{Before: 2.0, After: 3.0}) {
+		t.Errorf("changes[copies] = %v, want 2 -> 3", changes["copies"])
+	}
+}
+
+func TestDiffAuditStates_Created(t *testing.T) {
+	changes, err := DiffAuditStates((*testAuditState)(nil), testAuditState{Name: "Dom Casmurro", Copies: 1})
+	if err != nil {
+		t.Fatalf("DiffAuditStates() unexpected error = %v", err)
+	}
+	if len(changes) != 3 {
+		t.Errorf("DiffAuditStates() returned %d changes, want every field", len(changes))
+	}
+	if changes["name"] != (AuditChange{After: "Dom Casmurro"}) {
+		t.Errorf("changes[name] = %v, want nil -> Dom Casmurro", changes["name"])
+	}
+}
+
+func newTestAuditChain(t *testing.T, n int) []*AuditEntry {
+	t.Helper()
+	actorID := uuid.New()
+	entries := make([]*AuditEntry, n)
+	for i := range entries {
+		changes, _ := DiffAuditStates(nil, testAuditState{Name: "Dom Casmurro", Copies: i})
+		entries[i] = NewAuditEntry(&actorID, AuditBookCreated, AuditEntityBook, uuid.New(), changes, "req-1")
+		if i == 0 {
+			entries[i].Chain(0, "")
+		} else {
+			entries[i].Chain(entries[i-1].Sequence, entries[i-1].Hash)
+		}
+	}
+	return entries
+}
+
+func TestAuditEntry_Chain(t *testing.T) {
+	entries := newTestAuditChain(t, 3)
+
+	var prev *AuditEntry
+	for _, entry := range entries {
+		if err := entry.VerifyAfter(prev); err != nil {
+			t.Errorf("entry %d VerifyAfter() unexpected error = %v", entry.Sequence, err)
+		}
+		prev = entry
+	}
+	if entries[2].Sequence != 3 || entries[2].PrevHash != entries[1].Hash {
+		t.Errorf("third entry has sequence %d and prev hash %q, want 3 and %q", entries[2].Sequence, entries[2].PrevHash, entries[1].Hash)
+	}
+}
+
+// The hash must survive a round trip through JSON storage, which turns
+// every number into a float64.
+func TestAuditEntry_HashSurvivesStorage(t *testing.T) {
+	entry := newTestAuditChain(t, 1)[0]
+
+	stored, err := json.Marshal(entry.Changes)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error = %v", err)
+	}
+	var changes map[string]AuditChange
+	if err := json.Unmarshal(stored, &changes); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error = %v", err)
+	}
+	entry.Changes = changes
+
+	if err := entry.VerifyAfter(nil); err != nil {
+		t.Errorf("VerifyAfter() after storage unexpected error = %v", err)
+	}
+}
+
+func TestAuditEntry_VerifyAfter_Tampered(t *testing.T) {
+	entries := newTestAuditChain(t, 3)
+
+	edited := *entries[1]
+	edited.Changes = map[string]AuditChange{"copies": {After: 99.0}}
+	if err := edited.VerifyAfter(entries[0]); !errors.Is(err, ErrAuditHashMismatch) {
+		t.Errorf("VerifyAfter() of an edited entry error = %v, want %v", err, ErrAuditHashMismatch)
+	}
+
+	// Removing an entry leaves the next one pointing at it
+	if err := entries[2].VerifyAfter(entries[0]); !errors.Is(err, ErrAuditChainBroken) {
+		t.Errorf("VerifyAfter() across a removed entry error = %v, want %v", err, ErrAuditChainBroken)
+	}
+}
@@ -14,7 +14,14 @@ var (
 	ErrInvalidTotalCopies     = errors.New("invalid total copies: must be at least 1")
 	ErrBookNotFound           = errors.New("book not found")
 	ErrBookNotAvailable       = errors.New("book not available: all copies are borrowed")
-	ErrInvalidAvailableCopies = errors.New("invalid available copies")
+	ErrConcurrentModification = errors.New("book was modified concurrently, please retry")
+	ErrBookWithdrawn          = errors.New("book was withdrawn from the catalog")
+	ErrBookHasActiveLoans     = errors.New("book has copies on loan")
+	ErrBookHasHistory         = errors.New("book has loan or hold history, withdraw it instead")
+	ErrCopiesInCirculation    = errors.New("invalid total copies: too many copies are on loan, set aside for a hold or in transit")
+	ErrInvalidSearchQuery     = errors.New("invalid search query: must contain a word or number")
+	ErrInvalidYearRange       = errors.New("invalid published year range: start is after end")
+	ErrInvalidSuggestPrefix   = errors.New("invalid suggest prefix: must be between 2 and 100 characters")
 )
 
 const (
@@ -23,15 +30,26 @@ const (
 )
 
 type Book struct {
-	ID              uuid.UUID
-	Title           string
-	Author          string
-	ISBN            string
-	PublishedYear   int
+	ID            uuid.UUID
+	Title         string
+	Author        string
+	ISBN          string
+	PublishedYear int
+	// Category is the item category that selects the loan policy.
+	Category        string
 	TotalCopies     int
 	AvailableCopies int
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	// WithdrawnAt is set once the book is taken out of the catalog. It is
+	// kept, with its loan history, but no longer listed or lent.
+	WithdrawnAt *time.Time
+	// Version is bumped on every update and guards against lost updates:
+	// an update only applies if the stored version still matches.
+	Version int
+	// Branches breaks the copy counts down by branch. It is derived from
+	// the copies when the book is read and is not stored.
+	Branches []BranchAvailability
 }
 
 func NewBook(title, author, isbn string, publishedYear, totalCopies int) (*Book, error) {
@@ -41,10 +59,12 @@ func NewBook(title, author, isbn string, publishedYear, totalCopies int) (*Book,
 		Author:          author,
 		ISBN:            isbn,
 		PublishedYear:   publishedYear,
+		Category:        DefaultItemCategory,
 		TotalCopies:     totalCopies,
 		AvailableCopies: totalCopies,
 		CreatedAt:       time.Now(),
 		UpdatedAt:       time.Now(),
+		Version:         1,
 	}
 
 	if err := book.Validate(); err != nil {
@@ -55,6 +75,19 @@ func NewBook(title, author, isbn string, publishedYear, totalCopies int) (*Book,
 }
 
 func (b *Book) Validate() error {
+	if err := b.validateDetails(); err != nil {
+		return err
+	}
+
+	if b.TotalCopies < 1 {
+		return ErrInvalidTotalCopies
+	}
+
+	return nil
+}
+
+// validateDetails checks the catalog details, leaving out the copy counts.
+func (b *Book) validateDetails() error {
 	if len(b.Title) < 1 || len(b.Title) > 200 {
 		return ErrInvalidBookTitle
 	}
@@ -67,13 +100,51 @@ func (b *Book) Validate() error {
 		return ErrInvalidBookISBN
 	}
 
-	if b.TotalCopies < 1 {
-		return ErrInvalidTotalCopies
+	if !IsValidCategory(b.Category) {
+		return ErrInvalidCategory
+	}
+
+	return nil
+}
+
+// Update changes the catalog details of the book. The copy counts are
+// derived from its copies and change through them.
+func (b *Book) Update(title, author, isbn string, publishedYear int, category string) error {
+	updated := *b
+	updated.Title = title
+	updated.Author = author
+	updated.ISBN = isbn
+	updated.PublishedYear = publishedYear
+	updated.Category = category
+	if err := updated.validateDetails(); err != nil {
+		return err
 	}
 
+	b.Title = title
+	b.Author = author
+	b.ISBN = isbn
+	b.PublishedYear = publishedYear
+	b.Category = category
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// Withdraw takes the book out of the catalog. The caller withdraws its
+// copies and refreshes the counts.
+func (b *Book) Withdraw() error {
+	if b.IsWithdrawn() {
+		return ErrBookWithdrawn
+	}
+	now := time.Now()
+	b.WithdrawnAt = &now
+	b.UpdatedAt = now
 	return nil
 }
 
+func (b *Book) IsWithdrawn() bool {
+	return b.WithdrawnAt != nil
+}
+
 func (b *Book) IsAvailable() bool {
 	return b.AvailableCopies > 0
 }
@@ -85,22 +156,11 @@ func (b *Book) AvailabilityStatus() string {
 	return StatusUnavailable
 }
 
-func (b *Book) BorrowCopy() error {
-	if !b.IsAvailable() {
-		return ErrBookNotAvailable
-	}
-	b.AvailableCopies--
+// RefreshCopyCounts derives TotalCopies and AvailableCopies from the
+// statuses of the book's copies.
+func (b *Book) RefreshCopyCounts(copies []*BookCopy) {
+	b.TotalCopies, b.AvailableCopies = CountCopies(copies)
 	b.UpdatedAt = time.Now()
-	return nil
-}
-
-func (b *Book) ReturnCopy() error {
-	if b.AvailableCopies >= b.TotalCopies {
-		return ErrInvalidAvailableCopies
-	}
-	b.AvailableCopies++
-	b.UpdatedAt = time.Now()
-	return nil
 }
 
 func isValidISBN(isbn string) bool {
@@ -0,0 +1,210 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBookCopyNotFound     = errors.New("book copy not found")
+	ErrBarcodeAlreadyExists = errors.New("barcode already in use")
+	ErrInvalidBarcode       = errors.New("invalid barcode: must be 1 to 50 letters, digits or '-'")
+	ErrInvalidCopyLocation  = errors.New("invalid shelf location: must be at most 100 characters")
+	ErrInvalidCopyCondition = errors.New("invalid condition: must be new, good, fair, poor or damaged")
+	ErrInvalidCopyStatus    = errors.New("invalid copy status: must be available, in_repair or withdrawn")
+	ErrCopyNotAvailable     = errors.New("copy is not available for loan")
+	ErrCopyInCirculation    = errors.New("copy is on loan, set aside for a hold or in transit")
+	ErrCopyNotOnLoan        = errors.New("copy is not on loan")
+)
+
+const (
+	CopyStatusAvailable = "available"
+	CopyStatusOnLoan    = "on_loan"
+	CopyStatusOnHold    = "on_hold"
+	CopyStatusInTransit = "in_transit"
+	CopyStatusInRepair  = "in_repair"
+	CopyStatusWithdrawn = "withdrawn"
+)
+
+const (
+	CopyConditionNew     = "new"
+	CopyConditionGood    = "good"
+	CopyConditionFair    = "fair"
+	CopyConditionPoor    = "poor"
+	CopyConditionDamaged = "damaged"
+)
+
+var barcodeRegex = regexp.MustCompile(`^[A-Za-z0-9-]{1,50}$`)
+
+// BookCopy is one physical copy of a book, kept at one branch. Copies on
+// loan, set aside for a hold or in transit are moved by circulation and
+// transfers; staff only move the others between the shelf, repair and
+// withdrawal.
+type BookCopy struct {
+	ID       uuid.UUID
+	BookID   uuid.UUID
+	BranchID uuid.UUID
+	Barcode  string
+	// Location is the shelf the copy is kept on.
+	Location  string
+	Condition string
+	Status    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewBookCopy(bookID, branchID uuid.UUID, barcode, location, condition string) (*BookCopy, error) {
+	if condition == "" {
+		condition = CopyConditionGood
+	}
+
+	now := time.Now()
+	bookCopy := &BookCopy{
+		ID:        uuid.New(),
+		BookID:    bookID,
+		BranchID:  branchID,
+		Barcode:   barcode,
+		Location:  location,
+		Condition: condition,
+		Status:    CopyStatusAvailable,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := bookCopy.Validate(); err != nil {
+		return nil, err
+	}
+
+	return bookCopy, nil
+}
+
+// DefaultCopyBarcode numbers the n-th copy created along with a book.
+func DefaultCopyBarcode(isbn string, n int) string {
+	return fmt.Sprintf("%s-%03d", isbn, n)
+}
+
+func (c *BookCopy) Validate() error {
+	if !barcodeRegex.MatchString(c.Barcode) {
+		return ErrInvalidBarcode
+	}
+	if len(c.Location) > 100 {
+		return ErrInvalidCopyLocation
+	}
+	if !isValidCopyCondition(c.Condition) {
+		return ErrInvalidCopyCondition
+	}
+	return nil
+}
+
+// Update changes the shelf location, condition and status. Only the
+// statuses staff manage may be set, and not while the copy is in
+// circulation.
+func (c *BookCopy) Update(location, condition, status string) error {
+	if len(location) > 100 {
+		return ErrInvalidCopyLocation
+	}
+	if !isValidCopyCondition(condition) {
+		return ErrInvalidCopyCondition
+	}
+	if status != c.Status {
+		if status != CopyStatusAvailable && status != CopyStatusInRepair && status != CopyStatusWithdrawn {
+			return ErrInvalidCopyStatus
+		}
+		if c.IsInCirculation() {
+			return ErrCopyInCirculation
+		}
+	}
+
+	c.Location = location
+	c.Condition = condition
+	c.Status = status
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsInCirculation reports whether the copy is with a patron, set aside for
+// one or on its way to another branch.
+func (c *BookCopy) IsInCirculation() bool {
+	return c.Status == CopyStatusOnLoan || c.Status == CopyStatusOnHold || c.Status == CopyStatusInTransit
+}
+
+// CheckOut lends the copy from the shelf or from the hold it was set aside
+// for.
+func (c *BookCopy) CheckOut() error {
+	if c.Status != CopyStatusAvailable && c.Status != CopyStatusOnHold {
+		return ErrCopyNotAvailable
+	}
+	c.Status = CopyStatusOnLoan
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// Ship sends a shelf copy off to another branch.
+func (c *BookCopy) Ship() error {
+	if c.Status != CopyStatusAvailable {
+		return ErrCopyNotAvailable
+	}
+	c.Status = CopyStatusInTransit
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// MoveTo records the copy as kept at branchID. The caller decides whether it
+// goes on the shelf or to the hold queue.
+func (c *BookCopy) MoveTo(branchID uuid.UUID) {
+	c.BranchID = branchID
+	c.UpdatedAt = time.Now()
+}
+
+// SetAside reserves the copy for the next hold in line.
+func (c *BookCopy) SetAside() {
+	c.Status = CopyStatusOnHold
+	c.UpdatedAt = time.Now()
+}
+
+// Shelve puts the copy back on the shelf.
+func (c *BookCopy) Shelve() {
+	c.Status = CopyStatusAvailable
+	c.UpdatedAt = time.Now()
+}
+
+// SendToRepair takes a copy returned damaged off circulation until staff
+// shelve it again.
+func (c *BookCopy) SendToRepair() {
+	c.Condition = CopyConditionDamaged
+	c.Status = CopyStatusInRepair
+	c.UpdatedAt = time.Now()
+}
+
+// Withdraw takes the copy out of the collection, e.g. once it is lost.
+func (c *BookCopy) Withdraw() {
+	c.Status = CopyStatusWithdrawn
+	c.UpdatedAt = time.Now()
+}
+
+// CountCopies returns how many copies are held by the library, leaving out
+// withdrawn ones, and how many of those are on the shelf.
+func CountCopies(copies []*BookCopy) (total, available int) {
+	for _, c := range copies {
+		if c.Status == CopyStatusWithdrawn {
+			continue
+		}
+		total++
+		if c.Status == CopyStatusAvailable {
+			available++
+		}
+	}
+	return total, available
+}
+
+func isValidCopyCondition(condition string) bool {
+	switch condition {
+	case CopyConditionNew, CopyConditionGood, CopyConditionFair, CopyConditionPoor, CopyConditionDamaged:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewBookCopy(t *testing.T) {
+	tests := []struct {
+		name      string
+		barcode   string
+		location  string
+		condition string
+		wantErr   error
+	}{
+		{"valid copy", "BH-000123", "A-3", CopyConditionNew, nil},
+		{"default condition", "BH-000123", "", "", nil},
+		{"empty barcode", "", "A-3", CopyConditionGood, ErrInvalidBarcode},
+		{"barcode with spaces", "BH 000123", "A-3", CopyConditionGood, ErrInvalidBarcode},
+		{"location too long", "BH-000123", strings.Repeat("a", 101), CopyConditionGood, ErrInvalidCopyLocation},
+		{"unknown condition", "BH-000123", "A-3", "mint", ErrInvalidCopyCondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bookCopy, err := NewBookCopy(uuid.New(), uuid.New(), tt.barcode, tt.location, tt.condition)
+			if err != tt.wantErr {
+				t.Errorf("NewBookCopy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if bookCopy.Status != CopyStatusAvailable {
+				t.Errorf("NewBookCopy() status = %v, want %v", bookCopy.Status, CopyStatusAvailable)
+			}
+			if tt.condition == "" && bookCopy.Condition != CopyConditionGood {
+				t.Errorf("NewBookCopy() condition = %v, want %v", bookCopy.Condition, CopyConditionGood)
+			}
+		})
+	}
+}
+
+func TestDefaultCopyBarcode(t *testing.T) {
+	if got := DefaultCopyBarcode("9780132350884", 2); got != "9780132350884-002" {
+		t.Errorf("DefaultCopyBarcode() = %v, want %v", got, "9780132350884-002")
+	}
+	if got := DefaultCopyBarcode("9780132350884", 1000); got != "9780132350884-1000" {
+		t.Errorf("DefaultCopyBarcode() = %v, want %v", got, "9780132350884-1000")
+	}
+}
+
+func TestBookCopy_Update(t *testing.T) {
+	t.Run("send to repair", func(t *testing.T) {
+		bookCopy, _ := NewBookCopy(uuid.New(), uuid.New(), "BH-1", "A-3", CopyConditionGood)
+
+		if err := bookCopy.Update("B-1", CopyConditionDamaged, CopyStatusInRepair); err != nil {
+			t.Errorf("BookCopy.Update() unexpected error = %v", err)
+		}
+		if bookCopy.Location != "B-1" || bookCopy.Condition != CopyConditionDamaged || bookCopy.Status != CopyStatusInRepair {
+			t.Errorf("BookCopy.Update() = %v/%v/%v, want B-1/damaged/in_repair", bookCopy.Location, bookCopy.Condition, bookCopy.Status)
+		}
+	})
+
+	t.Run("circulation statuses are not settable", func(t *testing.T) {
+		bookCopy, _ := NewBookCopy(uuid.New(), uuid.New(), "BH-1", "A-3", CopyConditionGood)
+
+		if err := bookCopy.Update("A-3", CopyConditionGood, CopyStatusOnLoan); err != ErrInvalidCopyStatus {
+			t.Errorf("BookCopy.Update() error = %v, wantErr %v", err, ErrInvalidCopyStatus)
+		}
+	})
+
+	t.Run("copy on loan keeps its status", func(t *testing.T) {
+		bookCopy, _ := NewBookCopy(uuid.New(), uuid.New(), "BH-1", "A-3", CopyConditionGood)
+		_ = bookCopy.CheckOut()
+
+		if err := bookCopy.Update("A-3", CopyConditionGood, CopyStatusWithdrawn); err != ErrCopyInCirculation {
+			t.Errorf("BookCopy.Update() error = %v, wantErr %v", err, ErrCopyInCirculation)
+		}
+		if err := bookCopy.Update("B-1", CopyConditionFair, CopyStatusOnLoan); err != nil {
+			t.Errorf("BookCopy.Update() unexpected error = %v", err)
+		}
+	})
+}
+
+func TestBookCopy_CheckOut(t *testing.T) {
+	tests := []struct {
+		status  string
+		wantErr error
+	}{
+		{CopyStatusAvailable, nil},
+		{CopyStatusOnHold, nil},
+		{CopyStatusOnLoan, ErrCopyNotAvailable},
+		{CopyStatusInTransit, ErrCopyNotAvailable},
+		{CopyStatusInRepair, ErrCopyNotAvailable},
+		{CopyStatusWithdrawn, ErrCopyNotAvailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			bookCopy := &BookCopy{Status: tt.status}
+			if err := bookCopy.CheckOut(); err != tt.wantErr {
+				t.Errorf("BookCopy.CheckOut() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && bookCopy.Status != CopyStatusOnLoan {
+				t.Errorf("BookCopy.CheckOut() status = %v, want %v", bookCopy.Status, CopyStatusOnLoan)
+			}
+		})
+	}
+}
+
+func TestBookCopy_Ship(t *testing.T) {
+	tests := []struct {
+		status  string
+		wantErr error
+	}{
+		{CopyStatusAvailable, nil},
+		{CopyStatusOnHold, ErrCopyNotAvailable},
+		{CopyStatusOnLoan, ErrCopyNotAvailable},
+		{CopyStatusInTransit, ErrCopyNotAvailable},
+		{CopyStatusInRepair, ErrCopyNotAvailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			bookCopy := &BookCopy{Status: tt.status}
+			if err := bookCopy.Ship(); err != tt.wantErr {
+				t.Errorf("BookCopy.Ship() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !bookCopy.IsInCirculation() {
+				t.Errorf("BookCopy.Ship() status = %v, want it in circulation", bookCopy.Status)
+			}
+		})
+	}
+}
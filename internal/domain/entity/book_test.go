@@ -174,57 +174,75 @@ func TestBook_AvailabilityStatus(t *testing.T) {
 	}
 }
 
-func TestBook_BorrowCopy(t *testing.T) {
-	t.Run("borrow from available book", func(t *testing.T) {
-		book, _ := NewBook("Test Book", "Author", "9780132350884", 2020, 3)
-		initialCopies := book.AvailableCopies
+func TestBook_RefreshCopyCounts(t *testing.T) {
+	book, _ := NewBook("Test Book", "Author", "9780132350884", 2020, 1)
+	copies := []*BookCopy{
+		{Status: CopyStatusAvailable},
+		{Status: CopyStatusAvailable},
+		{Status: CopyStatusOnLoan},
+		{Status: CopyStatusOnHold},
+		{Status: CopyStatusInRepair},
+		{Status: CopyStatusWithdrawn},
+	}
 
-		err := book.BorrowCopy()
-		if err != nil {
-			t.Errorf("Book.BorrowCopy() unexpected error = %v", err)
-		}
+	book.RefreshCopyCounts(copies)
 
-		if book.AvailableCopies != initialCopies-1 {
-			t.Errorf("Book.BorrowCopy() available copies = %v, want %v", book.AvailableCopies, initialCopies-1)
-		}
-	})
+	if book.TotalCopies != 5 {
+		t.Errorf("Book.RefreshCopyCounts() total copies = %v, want %v", book.TotalCopies, 5)
+	}
+	if book.AvailableCopies != 2 {
+		t.Errorf("Book.RefreshCopyCounts() available copies = %v, want %v", book.AvailableCopies, 2)
+	}
+}
 
-	t.Run("borrow from unavailable book", func(t *testing.T) {
+func TestBook_Update(t *testing.T) {
+	t.Run("valid details", func(t *testing.T) {
 		book, _ := NewBook("Test Book", "Author", "9780132350884", 2020, 1)
-		book.AvailableCopies = 0
 
-		err := book.BorrowCopy()
-		if err != ErrBookNotAvailable {
-			t.Errorf("Book.BorrowCopy() error = %v, wantErr %v", err, ErrBookNotAvailable)
+		err := book.Update("Clean Code", "Robert C. Martin", "0132350882", 2008, "reference")
+		if err != nil {
+			t.Fatalf("Book.Update() unexpected error = %v", err)
+		}
+		if book.Title != "Clean Code" || book.ISBN != "0132350882" || book.Category != "reference" {
+			t.Errorf("Book.Update() = %+v, want the new details", book)
 		}
 	})
-}
 
-func TestBook_ReturnCopy(t *testing.T) {
-	t.Run("return borrowed copy", func(t *testing.T) {
-		book, _ := NewBook("Test Book", "Author", "9780132350884", 2020, 3)
-		book.AvailableCopies = 2
+	t.Run("invalid details leave the book untouched", func(t *testing.T) {
+		book, _ := NewBook("Test Book", "Author", "9780132350884", 2020, 1)
 
-		err := book.ReturnCopy()
-		if err != nil {
-			t.Errorf("Book.ReturnCopy() unexpected error = %v", err)
+		if err := book.Update("Clean Code", "", "9780132350884", 2008, DefaultItemCategory); err != ErrInvalidBookAuthor {
+			t.Errorf("Book.Update() error = %v, want %v", err, ErrInvalidBookAuthor)
 		}
-
-		if book.AvailableCopies != 3 {
-			t.Errorf("Book.ReturnCopy() available copies = %v, want %v", book.AvailableCopies, 3)
+		if book.Title != "Test Book" {
+			t.Errorf("Book.Update() title = %v, want it unchanged", book.Title)
 		}
 	})
 
-	t.Run("return when all copies available", func(t *testing.T) {
-		book, _ := NewBook("Test Book", "Author", "9780132350884", 2020, 3)
+	t.Run("book without copies", func(t *testing.T) {
+		book, _ := NewBook("Test Book", "Author", "9780132350884", 2020, 1)
+		book.RefreshCopyCounts([]*BookCopy{{Status: CopyStatusWithdrawn}})
 
-		err := book.ReturnCopy()
-		if err != ErrInvalidAvailableCopies {
-			t.Errorf("Book.ReturnCopy() error = %v, wantErr %v", err, ErrInvalidAvailableCopies)
+		if err := book.Update("Clean Code", "Author", "9780132350884", 2020, DefaultItemCategory); err != nil {
+			t.Errorf("Book.Update() unexpected error = %v", err)
 		}
 	})
 }
 
+func TestBook_Withdraw(t *testing.T) {
+	book, _ := NewBook("Test Book", "Author", "9780132350884", 2020, 1)
+
+	if err := book.Withdraw(); err != nil {
+		t.Fatalf("Book.Withdraw() unexpected error = %v", err)
+	}
+	if !book.IsWithdrawn() {
+		t.Error("Book.Withdraw() book is not withdrawn")
+	}
+	if err := book.Withdraw(); err != ErrBookWithdrawn {
+		t.Errorf("Book.Withdraw() twice error = %v, want %v", err, ErrBookWithdrawn)
+	}
+}
+
 func TestIsValidISBN(t *testing.T) {
 	tests := []struct {
 		isbn  string
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBranchNotFound          = errors.New("branch not found")
+	ErrBranchCodeAlreadyExists = errors.New("branch code already in use")
+	ErrInvalidBranchCode       = errors.New("invalid branch code: must be 2 to 20 uppercase letters, digits or '-'")
+	ErrInvalidBranchName       = errors.New("invalid branch name: must be between 2 and 100 characters")
+	ErrInvalidBranchAddress    = errors.New("invalid branch address: must be at most 255 characters")
+	ErrBranchInUse             = errors.New("branch still holds copies or has transfers on record")
+	ErrDefaultBranchRequired   = errors.New("the default branch cannot be deleted")
+)
+
+// DefaultBranchCode identifies the branch that receives new copies when no
+// branch is given.
+const DefaultBranchCode = "MAIN"
+
+var branchCodeRegex = regexp.MustCompile(`^[A-Z0-9-]{2,20}$`)
+
+// Branch is a library location that holds copies.
+type Branch struct {
+	ID uuid.UUID
+	// Code is a short label such as MAIN; it identifies the branch and is
+	// not changed after creation.
+	Code      string
+	Name      string
+	Address   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// BranchAvailability counts one book's copies held at one branch, leaving
+// out withdrawn ones, and how many of those are on the shelf.
+type BranchAvailability struct {
+	BookID          uuid.UUID
+	BranchID        uuid.UUID
+	TotalCopies     int
+	AvailableCopies int
+}
+
+func NewBranch(code, name, address string) (*Branch, error) {
+	now := time.Now()
+	branch := &Branch{
+		ID:        uuid.New(),
+		Code:      code,
+		Name:      name,
+		Address:   address,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := branch.Validate(); err != nil {
+		return nil, err
+	}
+
+	return branch, nil
+}
+
+func (b *Branch) Validate() error {
+	if !branchCodeRegex.MatchString(b.Code) {
+		return ErrInvalidBranchCode
+	}
+	return validateBranchDetails(b.Name, b.Address)
+}
+
+// Update replaces the name and address; the code identifies the branch and
+// is not changed.
+func (b *Branch) Update(name, address string) error {
+	if err := validateBranchDetails(name, address); err != nil {
+		return err
+	}
+	b.Name = name
+	b.Address = address
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsDefault reports whether the branch is the one new copies go to.
+func (b *Branch) IsDefault() bool {
+	return b.Code == DefaultBranchCode
+}
+
+func validateBranchDetails(name, address string) error {
+	if len(name) < 2 || len(name) > 100 {
+		return ErrInvalidBranchName
+	}
+	if len(address) > 255 {
+		return ErrInvalidBranchAddress
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewBranch(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		bname   string
+		address string
+		wantErr error
+	}{
+		{"valid", "NORTH-2", "North Branch", "1 Library Way", nil},
+		{"no address", "EAST", "East Branch", "", nil},
+		{"lowercase code", "north", "North Branch", "", ErrInvalidBranchCode},
+		{"code too short", "N", "North Branch", "", ErrInvalidBranchCode},
+		{"name too short", "NORTH", "N", "", ErrInvalidBranchName},
+		{"address too long", "NORTH", "North Branch", strings.Repeat("a", 256), ErrInvalidBranchAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBranch(tt.code, tt.bname, tt.address)
+			if err != tt.wantErr {
+				t.Errorf("NewBranch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBranch_Update(t *testing.T) {
+	branch, _ := NewBranch("NORTH", "North Branch", "")
+
+	if err := branch.Update("North Side Branch", "2 Library Way"); err != nil {
+		t.Fatalf("Branch.Update() unexpected error = %v", err)
+	}
+	if branch.Name != "North Side Branch" || branch.Address != "2 Library Way" {
+		t.Errorf("Branch.Update() = %v/%v, want North Side Branch/2 Library Way", branch.Name, branch.Address)
+	}
+
+	if err := branch.Update("", ""); err != ErrInvalidBranchName {
+		t.Errorf("Branch.Update() error = %v, wantErr %v", err, ErrInvalidBranchName)
+	}
+}
@@ -0,0 +1,146 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidWeekday          = errors.New("invalid weekday")
+	ErrInvalidClockTime        = errors.New("invalid time of day: must be HH:MM between 00:00 and 23:59")
+	ErrInvalidOpeningHours     = errors.New("invalid opening hours: must close after opening")
+	ErrDuplicateWeekday        = errors.New("opening hours list the same weekday twice")
+	ErrClosedDateNotFound      = errors.New("closed date not found")
+	ErrClosedDateAlreadyExists = errors.New("branch is already closed on this date")
+	ErrInvalidClosedDateReason = errors.New("invalid closed date reason: must be at most 255 characters")
+)
+
+// calendarHorizonDays bounds how far ahead DueAt looks for an open day.
+const calendarHorizonDays = 366
+
+// OpeningHours is when a branch opens and closes on one day of the week,
+// in minutes after midnight in the library time zone. A weekday without
+// opening hours is a day the branch is closed.
+type OpeningHours struct {
+	BranchID uuid.UUID
+	Weekday  time.Weekday
+	OpensAt  int
+	ClosesAt int
+}
+
+func NewOpeningHours(branchID uuid.UUID, weekday time.Weekday, opensAt, closesAt string) (*OpeningHours, error) {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return nil, ErrInvalidWeekday
+	}
+
+	opens, err := ParseClockTime(opensAt)
+	if err != nil {
+		return nil, err
+	}
+	closes, err := ParseClockTime(closesAt)
+	if err != nil {
+		return nil, err
+	}
+	if closes <= opens {
+		return nil, ErrInvalidOpeningHours
+	}
+
+	return &OpeningHours{
+		BranchID: branchID,
+		Weekday:  weekday,
+		OpensAt:  opens,
+		ClosesAt: closes,
+	}, nil
+}
+
+// ParseClockTime turns an HH:MM time of day into minutes after midnight.
+func ParseClockTime(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, ErrInvalidClockTime
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// FormatClockTime turns minutes after midnight into an HH:MM time of day.
+func FormatClockTime(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// ClosedDate is a day a branch is closed outside its weekly schedule, such
+// as a public holiday.
+type ClosedDate struct {
+	ID       uuid.UUID
+	BranchID uuid.UUID
+	// Date is the calendar day, at midnight UTC.
+	Date      time.Time
+	Reason    string
+	CreatedAt time.Time
+}
+
+func NewClosedDate(branchID uuid.UUID, date time.Time, reason string) (*ClosedDate, error) {
+	if len(reason) > 255 {
+		return nil, ErrInvalidClosedDateReason
+	}
+
+	return &ClosedDate{
+		ID:        uuid.New(),
+		BranchID:  branchID,
+		Date:      CalendarDay(date),
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// CalendarDay drops the time of day from t, keeping its year, month and day
+// at midnight UTC.
+func CalendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// LibraryCalendar tells on which days, and until when, a branch is open.
+type LibraryCalendar struct {
+	location *time.Location
+	hours    map[time.Weekday]OpeningHours
+	closed   map[time.Time]bool
+}
+
+// NewLibraryCalendar builds the calendar of a branch from its weekly
+// opening hours and closed dates, read in the library time zone.
+func NewLibraryCalendar(location *time.Location, hours []*OpeningHours, closed []*ClosedDate) *LibraryCalendar {
+	calendar := &LibraryCalendar{
+		location: location,
+		hours:    make(map[time.Weekday]OpeningHours, len(hours)),
+		closed:   make(map[time.Time]bool, len(closed)),
+	}
+	for _, h := range hours {
+		calendar.hours[h.Weekday] = *h
+	}
+	for _, c := range closed {
+		calendar.closed[CalendarDay(c.Date)] = true
+	}
+	return calendar
+}
+
+// DueAt moves due to closing time on the first day, on or after the day it
+// falls on, that the branch is open. A branch without opening hours on
+// record keeps no calendar, so due is returned unchanged.
+func (c *LibraryCalendar) DueAt(due time.Time) time.Time {
+	if len(c.hours) == 0 {
+		return due
+	}
+
+	local := due.In(c.location)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.location)
+	for i := 0; i < calendarHorizonDays; i++ {
+		hours, open := c.hours[day.Weekday()]
+		if open && !c.closed[CalendarDay(day)] {
+			return time.Date(day.Year(), day.Month(), day.Day(), hours.ClosesAt/60, hours.ClosesAt%60, 0, 0, c.location)
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return due
+}
@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewOpeningHours(t *testing.T) {
+	tests := []struct {
+		name     string
+		weekday  time.Weekday
+		opensAt  string
+		closesAt string
+		wantErr  error
+	}{
+		{"valid", time.Monday, "09:00", "18:00", nil},
+		{"invalid weekday", time.Weekday(7), "09:00", "18:00", ErrInvalidWeekday},
+		{"invalid opening time", time.Monday, "9am", "18:00", ErrInvalidClockTime},
+		{"invalid closing time", time.Monday, "09:00", "24:00", ErrInvalidClockTime},
+		{"closes before opening", time.Monday, "18:00", "09:00", ErrInvalidOpeningHours},
+		{"closes when opening", time.Monday, "09:00", "09:00", ErrInvalidOpeningHours},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewOpeningHours(uuid.New(), tt.weekday, tt.opensAt, tt.closesAt)
+			if err != tt.wantErr {
+				t.Errorf("NewOpeningHours() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClockTime(t *testing.T) {
+	minutes, err := ParseClockTime("09:30")
+	if err != nil {
+		t.Fatalf("ParseClockTime() unexpected error = %v", err)
+	}
+	if minutes != 570 {
+		t.Errorf("ParseClockTime() = %v, want %v", minutes, 570)
+	}
+	if got := FormatClockTime(minutes); got != "09:30" {
+		t.Errorf("FormatClockTime() = %v, want %v", got, "09:30")
+	}
+}
+
+func TestLibraryCalendar_DueAt(t *testing.T) {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+	branchID := uuid.New()
+
+	// Open Monday to Friday until 18:00 and Saturday until 13:00.
+	var hours []*OpeningHours
+	for day := time.Monday; day <= time.Friday; day++ {
+		h, _ := NewOpeningHours(branchID, day, "09:00", "18:00")
+		hours = append(hours, h)
+	}
+	saturday, _ := NewOpeningHours(branchID, time.Saturday, "09:00", "13:00")
+	hours = append(hours, saturday)
+
+	// Wednesday 2025-12-24 and Thursday 2025-12-25 are holidays.
+	christmasEve, _ := NewClosedDate(branchID, time.Date(2025, 12, 24, 0, 0, 0, 0, time.UTC), "")
+	christmas, _ := NewClosedDate(branchID, time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), "Christmas")
+
+	calendar := NewLibraryCalendar(loc, hours, []*ClosedDate{christmasEve, christmas})
+
+	tests := []struct {
+		name string
+		due  time.Time
+		want time.Time
+	}{
+		{"open day", time.Date(2025, 12, 22, 10, 15, 0, 0, loc), time.Date(2025, 12, 22, 18, 0, 0, 0, loc)},
+		{"saturday closes early", time.Date(2025, 12, 20, 10, 15, 0, 0, loc), time.Date(2025, 12, 20, 13, 0, 0, 0, loc)},
+		{"sunday", time.Date(2025, 12, 21, 10, 15, 0, 0, loc), time.Date(2025, 12, 22, 18, 0, 0, 0, loc)},
+		{"holidays", time.Date(2025, 12, 24, 10, 15, 0, 0, loc), time.Date(2025, 12, 26, 18, 0, 0, 0, loc)},
+		// 01:00 UTC on Thursday is still Wednesday evening in São Paulo.
+		{"library time zone", time.Date(2025, 12, 23, 1, 0, 0, 0, time.UTC), time.Date(2025, 12, 22, 18, 0, 0, 0, loc)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calendar.DueAt(tt.due); !got.Equal(tt.want) {
+				t.Errorf("LibraryCalendar.DueAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLibraryCalendar_DueAtWithoutOpeningHours(t *testing.T) {
+	due := time.Date(2025, 12, 21, 10, 15, 0, 0, time.UTC)
+
+	calendar := NewLibraryCalendar(time.UTC, nil, nil)
+
+	if got := calendar.DueAt(due); !got.Equal(due) {
+		t.Errorf("LibraryCalendar.DueAt() = %v, want %v", got, due)
+	}
+}
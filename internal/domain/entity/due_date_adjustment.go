@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidDueDateRange      = errors.New("invalid due date range: from must not be after to")
+	ErrInvalidDueDateAdjustment = errors.New("invalid due date adjustment: give either a positive shift in days or a new due date from today on")
+	ErrInvalidAdjustmentReason  = errors.New("invalid adjustment reason: must be at most 255 characters")
+)
+
+// DueDateAdjustment records a bulk change of the due dates of checked out
+// loans, e.g. after a branch closed unexpectedly.
+type DueDateAdjustment struct {
+	ID uuid.UUID
+	// DueFrom and DueTo are the first and last calendar day, at midnight
+	// UTC, on which the adjusted loans were due.
+	DueFrom  time.Time
+	DueTo    time.Time
+	BranchID *uuid.UUID
+	BookID   *uuid.UUID
+	// ShiftDays pushes each due date out by that many days. It is 0 when
+	// NewDueDate, a calendar day at midnight UTC, is set instead.
+	ShiftDays     int
+	NewDueDate    *time.Time
+	Reason        string
+	LoansAdjusted int
+	AdjustedBy    uuid.UUID
+	CreatedAt     time.Time
+}
+
+func NewDueDateAdjustment(adjustedBy uuid.UUID, dueFrom, dueTo time.Time, shiftDays int, newDueDate *time.Time, reason string) (*DueDateAdjustment, error) {
+	dueFrom, dueTo = CalendarDay(dueFrom), CalendarDay(dueTo)
+	if dueFrom.After(dueTo) {
+		return nil, ErrInvalidDueDateRange
+	}
+	if (shiftDays != 0) == (newDueDate != nil) || shiftDays < 0 {
+		return nil, ErrInvalidDueDateAdjustment
+	}
+	if newDueDate != nil {
+		day := CalendarDay(*newDueDate)
+		if day.Before(CalendarDay(time.Now())) {
+			return nil, ErrInvalidDueDateAdjustment
+		}
+		newDueDate = &day
+	}
+	if len(reason) > 255 {
+		return nil, ErrInvalidAdjustmentReason
+	}
+
+	return &DueDateAdjustment{
+		ID:         uuid.New(),
+		DueFrom:    dueFrom,
+		DueTo:      dueTo,
+		ShiftDays:  shiftDays,
+		NewDueDate: newDueDate,
+		Reason:     reason,
+		AdjustedBy: adjustedBy,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// DueWindow returns the instants, in location, between which the adjusted
+// loans fall due: from the start of DueFrom up to, but excluding, the day
+// after DueTo.
+func (a *DueDateAdjustment) DueWindow(location *time.Location) (time.Time, time.Time) {
+	from := time.Date(a.DueFrom.Year(), a.DueFrom.Month(), a.DueFrom.Day(), 0, 0, 0, 0, location)
+	before := time.Date(a.DueTo.Year(), a.DueTo.Month(), a.DueTo.Day()+1, 0, 0, 0, 0, location)
+	return from, before
+}
+
+// Apply returns the adjusted due date of a loan due at due. Shifts move it
+// by whole days in location; a new due date keeps its time of day there.
+func (a *DueDateAdjustment) Apply(due time.Time, location *time.Location) time.Time {
+	local := due.In(location)
+	if a.NewDueDate == nil {
+		return local.AddDate(0, 0, a.ShiftDays)
+	}
+	return time.Date(a.NewDueDate.Year(), a.NewDueDate.Month(), a.NewDueDate.Day(),
+		local.Hour(), local.Minute(), local.Second(), local.Nanosecond(), location)
+}
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewDueDateAdjustment(t *testing.T) {
+	from := time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC)
+	future := time.Now().AddDate(0, 0, 7)
+	past := time.Now().AddDate(0, 0, -7)
+
+	tests := []struct {
+		name       string
+		from, to   time.Time
+		shiftDays  int
+		newDueDate *time.Time
+		wantErr    error
+	}{
+		{"shift", from, to, 3, nil, nil},
+		{"new due date", from, to, 0, &future, nil},
+		{"single day", from, from, 1, nil, nil},
+		{"range reversed", to, from, 3, nil, ErrInvalidDueDateRange},
+		{"neither shift nor date", from, to, 0, nil, ErrInvalidDueDateAdjustment},
+		{"both shift and date", from, to, 3, &future, ErrInvalidDueDateAdjustment},
+		{"negative shift", from, to, -1, nil, ErrInvalidDueDateAdjustment},
+		{"new due date in the past", from, to, 0, &past, ErrInvalidDueDateAdjustment},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDueDateAdjustment(uuid.New(), tt.from, tt.to, tt.shiftDays, tt.newDueDate, "")
+			if err != tt.wantErr {
+				t.Errorf("NewDueDateAdjustment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDueDateAdjustment_Apply(t *testing.T) {
+	due := time.Date(2025, 12, 22, 18, 0, 0, 0, time.UTC)
+
+	shift := &DueDateAdjustment{ShiftDays: 3}
+	if got, want := shift.Apply(due, time.UTC), time.Date(2025, 12, 25, 18, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("DueDateAdjustment.Apply() shift = %v, want %v", got, want)
+	}
+
+	newDueDate := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	moved := &DueDateAdjustment{NewDueDate: &newDueDate}
+	if got, want := moved.Apply(due, time.UTC), time.Date(2026, 1, 5, 18, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("DueDateAdjustment.Apply() new due date = %v, want %v", got, want)
+	}
+}
+
+func TestDueDateAdjustment_DueWindow(t *testing.T) {
+	adjustment := &DueDateAdjustment{
+		DueFrom: time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC),
+		DueTo:   time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	from, before := adjustment.DueWindow(time.UTC)
+	if want := time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC); !from.Equal(want) {
+		t.Errorf("DueDateAdjustment.DueWindow() from = %v, want %v", from, want)
+	}
+	if want := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC); !before.Equal(want) {
+		t.Errorf("DueDateAdjustment.DueWindow() before = %v, want %v", before, want)
+	}
+}
@@ -0,0 +1,140 @@
+package entity
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidEscalationLadder = errors.New("invalid escalation ladder: steps must be days:action with increasing days and lost last")
+	ErrInvalidEscalationAction = errors.New("invalid escalation action: must be notice, block or lost")
+)
+
+// EscalationAction is what the library does once a loan is overdue long
+// enough to reach a step of the escalation ladder.
+type EscalationAction string
+
+const (
+	// EscalationNotice reminds the patron the book is overdue.
+	EscalationNotice EscalationAction = "notice"
+	// EscalationBlock bars the patron from borrowing until staff unblock them.
+	EscalationBlock EscalationAction = "block"
+	// EscalationLost closes the loan as lost and bills the replacement.
+	EscalationLost EscalationAction = "lost"
+)
+
+func (a EscalationAction) IsValid() bool {
+	return a == EscalationNotice || a == EscalationBlock || a == EscalationLost
+}
+
+// EscalationStep is taken once a loan is DaysOverdue days past due.
+type EscalationStep struct {
+	DaysOverdue int
+	Action      EscalationAction
+}
+
+// EscalationLadder lists the steps taken against an overdue loan, ordered
+// by the days overdue they are taken at.
+type EscalationLadder []EscalationStep
+
+// ParseEscalationLadder reads a ladder written as comma separated
+// days:action steps, e.g. "1:notice,7:notice,14:block,30:lost". An empty
+// string is a ladder without steps.
+func ParseEscalationLadder(s string) (EscalationLadder, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return EscalationLadder{}, nil
+	}
+
+	var ladder EscalationLadder
+	for _, part := range strings.Split(s, ",") {
+		days, action, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok {
+			return nil, ErrInvalidEscalationLadder
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(days))
+		if err != nil {
+			return nil, ErrInvalidEscalationLadder
+		}
+		ladder = append(ladder, EscalationStep{
+			DaysOverdue: n,
+			Action:      EscalationAction(strings.TrimSpace(action)),
+		})
+	}
+
+	if err := ladder.Validate(); err != nil {
+		return nil, err
+	}
+	return ladder, nil
+}
+
+// Validate checks every step is taken at least a day past due, later than
+// the one before it, and that nothing follows declaring the book lost.
+func (l EscalationLadder) Validate() error {
+	for i, step := range l {
+		if !step.Action.IsValid() {
+			return ErrInvalidEscalationAction
+		}
+		if step.DaysOverdue < 1 {
+			return ErrInvalidEscalationLadder
+		}
+		if i > 0 && step.DaysOverdue <= l[i-1].DaysOverdue {
+			return ErrInvalidEscalationLadder
+		}
+		if step.Action == EscalationLost && i != len(l)-1 {
+			return ErrInvalidEscalationLadder
+		}
+	}
+	return nil
+}
+
+// String writes the ladder back in the form ParseEscalationLadder reads.
+func (l EscalationLadder) String() string {
+	parts := make([]string, len(l))
+	for i, step := range l {
+		parts[i] = strconv.Itoa(step.DaysOverdue) + ":" + string(step.Action)
+	}
+	return strings.Join(parts, ",")
+}
+
+// Reached returns how many steps a loan daysOverdue days past due has
+// reached.
+func (l EscalationLadder) Reached(daysOverdue int) int {
+	n := 0
+	for _, step := range l {
+		if step.DaysOverdue > daysOverdue {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// LoanEscalation records a step of the ladder taken against a loan. Level is
+// the 1-based position of the step in the ladder, so each step is taken at
+// most once per loan.
+type LoanEscalation struct {
+	ID          uuid.UUID
+	LoanID      uuid.UUID
+	UserID      uuid.UUID
+	Level       int
+	Action      EscalationAction
+	DaysOverdue int
+	CreatedAt   time.Time
+}
+
+func NewLoanEscalation(loan *Loan, level int, action EscalationAction, daysOverdue int) *LoanEscalation {
+	return &LoanEscalation{
+		ID:          uuid.New(),
+		LoanID:      loan.ID,
+		UserID:      loan.UserID,
+		Level:       level,
+		Action:      action,
+		DaysOverdue: daysOverdue,
+		CreatedAt:   time.Now(),
+	}
+}
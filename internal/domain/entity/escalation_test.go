@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"testing"
+)
+
+func TestParseEscalationLadder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{"full ladder", "1:notice, 7:notice, 14:block, 30:lost", "1:notice,7:notice,14:block,30:lost", nil},
+		{"empty", "", "", nil},
+		{"missing action", "1", "", ErrInvalidEscalationLadder},
+		{"days not a number", "one:notice", "", ErrInvalidEscalationLadder},
+		{"unknown action", "1:email", "", ErrInvalidEscalationAction},
+		{"not past due", "0:notice", "", ErrInvalidEscalationLadder},
+		{"days not increasing", "7:notice,7:block", "", ErrInvalidEscalationLadder},
+		{"step after lost", "14:lost,30:notice", "", ErrInvalidEscalationLadder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ladder, err := ParseEscalationLadder(tt.input)
+			if err != tt.wantErr {
+				t.Fatalf("ParseEscalationLadder() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && ladder.String() != tt.want {
+				t.Errorf("ParseEscalationLadder() = %v, want %v", ladder, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscalationLadder_Reached(t *testing.T) {
+	ladder, _ := ParseEscalationLadder("1:notice,7:notice,14:block,30:lost")
+
+	tests := []struct {
+		daysOverdue int
+		want        int
+	}{
+		{0, 0},
+		{1, 1},
+		{6, 1},
+		{7, 2},
+		{29, 3},
+		{45, 4},
+	}
+
+	for _, tt := range tests {
+		if got := ladder.Reached(tt.daysOverdue); got != tt.want {
+			t.Errorf("EscalationLadder.Reached(%d) = %v, want %v", tt.daysOverdue, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType names something that happened in the library that systems
+// outside of it may react to.
+type EventType string
+
+const (
+	EventLoanBorrowed       EventType = "loan.borrowed"
+	EventLoanRenewed        EventType = "loan.renewed"
+	EventLoanReturned       EventType = "loan.returned"
+	EventLoanDamaged        EventType = "loan.damaged"
+	EventLoanLost           EventType = "loan.lost"
+	EventLoanDueDateChanged EventType = "loan.due_date_changed"
+	EventLoanOverdue        EventType = "loan.overdue"
+	EventBookCreated        EventType = "book.created"
+	EventBookUpdated        EventType = "book.updated"
+	EventBookWithdrawn      EventType = "book.withdrawn"
+	EventBookDeleted        EventType = "book.deleted"
+	EventUserCreated        EventType = "user.created"
+	EventUserUpdated        EventType = "user.updated"
+	EventUserBlocked        EventType = "user.blocked"
+	EventUserUnblocked      EventType = "user.unblocked"
+	EventUserDisabled       EventType = "user.disabled"
+	EventHoldPlaced         EventType = "hold.placed"
+	EventHoldReady          EventType = "hold.ready"
+	EventHoldFulfilled      EventType = "hold.fulfilled"
+	EventHoldCancelled      EventType = "hold.cancelled"
+	EventHoldExpired        EventType = "hold.expired"
+	EventFineAssessed       EventType = "fine.assessed"
+	EventFinePaid           EventType = "fine.paid"
+	EventFineWaived         EventType = "fine.waived"
+)
+
+// EventTypes lists every event type, in the order they are documented.
+var EventTypes = []EventType{
+	EventLoanBorrowed,
+	EventLoanRenewed,
+	EventLoanReturned,
+	EventLoanDamaged,
+	EventLoanLost,
+	EventLoanDueDateChanged,
+	EventLoanOverdue,
+	EventBookCreated,
+	EventBookUpdated,
+	EventBookWithdrawn,
+	EventBookDeleted,
+	EventUserCreated,
+	EventUserUpdated,
+	EventUserBlocked,
+	EventUserUnblocked,
+	EventUserDisabled,
+	EventHoldPlaced,
+	EventHoldReady,
+	EventHoldFulfilled,
+	EventHoldCancelled,
+	EventHoldExpired,
+	EventFineAssessed,
+	EventFinePaid,
+	EventFineWaived,
+}
+
+func (t EventType) IsValid() bool {
+	for _, known := range EventTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Event records that something happened to the aggregate AggregateID, e.g.
+// the loan that was borrowed. Data is the JSON-encodable snapshot of the
+// aggregate sent along with it.
+type Event struct {
+	ID          uuid.UUID
+	Type        EventType
+	AggregateID uuid.UUID
+	OccurredAt  time.Time
+	Data        any
+}
+
+func NewEvent(eventType EventType, aggregateID uuid.UUID, data any) *Event {
+	return &Event{
+		ID:          uuid.New(),
+		Type:        eventType,
+		AggregateID: aggregateID,
+		OccurredAt:  time.Now(),
+		Data:        data,
+	}
+}
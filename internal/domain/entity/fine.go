@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrFineNotFound          = errors.New("fine not found")
+	ErrFineNotOpen           = errors.New("fine is already paid or waived")
+	ErrInvalidPaymentAmount  = errors.New("payment amount must be positive")
+	ErrPaymentExceedsBalance = errors.New("payment exceeds the fine balance")
+	ErrOutstandingFines      = errors.New("user has outstanding fines above the allowed limit")
+	ErrInvalidChargeAmount   = errors.New("charge amount must not be negative")
+)
+
+const (
+	FineStatusOpen   = "open"
+	FineStatusPaid   = "paid"
+	FineStatusWaived = "waived"
+)
+
+const (
+	FineReasonOverdue = "overdue"
+	// FineReasonLost bills the replacement of a book that was not returned.
+	FineReasonLost = "lost"
+	// FineReasonDamaged bills the repair of a book returned damaged.
+	FineReasonDamaged = "damaged"
+)
+
+// Fine is money a patron owes the library. Amounts are integer cents so
+// partial payments never accumulate rounding errors.
+type Fine struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	LoanID      uuid.UUID
+	Reason      string
+	AmountCents int64
+	PaidCents   int64
+	Status      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// OverdueFineCents is the fine for returning a loan daysOverdue days late:
+// dailyRateCents per day, capped at maxCents when maxCents is positive.
+func OverdueFineCents(daysOverdue int, dailyRateCents, maxCents int64) int64 {
+	if daysOverdue <= 0 || dailyRateCents <= 0 {
+		return 0
+	}
+
+	amount := int64(daysOverdue) * dailyRateCents
+	if maxCents > 0 && amount > maxCents {
+		amount = maxCents
+	}
+	return amount
+}
+
+func NewFine(userID, loanID uuid.UUID, reason string, amountCents int64) *Fine {
+	now := time.Now()
+	return &Fine{
+		ID:          uuid.New(),
+		UserID:      userID,
+		LoanID:      loanID,
+		Reason:      reason,
+		AmountCents: amountCents,
+		Status:      FineStatusOpen,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Pay records a payment towards the fine, settling it once the balance
+// reaches zero.
+func (f *Fine) Pay(amountCents int64) error {
+	if f.Status != FineStatusOpen {
+		return ErrFineNotOpen
+	}
+	if amountCents <= 0 {
+		return ErrInvalidPaymentAmount
+	}
+	if amountCents > f.BalanceCents() {
+		return ErrPaymentExceedsBalance
+	}
+
+	f.PaidCents += amountCents
+	if f.PaidCents == f.AmountCents {
+		f.Status = FineStatusPaid
+	}
+	f.UpdatedAt = time.Now()
+	return nil
+}
+
+// Waive forgives whatever is left to pay.
+func (f *Fine) Waive() error {
+	if f.Status != FineStatusOpen {
+		return ErrFineNotOpen
+	}
+
+	f.Status = FineStatusWaived
+	f.UpdatedAt = time.Now()
+	return nil
+}
+
+// BalanceCents is what is still owed; settled fines owe nothing.
+func (f *Fine) BalanceCents() int64 {
+	if f.Status != FineStatusOpen {
+		return 0
+	}
+	return f.AmountCents - f.PaidCents
+}
@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestOverdueFineCents(t *testing.T) {
+	tests := []struct {
+		name        string
+		daysOverdue int
+		rate        int64
+		max         int64
+		want        int64
+	}{
+		{"returned on time", 0, 100, 5000, 0},
+		{"per day", 3, 100, 5000, 300},
+		{"capped", 90, 100, 5000, 5000},
+		{"no cap", 90, 100, 0, 9000},
+		{"no rate", 3, 0, 5000, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OverdueFineCents(tt.daysOverdue, tt.rate, tt.max); got != tt.want {
+				t.Errorf("OverdueFineCents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFine(t *testing.T) {
+	userID := uuid.New()
+	loanID := uuid.New()
+
+	fine := NewFine(userID, loanID, FineReasonOverdue, 300)
+
+	if fine.UserID != userID {
+		t.Errorf("NewFine() userID = %v, want %v", fine.UserID, userID)
+	}
+	if fine.LoanID != loanID {
+		t.Errorf("NewFine() loanID = %v, want %v", fine.LoanID, loanID)
+	}
+	if fine.Status != FineStatusOpen {
+		t.Errorf("NewFine() status = %v, want %v", fine.Status, FineStatusOpen)
+	}
+	if fine.BalanceCents() != 300 {
+		t.Errorf("NewFine() balance = %v, want 300", fine.BalanceCents())
+	}
+}
+
+func TestFine_Pay(t *testing.T) {
+	t.Run("partial payment", func(t *testing.T) {
+		fine := NewFine(uuid.New(), uuid.New(), FineReasonOverdue, 300)
+
+		if err := fine.Pay(100); err != nil {
+			t.Errorf("Fine.Pay() unexpected error = %v", err)
+		}
+		if fine.Status != FineStatusOpen {
+			t.Errorf("Fine.Pay() status = %v, want %v", fine.Status, FineStatusOpen)
+		}
+		if fine.BalanceCents() != 200 {
+			t.Errorf("Fine.Pay() balance = %v, want 200", fine.BalanceCents())
+		}
+	})
+
+	t.Run("full payment settles the fine", func(t *testing.T) {
+		fine := NewFine(uuid.New(), uuid.New(), FineReasonOverdue, 300)
+		_ = fine.Pay(100)
+
+		if err := fine.Pay(200); err != nil {
+			t.Errorf("Fine.Pay() unexpected error = %v", err)
+		}
+		if fine.Status != FineStatusPaid {
+			t.Errorf("Fine.Pay() status = %v, want %v", fine.Status, FineStatusPaid)
+		}
+		if fine.BalanceCents() != 0 {
+			t.Errorf("Fine.Pay() balance = %v, want 0", fine.BalanceCents())
+		}
+	})
+
+	t.Run("overpayment", func(t *testing.T) {
+		fine := NewFine(uuid.New(), uuid.New(), FineReasonOverdue, 300)
+
+		if err := fine.Pay(301); err != ErrPaymentExceedsBalance {
+			t.Errorf("Fine.Pay() error = %v, wantErr %v", err, ErrPaymentExceedsBalance)
+		}
+	})
+
+	t.Run("non-positive amount", func(t *testing.T) {
+		fine := NewFine(uuid.New(), uuid.New(), FineReasonOverdue, 300)
+
+		if err := fine.Pay(0); err != ErrInvalidPaymentAmount {
+			t.Errorf("Fine.Pay() error = %v, wantErr %v", err, ErrInvalidPaymentAmount)
+		}
+	})
+
+	t.Run("waived fine", func(t *testing.T) {
+		fine := NewFine(uuid.New(), uuid.New(), FineReasonOverdue, 300)
+		_ = fine.Waive()
+
+		if err := fine.Pay(100); err != ErrFineNotOpen {
+			t.Errorf("Fine.Pay() error = %v, wantErr %v", err, ErrFineNotOpen)
+		}
+	})
+}
+
+func TestFine_Waive(t *testing.T) {
+	t.Run("open fine", func(t *testing.T) {
+		fine := NewFine(uuid.New(), uuid.New(), FineReasonOverdue, 300)
+		_ = fine.Pay(100)
+
+		if err := fine.Waive(); err != nil {
+			t.Errorf("Fine.Waive() unexpected error = %v", err)
+		}
+		if fine.Status != FineStatusWaived {
+			t.Errorf("Fine.Waive() status = %v, want %v", fine.Status, FineStatusWaived)
+		}
+		if fine.BalanceCents() != 0 {
+			t.Errorf("Fine.Waive() balance = %v, want 0", fine.BalanceCents())
+		}
+	})
+
+	t.Run("paid fine", func(t *testing.T) {
+		fine := NewFine(uuid.New(), uuid.New(), FineReasonOverdue, 300)
+		_ = fine.Pay(300)
+
+		if err := fine.Waive(); err != ErrFineNotOpen {
+			t.Errorf("Fine.Waive() error = %v, wantErr %v", err, ErrFineNotOpen)
+		}
+	})
+}
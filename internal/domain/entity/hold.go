@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrHoldNotFound         = errors.New("hold not found")
+	ErrHoldNotActive        = errors.New("hold is no longer active")
+	ErrHoldNotReady         = errors.New("hold is not ready for pickup")
+	ErrUserHasActiveHold    = errors.New("user already has an active hold for this book")
+	ErrBookAvailableForLoan = errors.New("book has available copies, borrow it instead")
+)
+
+const (
+	HoldStatusWaiting   = "waiting"
+	HoldStatusReady     = "ready"
+	HoldStatusFulfilled = "fulfilled"
+	HoldStatusCancelled = "cancelled"
+	HoldStatusExpired   = "expired"
+)
+
+// Hold is a patron's place in the queue for a book with no copies left.
+// Once a copy is set aside for it the hold is ready until PickupDeadline,
+// and CopyID names that copy.
+type Hold struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	BookID         uuid.UUID
+	Status         string
+	CreatedAt      time.Time
+	ReadyAt        *time.Time
+	PickupDeadline *time.Time
+	UpdatedAt      time.Time
+	CopyID         *uuid.UUID
+}
+
+func NewHold(userID, bookID uuid.UUID) *Hold {
+	now := time.Now()
+	return &Hold{
+		ID:        uuid.New(),
+		UserID:    userID,
+		BookID:    bookID,
+		Status:    HoldStatusWaiting,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// MarkReady sets copyID aside for the hold until deadline.
+func (h *Hold) MarkReady(copyID uuid.UUID, deadline time.Time) error {
+	if h.Status != HoldStatusWaiting {
+		return ErrHoldNotActive
+	}
+
+	now := time.Now()
+	h.Status = HoldStatusReady
+	h.ReadyAt = &now
+	h.PickupDeadline = &deadline
+	h.CopyID = &copyID
+	h.UpdatedAt = now
+	return nil
+}
+
+// Fulfill closes the hold once the patron borrows the copy set aside for it.
+func (h *Hold) Fulfill() error {
+	if h.Status != HoldStatusReady {
+		return ErrHoldNotReady
+	}
+
+	h.Status = HoldStatusFulfilled
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+func (h *Hold) Cancel() error {
+	if !h.IsActive() {
+		return ErrHoldNotActive
+	}
+
+	h.Status = HoldStatusCancelled
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+// Expire closes a ready hold whose pickup deadline has passed.
+func (h *Hold) Expire() error {
+	if h.Status != HoldStatusReady {
+		return ErrHoldNotReady
+	}
+
+	h.Status = HoldStatusExpired
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+func (h *Hold) IsActive() bool {
+	return h.Status == HoldStatusWaiting || h.Status == HoldStatusReady
+}
+
+func (h *Hold) IsReady() bool {
+	return h.Status == HoldStatusReady
+}
+
+func (h *Hold) IsPickupExpired() bool {
+	if !h.IsReady() || h.PickupDeadline == nil {
+		return false
+	}
+	return time.Now().After(*h.PickupDeadline)
+}
@@ -0,0 +1,130 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewHold(t *testing.T) {
+	userID := uuid.New()
+	bookID := uuid.New()
+
+	hold := NewHold(userID, bookID)
+
+	if hold.UserID != userID {
+		t.Errorf("NewHold() userID = %v, want %v", hold.UserID, userID)
+	}
+	if hold.BookID != bookID {
+		t.Errorf("NewHold() bookID = %v, want %v", hold.BookID, bookID)
+	}
+	if hold.Status != HoldStatusWaiting {
+		t.Errorf("NewHold() status = %v, want %v", hold.Status, HoldStatusWaiting)
+	}
+	if hold.PickupDeadline != nil {
+		t.Error("NewHold() pickupDeadline should be nil")
+	}
+}
+
+func TestHold_MarkReady(t *testing.T) {
+	t.Run("waiting hold", func(t *testing.T) {
+		hold := NewHold(uuid.New(), uuid.New())
+		copyID := uuid.New()
+		deadline := time.Now().Add(48 * time.Hour)
+
+		err := hold.MarkReady(copyID, deadline)
+		if err != nil {
+			t.Errorf("Hold.MarkReady() unexpected error = %v", err)
+		}
+		if hold.Status != HoldStatusReady {
+			t.Errorf("Hold.MarkReady() status = %v, want %v", hold.Status, HoldStatusReady)
+		}
+		if hold.PickupDeadline == nil || !hold.PickupDeadline.Equal(deadline) {
+			t.Errorf("Hold.MarkReady() pickupDeadline = %v, want %v", hold.PickupDeadline, deadline)
+		}
+		if hold.CopyID == nil || *hold.CopyID != copyID {
+			t.Errorf("Hold.MarkReady() copyID = %v, want %v", hold.CopyID, copyID)
+		}
+	})
+
+	t.Run("cancelled hold", func(t *testing.T) {
+		hold := NewHold(uuid.New(), uuid.New())
+		_ = hold.Cancel()
+
+		err := hold.MarkReady(uuid.New(), time.Now().Add(time.Hour))
+		if err != ErrHoldNotActive {
+			t.Errorf("Hold.MarkReady() error = %v, wantErr %v", err, ErrHoldNotActive)
+		}
+	})
+}
+
+func TestHold_Fulfill(t *testing.T) {
+	t.Run("ready hold", func(t *testing.T) {
+		hold := NewHold(uuid.New(), uuid.New())
+		_ = hold.MarkReady(uuid.New(), time.Now().Add(time.Hour))
+
+		if err := hold.Fulfill(); err != nil {
+			t.Errorf("Hold.Fulfill() unexpected error = %v", err)
+		}
+		if hold.IsActive() {
+			t.Error("Hold.IsActive() = true, want false")
+		}
+	})
+
+	t.Run("waiting hold", func(t *testing.T) {
+		hold := NewHold(uuid.New(), uuid.New())
+
+		if err := hold.Fulfill(); err != ErrHoldNotReady {
+			t.Errorf("Hold.Fulfill() error = %v, wantErr %v", err, ErrHoldNotReady)
+		}
+	})
+}
+
+func TestHold_Cancel(t *testing.T) {
+	hold := NewHold(uuid.New(), uuid.New())
+
+	if err := hold.Cancel(); err != nil {
+		t.Errorf("Hold.Cancel() unexpected error = %v", err)
+	}
+	if hold.Status != HoldStatusCancelled {
+		t.Errorf("Hold.Cancel() status = %v, want %v", hold.Status, HoldStatusCancelled)
+	}
+	if err := hold.Cancel(); err != ErrHoldNotActive {
+		t.Errorf("Hold.Cancel() error = %v, wantErr %v", err, ErrHoldNotActive)
+	}
+}
+
+func TestHold_IsPickupExpired(t *testing.T) {
+	t.Run("before deadline", func(t *testing.T) {
+		hold := NewHold(uuid.New(), uuid.New())
+		_ = hold.MarkReady(uuid.New(), time.Now().Add(time.Hour))
+
+		if hold.IsPickupExpired() {
+			t.Error("Hold.IsPickupExpired() = true, want false")
+		}
+	})
+
+	t.Run("after deadline", func(t *testing.T) {
+		hold := NewHold(uuid.New(), uuid.New())
+		_ = hold.MarkReady(uuid.New(), time.Now().Add(-time.Hour))
+
+		if !hold.IsPickupExpired() {
+			t.Error("Hold.IsPickupExpired() = false, want true")
+		}
+		if err := hold.Expire(); err != nil {
+			t.Errorf("Hold.Expire() unexpected error = %v", err)
+		}
+		if hold.Status != HoldStatusExpired {
+			t.Errorf("Hold.Expire() status = %v, want %v", hold.Status, HoldStatusExpired)
+		}
+	})
+
+	t.Run("waiting hold", func(t *testing.T) {
+		hold := NewHold(uuid.New(), uuid.New())
+
+		if hold.IsPickupExpired() {
+			t.Error("Hold.IsPickupExpired() = true, want false")
+		}
+	})
+}
@@ -8,27 +8,41 @@ import (
 )
 
 var (
-	ErrLoanNotFound       = errors.New("loan not found")
+	ErrLoanNotFound        = errors.New("loan not found")
 	ErrLoanAlreadyReturned = errors.New("loan already returned")
-	ErrInvalidLoanDueDate = errors.New("invalid due date: must be in the future")
-	ErrUserHasActiveLoan  = errors.New("user already has an active loan for this book")
+	ErrInvalidReturnDate   = errors.New("invalid return date: must be between the borrow date and now")
+	ErrInvalidLoanDueDate  = errors.New("invalid due date: must be in the future")
+	ErrUserHasActiveLoan   = errors.New("user already has an active loan for this book")
+	ErrMaxRenewalsReached  = errors.New("loan has reached the maximum number of renewals")
+	ErrLoanTooOverdue      = errors.New("loan is overdue beyond the renewal grace period")
+	ErrBookHasPendingHolds = errors.New("book has pending holds by other users")
 )
 
 const (
 	LoanStatusActive   = "active"
+	LoanStatusOverdue  = "overdue"
 	LoanStatusReturned = "returned"
+	LoanStatusLost     = "lost"
+	LoanStatusDamaged  = "damaged"
 )
 
 const DefaultLoanDays = 14
 
 type Loan struct {
-	ID         uuid.UUID
-	UserID     uuid.UUID
-	BookID     uuid.UUID
+	ID     uuid.UUID
+	UserID uuid.UUID
+	BookID uuid.UUID
+	// CopyID is the copy lent out. It is nil only for loans recorded
+	// before copies were tracked.
+	CopyID     *uuid.UUID
 	BorrowedAt time.Time
 	DueDate    time.Time
+	// ReturnedAt is when the loan was closed: by returning the book, damaged
+	// or not, or by declaring it lost.
 	ReturnedAt *time.Time
 	Status     string
+	// RenewalCount is how many times the due date was extended.
+	RenewalCount int
 }
 
 func NewLoan(userID, bookID uuid.UUID, dueDate *time.Time) (*Loan, error) {
@@ -58,18 +72,89 @@ func NewLoan(userID, bookID uuid.UUID, dueDate *time.Time) (*Loan, error) {
 }
 
 func (l *Loan) Return() error {
-	if l.Status == LoanStatusReturned {
+	return l.ReturnAt(time.Now())
+}
+
+// ReturnAt records a return that happened at returnedAt, which may lie in
+// the past for books found in the book drop. It must not precede the
+// borrow nor lie in the future.
+func (l *Loan) ReturnAt(returnedAt time.Time) error {
+	if !l.IsActive() {
 		return ErrLoanAlreadyReturned
 	}
+	if returnedAt.Before(l.BorrowedAt) || returnedAt.After(time.Now()) {
+		return ErrInvalidReturnDate
+	}
 
-	now := time.Now()
-	l.ReturnedAt = &now
+	l.ReturnedAt = &returnedAt
 	l.Status = LoanStatusReturned
 	return nil
 }
 
+// ReturnDamagedAt records, like ReturnAt, a return of a book that came back
+// damaged.
+func (l *Loan) ReturnDamagedAt(returnedAt time.Time) error {
+	if err := l.ReturnAt(returnedAt); err != nil {
+		return err
+	}
+	l.Status = LoanStatusDamaged
+	return nil
+}
+
+// MarkLost closes a loan whose book will not come back.
+func (l *Loan) MarkLost(at time.Time) error {
+	if !l.IsActive() {
+		return ErrLoanAlreadyReturned
+	}
+
+	l.ReturnedAt = &at
+	l.Status = LoanStatusLost
+	return nil
+}
+
+// Renew extends the due date by loanDays. It is refused once maxRenewals is
+// reached or when the loan is overdue by more than grace.
+func (l *Loan) Renew(loanDays, maxRenewals int, grace time.Duration) error {
+	if !l.IsActive() {
+		return ErrLoanAlreadyReturned
+	}
+	if l.RenewalCount >= maxRenewals {
+		return ErrMaxRenewalsReached
+	}
+	if time.Now().After(l.DueDate.Add(grace)) {
+		return ErrLoanTooOverdue
+	}
+
+	l.DueDate = l.DueDate.AddDate(0, 0, loanDays)
+	l.RenewalCount++
+	if l.Status == LoanStatusOverdue && !time.Now().After(l.DueDate) {
+		l.Status = LoanStatusActive
+	}
+	return nil
+}
+
+// RescheduleDue moves the due date to due, e.g. to the next day the library
+// is open. An overdue loan whose new due date is still ahead is active again.
+func (l *Loan) RescheduleDue(due time.Time) {
+	l.DueDate = due
+	if l.Status == LoanStatusOverdue && !time.Now().After(l.DueDate) {
+		l.Status = LoanStatusActive
+	}
+}
+
+// MarkOverdue moves an active loan past its due date to overdue. It reports
+// whether the status changed.
+func (l *Loan) MarkOverdue(now time.Time) bool {
+	if l.Status != LoanStatusActive || !now.After(l.DueDate) {
+		return false
+	}
+	l.Status = LoanStatusOverdue
+	return true
+}
+
+// IsActive reports whether the book is still checked out, overdue or not.
 func (l *Loan) IsActive() bool {
-	return l.Status == LoanStatusActive
+	return l.Status == LoanStatusActive || l.Status == LoanStatusOverdue
 }
 
 func (l *Loan) IsOverdue() bool {
@@ -78,3 +163,22 @@ func (l *Loan) IsOverdue() bool {
 	}
 	return time.Now().After(l.DueDate)
 }
+
+// DaysOverdue counts the started days between the due date and the return,
+// or now while the book is still out. It is 0 for loans returned on time.
+func (l *Loan) DaysOverdue(now time.Time) int {
+	end := now
+	if l.ReturnedAt != nil {
+		end = *l.ReturnedAt
+	}
+	if !end.After(l.DueDate) {
+		return 0
+	}
+
+	late := end.Sub(l.DueDate)
+	days := int(late / (24 * time.Hour))
+	if late%(24*time.Hour) > 0 {
+		days++
+	}
+	return days
+}
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NoticeKind is the reason a patron is notified about a loan.
+type NoticeKind string
+
+const (
+	NoticeDueReminder NoticeKind = "due_reminder"
+	NoticeOverdue     NoticeKind = "overdue_notice"
+)
+
+// LoanNotice records that a notice of one kind was sent for a loan. A loan
+// gets at most one notice of each kind per due date, so a renewed loan is
+// reminded again.
+type LoanNotice struct {
+	ID      uuid.UUID
+	LoanID  uuid.UUID
+	Kind    NoticeKind
+	DueDate time.Time
+	SentAt  time.Time
+}
+
+func NewLoanNotice(loan *Loan, kind NoticeKind) *LoanNotice {
+	return &LoanNotice{
+		ID:      uuid.New(),
+		LoanID:  loan.ID,
+		Kind:    kind,
+		DueDate: loan.DueDate,
+		SentAt:  time.Now(),
+	}
+}
@@ -0,0 +1,129 @@
+package entity
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrLoanPolicyNotFound      = errors.New("loan policy not found")
+	ErrLoanPolicyAlreadyExists = errors.New("a loan policy already exists for this patron and item category")
+	ErrInvalidLoanPolicy       = errors.New("invalid loan policy: limits must not be negative and loan days must be at least 1")
+	ErrInvalidCategory         = errors.New("invalid category: must be 1 to 50 lowercase letters, digits, '-' or '_'")
+	ErrLoanLimitReached        = errors.New("user has reached the maximum number of concurrent loans")
+)
+
+// AnyCategory in a loan policy matches every patron or item category.
+const AnyCategory = "*"
+
+const (
+	DefaultPatronCategory = "standard"
+	DefaultItemCategory   = "general"
+)
+
+var categoryRegex = regexp.MustCompile(`^[a-z0-9_-]{1,50}$`)
+
+// LoanPolicy holds the circulation limits for one cell of the patron
+// category × item category matrix.
+type LoanPolicy struct {
+	ID             uuid.UUID
+	PatronCategory string
+	ItemCategory   string
+	// MaxLoans caps how many books the patron may have out at once when
+	// borrowing an item of this category; 0 makes the item non-circulating.
+	MaxLoans    int
+	LoanDays    int
+	MaxRenewals int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewLoanPolicy(patronCategory, itemCategory string, maxLoans, loanDays, maxRenewals int) (*LoanPolicy, error) {
+	now := time.Now()
+	policy := &LoanPolicy{
+		ID:             uuid.New(),
+		PatronCategory: patronCategory,
+		ItemCategory:   itemCategory,
+		MaxLoans:       maxLoans,
+		LoanDays:       loanDays,
+		MaxRenewals:    maxRenewals,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (p *LoanPolicy) Validate() error {
+	if !isValidPolicyCategory(p.PatronCategory) || !isValidPolicyCategory(p.ItemCategory) {
+		return ErrInvalidCategory
+	}
+	if p.MaxLoans < 0 || p.LoanDays < 1 || p.MaxRenewals < 0 {
+		return ErrInvalidLoanPolicy
+	}
+	return nil
+}
+
+// Update replaces the limits; the categories identify the policy and are
+// not changed.
+func (p *LoanPolicy) Update(maxLoans, loanDays, maxRenewals int) error {
+	if maxLoans < 0 || loanDays < 1 || maxRenewals < 0 {
+		return ErrInvalidLoanPolicy
+	}
+	p.MaxLoans = maxLoans
+	p.LoanDays = loanDays
+	p.MaxRenewals = maxRenewals
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// Matches reports whether the policy applies to the given categories.
+func (p *LoanPolicy) Matches(patronCategory, itemCategory string) bool {
+	return (p.PatronCategory == AnyCategory || p.PatronCategory == patronCategory) &&
+		(p.ItemCategory == AnyCategory || p.ItemCategory == itemCategory)
+}
+
+// specificity ranks matching policies: an exact patron category outweighs
+// an exact item category, and both outweigh wildcards.
+func (p *LoanPolicy) specificity() int {
+	score := 0
+	if p.PatronCategory != AnyCategory {
+		score += 2
+	}
+	if p.ItemCategory != AnyCategory {
+		score++
+	}
+	return score
+}
+
+// SelectLoanPolicy returns the most specific policy among policies that
+// matches the categories, or nil when none does.
+func SelectLoanPolicy(policies []*LoanPolicy, patronCategory, itemCategory string) *LoanPolicy {
+	var selected *LoanPolicy
+	for _, p := range policies {
+		if !p.Matches(patronCategory, itemCategory) {
+			continue
+		}
+		if selected == nil || p.specificity() > selected.specificity() {
+			selected = p
+		}
+	}
+	return selected
+}
+
+// IsValidCategory reports whether category may be assigned to a user or a
+// book.
+func IsValidCategory(category string) bool {
+	return categoryRegex.MatchString(category)
+}
+
+func isValidPolicyCategory(category string) bool {
+	return category == AnyCategory || IsValidCategory(category)
+}
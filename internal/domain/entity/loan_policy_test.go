@@ -0,0 +1,78 @@
+package entity
+
+import "testing"
+
+func TestNewLoanPolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		patronCategory string
+		itemCategory   string
+		maxLoans       int
+		loanDays       int
+		maxRenewals    int
+		wantErr        error
+	}{
+		{"valid", "student", "general", 3, 7, 1, nil},
+		{"wildcards", AnyCategory, AnyCategory, 5, 14, 2, nil},
+		{"non-circulating", "student", "reference", 0, 1, 0, nil},
+		{"invalid patron category", "Student", "general", 3, 7, 1, ErrInvalidCategory},
+		{"empty item category", "student", "", 3, 7, 1, ErrInvalidCategory},
+		{"zero loan days", "student", "general", 3, 0, 1, ErrInvalidLoanPolicy},
+		{"negative max loans", "student", "general", -1, 7, 1, ErrInvalidLoanPolicy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewLoanPolicy(tt.patronCategory, tt.itemCategory, tt.maxLoans, tt.loanDays, tt.maxRenewals)
+			if err != tt.wantErr {
+				t.Errorf("NewLoanPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSelectLoanPolicy(t *testing.T) {
+	fallback, _ := NewLoanPolicy(AnyCategory, AnyCategory, 5, 14, 2)
+	anyStudent, _ := NewLoanPolicy("student", AnyCategory, 3, 7, 1)
+	anyReference, _ := NewLoanPolicy(AnyCategory, "reference", 0, 1, 0)
+	studentReference, _ := NewLoanPolicy("student", "reference", 1, 2, 0)
+
+	tests := []struct {
+		name     string
+		policies []*LoanPolicy
+		patron   string
+		item     string
+		want     *LoanPolicy
+	}{
+		{"no policies", nil, "student", "general", nil},
+		{"wildcard only", []*LoanPolicy{fallback}, "student", "general", fallback},
+		{"patron category beats wildcard", []*LoanPolicy{fallback, anyStudent}, "student", "general", anyStudent},
+		{"patron category beats item category", []*LoanPolicy{anyReference, anyStudent}, "student", "reference", anyStudent},
+		{"exact match wins", []*LoanPolicy{anyStudent, studentReference, anyReference}, "student", "reference", studentReference},
+		{"other patron category", []*LoanPolicy{anyStudent, anyReference}, "faculty", "reference", anyReference},
+		{"no match", []*LoanPolicy{anyStudent}, "faculty", "general", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SelectLoanPolicy(tt.policies, tt.patron, tt.item); got != tt.want {
+				t.Errorf("SelectLoanPolicy() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoanPolicy_Update(t *testing.T) {
+	policy, _ := NewLoanPolicy("student", "general", 3, 7, 1)
+
+	if err := policy.Update(4, 10, 2); err != nil {
+		t.Errorf("LoanPolicy.Update() unexpected error = %v", err)
+	}
+	if policy.MaxLoans != 4 || policy.LoanDays != 10 || policy.MaxRenewals != 2 {
+		t.Errorf("LoanPolicy.Update() = %+v, want 4/10/2", policy)
+	}
+
+	if err := policy.Update(4, 0, 2); err != ErrInvalidLoanPolicy {
+		t.Errorf("LoanPolicy.Update() error = %v, wantErr %v", err, ErrInvalidLoanPolicy)
+	}
+}
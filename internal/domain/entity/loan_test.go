@@ -79,6 +79,20 @@ func TestLoan_Return(t *testing.T) {
 		}
 	})
 
+	t.Run("return overdue loan", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+		loan.Status = LoanStatusOverdue
+
+		err := loan.Return()
+		if err != nil {
+			t.Errorf("Loan.Return() unexpected error = %v", err)
+		}
+
+		if loan.Status != LoanStatusReturned {
+			t.Errorf("Loan.Return() status = %v, want %v", loan.Status, LoanStatusReturned)
+		}
+	})
+
 	t.Run("return already returned loan", func(t *testing.T) {
 		loan, _ := NewLoan(userID, bookID, nil)
 		_ = loan.Return()
@@ -90,6 +104,100 @@ func TestLoan_Return(t *testing.T) {
 	})
 }
 
+func TestLoan_MarkLost(t *testing.T) {
+	t.Run("overdue loan", func(t *testing.T) {
+		loan, _ := NewLoan(uuid.New(), uuid.New(), nil)
+		loan.Status = LoanStatusOverdue
+		at := time.Now()
+
+		if err := loan.MarkLost(at); err != nil {
+			t.Errorf("Loan.MarkLost() unexpected error = %v", err)
+		}
+		if loan.Status != LoanStatusLost {
+			t.Errorf("Loan.MarkLost() status = %v, want %v", loan.Status, LoanStatusLost)
+		}
+		if loan.ReturnedAt == nil || !loan.ReturnedAt.Equal(at) {
+			t.Errorf("Loan.MarkLost() returnedAt = %v, want %v", loan.ReturnedAt, at)
+		}
+		if loan.IsActive() {
+			t.Error("Loan.MarkLost() loan should no longer be active")
+		}
+	})
+
+	t.Run("returned loan", func(t *testing.T) {
+		loan, _ := NewLoan(uuid.New(), uuid.New(), nil)
+		_ = loan.Return()
+
+		if err := loan.MarkLost(time.Now()); err != ErrLoanAlreadyReturned {
+			t.Errorf("Loan.MarkLost() error = %v, wantErr %v", err, ErrLoanAlreadyReturned)
+		}
+	})
+}
+
+func TestLoan_ReturnDamagedAt(t *testing.T) {
+	t.Run("damaged return", func(t *testing.T) {
+		loan, _ := NewLoan(uuid.New(), uuid.New(), nil)
+
+		if err := loan.ReturnDamagedAt(time.Now()); err != nil {
+			t.Fatalf("Loan.ReturnDamagedAt() unexpected error = %v", err)
+		}
+		if loan.Status != LoanStatusDamaged {
+			t.Errorf("Loan.ReturnDamagedAt() status = %v, want %v", loan.Status, LoanStatusDamaged)
+		}
+		if loan.ReturnedAt == nil || loan.IsActive() {
+			t.Error("Loan.ReturnDamagedAt() loan should be closed")
+		}
+	})
+
+	t.Run("future return date", func(t *testing.T) {
+		loan, _ := NewLoan(uuid.New(), uuid.New(), nil)
+
+		if err := loan.ReturnDamagedAt(time.Now().Add(time.Hour)); err != ErrInvalidReturnDate {
+			t.Errorf("Loan.ReturnDamagedAt() error = %v, wantErr %v", err, ErrInvalidReturnDate)
+		}
+		if loan.Status != LoanStatusActive {
+			t.Errorf("Loan.ReturnDamagedAt() status = %v, want %v", loan.Status, LoanStatusActive)
+		}
+	})
+}
+
+func TestLoan_ReturnAt(t *testing.T) {
+	userID := uuid.New()
+	bookID := uuid.New()
+
+	t.Run("backdated return", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+		loan.BorrowedAt = time.Now().AddDate(0, 0, -10)
+		returnedAt := time.Now().AddDate(0, 0, -2)
+
+		if err := loan.ReturnAt(returnedAt); err != nil {
+			t.Fatalf("Loan.ReturnAt() unexpected error = %v", err)
+		}
+
+		if !loan.ReturnedAt.Equal(returnedAt) {
+			t.Errorf("Loan.ReturnAt() returnedAt = %v, want %v", loan.ReturnedAt, returnedAt)
+		}
+	})
+
+	t.Run("before the borrow", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+
+		err := loan.ReturnAt(loan.BorrowedAt.Add(-time.Hour))
+		if err != ErrInvalidReturnDate {
+			t.Errorf("Loan.ReturnAt() error = %v, wantErr %v", err, ErrInvalidReturnDate)
+		}
+	})
+
+	t.Run("in the future", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+
+		err := loan.ReturnAt(time.Now().Add(time.Hour))
+		if err != ErrInvalidReturnDate {
+			t.Errorf("Loan.ReturnAt() error = %v, wantErr %v", err, ErrInvalidReturnDate)
+		}
+	})
+}
+
 func TestLoan_IsActive(t *testing.T) {
 	userID := uuid.New()
 	bookID := uuid.New()
@@ -102,6 +210,15 @@ func TestLoan_IsActive(t *testing.T) {
 		}
 	})
 
+	t.Run("overdue loan", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+		loan.Status = LoanStatusOverdue
+
+		if !loan.IsActive() {
+			t.Error("Loan.IsActive() = false, want true")
+		}
+	})
+
 	t.Run("returned loan", func(t *testing.T) {
 		loan, _ := NewLoan(userID, bookID, nil)
 
@@ -144,3 +261,144 @@ func TestLoan_IsOverdue(t *testing.T) {
 		}
 	})
 }
+
+func TestLoan_Renew(t *testing.T) {
+	userID := uuid.New()
+	bookID := uuid.New()
+
+	t.Run("extends due date", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+		dueDate := loan.DueDate
+
+		err := loan.Renew(DefaultLoanDays, 2, 0)
+
+		if err != nil {
+			t.Errorf("Loan.Renew() unexpected error = %v", err)
+		}
+		if !loan.DueDate.Equal(dueDate.AddDate(0, 0, DefaultLoanDays)) {
+			t.Errorf("Loan.Renew() DueDate = %v, want %v", loan.DueDate, dueDate.AddDate(0, 0, DefaultLoanDays))
+		}
+		if loan.RenewalCount != 1 {
+			t.Errorf("Loan.Renew() RenewalCount = %v, want 1", loan.RenewalCount)
+		}
+	})
+
+	t.Run("max renewals reached", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+		loan.RenewalCount = 2
+
+		err := loan.Renew(DefaultLoanDays, 2, 0)
+
+		if err != ErrMaxRenewalsReached {
+			t.Errorf("Loan.Renew() error = %v, wantErr %v", err, ErrMaxRenewalsReached)
+		}
+	})
+
+	t.Run("overdue within grace period", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+		loan.DueDate = time.Now().Add(-time.Hour)
+		loan.Status = LoanStatusOverdue
+
+		err := loan.Renew(DefaultLoanDays, 2, 24*time.Hour)
+
+		if err != nil {
+			t.Errorf("Loan.Renew() unexpected error = %v", err)
+		}
+		if loan.Status != LoanStatusActive {
+			t.Errorf("Loan.Renew() status = %v, want %v", loan.Status, LoanStatusActive)
+		}
+	})
+
+	t.Run("overdue beyond grace period", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+		loan.DueDate = time.Now().AddDate(0, 0, -3)
+
+		err := loan.Renew(DefaultLoanDays, 2, 24*time.Hour)
+
+		if err != ErrLoanTooOverdue {
+			t.Errorf("Loan.Renew() error = %v, wantErr %v", err, ErrLoanTooOverdue)
+		}
+		if loan.RenewalCount != 0 {
+			t.Errorf("Loan.Renew() RenewalCount = %v, want 0", loan.RenewalCount)
+		}
+	})
+
+	t.Run("returned loan", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+		_ = loan.Return()
+
+		err := loan.Renew(DefaultLoanDays, 2, 0)
+
+		if err != ErrLoanAlreadyReturned {
+			t.Errorf("Loan.Renew() error = %v, wantErr %v", err, ErrLoanAlreadyReturned)
+		}
+	})
+}
+
+func TestLoan_MarkOverdue(t *testing.T) {
+	userID := uuid.New()
+	bookID := uuid.New()
+
+	t.Run("active loan past due date", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+		loan.DueDate = time.Now().Add(-time.Hour)
+
+		if !loan.MarkOverdue(time.Now()) {
+			t.Error("Loan.MarkOverdue() = false, want true")
+		}
+		if loan.Status != LoanStatusOverdue {
+			t.Errorf("Loan.MarkOverdue() status = %v, want %v", loan.Status, LoanStatusOverdue)
+		}
+	})
+
+	t.Run("active loan not yet due", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+
+		if loan.MarkOverdue(time.Now()) {
+			t.Error("Loan.MarkOverdue() = true, want false")
+		}
+		if loan.Status != LoanStatusActive {
+			t.Errorf("Loan.MarkOverdue() status = %v, want %v", loan.Status, LoanStatusActive)
+		}
+	})
+
+	t.Run("returned loan", func(t *testing.T) {
+		loan, _ := NewLoan(userID, bookID, nil)
+		loan.DueDate = time.Now().Add(-time.Hour)
+		_ = loan.Return()
+
+		if loan.MarkOverdue(time.Now()) {
+			t.Error("Loan.MarkOverdue() = true, want false")
+		}
+	})
+}
+
+func TestLoan_DaysOverdue(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	returnedLate := now.Add(-24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		dueDate    time.Time
+		returnedAt *time.Time
+		want       int
+	}{
+		{"not yet due", now.Add(time.Hour), nil, 0},
+		{"due right now", now, nil, 0},
+		{"one hour late", now.Add(-time.Hour), nil, 1},
+		{"exactly two days late", now.AddDate(0, 0, -2), nil, 2},
+		{"two days and one hour late", now.AddDate(0, 0, -2).Add(-time.Hour), nil, 3},
+		{"returned late", now.AddDate(0, 0, -5), &returnedLate, 4},
+		{"returned on time", now.Add(-time.Hour), &returnedLate, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loan := &Loan{DueDate: tt.dueDate, ReturnedAt: tt.returnedAt}
+
+			if got := loan.DaysOverdue(now); got != tt.want {
+				t.Errorf("Loan.DaysOverdue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
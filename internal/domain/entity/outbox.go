@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessage is a domain event waiting in the outbox to be published.
+// It is written in the transaction of the change that raised the event and
+// published by the relay afterwards, in Position order within its
+// aggregate.
+type OutboxMessage struct {
+	// ID is the event's ID, so republished events keep it.
+	ID          uuid.UUID
+	Position    int64
+	EventType   EventType
+	AggregateID uuid.UUID
+	Payload     []byte
+	OccurredAt  time.Time
+	Attempts    int
+	LastError   string
+	// LockedUntil keeps other relays away from the aggregate's messages
+	// while one publishes them, or until a failed message is retried.
+	LockedUntil *time.Time
+	PublishedAt *time.Time
+}
+
+// NewOutboxMessage encodes the event's data as the message payload. The
+// repository assigns the position when the message is appended.
+func NewOutboxMessage(event *Event) (*OutboxMessage, error) {
+	payload, err := json.Marshal(event.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxMessage{
+		ID:          event.ID,
+		EventType:   event.Type,
+		AggregateID: event.AggregateID,
+		Payload:     payload,
+		OccurredAt:  event.OccurredAt,
+	}, nil
+}
+
+// Event rebuilds the event the message was created from. Its Data is the
+// encoded payload.
+func (m *OutboxMessage) Event() *Event {
+	return &Event{
+		ID:          m.ID,
+		Type:        m.EventType,
+		AggregateID: m.AggregateID,
+		OccurredAt:  m.OccurredAt,
+		Data:        json.RawMessage(m.Payload),
+	}
+}
+
+func (m *OutboxMessage) IsPublished() bool {
+	return m.PublishedAt != nil
+}
+
+func (m *OutboxMessage) Publish(at time.Time) {
+	m.Attempts++
+	m.LastError = ""
+	m.LockedUntil = nil
+	m.PublishedAt = &at
+}
+
+// Fail records an attempt the publisher refused and holds the message, and
+// with it the rest of its aggregate, until retryAt.
+func (m *OutboxMessage) Fail(reason string, retryAt time.Time) {
+	m.Attempts++
+	m.LastError = reason
+	m.LockedUntil = &retryAt
+}
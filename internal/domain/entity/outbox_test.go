@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewOutboxMessage(t *testing.T) {
+	event := NewEvent(EventBookCreated, uuid.New(), map[string]string{"title": "Dom Casmurro"})
+
+	message, err := NewOutboxMessage(event)
+	if err != nil {
+		t.Fatalf("NewOutboxMessage() unexpected error = %v", err)
+	}
+	if message.ID != event.ID || message.AggregateID != event.AggregateID || message.EventType != EventBookCreated {
+		t.Errorf("NewOutboxMessage() = %+v, want the event's ID, aggregate and type", message)
+	}
+	if string(message.Payload) != `{"title":"Dom Casmurro"}` {
+		t.Errorf("NewOutboxMessage() Payload = %s", message.Payload)
+	}
+
+	again := message.Event()
+	data, _ := json.Marshal(again.Data)
+	if again.ID != event.ID || string(data) != `{"title":"Dom Casmurro"}` {
+		t.Errorf("OutboxMessage.Event() = %+v with data %s, want the original event", again, data)
+	}
+}
+
+func TestNewOutboxMessage_UnencodableData(t *testing.T) {
+	if _, err := NewOutboxMessage(NewEvent(EventBookCreated, uuid.New(), func() {})); err == nil {
+		t.Error("NewOutboxMessage() error = nil for data that is not JSON")
+	}
+}
+
+func TestOutboxMessage_Attempts(t *testing.T) {
+	message, _ := NewOutboxMessage(NewEvent(EventLoanBorrowed, uuid.New(), nil))
+	now := time.Now()
+
+	message.Fail("broker unavailable", now.Add(time.Minute))
+	if message.IsPublished() || message.Attempts != 1 || message.LastError != "broker unavailable" {
+		t.Errorf("after Fail() message = %+v", message)
+	}
+	if message.LockedUntil == nil || !message.LockedUntil.Equal(now.Add(time.Minute)) {
+		t.Errorf("after Fail() LockedUntil = %v, want the retry time", message.LockedUntil)
+	}
+
+	message.Publish(now)
+	if !message.IsPublished() || message.Attempts != 2 || message.LastError != "" || message.LockedUntil != nil {
+		t.Errorf("after Publish() message = %+v", message)
+	}
+}
@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransferNotFound     = errors.New("transfer not found")
+	ErrTransferToSameBranch = errors.New("copy is already at the destination branch")
+	ErrCopyHasOpenTransfer  = errors.New("copy already has an open transfer")
+	ErrTransferNotRequested = errors.New("transfer has already been shipped or closed")
+	ErrTransferNotInTransit = errors.New("transfer is not in transit")
+)
+
+const (
+	TransferStatusRequested = "requested"
+	TransferStatusInTransit = "in_transit"
+	TransferStatusReceived  = "received"
+	TransferStatusCancelled = "cancelled"
+)
+
+// Transfer moves one copy from the branch holding it to another. The copy
+// stays on the shelf while the transfer is requested, is in transit once
+// shipped and joins the destination branch when received.
+type Transfer struct {
+	ID           uuid.UUID
+	CopyID       uuid.UUID
+	BookID       uuid.UUID
+	FromBranchID uuid.UUID
+	ToBranchID   uuid.UUID
+	Status       string
+	RequestedAt  time.Time
+	ShippedAt    *time.Time
+	ReceivedAt   *time.Time
+	UpdatedAt    time.Time
+}
+
+func NewTransfer(bookCopy *BookCopy, toBranchID uuid.UUID) (*Transfer, error) {
+	if bookCopy.BranchID == toBranchID {
+		return nil, ErrTransferToSameBranch
+	}
+
+	now := time.Now()
+	return &Transfer{
+		ID:           uuid.New(),
+		CopyID:       bookCopy.ID,
+		BookID:       bookCopy.BookID,
+		FromBranchID: bookCopy.BranchID,
+		ToBranchID:   toBranchID,
+		Status:       TransferStatusRequested,
+		RequestedAt:  now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// Ship records that the copy has left the sending branch.
+func (t *Transfer) Ship() error {
+	if t.Status != TransferStatusRequested {
+		return ErrTransferNotRequested
+	}
+
+	now := time.Now()
+	t.Status = TransferStatusInTransit
+	t.ShippedAt = &now
+	t.UpdatedAt = now
+	return nil
+}
+
+// Receive records that the copy has arrived at the destination branch.
+func (t *Transfer) Receive() error {
+	if t.Status != TransferStatusInTransit {
+		return ErrTransferNotInTransit
+	}
+
+	now := time.Now()
+	t.Status = TransferStatusReceived
+	t.ReceivedAt = &now
+	t.UpdatedAt = now
+	return nil
+}
+
+// Cancel withdraws a transfer that has not been shipped yet.
+func (t *Transfer) Cancel() error {
+	if t.Status != TransferStatusRequested {
+		return ErrTransferNotRequested
+	}
+
+	t.Status = TransferStatusCancelled
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsOpen reports whether the transfer is still waiting to be shipped or
+// received.
+func (t *Transfer) IsOpen() bool {
+	return t.Status == TransferStatusRequested || t.Status == TransferStatusInTransit
+}
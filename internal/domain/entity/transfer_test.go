@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewTransfer(t *testing.T) {
+	bookCopy, _ := NewBookCopy(uuid.New(), uuid.New(), "BH-1", "", "")
+
+	t.Run("to another branch", func(t *testing.T) {
+		toBranchID := uuid.New()
+		transfer, err := NewTransfer(bookCopy, toBranchID)
+		if err != nil {
+			t.Fatalf("NewTransfer() unexpected error = %v", err)
+		}
+		if transfer.FromBranchID != bookCopy.BranchID || transfer.ToBranchID != toBranchID {
+			t.Errorf("NewTransfer() branches = %v -> %v, want %v -> %v", transfer.FromBranchID, transfer.ToBranchID, bookCopy.BranchID, toBranchID)
+		}
+		if transfer.Status != TransferStatusRequested {
+			t.Errorf("NewTransfer() status = %v, want %v", transfer.Status, TransferStatusRequested)
+		}
+	})
+
+	t.Run("to the branch holding the copy", func(t *testing.T) {
+		if _, err := NewTransfer(bookCopy, bookCopy.BranchID); err != ErrTransferToSameBranch {
+			t.Errorf("NewTransfer() error = %v, wantErr %v", err, ErrTransferToSameBranch)
+		}
+	})
+}
+
+func TestTransfer_Lifecycle(t *testing.T) {
+	bookCopy, _ := NewBookCopy(uuid.New(), uuid.New(), "BH-1", "", "")
+
+	t.Run("ship then receive", func(t *testing.T) {
+		transfer, _ := NewTransfer(bookCopy, uuid.New())
+
+		if err := transfer.Receive(); err != ErrTransferNotInTransit {
+			t.Errorf("Transfer.Receive() error = %v, wantErr %v", err, ErrTransferNotInTransit)
+		}
+		if err := transfer.Ship(); err != nil {
+			t.Fatalf("Transfer.Ship() unexpected error = %v", err)
+		}
+		if transfer.ShippedAt == nil {
+			t.Error("Transfer.Ship() shipped at not set")
+		}
+		if err := transfer.Receive(); err != nil {
+			t.Fatalf("Transfer.Receive() unexpected error = %v", err)
+		}
+		if transfer.IsOpen() {
+			t.Errorf("Transfer.Receive() status = %v, want it closed", transfer.Status)
+		}
+	})
+
+	t.Run("shipped transfer cannot be cancelled", func(t *testing.T) {
+		transfer, _ := NewTransfer(bookCopy, uuid.New())
+		_ = transfer.Ship()
+
+		if err := transfer.Cancel(); err != ErrTransferNotRequested {
+			t.Errorf("Transfer.Cancel() error = %v, wantErr %v", err, ErrTransferNotRequested)
+		}
+	})
+
+	t.Run("cancelled transfer cannot be shipped", func(t *testing.T) {
+		transfer, _ := NewTransfer(bookCopy, uuid.New())
+		_ = transfer.Cancel()
+
+		if err := transfer.Ship(); err != ErrTransferNotRequested {
+			t.Errorf("Transfer.Ship() error = %v, wantErr %v", err, ErrTransferNotRequested)
+		}
+	})
+}
@@ -2,6 +2,8 @@ package entity
 
 import (
 	"errors"
+	"fmt"
+	"math/rand/v2"
 	"regexp"
 	"time"
 
@@ -9,12 +11,24 @@ import (
 )
 
 var (
-	ErrInvalidUserName     = errors.New("invalid user name: must be between 3 and 100 characters")
-	ErrInvalidUserEmail    = errors.New("invalid user email format")
-	ErrInvalidUserPassword = errors.New("invalid password: must be at least 6 characters")
-	ErrUserDisabled        = errors.New("user is disabled")
-	ErrUserNotFound        = errors.New("user not found")
-	ErrEmailAlreadyExists  = errors.New("email already exists")
+	ErrInvalidUserName         = errors.New("invalid user name: must be between 3 and 100 characters")
+	ErrInvalidUserEmail        = errors.New("invalid user email format")
+	ErrInvalidUserPassword     = errors.New("invalid password: must be at least 6 characters")
+	ErrUserDisabled            = errors.New("user is disabled")
+	ErrUserNotFound            = errors.New("user not found")
+	ErrEmailAlreadyExists      = errors.New("email already exists")
+	ErrInvalidUserRole         = errors.New("invalid user role: must be admin, librarian or member")
+	ErrInvalidCurrentPassword  = errors.New("current password is incorrect")
+	ErrInvalidCardNumber       = errors.New("invalid card number: must be 4 to 20 letters, digits or '-'")
+	ErrCardNumberAlreadyExists = errors.New("card number already in use")
+	ErrUserBlocked             = errors.New("user is blocked from borrowing")
+	ErrUserNotBlocked          = errors.New("user is not blocked from borrowing")
+)
+
+const (
+	RoleAdmin     = "admin"
+	RoleLibrarian = "librarian"
+	RoleMember    = "member"
 )
 
 type User struct {
@@ -22,9 +36,18 @@ type User struct {
 	Name         string
 	Email        string
 	PasswordHash string
-	Active       bool
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	Role         string
+	// Category is the patron category that selects the loan policy.
+	Category string
+	// CardNumber is printed on the patron's library card and scanned at the
+	// circulation desk.
+	CardNumber string
+	Active     bool
+	// Blocked users may not borrow until staff lift the block. Overdue
+	// escalation blocks patrons whose loans are long overdue.
+	Blocked   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 func NewUser(name, email, passwordHash string) (*User, error) {
@@ -33,6 +56,9 @@ func NewUser(name, email, passwordHash string) (*User, error) {
 		Name:         name,
 		Email:        email,
 		PasswordHash: passwordHash,
+		Role:         RoleMember,
+		Category:     DefaultPatronCategory,
+		CardNumber:   NewCardNumber(),
 		Active:       true,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
@@ -58,6 +84,18 @@ func (u *User) Validate() error {
 		return ErrInvalidUserPassword
 	}
 
+	if !IsValidRole(u.Role) {
+		return ErrInvalidUserRole
+	}
+
+	if !IsValidCategory(u.Category) {
+		return ErrInvalidCategory
+	}
+
+	if !cardNumberRegex.MatchString(u.CardNumber) {
+		return ErrInvalidCardNumber
+	}
+
 	return nil
 }
 
@@ -80,6 +118,43 @@ func (u *User) Update(name, email string) error {
 	return nil
 }
 
+func (u *User) ChangePassword(passwordHash string) error {
+	if len(passwordHash) < 6 {
+		return ErrInvalidUserPassword
+	}
+	u.PasswordHash = passwordHash
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+func (u *User) ChangeRole(role string) error {
+	if !IsValidRole(role) {
+		return ErrInvalidUserRole
+	}
+	u.Role = role
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+func (u *User) ChangeCategory(category string) error {
+	if !IsValidCategory(category) {
+		return ErrInvalidCategory
+	}
+	u.Category = category
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+// ChangeCardNumber assigns a new library card, e.g. when the old one was lost.
+func (u *User) ChangeCardNumber(cardNumber string) error {
+	if !cardNumberRegex.MatchString(cardNumber) {
+		return ErrInvalidCardNumber
+	}
+	u.CardNumber = cardNumber
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
 func (u *User) Disable() error {
 	if !u.Active {
 		return ErrUserDisabled
@@ -89,10 +164,49 @@ func (u *User) Disable() error {
 	return nil
 }
 
+// Block stops the user from borrowing. Blocking a blocked user is a no-op.
+func (u *User) Block() {
+	if u.Blocked {
+		return
+	}
+	u.Blocked = true
+	u.UpdatedAt = time.Now()
+}
+
+func (u *User) Unblock() error {
+	if !u.Blocked {
+		return ErrUserNotBlocked
+	}
+	u.Blocked = false
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
 func (u *User) IsActive() bool {
 	return u.Active
 }
 
+func IsValidRole(role string) bool {
+	switch role {
+	case RoleAdmin, RoleLibrarian, RoleMember:
+		return true
+	}
+	return false
+}
+
+// IsStaffRole reports whether role belongs to library staff, who may manage
+// books and loans on behalf of other users.
+func IsStaffRole(role string) bool {
+	return role == RoleAdmin || role == RoleLibrarian
+}
+
+var cardNumberRegex = regexp.MustCompile(`^[A-Za-z0-9-]{4,20}$`)
+
+// NewCardNumber returns a random 10-digit library card number.
+func NewCardNumber() string {
+	return fmt.Sprintf("%010d", rand.Int64N(10_000_000_000))
+}
+
 func isValidEmail(email string) bool {
 	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 	return emailRegex.MatchString(email)
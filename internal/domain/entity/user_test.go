@@ -152,6 +152,137 @@ func TestUser_Disable(t *testing.T) {
 	})
 }
 
+func TestUser_BlockAndUnblock(t *testing.T) {
+	user, _ := NewUser("John Doe", "john@example.com", "hashedpassword123")
+
+	if err := user.Unblock(); err != ErrUserNotBlocked {
+		t.Errorf("User.Unblock() error = %v, wantErr %v", err, ErrUserNotBlocked)
+	}
+
+	user.Block()
+	user.Block()
+	if !user.Blocked {
+		t.Error("User.Block() user should be blocked")
+	}
+
+	if err := user.Unblock(); err != nil {
+		t.Errorf("User.Unblock() unexpected error = %v", err)
+	}
+	if user.Blocked {
+		t.Error("User.Unblock() user should not be blocked")
+	}
+}
+
+func TestUser_ChangeRole(t *testing.T) {
+	t.Run("new users are members", func(t *testing.T) {
+		user, _ := NewUser("John Doe", "john@example.com", "hashedpassword123")
+
+		if user.Role != RoleMember {
+			t.Errorf("NewUser() role = %v, want %v", user.Role, RoleMember)
+		}
+	})
+
+	t.Run("promote to librarian", func(t *testing.T) {
+		user, _ := NewUser("John Doe", "john@example.com", "hashedpassword123")
+
+		if err := user.ChangeRole(RoleLibrarian); err != nil {
+			t.Errorf("User.ChangeRole() unexpected error = %v", err)
+		}
+		if user.Role != RoleLibrarian {
+			t.Errorf("User.ChangeRole() role = %v, want %v", user.Role, RoleLibrarian)
+		}
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		user, _ := NewUser("John Doe", "john@example.com", "hashedpassword123")
+
+		if err := user.ChangeRole("superuser"); err != ErrInvalidUserRole {
+			t.Errorf("User.ChangeRole() error = %v, wantErr %v", err, ErrInvalidUserRole)
+		}
+		if user.Role != RoleMember {
+			t.Errorf("User.ChangeRole() role = %v, want %v", user.Role, RoleMember)
+		}
+	})
+}
+
+func TestUser_ChangeCategory(t *testing.T) {
+	t.Run("new users are standard patrons", func(t *testing.T) {
+		user, _ := NewUser("John Doe", "john@example.com", "hashedpassword123")
+
+		if user.Category != DefaultPatronCategory {
+			t.Errorf("NewUser() category = %v, want %v", user.Category, DefaultPatronCategory)
+		}
+	})
+
+	t.Run("valid category", func(t *testing.T) {
+		user, _ := NewUser("John Doe", "john@example.com", "hashedpassword123")
+
+		if err := user.ChangeCategory("student"); err != nil {
+			t.Errorf("User.ChangeCategory() unexpected error = %v", err)
+		}
+		if user.Category != "student" {
+			t.Errorf("User.ChangeCategory() category = %v, want student", user.Category)
+		}
+	})
+
+	t.Run("wildcard is not a patron category", func(t *testing.T) {
+		user, _ := NewUser("John Doe", "john@example.com", "hashedpassword123")
+
+		if err := user.ChangeCategory(AnyCategory); err != ErrInvalidCategory {
+			t.Errorf("User.ChangeCategory() error = %v, wantErr %v", err, ErrInvalidCategory)
+		}
+	})
+}
+
+func TestUser_ChangeCardNumber(t *testing.T) {
+	t.Run("new users get a card number", func(t *testing.T) {
+		user, _ := NewUser("John Doe", "john@example.com", "hashedpassword123")
+
+		if len(user.CardNumber) != 10 {
+			t.Errorf("NewUser() card number = %v, want 10 digits", user.CardNumber)
+		}
+	})
+
+	t.Run("valid card number", func(t *testing.T) {
+		user, _ := NewUser("John Doe", "john@example.com", "hashedpassword123")
+
+		if err := user.ChangeCardNumber("P-000123"); err != nil {
+			t.Errorf("User.ChangeCardNumber() unexpected error = %v", err)
+		}
+		if user.CardNumber != "P-000123" {
+			t.Errorf("User.ChangeCardNumber() card number = %v, want P-000123", user.CardNumber)
+		}
+	})
+
+	t.Run("card number with spaces", func(t *testing.T) {
+		user, _ := NewUser("John Doe", "john@example.com", "hashedpassword123")
+
+		if err := user.ChangeCardNumber("P 000123"); err != ErrInvalidCardNumber {
+			t.Errorf("User.ChangeCardNumber() error = %v, wantErr %v", err, ErrInvalidCardNumber)
+		}
+	})
+}
+
+func TestIsStaffRole(t *testing.T) {
+	tests := []struct {
+		role  string
+		staff bool
+	}{
+		{RoleAdmin, true},
+		{RoleLibrarian, true},
+		{RoleMember, false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			if got := IsStaffRole(tt.role); got != tt.staff {
+				t.Errorf("IsStaffRole(%q) = %v, want %v", tt.role, got, tt.staff)
+			}
+		})
+	}
+}
+
 func TestIsValidEmail(t *testing.T) {
 	tests := []struct {
 		email string
@@ -0,0 +1,202 @@
+package entity
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrInvalidWebhookURL       = errors.New("webhook URL must be an absolute http or https URL")
+	ErrInvalidWebhookEvents    = errors.New("webhook must subscribe to at least one known event type")
+	ErrInvalidWebhookSecret    = errors.New("webhook secret must be between 16 and 256 characters")
+)
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+const (
+	minWebhookSecretLength = 16
+	maxWebhookSecretLength = 256
+)
+
+// WebhookSubscription asks for events of the listed types to be posted to
+// URL, signed with Secret. Inactive subscriptions receive nothing.
+type WebhookSubscription struct {
+	ID        uuid.UUID
+	URL       string
+	Events    []EventType
+	Secret    string
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewWebhookSubscription(rawURL string, events []EventType, secret string) (*WebhookSubscription, error) {
+	now := time.Now()
+	subscription := &WebhookSubscription{
+		ID:        uuid.New(),
+		URL:       rawURL,
+		Events:    events,
+		Secret:    secret,
+		Active:    true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (s *WebhookSubscription) Validate() error {
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+	if len(s.Events) == 0 {
+		return ErrInvalidWebhookEvents
+	}
+	for _, eventType := range s.Events {
+		if !eventType.IsValid() {
+			return ErrInvalidWebhookEvents
+		}
+	}
+	if len(s.Secret) < minWebhookSecretLength || len(s.Secret) > maxWebhookSecretLength {
+		return ErrInvalidWebhookSecret
+	}
+	return nil
+}
+
+// Update replaces the subscription's settings, leaving it unchanged when
+// they are not valid.
+func (s *WebhookSubscription) Update(rawURL string, events []EventType, secret string, active bool) error {
+	updated := *s
+	updated.URL = rawURL
+	updated.Events = events
+	updated.Secret = secret
+	updated.Active = active
+	if err := updated.Validate(); err != nil {
+		return err
+	}
+
+	updated.UpdatedAt = time.Now()
+	*s = updated
+	return nil
+}
+
+// Subscribes reports whether the subscription receives events of eventType.
+func (s *WebhookSubscription) Subscribes(eventType EventType) bool {
+	if !s.Active {
+		return false
+	}
+	for _, subscribed := range s.Events {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookRetryPolicy spaces out the attempts to deliver an event: the wait
+// after the nth failed attempt is BaseDelay doubled n-1 times, up to
+// MaxDelay. A delivery fails for good after MaxAttempts attempts.
+type WebhookRetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Backoff returns how long to wait after the given failed attempt.
+func (p WebhookRetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// WebhookDelivery is the log of posting one event to one subscription.
+// While pending, the next attempt is made at NextAttemptAt. ResponseStatus
+// and LastError describe the latest attempt.
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	EventType      EventType
+	Payload        []byte
+	Status         string
+	Attempts       int
+	NextAttemptAt  *time.Time
+	ResponseStatus *int
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// NewWebhookDelivery queues payload, the JSON body of the event, for
+// delivery to the subscription straight away.
+func NewWebhookDelivery(subscriptionID, eventID uuid.UUID, eventType EventType, payload []byte) *WebhookDelivery {
+	now := time.Now()
+	return &WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         WebhookDeliveryPending,
+		NextAttemptAt:  &now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// Succeed records an attempt the receiver answered with a 2xx status.
+func (d *WebhookDelivery) Succeed(statusCode int, at time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryDelivered
+	d.NextAttemptAt = nil
+	d.ResponseStatus = &statusCode
+	d.LastError = ""
+	d.DeliveredAt = &at
+	d.UpdatedAt = at
+}
+
+// Fail records an attempt that did not reach the receiver or that it
+// refused, and schedules the next one according to policy. statusCode is
+// zero when no response came back.
+func (d *WebhookDelivery) Fail(statusCode int, reason string, at time.Time, policy WebhookRetryPolicy) {
+	d.Attempts++
+	d.ResponseStatus = nil
+	if statusCode != 0 {
+		d.ResponseStatus = &statusCode
+	}
+	d.LastError = reason
+	d.UpdatedAt = at
+
+	if d.Attempts >= policy.MaxAttempts {
+		d.Status = WebhookDeliveryFailed
+		d.NextAttemptAt = nil
+		return
+	}
+	next := at.Add(policy.Backoff(d.Attempts))
+	d.NextAttemptAt = &next
+}
+
+// Redeliver queues the same event for the same subscription once more, as
+// a new delivery, leaving this one in the log as it is.
+func (d *WebhookDelivery) Redeliver() *WebhookDelivery {
+	return NewWebhookDelivery(d.SubscriptionID, d.EventID, d.EventType, d.Payload)
+}
@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewWebhookSubscription(t *testing.T) {
+	secret := "0123456789abcdef"
+
+	tests := []struct {
+		name    string
+		url     string
+		events  []EventType
+		secret  string
+		wantErr error
+	}{
+		{"valid", "https://example.com/hooks", []EventType{EventLoanBorrowed, EventBookCreated}, secret, nil},
+		{"relative URL", "/hooks", []EventType{EventLoanBorrowed}, secret, ErrInvalidWebhookURL},
+		{"unsupported scheme", "ftp://example.com/hooks", []EventType{EventLoanBorrowed}, secret, ErrInvalidWebhookURL},
+		{"no events", "https://example.com/hooks", nil, secret, ErrInvalidWebhookEvents},
+		{"unknown event", "https://example.com/hooks", []EventType{"loan.eaten"}, secret, ErrInvalidWebhookEvents},
+		{"short secret", "https://example.com/hooks", []EventType{EventLoanBorrowed}, "secret", ErrInvalidWebhookSecret},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription, err := NewWebhookSubscription(tt.url, tt.events, tt.secret)
+			if err != tt.wantErr {
+				t.Fatalf("NewWebhookSubscription() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !subscription.Active {
+				t.Error("NewWebhookSubscription() Active = false, want true")
+			}
+		})
+	}
+}
+
+func TestWebhookSubscription_Subscribes(t *testing.T) {
+	subscription, _ := NewWebhookSubscription("https://example.com/hooks", []EventType{EventLoanReturned}, "0123456789abcdef")
+
+	if !subscription.Subscribes(EventLoanReturned) {
+		t.Error("WebhookSubscription.Subscribes(loan.returned) = false, want true")
+	}
+	if subscription.Subscribes(EventLoanBorrowed) {
+		t.Error("WebhookSubscription.Subscribes(loan.borrowed) = true, want false")
+	}
+
+	subscription.Active = false
+	if subscription.Subscribes(EventLoanReturned) {
+		t.Error("WebhookSubscription.Subscribes() on inactive subscription = true, want false")
+	}
+}
+
+func TestWebhookRetryPolicy_Backoff(t *testing.T) {
+	policy := WebhookRetryPolicy{MaxAttempts: 8, BaseDelay: 30 * time.Second, MaxDelay: 10 * time.Minute}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{5, 8 * time.Minute},
+		{6, 10 * time.Minute},
+		{40, 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := policy.Backoff(tt.attempt); got != tt.want {
+			t.Errorf("WebhookRetryPolicy.Backoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestWebhookDelivery_Attempts(t *testing.T) {
+	policy := WebhookRetryPolicy{MaxAttempts: 2, BaseDelay: time.Minute, MaxDelay: time.Hour}
+	now := time.Now()
+
+	t.Run("retried then failed", func(t *testing.T) {
+		delivery := NewWebhookDelivery(uuid.New(), uuid.New(), EventLoanBorrowed, []byte(`{}`))
+
+		delivery.Fail(503, "receiver responded with status 503", now, policy)
+		if delivery.Status != WebhookDeliveryPending {
+			t.Fatalf("WebhookDelivery.Fail() status = %v, want %v", delivery.Status, WebhookDeliveryPending)
+		}
+		if delivery.NextAttemptAt == nil || !delivery.NextAttemptAt.Equal(now.Add(time.Minute)) {
+			t.Errorf("WebhookDelivery.Fail() next attempt = %v, want %v", delivery.NextAttemptAt, now.Add(time.Minute))
+		}
+
+		delivery.Fail(0, "connection refused", now, policy)
+		if delivery.Status != WebhookDeliveryFailed || delivery.NextAttemptAt != nil {
+			t.Errorf("WebhookDelivery.Fail() status = %v next = %v, want %v and no next attempt", delivery.Status, delivery.NextAttemptAt, WebhookDeliveryFailed)
+		}
+		if delivery.ResponseStatus != nil {
+			t.Errorf("WebhookDelivery.Fail() response status = %v, want nil", *delivery.ResponseStatus)
+		}
+	})
+
+	t.Run("delivered", func(t *testing.T) {
+		delivery := NewWebhookDelivery(uuid.New(), uuid.New(), EventLoanBorrowed, []byte(`{}`))
+		delivery.Fail(500, "receiver responded with status 500", now, policy)
+
+		delivery.Succeed(204, now)
+		if delivery.Status != WebhookDeliveryDelivered || delivery.Attempts != 2 || delivery.LastError != "" {
+			t.Errorf("WebhookDelivery.Succeed() = %v after %d attempts (%q), want %v", delivery.Status, delivery.Attempts, delivery.LastError, WebhookDeliveryDelivered)
+		}
+	})
+
+	t.Run("redelivered", func(t *testing.T) {
+		delivery := NewWebhookDelivery(uuid.New(), uuid.New(), EventLoanBorrowed, []byte(`{"id":"1"}`))
+		delivery.Succeed(200, now)
+
+		again := delivery.Redeliver()
+		if again.ID == delivery.ID || again.EventID != delivery.EventID || string(again.Payload) != `{"id":"1"}` {
+			t.Errorf("WebhookDelivery.Redeliver() = %+v, want a new delivery of the same event", again)
+		}
+		if again.Status != WebhookDeliveryPending || again.Attempts != 0 {
+			t.Errorf("WebhookDelivery.Redeliver() status = %v attempts = %d, want pending and none", again.Status, again.Attempts)
+		}
+	})
+}
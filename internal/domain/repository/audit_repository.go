@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// AuditFilter narrows an audit log listing. From and To bound the entries'
+// creation time, both inclusive.
+type AuditFilter struct {
+	ActorID    *uuid.UUID
+	Action     *string
+	EntityType *string
+	EntityID   *uuid.UUID
+	From       *time.Time
+	To         *time.Time
+}
+
+type AuditRepository interface {
+	// Append chains the entry after the last one in the log and stores it.
+	// It must run in the transaction in ctx: appends wait for each other
+	// until that transaction ends, so the chain never forks.
+	Append(ctx context.Context, entry *entity.AuditEntry) error
+	// List returns the entries matching filter, newest first.
+	List(ctx context.Context, page, limit int, filter AuditFilter) ([]*entity.AuditEntry, int, error)
+	// ListChain returns up to limit entries that come after the one with
+	// sequence afterSequence, in chain order.
+	ListChain(ctx context.Context, afterSequence int64, limit int) ([]*entity.AuditEntry, error)
+}
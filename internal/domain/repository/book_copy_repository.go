@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type BookCopyRepository interface {
+	Create(ctx context.Context, bookCopy *entity.BookCopy) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.BookCopy, error)
+	GetByBarcode(ctx context.Context, barcode string) (*entity.BookCopy, error)
+	ListByBook(ctx context.Context, bookID uuid.UUID) ([]*entity.BookCopy, error)
+	// FindByStatus returns one of the book's copies in status, or nil when
+	// it has none.
+	FindByStatus(ctx context.Context, bookID uuid.UUID, status string) (*entity.BookCopy, error)
+	// CountByBranch counts the copies kept at the branch, withdrawn ones
+	// included.
+	CountByBranch(ctx context.Context, branchID uuid.UUID) (int, error)
+	// AvailabilityByBranch breaks the copy counts of each book down by
+	// branch. Branches where a book has only withdrawn copies are left out.
+	AvailabilityByBranch(ctx context.Context, bookIDs []uuid.UUID) ([]entity.BranchAvailability, error)
+	Update(ctx context.Context, bookCopy *entity.BookCopy) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
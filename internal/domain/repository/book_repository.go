@@ -8,11 +8,54 @@ import (
 	"github.com/google/uuid"
 )
 
+// BookFilter narrows a book list. With BranchID set only books with copies
+// at that branch are listed, and AvailableOnly looks at that branch's shelf.
+// Author narrows to authors containing it regardless of case and accents;
+// the published years are inclusive. Sort uses BookSortFields and defaults
+// to the title.
+type BookFilter struct {
+	AvailableOnly bool
+	BranchID      *uuid.UUID
+	Author        string
+	PublishedFrom *int
+	PublishedTo   *int
+	Sort          []SortField
+}
+
+// BookSortFields are the fields a book list can be sorted by.
+var BookSortFields = []string{"title", "author", "published_year", "created_at"}
+
+// BookSearch is a full-text query over title, author and ISBN. Terms match
+// regardless of case and accents, and books matching any term are found,
+// those matching more terms or matching in the title ranked first. Results
+// are always ordered by relevance, so the filter's Sort is not used.
+type BookSearch struct {
+	BookFilter
+	Query string
+}
+
+// BookMatch is a book found by Search with its relevance score, which only
+// orders results of the same search, and its title and author with the
+// matched words wrapped in <mark> tags.
+type BookMatch struct {
+	Book            *entity.Book
+	Score           float64
+	TitleHighlight  string
+	AuthorHighlight string
+}
+
 type BookRepository interface {
 	Create(ctx context.Context, book *entity.Book) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Book, error)
 	GetByISBN(ctx context.Context, isbn string) (*entity.Book, error)
-	List(ctx context.Context, page, limit int, availableOnly *bool) ([]*entity.Book, int, error)
+	List(ctx context.Context, page, limit int, filter BookFilter) ([]*entity.Book, int, error)
+	// Search returns the books matching search, best first.
+	Search(ctx context.Context, page, limit int, search BookSearch) ([]*BookMatch, int, error)
+	// SuggestTitles returns up to limit distinct titles of listed books that
+	// start with prefix, ignoring case and accents, in alphabetical order.
+	SuggestTitles(ctx context.Context, prefix string, limit int) ([]string, error)
+	// SuggestAuthors is SuggestTitles for authors.
+	SuggestAuthors(ctx context.Context, prefix string, limit int) ([]string, error)
 	Update(ctx context.Context, book *entity.Book) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type BranchRepository interface {
+	Create(ctx context.Context, branch *entity.Branch) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Branch, error)
+	GetByCode(ctx context.Context, code string) (*entity.Branch, error)
+	List(ctx context.Context) ([]*entity.Branch, error)
+	Update(ctx context.Context, branch *entity.Branch) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
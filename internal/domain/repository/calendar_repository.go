@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type CalendarRepository interface {
+	// ListOpeningHours returns the branch's weekly schedule ordered by
+	// weekday, Sunday first.
+	ListOpeningHours(ctx context.Context, branchID uuid.UUID) ([]*entity.OpeningHours, error)
+	// ReplaceOpeningHours swaps the branch's whole weekly schedule for hours.
+	ReplaceOpeningHours(ctx context.Context, branchID uuid.UUID, hours []*entity.OpeningHours) error
+	CreateClosedDate(ctx context.Context, closedDate *entity.ClosedDate) error
+	GetClosedDate(ctx context.Context, id uuid.UUID) (*entity.ClosedDate, error)
+	GetClosedDateByDay(ctx context.Context, branchID uuid.UUID, date time.Time) (*entity.ClosedDate, error)
+	// ListClosedDates returns the branch's closed dates from from on,
+	// up to and including to when it is set, ordered by date.
+	ListClosedDates(ctx context.Context, branchID uuid.UUID, from time.Time, to *time.Time) ([]*entity.ClosedDate, error)
+	DeleteClosedDate(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+)
+
+type DueDateAdjustmentRepository interface {
+	Create(ctx context.Context, adjustment *entity.DueDateAdjustment) error
+}
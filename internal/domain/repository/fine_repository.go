@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type FineFilter struct {
+	UserID *uuid.UUID
+	Status *string
+}
+
+type FineRepository interface {
+	Create(ctx context.Context, fine *entity.Fine) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Fine, error)
+	List(ctx context.Context, page, limit int, filter FineFilter) ([]*entity.Fine, int, error)
+	// OutstandingCents sums what the user still owes across open fines.
+	OutstandingCents(ctx context.Context, userID uuid.UUID) (int64, error)
+	Update(ctx context.Context, fine *entity.Fine) error
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type HoldFilter struct {
+	UserID *uuid.UUID
+	BookID *uuid.UUID
+	Status *string
+}
+
+// HoldWithPosition pairs a hold with its place in the book's queue. Position
+// starts at 1 and is 0 for holds that are no longer waiting.
+type HoldWithPosition struct {
+	Hold     *entity.Hold
+	Position int
+}
+
+type HoldRepository interface {
+	Create(ctx context.Context, hold *entity.Hold) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Hold, error)
+	// GetActiveByUserAndBook returns the user's waiting or ready hold, if any.
+	GetActiveByUserAndBook(ctx context.Context, userID, bookID uuid.UUID) (*entity.Hold, error)
+	// GetNextWaiting returns the oldest waiting hold for the book.
+	GetNextWaiting(ctx context.Context, bookID uuid.UUID) (*entity.Hold, error)
+	// HasPendingHolds reports whether anyone but excludeUserID has a waiting
+	// or ready hold for the book.
+	HasPendingHolds(ctx context.Context, bookID, excludeUserID uuid.UUID) (bool, error)
+	// CountAhead counts the waiting holds for the book placed before hold.
+	CountAhead(ctx context.Context, hold *entity.Hold) (int, error)
+	List(ctx context.Context, page, limit int, filter HoldFilter) ([]*entity.Hold, int, error)
+	// ListExpiredPickups returns ready holds whose deadline is before now.
+	ListExpiredPickups(ctx context.Context, now time.Time) ([]*entity.Hold, error)
+	Update(ctx context.Context, hold *entity.Hold) error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type LoanEscalationRepository interface {
+	// Claim records escalation unless a step of the same level was already
+	// recorded for the loan. It reports whether escalation was recorded, so
+	// only one caller goes on to take the step.
+	Claim(ctx context.Context, escalation *entity.LoanEscalation) (bool, error)
+	// ListByLoan returns the steps taken against the loan ordered by level.
+	ListByLoan(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanEscalation, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type LoanNoticeRepository interface {
+	// Claim records notice unless one of the same kind was already recorded
+	// for the loan and due date. It reports whether notice was recorded, so
+	// only one caller goes on to send it.
+	Claim(ctx context.Context, notice *entity.LoanNotice) (bool, error)
+	// Delete drops a claimed notice that could not be sent, so it is tried
+	// again.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
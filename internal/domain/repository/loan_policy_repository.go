@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type LoanPolicyRepository interface {
+	Create(ctx context.Context, policy *entity.LoanPolicy) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.LoanPolicy, error)
+	GetByCategories(ctx context.Context, patronCategory, itemCategory string) (*entity.LoanPolicy, error)
+	List(ctx context.Context) ([]*entity.LoanPolicy, error)
+	// ListMatching returns the policies for exactly these categories or
+	// for entity.AnyCategory in their place.
+	ListMatching(ctx context.Context, patronCategory, itemCategory string) ([]*entity.LoanPolicy, error)
+	Update(ctx context.Context, policy *entity.LoanPolicy) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
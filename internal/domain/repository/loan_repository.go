@@ -2,18 +2,62 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"bookhub/internal/domain/entity"
 
 	"github.com/google/uuid"
 )
 
+// DueLoanFilter selects checked out loans due from DueFrom up to, but
+// excluding, DueBefore. BookID and BranchID narrow it to one book or to
+// copies kept at one branch.
+type DueLoanFilter struct {
+	DueFrom   time.Time
+	DueBefore time.Time
+	BookID    *uuid.UUID
+	BranchID  *uuid.UUID
+}
+
+// LoanFilter narrows a loan list. The borrowed and returned ranges are
+// inclusive; DueBefore keeps loans due strictly before it. Sort uses
+// LoanSortFields and defaults to the latest borrowed first.
+type LoanFilter struct {
+	UserID       *uuid.UUID
+	BookID       *uuid.UUID
+	Status       *string
+	BorrowedFrom *time.Time
+	BorrowedTo   *time.Time
+	ReturnedFrom *time.Time
+	ReturnedTo   *time.Time
+	DueBefore    *time.Time
+	Sort         []SortField
+}
+
+// LoanSortFields are the fields a loan list can be sorted by.
+var LoanSortFields = []string{"borrowed_at", "due_date", "returned_at"}
+
 type LoanRepository interface {
 	Create(ctx context.Context, loan *entity.Loan) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Loan, error)
 	GetActiveByUserAndBook(ctx context.Context, userID, bookID uuid.UUID) (*entity.Loan, error)
-	List(ctx context.Context, page, limit int, userID *uuid.UUID, status *string) ([]*entity.Loan, int, error)
+	// GetActiveByCopy returns the open loan of the copy, or nil when the copy
+	// is not checked out.
+	GetActiveByCopy(ctx context.Context, copyID uuid.UUID) (*entity.Loan, error)
+	// CountActiveByUser counts the user's loans that are still checked out.
+	CountActiveByUser(ctx context.Context, userID uuid.UUID) (int, error)
+	// CountActiveByBook counts the book's loans that are still checked out.
+	CountActiveByBook(ctx context.Context, bookID uuid.UUID) (int, error)
+	// CountByBook counts all of the book's loans, closed ones included.
+	CountByBook(ctx context.Context, bookID uuid.UUID) (int, error)
+	List(ctx context.Context, page, limit int, filter LoanFilter) ([]*entity.Loan, int, error)
+	// ListActiveDue returns the checked out loans matching filter ordered by
+	// due date. Inside a transaction they stay locked until it ends.
+	ListActiveDue(ctx context.Context, filter DueLoanFilter) ([]*entity.Loan, error)
 	Update(ctx context.Context, loan *entity.Loan) error
+	// MarkOverdue moves active loans due before now to overdue and returns
+	// them as they are now.
+	MarkOverdue(ctx context.Context, now time.Time) ([]*entity.Loan, error)
 }
 
 type LoanWithDetails struct {
@@ -25,5 +69,5 @@ type LoanWithDetails struct {
 type LoanRepositoryWithDetails interface {
 	LoanRepository
 	GetByIDWithDetails(ctx context.Context, id uuid.UUID) (*LoanWithDetails, error)
-	ListWithDetails(ctx context.Context, page, limit int, userID *uuid.UUID, status *string) ([]*LoanWithDetails, int, error)
+	ListWithDetails(ctx context.Context, page, limit int, filter LoanFilter) ([]*LoanWithDetails, int, error)
 }
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+)
+
+type OutboxRepository interface {
+	// Append adds the message to the end of its aggregate's messages and
+	// sets its position. It joins the transaction in ctx, if any.
+	Append(ctx context.Context, message *entity.OutboxMessage) error
+	// ClaimPending picks up to limit aggregates whose oldest unpublished
+	// message is not locked at now and locks all of their unpublished
+	// messages until leaseUntil, so concurrent callers never publish the
+	// same aggregate at once. Messages come grouped by aggregate, in
+	// position order.
+	ClaimPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.OutboxMessage, error)
+	Update(ctx context.Context, message *entity.OutboxMessage) error
+	// DeletePublishedBefore removes the messages published before the
+	// given time and returns how many there were.
+	DeletePublishedBefore(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var ErrInvalidSort = errors.New("invalid sort")
+
+// SortField orders a list by one field, descending when Desc is set.
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// ParseSort reads a comma-separated list of fields such as
+// "-published_year,title", where a leading - sorts that field in descending
+// order. Each field must be one of allowed and appear once.
+func ParseSort(s string, allowed []string) ([]SortField, error) {
+	var sort []SortField
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		field := SortField{Field: strings.TrimPrefix(part, "-"), Desc: strings.HasPrefix(part, "-")}
+		if !slices.Contains(allowed, field.Field) {
+			return nil, fmt.Errorf("%w: unknown field %q, use %s", ErrInvalidSort, field.Field, strings.Join(allowed, ", "))
+		}
+		if slices.ContainsFunc(sort, func(f SortField) bool { return f.Field == field.Field }) {
+			return nil, fmt.Errorf("%w: field %q given twice", ErrInvalidSort, field.Field)
+		}
+		sort = append(sort, field)
+	}
+	return sort, nil
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		name    string
+		sort    string
+		want    []SortField
+		wantErr error
+	}{
+		{"single field", "title", []SortField{{Field: "title"}}, nil},
+		{"descending and ascending", "-published_year, title", []SortField{{Field: "published_year", Desc: true}, {Field: "title"}}, nil},
+		{"unknown field", "isbn", nil, ErrInvalidSort},
+		{"repeated field", "title,-title", nil, ErrInvalidSort},
+		{"empty field", "title,", nil, ErrInvalidSort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSort(tt.sort, BookSortFields)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseSort() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// TransferFilter narrows a transfer list. BranchID matches transfers
+// leaving or arriving at the branch.
+type TransferFilter struct {
+	BranchID *uuid.UUID
+	Status   *string
+}
+
+type TransferRepository interface {
+	Create(ctx context.Context, transfer *entity.Transfer) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Transfer, error)
+	// GetOpenByCopy returns the copy's requested or in-transit transfer, if
+	// any.
+	GetOpenByCopy(ctx context.Context, copyID uuid.UUID) (*entity.Transfer, error)
+	List(ctx context.Context, page, limit int, filter TransferFilter) ([]*entity.Transfer, int, error)
+	Update(ctx context.Context, transfer *entity.Transfer) error
+}
@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// TxManager runs a unit of work that spans several repositories atomically.
+// Repository calls made with the context handed to fn take part in the same
+// transaction, which is committed when fn returns nil and rolled back otherwise.
+type TxManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
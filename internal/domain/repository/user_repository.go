@@ -8,11 +8,24 @@ import (
 	"github.com/google/uuid"
 )
 
+// UserFilter narrows a user list. Query matches users whose name or email
+// contains it, regardless of case. Sort uses UserSortFields and defaults to
+// the newest users first.
+type UserFilter struct {
+	Active *bool
+	Query  string
+	Sort   []SortField
+}
+
+// UserSortFields are the fields a user list can be sorted by.
+var UserSortFields = []string{"name", "email", "created_at"}
+
 type UserRepository interface {
 	Create(ctx context.Context, user *entity.User) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	GetByEmail(ctx context.Context, email string) (*entity.User, error)
-	List(ctx context.Context, page, limit int) ([]*entity.User, int, error)
+	GetByCardNumber(ctx context.Context, cardNumber string) (*entity.User, error)
+	List(ctx context.Context, page, limit int, filter UserFilter) ([]*entity.User, int, error)
 	Update(ctx context.Context, user *entity.User) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
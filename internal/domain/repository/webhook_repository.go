@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error
+	GetSubscription(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error)
+	// ListSubscriptions returns every subscription ordered by creation,
+	// oldest first.
+	ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error)
+	// ListSubscriptionsForEvent returns the active subscriptions that receive
+	// events of eventType.
+	ListSubscriptionsForEvent(ctx context.Context, eventType entity.EventType) ([]*entity.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error
+	// DeleteSubscription removes the subscription along with its deliveries.
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+
+	CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+	GetDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	// ListDeliveries returns a page of the subscription's deliveries, newest
+	// first, and how many it has in total.
+	ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, page, limit int) ([]*entity.WebhookDelivery, int, error)
+	// ClaimDueDeliveries returns up to limit pending deliveries whose next
+	// attempt is due at now, pushing that attempt back to leaseUntil so no
+	// other caller picks them up while they are being posted.
+	ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
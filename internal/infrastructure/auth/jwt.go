@@ -22,11 +22,12 @@ type JWTConfig struct {
 type Claims struct {
 	UserID uuid.UUID `json:"user_id"`
 	Email  string    `json:"email"`
+	Role   string    `json:"role"`
 	jwt.RegisteredClaims
 }
 
 type JWTService interface {
-	GenerateToken(userID uuid.UUID, email string) (string, time.Time, error)
+	GenerateToken(userID uuid.UUID, email, role string) (string, time.Time, error)
 	ValidateToken(tokenString string) (*Claims, error)
 }
 
@@ -40,12 +41,13 @@ func NewJWTService(config JWTConfig) JWTService {
 	}
 }
 
-func (s *jwtService) GenerateToken(userID uuid.UUID, email string) (string, time.Time, error) {
+func (s *jwtService) GenerateToken(userID uuid.UUID, email, role string) (string, time.Time, error) {
 	expiresAt := time.Now().Add(s.config.TokenDuration)
 
 	claims := &Claims{
 		UserID: userID,
 		Email:  email,
+		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
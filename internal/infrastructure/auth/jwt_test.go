@@ -20,7 +20,7 @@ func TestJWTService_GenerateToken(t *testing.T) {
 		userID := uuid.New()
 		email := "test@example.com"
 
-		token, expiresAt, err := service.GenerateToken(userID, email)
+		token, expiresAt, err := service.GenerateToken(userID, email, "member")
 		if err != nil {
 			t.Errorf("JWTService.GenerateToken() unexpected error = %v", err)
 			return
@@ -49,7 +49,7 @@ func TestJWTService_ValidateToken(t *testing.T) {
 		userID := uuid.New()
 		email := "test@example.com"
 
-		token, _, _ := service.GenerateToken(userID, email)
+		token, _, _ := service.GenerateToken(userID, email, "member")
 
 		claims, err := service.ValidateToken(token)
 		if err != nil {
@@ -63,6 +63,9 @@ func TestJWTService_ValidateToken(t *testing.T) {
 		if claims.Email != email {
 			t.Errorf("JWTService.ValidateToken() email = %v, want %v", claims.Email, email)
 		}
+		if claims.Role != "member" {
+			t.Errorf("JWTService.ValidateToken() role = %v, want %v", claims.Role, "member")
+		}
 	})
 
 	t.Run("validate invalid token", func(t *testing.T) {
@@ -82,7 +85,7 @@ func TestJWTService_ValidateToken(t *testing.T) {
 
 		userID := uuid.New()
 		email := "test@example.com"
-		token, _, _ := expiredService.GenerateToken(userID, email)
+		token, _, _ := expiredService.GenerateToken(userID, email, "member")
 
 		_, err := service.ValidateToken(token)
 		if err != ErrExpiredToken {
@@ -93,7 +96,7 @@ func TestJWTService_ValidateToken(t *testing.T) {
 	t.Run("validate token with wrong secret", func(t *testing.T) {
 		userID := uuid.New()
 		email := "test@example.com"
-		token, _, _ := service.GenerateToken(userID, email)
+		token, _, _ := service.GenerateToken(userID, email, "member")
 
 		wrongConfig := JWTConfig{
 			SecretKey:     "wrong-secret-key",
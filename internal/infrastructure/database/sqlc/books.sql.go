@@ -13,32 +13,49 @@ import (
 	"github.com/google/uuid"
 )
 
-const countAvailableBooks = `-- name: CountAvailableBooks :one
-SELECT COUNT(*) FROM books WHERE available_copies > 0
+const countSearchBooks = `-- name: CountSearchBooks :one
+SELECT COUNT(*) FROM books
+WHERE withdrawn_at IS NULL
+  AND books_search_vector(title, author, isbn) @@ websearch_to_tsquery('bookhub_portuguese', $1)
+  AND ($2::text IS NULL OR unaccent(author) ILIKE '%' || unaccent($2) || '%')
+  AND ($3::int IS NULL OR published_year >= $3)
+  AND ($4::int IS NULL OR published_year <= $4)
+  AND ($5::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM book_copies c
+    WHERE c.book_id = books.id
+      AND c.branch_id = $5
+      AND (c.status = 'available' OR (NOT $6::boolean AND c.status <> 'withdrawn'))
+  ))
+  AND (NOT $6::boolean OR $5::uuid IS NOT NULL OR available_copies > 0)
 `
 
-func (q *Queries) CountAvailableBooks(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countAvailableBooks)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+type CountSearchBooksParams struct {
+	Query         string         `json:"query"`
+	Author        sql.NullString `json:"author"`
+	PublishedFrom sql.NullInt32  `json:"published_from"`
+	PublishedTo   sql.NullInt32  `json:"published_to"`
+	BranchID      uuid.NullUUID  `json:"branch_id"`
+	AvailableOnly bool           `json:"available_only"`
 }
 
-const countBooks = `-- name: CountBooks :one
-SELECT COUNT(*) FROM books
-`
-
-func (q *Queries) CountBooks(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countBooks)
+func (q *Queries) CountSearchBooks(ctx context.Context, arg CountSearchBooksParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchBooks,
+		arg.Query,
+		arg.Author,
+		arg.PublishedFrom,
+		arg.PublishedTo,
+		arg.BranchID,
+		arg.AvailableOnly,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const createBook = `-- name: CreateBook :one
-INSERT INTO books (id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-RETURNING id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at
+INSERT INTO books (id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at, version, category)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at, version, category, withdrawn_at
 `
 
 type CreateBookParams struct {
@@ -51,6 +68,8 @@ type CreateBookParams struct {
 	AvailableCopies int32         `json:"available_copies"`
 	CreatedAt       time.Time     `json:"created_at"`
 	UpdatedAt       time.Time     `json:"updated_at"`
+	Version         int32         `json:"version"`
+	Category        string        `json:"category"`
 }
 
 func (q *Queries) CreateBook(ctx context.Context, arg CreateBookParams) (Book, error) {
@@ -64,6 +83,8 @@ func (q *Queries) CreateBook(ctx context.Context, arg CreateBookParams) (Book, e
 		arg.AvailableCopies,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.Version,
+		arg.Category,
 	)
 	var i Book
 	err := row.Scan(
@@ -76,6 +97,9 @@ func (q *Queries) CreateBook(ctx context.Context, arg CreateBookParams) (Book, e
 		&i.AvailableCopies,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.Category,
+		&i.WithdrawnAt,
 	)
 	return i, err
 }
@@ -90,7 +114,7 @@ func (q *Queries) DeleteBook(ctx context.Context, id uuid.UUID) error {
 }
 
 const getBookByID = `-- name: GetBookByID :one
-SELECT id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at FROM books WHERE id = $1
+SELECT id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at, version, category, withdrawn_at FROM books WHERE id = $1
 `
 
 func (q *Queries) GetBookByID(ctx context.Context, id uuid.UUID) (Book, error) {
@@ -106,12 +130,15 @@ func (q *Queries) GetBookByID(ctx context.Context, id uuid.UUID) (Book, error) {
 		&i.AvailableCopies,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.Category,
+		&i.WithdrawnAt,
 	)
 	return i, err
 }
 
 const getBookByISBN = `-- name: GetBookByISBN :one
-SELECT id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at FROM books WHERE isbn = $1
+SELECT id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at, version, category, withdrawn_at FROM books WHERE isbn = $1
 `
 
 func (q *Queries) GetBookByISBN(ctx context.Context, isbn string) (Book, error) {
@@ -127,41 +154,87 @@ func (q *Queries) GetBookByISBN(ctx context.Context, isbn string) (Book, error)
 		&i.AvailableCopies,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.Category,
+		&i.WithdrawnAt,
 	)
 	return i, err
 }
 
-const listAvailableBooks = `-- name: ListAvailableBooks :many
-SELECT id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at FROM books
-WHERE available_copies > 0
-ORDER BY title ASC
+const searchBooks = `-- name: SearchBooks :many
+SELECT books.id, books.title, books.author, books.isbn, books.published_year, books.total_copies, books.available_copies, books.created_at, books.updated_at, books.version, books.category, books.withdrawn_at,
+    ts_rank(books_search_vector(title, author, isbn), websearch_to_tsquery('bookhub_portuguese', $3))::float8 AS rank,
+    ts_headline('bookhub_portuguese', title, websearch_to_tsquery('bookhub_portuguese', $3), 'HighlightAll=true, StartSel=<mark>, StopSel=</mark>') AS title_highlight,
+    ts_headline('bookhub_portuguese', author, websearch_to_tsquery('bookhub_portuguese', $3), 'HighlightAll=true, StartSel=<mark>, StopSel=</mark>') AS author_highlight
+FROM books
+WHERE withdrawn_at IS NULL
+  AND books_search_vector(title, author, isbn) @@ websearch_to_tsquery('bookhub_portuguese', $3)
+  AND ($4::text IS NULL OR unaccent(author) ILIKE '%' || unaccent($4) || '%')
+  AND ($5::int IS NULL OR published_year >= $5)
+  AND ($6::int IS NULL OR published_year <= $6)
+  AND ($7::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM book_copies c
+    WHERE c.book_id = books.id
+      AND c.branch_id = $7
+      AND (c.status = 'available' OR (NOT $8::boolean AND c.status <> 'withdrawn'))
+  ))
+  AND (NOT $8::boolean OR $7::uuid IS NOT NULL OR available_copies > 0)
+ORDER BY rank DESC, title ASC
 LIMIT $1 OFFSET $2
 `
 
-type ListAvailableBooksParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+type SearchBooksParams struct {
+	Limit         int32          `json:"limit"`
+	Offset        int32          `json:"offset"`
+	Query         string         `json:"query"`
+	Author        sql.NullString `json:"author"`
+	PublishedFrom sql.NullInt32  `json:"published_from"`
+	PublishedTo   sql.NullInt32  `json:"published_to"`
+	BranchID      uuid.NullUUID  `json:"branch_id"`
+	AvailableOnly bool           `json:"available_only"`
 }
 
-func (q *Queries) ListAvailableBooks(ctx context.Context, arg ListAvailableBooksParams) ([]Book, error) {
-	rows, err := q.db.QueryContext(ctx, listAvailableBooks, arg.Limit, arg.Offset)
+type SearchBooksRow struct {
+	Book            Book    `json:"book"`
+	Rank            float64 `json:"rank"`
+	TitleHighlight  string  `json:"title_highlight"`
+	AuthorHighlight string  `json:"author_highlight"`
+}
+
+func (q *Queries) SearchBooks(ctx context.Context, arg SearchBooksParams) ([]SearchBooksRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchBooks,
+		arg.Limit,
+		arg.Offset,
+		arg.Query,
+		arg.Author,
+		arg.PublishedFrom,
+		arg.PublishedTo,
+		arg.BranchID,
+		arg.AvailableOnly,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Book{}
+	items := []SearchBooksRow{}
 	for rows.Next() {
-		var i Book
+		var i SearchBooksRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.Title,
-			&i.Author,
-			&i.Isbn,
-			&i.PublishedYear,
-			&i.TotalCopies,
-			&i.AvailableCopies,
-			&i.CreatedAt,
-			&i.UpdatedAt,
+			&i.Book.ID,
+			&i.Book.Title,
+			&i.Book.Author,
+			&i.Book.Isbn,
+			&i.Book.PublishedYear,
+			&i.Book.TotalCopies,
+			&i.Book.AvailableCopies,
+			&i.Book.CreatedAt,
+			&i.Book.UpdatedAt,
+			&i.Book.Version,
+			&i.Book.Category,
+			&i.Book.WithdrawnAt,
+			&i.Rank,
+			&i.TitleHighlight,
+			&i.AuthorHighlight,
 		); err != nil {
 			return nil, err
 		}
@@ -176,40 +249,68 @@ func (q *Queries) ListAvailableBooks(ctx context.Context, arg ListAvailableBooks
 	return items, nil
 }
 
-const listBooks = `-- name: ListBooks :many
-SELECT id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at FROM books
-ORDER BY title ASC
-LIMIT $1 OFFSET $2
+const suggestBookAuthors = `-- name: SuggestBookAuthors :many
+SELECT author FROM books
+WHERE withdrawn_at IS NULL AND books_fold(author) LIKE books_fold($1) || '%' ESCAPE '\'
+GROUP BY author
+ORDER BY books_fold(author), author
+LIMIT $2
 `
 
-type ListBooksParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+type SuggestBookAuthorsParams struct {
+	Prefix string `json:"prefix"`
+	Limit  int32  `json:"limit"`
 }
 
-func (q *Queries) ListBooks(ctx context.Context, arg ListBooksParams) ([]Book, error) {
-	rows, err := q.db.QueryContext(ctx, listBooks, arg.Limit, arg.Offset)
+func (q *Queries) SuggestBookAuthors(ctx context.Context, arg SuggestBookAuthorsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, suggestBookAuthors, arg.Prefix, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Book{}
+	items := []string{}
 	for rows.Next() {
-		var i Book
-		if err := rows.Scan(
-			&i.ID,
-			&i.Title,
-			&i.Author,
-			&i.Isbn,
-			&i.PublishedYear,
-			&i.TotalCopies,
-			&i.AvailableCopies,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
+		var author string
+		if err := rows.Scan(&author); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, author)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const suggestBookTitles = `-- name: SuggestBookTitles :many
+SELECT title FROM books
+WHERE withdrawn_at IS NULL AND books_fold(title) LIKE books_fold($1) || '%' ESCAPE '\'
+GROUP BY title
+ORDER BY books_fold(title), title
+LIMIT $2
+`
+
+type SuggestBookTitlesParams struct {
+	Prefix string `json:"prefix"`
+	Limit  int32  `json:"limit"`
+}
+
+func (q *Queries) SuggestBookTitles(ctx context.Context, arg SuggestBookTitlesParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, suggestBookTitles, arg.Prefix, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var title string
+		if err := rows.Scan(&title); err != nil {
+			return nil, err
+		}
+		items = append(items, title)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -223,9 +324,10 @@ func (q *Queries) ListBooks(ctx context.Context, arg ListBooksParams) ([]Book, e
 const updateBook = `-- name: UpdateBook :one
 UPDATE books
 SET title = $2, author = $3, isbn = $4, published_year = $5,
-    total_copies = $6, available_copies = $7, updated_at = $8
-WHERE id = $1
-RETURNING id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at
+    total_copies = $6, available_copies = $7, updated_at = $8,
+    category = $10, withdrawn_at = $11, version = version + 1
+WHERE id = $1 AND version = $9
+RETURNING id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at, version, category, withdrawn_at
 `
 
 type UpdateBookParams struct {
@@ -237,6 +339,9 @@ type UpdateBookParams struct {
 	TotalCopies     int32         `json:"total_copies"`
 	AvailableCopies int32         `json:"available_copies"`
 	UpdatedAt       time.Time     `json:"updated_at"`
+	Version         int32         `json:"version"`
+	Category        string        `json:"category"`
+	WithdrawnAt     sql.NullTime  `json:"withdrawn_at"`
 }
 
 func (q *Queries) UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, error) {
@@ -249,6 +354,9 @@ func (q *Queries) UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, e
 		arg.TotalCopies,
 		arg.AvailableCopies,
 		arg.UpdatedAt,
+		arg.Version,
+		arg.Category,
+		arg.WithdrawnAt,
 	)
 	var i Book
 	err := row.Scan(
@@ -261,6 +369,9 @@ func (q *Queries) UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, e
 		&i.AvailableCopies,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.Category,
+		&i.WithdrawnAt,
 	)
 	return i, err
 }
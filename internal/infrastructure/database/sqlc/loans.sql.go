@@ -13,69 +13,57 @@ import (
 	"github.com/google/uuid"
 )
 
-const countLoans = `-- name: CountLoans :one
+const countActiveLoansByBook = `-- name: CountActiveLoansByBook :one
 SELECT COUNT(*) FROM loans
+WHERE book_id = $1 AND status IN ('active', 'overdue')
 `
 
-func (q *Queries) CountLoans(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countLoans)
+func (q *Queries) CountActiveLoansByBook(ctx context.Context, bookID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveLoansByBook, bookID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
-const countLoansByStatus = `-- name: CountLoansByStatus :one
-SELECT COUNT(*) FROM loans WHERE status = $1
+const countActiveLoansByUser = `-- name: CountActiveLoansByUser :one
+SELECT COUNT(*) FROM loans
+WHERE user_id = $1 AND status IN ('active', 'overdue')
 `
 
-func (q *Queries) CountLoansByStatus(ctx context.Context, status string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countLoansByStatus, status)
+func (q *Queries) CountActiveLoansByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveLoansByUser, userID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
-const countLoansByUser = `-- name: CountLoansByUser :one
-SELECT COUNT(*) FROM loans WHERE user_id = $1
+const countLoansByBook = `-- name: CountLoansByBook :one
+SELECT COUNT(*) FROM loans WHERE book_id = $1
 `
 
-func (q *Queries) CountLoansByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countLoansByUser, userID)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
-}
-
-const countLoansByUserAndStatus = `-- name: CountLoansByUserAndStatus :one
-SELECT COUNT(*) FROM loans WHERE user_id = $1 AND status = $2
-`
-
-type CountLoansByUserAndStatusParams struct {
-	UserID uuid.UUID `json:"user_id"`
-	Status string    `json:"status"`
-}
-
-func (q *Queries) CountLoansByUserAndStatus(ctx context.Context, arg CountLoansByUserAndStatusParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countLoansByUserAndStatus, arg.UserID, arg.Status)
+func (q *Queries) CountLoansByBook(ctx context.Context, bookID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countLoansByBook, bookID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const createLoan = `-- name: CreateLoan :one
-INSERT INTO loans (id, user_id, book_id, borrowed_at, due_date, returned_at, status)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, user_id, book_id, borrowed_at, due_date, returned_at, status
+INSERT INTO loans (id, user_id, book_id, borrowed_at, due_date, returned_at, status, renewal_count, copy_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, user_id, book_id, borrowed_at, due_date, returned_at, status, renewal_count, copy_id
 `
 
 type CreateLoanParams struct {
-	ID         uuid.UUID    `json:"id"`
-	UserID     uuid.UUID    `json:"user_id"`
-	BookID     uuid.UUID    `json:"book_id"`
-	BorrowedAt time.Time    `json:"borrowed_at"`
-	DueDate    time.Time    `json:"due_date"`
-	ReturnedAt sql.NullTime `json:"returned_at"`
-	Status     string       `json:"status"`
+	ID           uuid.UUID     `json:"id"`
+	UserID       uuid.UUID     `json:"user_id"`
+	BookID       uuid.UUID     `json:"book_id"`
+	BorrowedAt   time.Time     `json:"borrowed_at"`
+	DueDate      time.Time     `json:"due_date"`
+	ReturnedAt   sql.NullTime  `json:"returned_at"`
+	Status       string        `json:"status"`
+	RenewalCount int32         `json:"renewal_count"`
+	CopyID       uuid.NullUUID `json:"copy_id"`
 }
 
 func (q *Queries) CreateLoan(ctx context.Context, arg CreateLoanParams) (Loan, error) {
@@ -87,6 +75,8 @@ func (q *Queries) CreateLoan(ctx context.Context, arg CreateLoanParams) (Loan, e
 		arg.DueDate,
 		arg.ReturnedAt,
 		arg.Status,
+		arg.RenewalCount,
+		arg.CopyID,
 	)
 	var i Loan
 	err := row.Scan(
@@ -97,13 +87,15 @@ func (q *Queries) CreateLoan(ctx context.Context, arg CreateLoanParams) (Loan, e
 		&i.DueDate,
 		&i.ReturnedAt,
 		&i.Status,
+		&i.RenewalCount,
+		&i.CopyID,
 	)
 	return i, err
 }
 
 const getActiveByUserAndBook = `-- name: GetActiveByUserAndBook :one
-SELECT id, user_id, book_id, borrowed_at, due_date, returned_at, status FROM loans
-WHERE user_id = $1 AND book_id = $2 AND status = 'active'
+SELECT id, user_id, book_id, borrowed_at, due_date, returned_at, status, renewal_count, copy_id FROM loans
+WHERE user_id = $1 AND book_id = $2 AND status IN ('active', 'overdue')
 `
 
 type GetActiveByUserAndBookParams struct {
@@ -122,12 +114,36 @@ func (q *Queries) GetActiveByUserAndBook(ctx context.Context, arg GetActiveByUse
 		&i.DueDate,
 		&i.ReturnedAt,
 		&i.Status,
+		&i.RenewalCount,
+		&i.CopyID,
+	)
+	return i, err
+}
+
+const getActiveLoanByCopy = `-- name: GetActiveLoanByCopy :one
+SELECT id, user_id, book_id, borrowed_at, due_date, returned_at, status, renewal_count, copy_id FROM loans
+WHERE copy_id = $1 AND status IN ('active', 'overdue')
+`
+
+func (q *Queries) GetActiveLoanByCopy(ctx context.Context, copyID uuid.NullUUID) (Loan, error) {
+	row := q.db.QueryRowContext(ctx, getActiveLoanByCopy, copyID)
+	var i Loan
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.BookID,
+		&i.BorrowedAt,
+		&i.DueDate,
+		&i.ReturnedAt,
+		&i.Status,
+		&i.RenewalCount,
+		&i.CopyID,
 	)
 	return i, err
 }
 
 const getLoanByID = `-- name: GetLoanByID :one
-SELECT id, user_id, book_id, borrowed_at, due_date, returned_at, status FROM loans WHERE id = $1
+SELECT id, user_id, book_id, borrowed_at, due_date, returned_at, status, renewal_count, copy_id FROM loans WHERE id = $1
 `
 
 func (q *Queries) GetLoanByID(ctx context.Context, id uuid.UUID) (Loan, error) {
@@ -141,13 +157,15 @@ func (q *Queries) GetLoanByID(ctx context.Context, id uuid.UUID) (Loan, error) {
 		&i.DueDate,
 		&i.ReturnedAt,
 		&i.Status,
+		&i.RenewalCount,
+		&i.CopyID,
 	)
 	return i, err
 }
 
 const getLoanByIDWithDetails = `-- name: GetLoanByIDWithDetails :one
 SELECT
-    l.id, l.user_id, l.book_id, l.borrowed_at, l.due_date, l.returned_at, l.status,
+    l.id, l.user_id, l.book_id, l.borrowed_at, l.due_date, l.returned_at, l.status, l.renewal_count, l.copy_id,
     u.name as user_name,
     b.title as book_title
 FROM loans l
@@ -157,15 +175,17 @@ WHERE l.id = $1
 `
 
 type GetLoanByIDWithDetailsRow struct {
-	ID         uuid.UUID    `json:"id"`
-	UserID     uuid.UUID    `json:"user_id"`
-	BookID     uuid.UUID    `json:"book_id"`
-	BorrowedAt time.Time    `json:"borrowed_at"`
-	DueDate    time.Time    `json:"due_date"`
-	ReturnedAt sql.NullTime `json:"returned_at"`
-	Status     string       `json:"status"`
-	UserName   string       `json:"user_name"`
-	BookTitle  string       `json:"book_title"`
+	ID           uuid.UUID     `json:"id"`
+	UserID       uuid.UUID     `json:"user_id"`
+	BookID       uuid.UUID     `json:"book_id"`
+	BorrowedAt   time.Time     `json:"borrowed_at"`
+	DueDate      time.Time     `json:"due_date"`
+	ReturnedAt   sql.NullTime  `json:"returned_at"`
+	Status       string        `json:"status"`
+	RenewalCount int32         `json:"renewal_count"`
+	CopyID       uuid.NullUUID `json:"copy_id"`
+	UserName     string        `json:"user_name"`
+	BookTitle    string        `json:"book_title"`
 }
 
 func (q *Queries) GetLoanByIDWithDetails(ctx context.Context, id uuid.UUID) (GetLoanByIDWithDetailsRow, error) {
@@ -179,177 +199,40 @@ func (q *Queries) GetLoanByIDWithDetails(ctx context.Context, id uuid.UUID) (Get
 		&i.DueDate,
 		&i.ReturnedAt,
 		&i.Status,
+		&i.RenewalCount,
+		&i.CopyID,
 		&i.UserName,
 		&i.BookTitle,
 	)
 	return i, err
 }
 
-const listLoans = `-- name: ListLoans :many
-SELECT id, user_id, book_id, borrowed_at, due_date, returned_at, status FROM loans
-ORDER BY borrowed_at DESC
-LIMIT $1 OFFSET $2
-`
-
-type ListLoansParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
-}
-
-func (q *Queries) ListLoans(ctx context.Context, arg ListLoansParams) ([]Loan, error) {
-	rows, err := q.db.QueryContext(ctx, listLoans, arg.Limit, arg.Offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Loan{}
-	for rows.Next() {
-		var i Loan
-		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.BookID,
-			&i.BorrowedAt,
-			&i.DueDate,
-			&i.ReturnedAt,
-			&i.Status,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const listLoansByStatus = `-- name: ListLoansByStatus :many
-SELECT id, user_id, book_id, borrowed_at, due_date, returned_at, status FROM loans
-WHERE status = $1
-ORDER BY borrowed_at DESC
-LIMIT $2 OFFSET $3
-`
-
-type ListLoansByStatusParams struct {
-	Status string `json:"status"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
-}
-
-func (q *Queries) ListLoansByStatus(ctx context.Context, arg ListLoansByStatusParams) ([]Loan, error) {
-	rows, err := q.db.QueryContext(ctx, listLoansByStatus, arg.Status, arg.Limit, arg.Offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Loan{}
-	for rows.Next() {
-		var i Loan
-		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.BookID,
-			&i.BorrowedAt,
-			&i.DueDate,
-			&i.ReturnedAt,
-			&i.Status,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const listLoansByStatusWithDetails = `-- name: ListLoansByStatusWithDetails :many
-SELECT
-    l.id, l.user_id, l.book_id, l.borrowed_at, l.due_date, l.returned_at, l.status,
-    u.name as user_name,
-    b.title as book_title
-FROM loans l
-JOIN users u ON l.user_id = u.id
-JOIN books b ON l.book_id = b.id
-WHERE l.status = $1
-ORDER BY l.borrowed_at DESC
-LIMIT $2 OFFSET $3
+const listActiveLoansDue = `-- name: ListActiveLoansDue :many
+SELECT l.id, l.user_id, l.book_id, l.borrowed_at, l.due_date, l.returned_at, l.status, l.renewal_count, l.copy_id FROM loans l
+LEFT JOIN book_copies c ON c.id = l.copy_id
+WHERE l.status IN ('active', 'overdue')
+  AND l.due_date >= $1
+  AND l.due_date < $2
+  AND ($3::uuid IS NULL OR l.book_id = $3)
+  AND ($4::uuid IS NULL OR c.branch_id = $4)
+ORDER BY l.due_date ASC
+FOR UPDATE OF l
 `
 
-type ListLoansByStatusWithDetailsParams struct {
-	Status string `json:"status"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
-}
-
-type ListLoansByStatusWithDetailsRow struct {
-	ID         uuid.UUID    `json:"id"`
-	UserID     uuid.UUID    `json:"user_id"`
-	BookID     uuid.UUID    `json:"book_id"`
-	BorrowedAt time.Time    `json:"borrowed_at"`
-	DueDate    time.Time    `json:"due_date"`
-	ReturnedAt sql.NullTime `json:"returned_at"`
-	Status     string       `json:"status"`
-	UserName   string       `json:"user_name"`
-	BookTitle  string       `json:"book_title"`
+type ListActiveLoansDueParams struct {
+	DueFrom   time.Time     `json:"due_from"`
+	DueBefore time.Time     `json:"due_before"`
+	BookID    uuid.NullUUID `json:"book_id"`
+	BranchID  uuid.NullUUID `json:"branch_id"`
 }
 
-func (q *Queries) ListLoansByStatusWithDetails(ctx context.Context, arg ListLoansByStatusWithDetailsParams) ([]ListLoansByStatusWithDetailsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listLoansByStatusWithDetails, arg.Status, arg.Limit, arg.Offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ListLoansByStatusWithDetailsRow{}
-	for rows.Next() {
-		var i ListLoansByStatusWithDetailsRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.BookID,
-			&i.BorrowedAt,
-			&i.DueDate,
-			&i.ReturnedAt,
-			&i.Status,
-			&i.UserName,
-			&i.BookTitle,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const listLoansByUser = `-- name: ListLoansByUser :many
-SELECT id, user_id, book_id, borrowed_at, due_date, returned_at, status FROM loans
-WHERE user_id = $1
-ORDER BY borrowed_at DESC
-LIMIT $2 OFFSET $3
-`
-
-type ListLoansByUserParams struct {
-	UserID uuid.UUID `json:"user_id"`
-	Limit  int32     `json:"limit"`
-	Offset int32     `json:"offset"`
-}
-
-func (q *Queries) ListLoansByUser(ctx context.Context, arg ListLoansByUserParams) ([]Loan, error) {
-	rows, err := q.db.QueryContext(ctx, listLoansByUser, arg.UserID, arg.Limit, arg.Offset)
+func (q *Queries) ListActiveLoansDue(ctx context.Context, arg ListActiveLoansDueParams) ([]Loan, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveLoansDue,
+		arg.DueFrom,
+		arg.DueBefore,
+		arg.BookID,
+		arg.BranchID,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -365,6 +248,8 @@ func (q *Queries) ListLoansByUser(ctx context.Context, arg ListLoansByUserParams
 			&i.DueDate,
 			&i.ReturnedAt,
 			&i.Status,
+			&i.RenewalCount,
+			&i.CopyID,
 		); err != nil {
 			return nil, err
 		}
@@ -379,27 +264,15 @@ func (q *Queries) ListLoansByUser(ctx context.Context, arg ListLoansByUserParams
 	return items, nil
 }
 
-const listLoansByUserAndStatus = `-- name: ListLoansByUserAndStatus :many
-SELECT id, user_id, book_id, borrowed_at, due_date, returned_at, status FROM loans
-WHERE user_id = $1 AND status = $2
-ORDER BY borrowed_at DESC
-LIMIT $3 OFFSET $4
+const markOverdueLoans = `-- name: MarkOverdueLoans :many
+UPDATE loans
+SET status = 'overdue'
+WHERE status = 'active' AND due_date < $1
+RETURNING id, user_id, book_id, borrowed_at, due_date, returned_at, status, renewal_count, copy_id
 `
 
-type ListLoansByUserAndStatusParams struct {
-	UserID uuid.UUID `json:"user_id"`
-	Status string    `json:"status"`
-	Limit  int32     `json:"limit"`
-	Offset int32     `json:"offset"`
-}
-
-func (q *Queries) ListLoansByUserAndStatus(ctx context.Context, arg ListLoansByUserAndStatusParams) ([]Loan, error) {
-	rows, err := q.db.QueryContext(ctx, listLoansByUserAndStatus,
-		arg.UserID,
-		arg.Status,
-		arg.Limit,
-		arg.Offset,
-	)
+func (q *Queries) MarkOverdueLoans(ctx context.Context, dueDate time.Time) ([]Loan, error) {
+	rows, err := q.db.QueryContext(ctx, markOverdueLoans, dueDate)
 	if err != nil {
 		return nil, err
 	}
@@ -415,202 +288,8 @@ func (q *Queries) ListLoansByUserAndStatus(ctx context.Context, arg ListLoansByU
 			&i.DueDate,
 			&i.ReturnedAt,
 			&i.Status,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const listLoansByUserAndStatusWithDetails = `-- name: ListLoansByUserAndStatusWithDetails :many
-SELECT
-    l.id, l.user_id, l.book_id, l.borrowed_at, l.due_date, l.returned_at, l.status,
-    u.name as user_name,
-    b.title as book_title
-FROM loans l
-JOIN users u ON l.user_id = u.id
-JOIN books b ON l.book_id = b.id
-WHERE l.user_id = $1 AND l.status = $2
-ORDER BY l.borrowed_at DESC
-LIMIT $3 OFFSET $4
-`
-
-type ListLoansByUserAndStatusWithDetailsParams struct {
-	UserID uuid.UUID `json:"user_id"`
-	Status string    `json:"status"`
-	Limit  int32     `json:"limit"`
-	Offset int32     `json:"offset"`
-}
-
-type ListLoansByUserAndStatusWithDetailsRow struct {
-	ID         uuid.UUID    `json:"id"`
-	UserID     uuid.UUID    `json:"user_id"`
-	BookID     uuid.UUID    `json:"book_id"`
-	BorrowedAt time.Time    `json:"borrowed_at"`
-	DueDate    time.Time    `json:"due_date"`
-	ReturnedAt sql.NullTime `json:"returned_at"`
-	Status     string       `json:"status"`
-	UserName   string       `json:"user_name"`
-	BookTitle  string       `json:"book_title"`
-}
-
-func (q *Queries) ListLoansByUserAndStatusWithDetails(ctx context.Context, arg ListLoansByUserAndStatusWithDetailsParams) ([]ListLoansByUserAndStatusWithDetailsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listLoansByUserAndStatusWithDetails,
-		arg.UserID,
-		arg.Status,
-		arg.Limit,
-		arg.Offset,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ListLoansByUserAndStatusWithDetailsRow{}
-	for rows.Next() {
-		var i ListLoansByUserAndStatusWithDetailsRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.BookID,
-			&i.BorrowedAt,
-			&i.DueDate,
-			&i.ReturnedAt,
-			&i.Status,
-			&i.UserName,
-			&i.BookTitle,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const listLoansByUserWithDetails = `-- name: ListLoansByUserWithDetails :many
-SELECT
-    l.id, l.user_id, l.book_id, l.borrowed_at, l.due_date, l.returned_at, l.status,
-    u.name as user_name,
-    b.title as book_title
-FROM loans l
-JOIN users u ON l.user_id = u.id
-JOIN books b ON l.book_id = b.id
-WHERE l.user_id = $1
-ORDER BY l.borrowed_at DESC
-LIMIT $2 OFFSET $3
-`
-
-type ListLoansByUserWithDetailsParams struct {
-	UserID uuid.UUID `json:"user_id"`
-	Limit  int32     `json:"limit"`
-	Offset int32     `json:"offset"`
-}
-
-type ListLoansByUserWithDetailsRow struct {
-	ID         uuid.UUID    `json:"id"`
-	UserID     uuid.UUID    `json:"user_id"`
-	BookID     uuid.UUID    `json:"book_id"`
-	BorrowedAt time.Time    `json:"borrowed_at"`
-	DueDate    time.Time    `json:"due_date"`
-	ReturnedAt sql.NullTime `json:"returned_at"`
-	Status     string       `json:"status"`
-	UserName   string       `json:"user_name"`
-	BookTitle  string       `json:"book_title"`
-}
-
-func (q *Queries) ListLoansByUserWithDetails(ctx context.Context, arg ListLoansByUserWithDetailsParams) ([]ListLoansByUserWithDetailsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listLoansByUserWithDetails, arg.UserID, arg.Limit, arg.Offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ListLoansByUserWithDetailsRow{}
-	for rows.Next() {
-		var i ListLoansByUserWithDetailsRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.BookID,
-			&i.BorrowedAt,
-			&i.DueDate,
-			&i.ReturnedAt,
-			&i.Status,
-			&i.UserName,
-			&i.BookTitle,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const listLoansWithDetails = `-- name: ListLoansWithDetails :many
-SELECT
-    l.id, l.user_id, l.book_id, l.borrowed_at, l.due_date, l.returned_at, l.status,
-    u.name as user_name,
-    b.title as book_title
-FROM loans l
-JOIN users u ON l.user_id = u.id
-JOIN books b ON l.book_id = b.id
-ORDER BY l.borrowed_at DESC
-LIMIT $1 OFFSET $2
-`
-
-type ListLoansWithDetailsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
-}
-
-type ListLoansWithDetailsRow struct {
-	ID         uuid.UUID    `json:"id"`
-	UserID     uuid.UUID    `json:"user_id"`
-	BookID     uuid.UUID    `json:"book_id"`
-	BorrowedAt time.Time    `json:"borrowed_at"`
-	DueDate    time.Time    `json:"due_date"`
-	ReturnedAt sql.NullTime `json:"returned_at"`
-	Status     string       `json:"status"`
-	UserName   string       `json:"user_name"`
-	BookTitle  string       `json:"book_title"`
-}
-
-func (q *Queries) ListLoansWithDetails(ctx context.Context, arg ListLoansWithDetailsParams) ([]ListLoansWithDetailsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listLoansWithDetails, arg.Limit, arg.Offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ListLoansWithDetailsRow{}
-	for rows.Next() {
-		var i ListLoansWithDetailsRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.BookID,
-			&i.BorrowedAt,
-			&i.DueDate,
-			&i.ReturnedAt,
-			&i.Status,
-			&i.UserName,
-			&i.BookTitle,
+			&i.RenewalCount,
+			&i.CopyID,
 		); err != nil {
 			return nil, err
 		}
@@ -627,19 +306,27 @@ func (q *Queries) ListLoansWithDetails(ctx context.Context, arg ListLoansWithDet
 
 const updateLoan = `-- name: UpdateLoan :one
 UPDATE loans
-SET returned_at = $2, status = $3
+SET returned_at = $2, status = $3, due_date = $4, renewal_count = $5
 WHERE id = $1
-RETURNING id, user_id, book_id, borrowed_at, due_date, returned_at, status
+RETURNING id, user_id, book_id, borrowed_at, due_date, returned_at, status, renewal_count, copy_id
 `
 
 type UpdateLoanParams struct {
-	ID         uuid.UUID    `json:"id"`
-	ReturnedAt sql.NullTime `json:"returned_at"`
-	Status     string       `json:"status"`
+	ID           uuid.UUID    `json:"id"`
+	ReturnedAt   sql.NullTime `json:"returned_at"`
+	Status       string       `json:"status"`
+	DueDate      time.Time    `json:"due_date"`
+	RenewalCount int32        `json:"renewal_count"`
 }
 
 func (q *Queries) UpdateLoan(ctx context.Context, arg UpdateLoanParams) (Loan, error) {
-	row := q.db.QueryRowContext(ctx, updateLoan, arg.ID, arg.ReturnedAt, arg.Status)
+	row := q.db.QueryRowContext(ctx, updateLoan,
+		arg.ID,
+		arg.ReturnedAt,
+		arg.Status,
+		arg.DueDate,
+		arg.RenewalCount,
+	)
 	var i Loan
 	err := row.Scan(
 		&i.ID,
@@ -649,6 +336,8 @@ func (q *Queries) UpdateLoan(ctx context.Context, arg UpdateLoanParams) (Loan, e
 		&i.DueDate,
 		&i.ReturnedAt,
 		&i.Status,
+		&i.RenewalCount,
+		&i.CopyID,
 	)
 	return i, err
 }
@@ -6,11 +6,26 @@ package sqlc
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type AuditLog struct {
+	ID         uuid.UUID       `json:"id"`
+	Sequence   int64           `json:"sequence"`
+	ActorID    uuid.NullUUID   `json:"actor_id"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityID   uuid.UUID       `json:"entity_id"`
+	Changes    json.RawMessage `json:"changes"`
+	RequestID  string          `json:"request_id"`
+	CreatedAt  time.Time       `json:"created_at"`
+	PrevHash   string          `json:"prev_hash"`
+	Hash       string          `json:"hash"`
+}
+
 type Book struct {
 	ID              uuid.UUID     `json:"id"`
 	Title           string        `json:"title"`
@@ -21,16 +36,150 @@ type Book struct {
 	AvailableCopies int32         `json:"available_copies"`
 	CreatedAt       time.Time     `json:"created_at"`
 	UpdatedAt       time.Time     `json:"updated_at"`
+	Version         int32         `json:"version"`
+	Category        string        `json:"category"`
+	WithdrawnAt     sql.NullTime  `json:"withdrawn_at"`
+}
+
+type BookCopy struct {
+	ID        uuid.UUID `json:"id"`
+	BookID    uuid.UUID `json:"book_id"`
+	Barcode   string    `json:"barcode"`
+	Location  string    `json:"location"`
+	Condition string    `json:"condition"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	BranchID  uuid.UUID `json:"branch_id"`
+}
+
+type Branch struct {
+	ID        uuid.UUID `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Address   string    `json:"address"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ClosedDate struct {
+	ID        uuid.UUID `json:"id"`
+	BranchID  uuid.UUID `json:"branch_id"`
+	Date      time.Time `json:"date"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type DueDateAdjustment struct {
+	ID            uuid.UUID     `json:"id"`
+	DueFrom       time.Time     `json:"due_from"`
+	DueTo         time.Time     `json:"due_to"`
+	BranchID      uuid.NullUUID `json:"branch_id"`
+	BookID        uuid.NullUUID `json:"book_id"`
+	ShiftDays     int32         `json:"shift_days"`
+	NewDueDate    sql.NullTime  `json:"new_due_date"`
+	Reason        string        `json:"reason"`
+	LoansAdjusted int32         `json:"loans_adjusted"`
+	AdjustedBy    uuid.UUID     `json:"adjusted_by"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+type Fine struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	LoanID      uuid.UUID `json:"loan_id"`
+	Reason      string    `json:"reason"`
+	AmountCents int64     `json:"amount_cents"`
+	PaidCents   int64     `json:"paid_cents"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type Hold struct {
+	ID             uuid.UUID     `json:"id"`
+	UserID         uuid.UUID     `json:"user_id"`
+	BookID         uuid.UUID     `json:"book_id"`
+	Status         string        `json:"status"`
+	CreatedAt      time.Time     `json:"created_at"`
+	ReadyAt        sql.NullTime  `json:"ready_at"`
+	PickupDeadline sql.NullTime  `json:"pickup_deadline"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+	CopyID         uuid.NullUUID `json:"copy_id"`
 }
 
 type Loan struct {
-	ID         uuid.UUID    `json:"id"`
-	UserID     uuid.UUID    `json:"user_id"`
-	BookID     uuid.UUID    `json:"book_id"`
-	BorrowedAt time.Time    `json:"borrowed_at"`
-	DueDate    time.Time    `json:"due_date"`
-	ReturnedAt sql.NullTime `json:"returned_at"`
-	Status     string       `json:"status"`
+	ID           uuid.UUID     `json:"id"`
+	UserID       uuid.UUID     `json:"user_id"`
+	BookID       uuid.UUID     `json:"book_id"`
+	BorrowedAt   time.Time     `json:"borrowed_at"`
+	DueDate      time.Time     `json:"due_date"`
+	ReturnedAt   sql.NullTime  `json:"returned_at"`
+	Status       string        `json:"status"`
+	RenewalCount int32         `json:"renewal_count"`
+	CopyID       uuid.NullUUID `json:"copy_id"`
+}
+
+type LoanEscalation struct {
+	ID          uuid.UUID `json:"id"`
+	LoanID      uuid.UUID `json:"loan_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Level       int32     `json:"level"`
+	Action      string    `json:"action"`
+	DaysOverdue int32     `json:"days_overdue"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type LoanNotice struct {
+	ID      uuid.UUID `json:"id"`
+	LoanID  uuid.UUID `json:"loan_id"`
+	Kind    string    `json:"kind"`
+	DueDate time.Time `json:"due_date"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+type LoanPolicy struct {
+	ID             uuid.UUID `json:"id"`
+	PatronCategory string    `json:"patron_category"`
+	ItemCategory   string    `json:"item_category"`
+	MaxLoans       int32     `json:"max_loans"`
+	LoanDays       int32     `json:"loan_days"`
+	MaxRenewals    int32     `json:"max_renewals"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type OpeningHour struct {
+	BranchID uuid.UUID `json:"branch_id"`
+	Weekday  int16     `json:"weekday"`
+	OpensAt  int16     `json:"opens_at"`
+	ClosesAt int16     `json:"closes_at"`
+}
+
+type OutboxMessage struct {
+	ID          uuid.UUID       `json:"id"`
+	Position    int64           `json:"position"`
+	EventType   string          `json:"event_type"`
+	AggregateID uuid.UUID       `json:"aggregate_id"`
+	Payload     json.RawMessage `json:"payload"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Attempts    int32           `json:"attempts"`
+	LastError   string          `json:"last_error"`
+	LockedUntil sql.NullTime    `json:"locked_until"`
+	PublishedAt sql.NullTime    `json:"published_at"`
+}
+
+type Transfer struct {
+	ID           uuid.UUID    `json:"id"`
+	CopyID       uuid.UUID    `json:"copy_id"`
+	BookID       uuid.UUID    `json:"book_id"`
+	FromBranchID uuid.UUID    `json:"from_branch_id"`
+	ToBranchID   uuid.UUID    `json:"to_branch_id"`
+	Status       string       `json:"status"`
+	RequestedAt  time.Time    `json:"requested_at"`
+	ShippedAt    sql.NullTime `json:"shipped_at"`
+	ReceivedAt   sql.NullTime `json:"received_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
 }
 
 type User struct {
@@ -41,4 +190,34 @@ type User struct {
 	Active       bool      `json:"active"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
+	Role         string    `json:"role"`
+	Category     string    `json:"category"`
+	CardNumber   string    `json:"card_number"`
+	Blocked      bool      `json:"blocked"`
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID       `json:"id"`
+	SubscriptionID uuid.UUID       `json:"subscription_id"`
+	EventID        uuid.UUID       `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int32           `json:"attempts"`
+	NextAttemptAt  sql.NullTime    `json:"next_attempt_at"`
+	ResponseStatus sql.NullInt32   `json:"response_status"`
+	LastError      string          `json:"last_error"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	DeliveredAt    sql.NullTime    `json:"delivered_at"`
+}
+
+type WebhookSubscription struct {
+	ID        uuid.UUID `json:"id"`
+	Url       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"secret"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
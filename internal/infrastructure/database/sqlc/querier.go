@@ -6,44 +6,123 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
-	CountAvailableBooks(ctx context.Context) (int64, error)
-	CountBooks(ctx context.Context) (int64, error)
-	CountLoans(ctx context.Context) (int64, error)
-	CountLoansByStatus(ctx context.Context, status string) (int64, error)
-	CountLoansByUser(ctx context.Context, userID uuid.UUID) (int64, error)
-	CountLoansByUserAndStatus(ctx context.Context, arg CountLoansByUserAndStatusParams) (int64, error)
-	CountUsers(ctx context.Context) (int64, error)
+	AppendOutboxMessage(ctx context.Context, arg AppendOutboxMessageParams) (int64, error)
+	ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	// Picks the aggregates whose oldest unpublished message is free and locks
+	// all of their unpublished messages. Later messages are never picked while
+	// an earlier one is unpublished, so each aggregate has one relay at a time.
+	ClaimOutboxMessages(ctx context.Context, arg ClaimOutboxMessagesParams) ([]OutboxMessage, error)
+	ClaimLoanEscalation(ctx context.Context, arg ClaimLoanEscalationParams) (int64, error)
+	ClaimLoanNotice(ctx context.Context, arg ClaimLoanNoticeParams) (int64, error)
+	CountActiveLoansByBook(ctx context.Context, bookID uuid.UUID) (int64, error)
+	CountActiveLoansByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+	CountAuditEntries(ctx context.Context, arg CountAuditEntriesParams) (int64, error)
+	CountBookCopiesByBranch(ctx context.Context, branchID uuid.UUID) (int64, error)
+	CountSearchBooks(ctx context.Context, arg CountSearchBooksParams) (int64, error)
+	CountFines(ctx context.Context, arg CountFinesParams) (int64, error)
+	CountHolds(ctx context.Context, arg CountHoldsParams) (int64, error)
+	CountHoldsAhead(ctx context.Context, arg CountHoldsAheadParams) (int64, error)
+	CountLoansByBook(ctx context.Context, bookID uuid.UUID) (int64, error)
+	CountTransfers(ctx context.Context, arg CountTransfersParams) (int64, error)
+	CountWebhookDeliveries(ctx context.Context, subscriptionID uuid.UUID) (int64, error)
+	CreateAuditEntry(ctx context.Context, arg CreateAuditEntryParams) error
 	CreateBook(ctx context.Context, arg CreateBookParams) (Book, error)
+	CreateBookCopy(ctx context.Context, arg CreateBookCopyParams) (BookCopy, error)
+	CreateBranch(ctx context.Context, arg CreateBranchParams) (Branch, error)
+	CreateClosedDate(ctx context.Context, arg CreateClosedDateParams) (ClosedDate, error)
+	CreateDueDateAdjustment(ctx context.Context, arg CreateDueDateAdjustmentParams) (DueDateAdjustment, error)
+	CreateFine(ctx context.Context, arg CreateFineParams) (Fine, error)
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error)
 	CreateLoan(ctx context.Context, arg CreateLoanParams) (Loan, error)
+	CreateLoanPolicy(ctx context.Context, arg CreateLoanPolicyParams) (LoanPolicy, error)
+	CreateOpeningHours(ctx context.Context, arg CreateOpeningHoursParams) error
+	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error)
 	DeleteBook(ctx context.Context, id uuid.UUID) error
+	DeleteBookCopy(ctx context.Context, id uuid.UUID) error
+	DeleteBranch(ctx context.Context, id uuid.UUID) error
+	DeleteClosedDate(ctx context.Context, id uuid.UUID) error
+	DeleteLoanNotice(ctx context.Context, id uuid.UUID) error
+	DeleteLoanPolicy(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteOpeningHours(ctx context.Context, branchID uuid.UUID) error
+	DeletePublishedOutboxMessages(ctx context.Context, publishedAt sql.NullTime) (int64, error)
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error
+	FindBookCopyByStatus(ctx context.Context, arg FindBookCopyByStatusParams) (BookCopy, error)
 	GetActiveByUserAndBook(ctx context.Context, arg GetActiveByUserAndBookParams) (Loan, error)
+	GetActiveHoldByUserAndBook(ctx context.Context, arg GetActiveHoldByUserAndBookParams) (Hold, error)
+	GetActiveLoanByCopy(ctx context.Context, copyID uuid.NullUUID) (Loan, error)
 	GetBookByID(ctx context.Context, id uuid.UUID) (Book, error)
 	GetBookByISBN(ctx context.Context, isbn string) (Book, error)
+	GetBookCopyByBarcode(ctx context.Context, barcode string) (BookCopy, error)
+	GetBookCopyByID(ctx context.Context, id uuid.UUID) (BookCopy, error)
+	GetBranchByCode(ctx context.Context, code string) (Branch, error)
+	GetBranchByID(ctx context.Context, id uuid.UUID) (Branch, error)
+	GetClosedDateByDay(ctx context.Context, arg GetClosedDateByDayParams) (ClosedDate, error)
+	GetClosedDateByID(ctx context.Context, id uuid.UUID) (ClosedDate, error)
+	GetFineByID(ctx context.Context, id uuid.UUID) (Fine, error)
+	GetHoldByID(ctx context.Context, id uuid.UUID) (Hold, error)
+	GetLastAuditEntry(ctx context.Context) (AuditLog, error)
 	GetLoanByID(ctx context.Context, id uuid.UUID) (Loan, error)
 	GetLoanByIDWithDetails(ctx context.Context, id uuid.UUID) (GetLoanByIDWithDetailsRow, error)
+	GetLoanPolicyByCategories(ctx context.Context, arg GetLoanPolicyByCategoriesParams) (LoanPolicy, error)
+	GetLoanPolicyByID(ctx context.Context, id uuid.UUID) (LoanPolicy, error)
+	GetNextWaitingHold(ctx context.Context, bookID uuid.UUID) (Hold, error)
+	GetOpenTransferByCopy(ctx context.Context, copyID uuid.UUID) (Transfer, error)
+	GetTransferByID(ctx context.Context, id uuid.UUID) (Transfer, error)
+	GetUserByCardNumber(ctx context.Context, cardNumber string) (User, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
-	ListAvailableBooks(ctx context.Context, arg ListAvailableBooksParams) ([]Book, error)
-	ListBooks(ctx context.Context, arg ListBooksParams) ([]Book, error)
-	ListLoans(ctx context.Context, arg ListLoansParams) ([]Loan, error)
-	ListLoansByStatus(ctx context.Context, arg ListLoansByStatusParams) ([]Loan, error)
-	ListLoansByStatusWithDetails(ctx context.Context, arg ListLoansByStatusWithDetailsParams) ([]ListLoansByStatusWithDetailsRow, error)
-	ListLoansByUser(ctx context.Context, arg ListLoansByUserParams) ([]Loan, error)
-	ListLoansByUserAndStatus(ctx context.Context, arg ListLoansByUserAndStatusParams) ([]Loan, error)
-	ListLoansByUserAndStatusWithDetails(ctx context.Context, arg ListLoansByUserAndStatusWithDetailsParams) ([]ListLoansByUserAndStatusWithDetailsRow, error)
-	ListLoansByUserWithDetails(ctx context.Context, arg ListLoansByUserWithDetailsParams) ([]ListLoansByUserWithDetailsRow, error)
-	ListLoansWithDetails(ctx context.Context, arg ListLoansWithDetailsParams) ([]ListLoansWithDetailsRow, error)
-	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	GetWebhookDeliveryByID(ctx context.Context, id uuid.UUID) (WebhookDelivery, error)
+	GetWebhookSubscriptionByID(ctx context.Context, id uuid.UUID) (WebhookSubscription, error)
+	HasPendingHolds(ctx context.Context, arg HasPendingHoldsParams) (bool, error)
+	ListActiveLoansDue(ctx context.Context, arg ListActiveLoansDueParams) ([]Loan, error)
+	ListAuditChain(ctx context.Context, arg ListAuditChainParams) ([]AuditLog, error)
+	ListAuditEntries(ctx context.Context, arg ListAuditEntriesParams) ([]AuditLog, error)
+	ListBookCopiesByBook(ctx context.Context, bookID uuid.UUID) ([]BookCopy, error)
+	ListBranchAvailability(ctx context.Context, bookIds []uuid.UUID) ([]ListBranchAvailabilityRow, error)
+	ListBranches(ctx context.Context) ([]Branch, error)
+	ListClosedDates(ctx context.Context, arg ListClosedDatesParams) ([]ClosedDate, error)
+	ListExpiredPickups(ctx context.Context, pickupDeadline sql.NullTime) ([]Hold, error)
+	ListFines(ctx context.Context, arg ListFinesParams) ([]Fine, error)
+	ListHolds(ctx context.Context, arg ListHoldsParams) ([]Hold, error)
+	ListLoanEscalationsByLoan(ctx context.Context, loanID uuid.UUID) ([]LoanEscalation, error)
+	ListLoanPolicies(ctx context.Context) ([]LoanPolicy, error)
+	ListMatchingLoanPolicies(ctx context.Context, arg ListMatchingLoanPoliciesParams) ([]LoanPolicy, error)
+	ListOpeningHours(ctx context.Context, branchID uuid.UUID) ([]OpeningHour, error)
+	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error)
+	ListWebhookSubscriptionsForEvent(ctx context.Context, eventType string) ([]WebhookSubscription, error)
+	// Held until the transaction ends, so appends take turns at the head of
+	// the chain. Only other appends wait on it.
+	LockAuditLog(ctx context.Context) error
+	MarkOverdueLoans(ctx context.Context, dueDate time.Time) ([]Loan, error)
+	SearchBooks(ctx context.Context, arg SearchBooksParams) ([]SearchBooksRow, error)
+	SuggestBookAuthors(ctx context.Context, arg SuggestBookAuthorsParams) ([]string, error)
+	SuggestBookTitles(ctx context.Context, arg SuggestBookTitlesParams) ([]string, error)
+	SumOutstandingFines(ctx context.Context, userID uuid.UUID) (int64, error)
 	UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, error)
+	UpdateBookCopy(ctx context.Context, arg UpdateBookCopyParams) (BookCopy, error)
+	UpdateBranch(ctx context.Context, arg UpdateBranchParams) (Branch, error)
+	UpdateFine(ctx context.Context, arg UpdateFineParams) (Fine, error)
+	UpdateHold(ctx context.Context, arg UpdateHoldParams) (Hold, error)
 	UpdateLoan(ctx context.Context, arg UpdateLoanParams) (Loan, error)
+	UpdateLoanPolicy(ctx context.Context, arg UpdateLoanPolicyParams) (LoanPolicy, error)
+	UpdateOutboxMessage(ctx context.Context, arg UpdateOutboxMessageParams) error
+	UpdateTransfer(ctx context.Context, arg UpdateTransferParams) (Transfer, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateWebhookDelivery(ctx context.Context, arg UpdateWebhookDeliveryParams) error
+	UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) (WebhookSubscription, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,37 @@
+-- name: LockAuditLog :exec
+-- Held until the transaction ends, so appends take turns at the head of
+-- the chain. Only other appends wait on it.
+SELECT pg_advisory_xact_lock(hashtext('audit_log'));
+
+-- name: GetLastAuditEntry :one
+SELECT * FROM audit_log ORDER BY sequence DESC LIMIT 1;
+
+-- name: CreateAuditEntry :exec
+INSERT INTO audit_log (id, sequence, actor_id, action, entity_type, entity_id, changes, request_id, created_at, prev_hash, hash)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: ListAuditEntries :many
+SELECT * FROM audit_log
+WHERE (sqlc.narg('actor_id')::uuid IS NULL OR actor_id = sqlc.narg('actor_id'))
+  AND (sqlc.narg('action')::varchar IS NULL OR action = sqlc.narg('action'))
+  AND (sqlc.narg('entity_type')::varchar IS NULL OR entity_type = sqlc.narg('entity_type'))
+  AND (sqlc.narg('entity_id')::uuid IS NULL OR entity_id = sqlc.narg('entity_id'))
+  AND (sqlc.narg('from')::timestamptz IS NULL OR created_at >= sqlc.narg('from'))
+  AND (sqlc.narg('to')::timestamptz IS NULL OR created_at <= sqlc.narg('to'))
+ORDER BY sequence DESC
+LIMIT $1 OFFSET $2;
+
+-- name: CountAuditEntries :one
+SELECT COUNT(*) FROM audit_log
+WHERE (sqlc.narg('actor_id')::uuid IS NULL OR actor_id = sqlc.narg('actor_id'))
+  AND (sqlc.narg('action')::varchar IS NULL OR action = sqlc.narg('action'))
+  AND (sqlc.narg('entity_type')::varchar IS NULL OR entity_type = sqlc.narg('entity_type'))
+  AND (sqlc.narg('entity_id')::uuid IS NULL OR entity_id = sqlc.narg('entity_id'))
+  AND (sqlc.narg('from')::timestamptz IS NULL OR created_at >= sqlc.narg('from'))
+  AND (sqlc.narg('to')::timestamptz IS NULL OR created_at <= sqlc.narg('to'));
+
+-- name: ListAuditChain :many
+SELECT * FROM audit_log
+WHERE sequence > $1
+ORDER BY sequence
+LIMIT $2;
@@ -0,0 +1,42 @@
+-- name: CreateBookCopy :one
+INSERT INTO book_copies (id, book_id, branch_id, barcode, location, condition, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetBookCopyByID :one
+SELECT * FROM book_copies WHERE id = $1;
+
+-- name: GetBookCopyByBarcode :one
+SELECT * FROM book_copies WHERE barcode = $1;
+
+-- name: ListBookCopiesByBook :many
+SELECT * FROM book_copies
+WHERE book_id = $1
+ORDER BY barcode;
+
+-- name: FindBookCopyByStatus :one
+SELECT * FROM book_copies
+WHERE book_id = $1 AND status = $2
+ORDER BY barcode
+LIMIT 1;
+
+-- name: CountBookCopiesByBranch :one
+SELECT COUNT(*) FROM book_copies WHERE branch_id = $1;
+
+-- name: ListBranchAvailability :many
+SELECT book_id, branch_id,
+       COUNT(*) AS total_copies,
+       COUNT(*) FILTER (WHERE status = 'available') AS available_copies
+FROM book_copies
+WHERE book_id = ANY(@book_ids::uuid[]) AND status <> 'withdrawn'
+GROUP BY book_id, branch_id
+ORDER BY book_id, branch_id;
+
+-- name: UpdateBookCopy :one
+UPDATE book_copies
+SET branch_id = $2, location = $3, condition = $4, status = $5, updated_at = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteBookCopy :exec
+DELETE FROM book_copies WHERE id = $1;
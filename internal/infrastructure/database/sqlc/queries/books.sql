@@ -1,6 +1,6 @@
 -- name: CreateBook :one
-INSERT INTO books (id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+INSERT INTO books (id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at, version, category)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 RETURNING *;
 
 -- name: GetBookByID :one
@@ -9,28 +9,62 @@ SELECT * FROM books WHERE id = $1;
 -- name: GetBookByISBN :one
 SELECT * FROM books WHERE isbn = $1;
 
--- name: ListBooks :many
-SELECT * FROM books
-ORDER BY title ASC
+-- name: SearchBooks :many
+SELECT sqlc.embed(books),
+    ts_rank(books_search_vector(title, author, isbn), websearch_to_tsquery('bookhub_portuguese', sqlc.arg('query')))::float8 AS rank,
+    ts_headline('bookhub_portuguese', title, websearch_to_tsquery('bookhub_portuguese', sqlc.arg('query')), 'HighlightAll=true, StartSel=<mark>, StopSel=</mark>') AS title_highlight,
+    ts_headline('bookhub_portuguese', author, websearch_to_tsquery('bookhub_portuguese', sqlc.arg('query')), 'HighlightAll=true, StartSel=<mark>, StopSel=</mark>') AS author_highlight
+FROM books
+WHERE withdrawn_at IS NULL
+  AND books_search_vector(title, author, isbn) @@ websearch_to_tsquery('bookhub_portuguese', sqlc.arg('query'))
+  AND (sqlc.narg('author')::text IS NULL OR unaccent(author) ILIKE '%' || unaccent(sqlc.narg('author')) || '%')
+  AND (sqlc.narg('published_from')::int IS NULL OR published_year >= sqlc.narg('published_from'))
+  AND (sqlc.narg('published_to')::int IS NULL OR published_year <= sqlc.narg('published_to'))
+  AND (sqlc.narg('branch_id')::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM book_copies c
+    WHERE c.book_id = books.id
+      AND c.branch_id = sqlc.narg('branch_id')
+      AND (c.status = 'available' OR (NOT sqlc.arg('available_only')::boolean AND c.status <> 'withdrawn'))
+  ))
+  AND (NOT sqlc.arg('available_only')::boolean OR sqlc.narg('branch_id')::uuid IS NOT NULL OR available_copies > 0)
+ORDER BY rank DESC, title ASC
 LIMIT $1 OFFSET $2;
 
--- name: ListAvailableBooks :many
-SELECT * FROM books
-WHERE available_copies > 0
-ORDER BY title ASC
-LIMIT $1 OFFSET $2;
+-- name: CountSearchBooks :one
+SELECT COUNT(*) FROM books
+WHERE withdrawn_at IS NULL
+  AND books_search_vector(title, author, isbn) @@ websearch_to_tsquery('bookhub_portuguese', sqlc.arg('query'))
+  AND (sqlc.narg('author')::text IS NULL OR unaccent(author) ILIKE '%' || unaccent(sqlc.narg('author')) || '%')
+  AND (sqlc.narg('published_from')::int IS NULL OR published_year >= sqlc.narg('published_from'))
+  AND (sqlc.narg('published_to')::int IS NULL OR published_year <= sqlc.narg('published_to'))
+  AND (sqlc.narg('branch_id')::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM book_copies c
+    WHERE c.book_id = books.id
+      AND c.branch_id = sqlc.narg('branch_id')
+      AND (c.status = 'available' OR (NOT sqlc.arg('available_only')::boolean AND c.status <> 'withdrawn'))
+  ))
+  AND (NOT sqlc.arg('available_only')::boolean OR sqlc.narg('branch_id')::uuid IS NOT NULL OR available_copies > 0);
 
--- name: CountBooks :one
-SELECT COUNT(*) FROM books;
+-- name: SuggestBookTitles :many
+SELECT title FROM books
+WHERE withdrawn_at IS NULL AND books_fold(title) LIKE books_fold(sqlc.arg('prefix')) || '%' ESCAPE '\'
+GROUP BY title
+ORDER BY books_fold(title), title
+LIMIT sqlc.arg('limit');
 
--- name: CountAvailableBooks :one
-SELECT COUNT(*) FROM books WHERE available_copies > 0;
+-- name: SuggestBookAuthors :many
+SELECT author FROM books
+WHERE withdrawn_at IS NULL AND books_fold(author) LIKE books_fold(sqlc.arg('prefix')) || '%' ESCAPE '\'
+GROUP BY author
+ORDER BY books_fold(author), author
+LIMIT sqlc.arg('limit');
 
 -- name: UpdateBook :one
 UPDATE books
 SET title = $2, author = $3, isbn = $4, published_year = $5,
-    total_copies = $6, available_copies = $7, updated_at = $8
-WHERE id = $1
+    total_copies = $6, available_copies = $7, updated_at = $8,
+    category = $10, withdrawn_at = $11, version = version + 1
+WHERE id = $1 AND version = $9
 RETURNING *;
 
 -- name: DeleteBook :exec
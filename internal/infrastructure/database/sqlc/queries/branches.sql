@@ -0,0 +1,23 @@
+-- name: CreateBranch :one
+INSERT INTO branches (id, code, name, address, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetBranchByID :one
+SELECT * FROM branches WHERE id = $1;
+
+-- name: GetBranchByCode :one
+SELECT * FROM branches WHERE code = $1;
+
+-- name: ListBranches :many
+SELECT * FROM branches
+ORDER BY code ASC;
+
+-- name: UpdateBranch :one
+UPDATE branches
+SET name = $2, address = $3, updated_at = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteBranch :exec
+DELETE FROM branches WHERE id = $1;
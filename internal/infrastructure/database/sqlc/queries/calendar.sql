@@ -0,0 +1,32 @@
+-- name: ListOpeningHours :many
+SELECT * FROM opening_hours
+WHERE branch_id = $1
+ORDER BY weekday ASC;
+
+-- name: CreateOpeningHours :exec
+INSERT INTO opening_hours (branch_id, weekday, opens_at, closes_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: DeleteOpeningHours :exec
+DELETE FROM opening_hours WHERE branch_id = $1;
+
+-- name: CreateClosedDate :one
+INSERT INTO closed_dates (id, branch_id, date, reason, created_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetClosedDateByID :one
+SELECT * FROM closed_dates WHERE id = $1;
+
+-- name: GetClosedDateByDay :one
+SELECT * FROM closed_dates WHERE branch_id = $1 AND date = $2;
+
+-- name: ListClosedDates :many
+SELECT * FROM closed_dates
+WHERE branch_id = $1
+  AND date >= $2
+  AND (sqlc.narg('until')::date IS NULL OR date <= sqlc.narg('until'))
+ORDER BY date ASC;
+
+-- name: DeleteClosedDate :exec
+DELETE FROM closed_dates WHERE id = $1;
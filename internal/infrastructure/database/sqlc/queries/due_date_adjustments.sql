@@ -0,0 +1,7 @@
+-- name: CreateDueDateAdjustment :one
+INSERT INTO due_date_adjustments (
+    id, due_from, due_to, branch_id, book_id, shift_days, new_due_date,
+    reason, loans_adjusted, adjusted_by, created_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
@@ -0,0 +1,29 @@
+-- name: CreateFine :one
+INSERT INTO fines (id, user_id, loan_id, reason, amount_cents, paid_cents, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetFineByID :one
+SELECT * FROM fines WHERE id = $1;
+
+-- name: ListFines :many
+SELECT * FROM fines
+WHERE (sqlc.narg('user_id')::uuid IS NULL OR user_id = sqlc.narg('user_id'))
+  AND (sqlc.narg('status')::varchar IS NULL OR status = sqlc.narg('status'))
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: CountFines :one
+SELECT COUNT(*) FROM fines
+WHERE (sqlc.narg('user_id')::uuid IS NULL OR user_id = sqlc.narg('user_id'))
+  AND (sqlc.narg('status')::varchar IS NULL OR status = sqlc.narg('status'));
+
+-- name: SumOutstandingFines :one
+SELECT COALESCE(SUM(amount_cents - paid_cents), 0)::bigint FROM fines
+WHERE user_id = $1 AND status = 'open';
+
+-- name: UpdateFine :one
+UPDATE fines
+SET paid_cents = $2, status = $3, updated_at = $4
+WHERE id = $1
+RETURNING *;
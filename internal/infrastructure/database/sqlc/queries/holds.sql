@@ -0,0 +1,52 @@
+-- name: CreateHold :one
+INSERT INTO holds (id, user_id, book_id, status, created_at, ready_at, pickup_deadline, updated_at, copy_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetHoldByID :one
+SELECT * FROM holds WHERE id = $1;
+
+-- name: GetActiveHoldByUserAndBook :one
+SELECT * FROM holds
+WHERE user_id = $1 AND book_id = $2 AND status IN ('waiting', 'ready');
+
+-- name: GetNextWaitingHold :one
+SELECT * FROM holds
+WHERE book_id = $1 AND status = 'waiting'
+ORDER BY created_at, id
+LIMIT 1;
+
+-- name: HasPendingHolds :one
+SELECT EXISTS (
+    SELECT 1 FROM holds
+    WHERE book_id = $1 AND user_id <> $2 AND status IN ('waiting', 'ready')
+);
+
+-- name: CountHoldsAhead :one
+SELECT COUNT(*) FROM holds
+WHERE book_id = $1 AND status = 'waiting' AND (created_at, id) < (@created_at::timestamptz, @id::uuid);
+
+-- name: ListHolds :many
+SELECT * FROM holds
+WHERE (sqlc.narg('user_id')::uuid IS NULL OR user_id = sqlc.narg('user_id'))
+  AND (sqlc.narg('book_id')::uuid IS NULL OR book_id = sqlc.narg('book_id'))
+  AND (sqlc.narg('status')::varchar IS NULL OR status = sqlc.narg('status'))
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: CountHolds :one
+SELECT COUNT(*) FROM holds
+WHERE (sqlc.narg('user_id')::uuid IS NULL OR user_id = sqlc.narg('user_id'))
+  AND (sqlc.narg('book_id')::uuid IS NULL OR book_id = sqlc.narg('book_id'))
+  AND (sqlc.narg('status')::varchar IS NULL OR status = sqlc.narg('status'));
+
+-- name: ListExpiredPickups :many
+SELECT * FROM holds
+WHERE status = 'ready' AND pickup_deadline < $1
+ORDER BY pickup_deadline;
+
+-- name: UpdateHold :one
+UPDATE holds
+SET status = $2, ready_at = $3, pickup_deadline = $4, updated_at = $5, copy_id = $6
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,9 @@
+-- name: ClaimLoanEscalation :execrows
+INSERT INTO loan_escalations (id, loan_id, user_id, level, action, days_overdue, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (loan_id, level) DO NOTHING;
+
+-- name: ListLoanEscalationsByLoan :many
+SELECT * FROM loan_escalations
+WHERE loan_id = $1
+ORDER BY level ASC;
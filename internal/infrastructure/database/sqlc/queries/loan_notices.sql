@@ -0,0 +1,7 @@
+-- name: ClaimLoanNotice :execrows
+INSERT INTO loan_notices (id, loan_id, kind, due_date, sent_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (loan_id, kind, due_date) DO NOTHING;
+
+-- name: DeleteLoanNotice :exec
+DELETE FROM loan_notices WHERE id = $1;
@@ -0,0 +1,29 @@
+-- name: CreateLoanPolicy :one
+INSERT INTO loan_policies (id, patron_category, item_category, max_loans, loan_days, max_renewals, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetLoanPolicyByID :one
+SELECT * FROM loan_policies WHERE id = $1;
+
+-- name: GetLoanPolicyByCategories :one
+SELECT * FROM loan_policies
+WHERE patron_category = $1 AND item_category = $2;
+
+-- name: ListLoanPolicies :many
+SELECT * FROM loan_policies
+ORDER BY patron_category ASC, item_category ASC;
+
+-- name: ListMatchingLoanPolicies :many
+SELECT * FROM loan_policies
+WHERE patron_category IN (sqlc.arg('patron_category')::varchar, '*')
+  AND item_category IN (sqlc.arg('item_category')::varchar, '*');
+
+-- name: UpdateLoanPolicy :one
+UPDATE loan_policies
+SET max_loans = $2, loan_days = $3, max_renewals = $4, updated_at = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteLoanPolicy :execrows
+DELETE FROM loan_policies WHERE id = $1;
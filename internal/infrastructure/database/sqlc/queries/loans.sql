@@ -1,6 +1,6 @@
 -- name: CreateLoan :one
-INSERT INTO loans (id, user_id, book_id, borrowed_at, due_date, returned_at, status)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO loans (id, user_id, book_id, borrowed_at, due_date, returned_at, status, renewal_count, copy_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING *;
 
 -- name: GetLoanByID :one
@@ -18,92 +18,42 @@ WHERE l.id = $1;
 
 -- name: GetActiveByUserAndBook :one
 SELECT * FROM loans
-WHERE user_id = $1 AND book_id = $2 AND status = 'active';
+WHERE user_id = $1 AND book_id = $2 AND status IN ('active', 'overdue');
 
--- name: ListLoans :many
+-- name: GetActiveLoanByCopy :one
 SELECT * FROM loans
-ORDER BY borrowed_at DESC
-LIMIT $1 OFFSET $2;
-
--- name: ListLoansWithDetails :many
-SELECT
-    l.*,
-    u.name as user_name,
-    b.title as book_title
-FROM loans l
-JOIN users u ON l.user_id = u.id
-JOIN books b ON l.book_id = b.id
-ORDER BY l.borrowed_at DESC
-LIMIT $1 OFFSET $2;
-
--- name: ListLoansByUser :many
-SELECT * FROM loans
-WHERE user_id = $1
-ORDER BY borrowed_at DESC
-LIMIT $2 OFFSET $3;
-
--- name: ListLoansByUserWithDetails :many
-SELECT
-    l.*,
-    u.name as user_name,
-    b.title as book_title
-FROM loans l
-JOIN users u ON l.user_id = u.id
-JOIN books b ON l.book_id = b.id
-WHERE l.user_id = $1
-ORDER BY l.borrowed_at DESC
-LIMIT $2 OFFSET $3;
-
--- name: ListLoansByStatus :many
-SELECT * FROM loans
-WHERE status = $1
-ORDER BY borrowed_at DESC
-LIMIT $2 OFFSET $3;
-
--- name: ListLoansByStatusWithDetails :many
-SELECT
-    l.*,
-    u.name as user_name,
-    b.title as book_title
-FROM loans l
-JOIN users u ON l.user_id = u.id
-JOIN books b ON l.book_id = b.id
-WHERE l.status = $1
-ORDER BY l.borrowed_at DESC
-LIMIT $2 OFFSET $3;
-
--- name: ListLoansByUserAndStatus :many
-SELECT * FROM loans
-WHERE user_id = $1 AND status = $2
-ORDER BY borrowed_at DESC
-LIMIT $3 OFFSET $4;
-
--- name: ListLoansByUserAndStatusWithDetails :many
-SELECT
-    l.*,
-    u.name as user_name,
-    b.title as book_title
-FROM loans l
-JOIN users u ON l.user_id = u.id
-JOIN books b ON l.book_id = b.id
-WHERE l.user_id = $1 AND l.status = $2
-ORDER BY l.borrowed_at DESC
-LIMIT $3 OFFSET $4;
-
--- name: CountLoans :one
-SELECT COUNT(*) FROM loans;
-
--- name: CountLoansByUser :one
-SELECT COUNT(*) FROM loans WHERE user_id = $1;
-
--- name: CountLoansByStatus :one
-SELECT COUNT(*) FROM loans WHERE status = $1;
-
--- name: CountLoansByUserAndStatus :one
-SELECT COUNT(*) FROM loans WHERE user_id = $1 AND status = $2;
+WHERE copy_id = $1 AND status IN ('active', 'overdue');
+
+-- name: CountActiveLoansByUser :one
+SELECT COUNT(*) FROM loans
+WHERE user_id = $1 AND status IN ('active', 'overdue');
+
+-- name: CountActiveLoansByBook :one
+SELECT COUNT(*) FROM loans
+WHERE book_id = $1 AND status IN ('active', 'overdue');
+
+-- name: CountLoansByBook :one
+SELECT COUNT(*) FROM loans WHERE book_id = $1;
+
+-- name: ListActiveLoansDue :many
+SELECT l.* FROM loans l
+LEFT JOIN book_copies c ON c.id = l.copy_id
+WHERE l.status IN ('active', 'overdue')
+  AND l.due_date >= sqlc.arg('due_from')
+  AND l.due_date < sqlc.arg('due_before')
+  AND (sqlc.narg('book_id')::uuid IS NULL OR l.book_id = sqlc.narg('book_id'))
+  AND (sqlc.narg('branch_id')::uuid IS NULL OR c.branch_id = sqlc.narg('branch_id'))
+ORDER BY l.due_date ASC
+FOR UPDATE OF l;
 
 -- name: UpdateLoan :one
 UPDATE loans
-SET returned_at = $2, status = $3
+SET returned_at = $2, status = $3, due_date = $4, renewal_count = $5
 WHERE id = $1
 RETURNING *;
+
+-- name: MarkOverdueLoans :many
+UPDATE loans
+SET status = 'overdue'
+WHERE status = 'active' AND due_date < $1
+RETURNING *;
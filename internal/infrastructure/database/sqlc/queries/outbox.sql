@@ -0,0 +1,34 @@
+-- name: AppendOutboxMessage :one
+INSERT INTO outbox_messages (id, event_type, aggregate_id, payload, occurred_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING position;
+
+-- name: ClaimOutboxMessages :many
+-- Picks the aggregates whose oldest unpublished message is free and locks
+-- all of their unpublished messages. Later messages are never picked while
+-- an earlier one is unpublished, so each aggregate has one relay at a time.
+UPDATE outbox_messages
+SET locked_until = sqlc.arg('lease_until')
+WHERE published_at IS NULL AND aggregate_id IN (
+    SELECT head.aggregate_id FROM outbox_messages head
+    WHERE head.published_at IS NULL
+      AND (head.locked_until IS NULL OR head.locked_until <= sqlc.arg('now'))
+      AND NOT EXISTS (
+          SELECT 1 FROM outbox_messages earlier
+          WHERE earlier.aggregate_id = head.aggregate_id
+            AND earlier.published_at IS NULL
+            AND earlier.position < head.position
+      )
+    ORDER BY head.position
+    LIMIT sqlc.arg('batch_size')
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: UpdateOutboxMessage :exec
+UPDATE outbox_messages
+SET attempts = $2, last_error = $3, locked_until = $4, published_at = $5
+WHERE id = $1;
+
+-- name: DeletePublishedOutboxMessages :execrows
+DELETE FROM outbox_messages WHERE published_at < $1;
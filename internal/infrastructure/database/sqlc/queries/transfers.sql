@@ -0,0 +1,30 @@
+-- name: CreateTransfer :one
+INSERT INTO transfers (id, copy_id, book_id, from_branch_id, to_branch_id, status, requested_at, shipped_at, received_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING *;
+
+-- name: GetTransferByID :one
+SELECT * FROM transfers WHERE id = $1;
+
+-- name: GetOpenTransferByCopy :one
+SELECT * FROM transfers
+WHERE copy_id = $1 AND status IN ('requested', 'in_transit')
+LIMIT 1;
+
+-- name: ListTransfers :many
+SELECT * FROM transfers
+WHERE (sqlc.narg('branch_id')::uuid IS NULL OR from_branch_id = sqlc.narg('branch_id') OR to_branch_id = sqlc.narg('branch_id'))
+  AND (sqlc.narg('status')::varchar IS NULL OR status = sqlc.narg('status'))
+ORDER BY requested_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: CountTransfers :one
+SELECT COUNT(*) FROM transfers
+WHERE (sqlc.narg('branch_id')::uuid IS NULL OR from_branch_id = sqlc.narg('branch_id') OR to_branch_id = sqlc.narg('branch_id'))
+  AND (sqlc.narg('status')::varchar IS NULL OR status = sqlc.narg('status'));
+
+-- name: UpdateTransfer :one
+UPDATE transfers
+SET status = $2, shipped_at = $3, received_at = $4, updated_at = $5
+WHERE id = $1
+RETURNING *;
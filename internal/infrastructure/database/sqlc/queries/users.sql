@@ -1,6 +1,6 @@
 -- name: CreateUser :one
-INSERT INTO users (id, name, email, password_hash, active, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO users (id, name, email, password_hash, active, created_at, updated_at, role, category, card_number, blocked)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 RETURNING *;
 
 -- name: GetUserByID :one
@@ -9,17 +9,13 @@ SELECT * FROM users WHERE id = $1;
 -- name: GetUserByEmail :one
 SELECT * FROM users WHERE email = $1;
 
--- name: ListUsers :many
-SELECT * FROM users
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
-
--- name: CountUsers :one
-SELECT COUNT(*) FROM users;
+-- name: GetUserByCardNumber :one
+SELECT * FROM users WHERE card_number = $1;
 
 -- name: UpdateUser :one
 UPDATE users
-SET name = $2, email = $3, active = $4, updated_at = $5
+SET name = $2, email = $3, active = $4, updated_at = $5, role = $6,
+    password_hash = $7, category = $8, card_number = $9, blocked = $10
 WHERE id = $1
 RETURNING *;
 
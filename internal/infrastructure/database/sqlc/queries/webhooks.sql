@@ -0,0 +1,63 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (id, url, events, secret, active, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetWebhookSubscriptionByID :one
+SELECT * FROM webhook_subscriptions WHERE id = $1;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+ORDER BY created_at, id;
+
+-- name: ListWebhookSubscriptionsForEvent :many
+SELECT * FROM webhook_subscriptions
+WHERE active AND sqlc.arg('event_type')::text = ANY(events)
+ORDER BY created_at, id;
+
+-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET url = $2, events = $3, secret = $4, active = $5, updated_at = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions WHERE id = $1;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    id, subscription_id, event_id, event_type, payload, status, attempts,
+    next_attempt_at, response_status, last_error, created_at, updated_at, delivered_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING *;
+
+-- name: GetWebhookDeliveryByID :one
+SELECT * FROM webhook_deliveries WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY created_at DESC, id
+LIMIT $2 OFFSET $3;
+
+-- name: CountWebhookDeliveries :one
+SELECT COUNT(*) FROM webhook_deliveries WHERE subscription_id = $1;
+
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET next_attempt_at = sqlc.arg('lease_until')
+WHERE id IN (
+    SELECT id FROM webhook_deliveries
+    WHERE status = 'pending' AND next_attempt_at <= sqlc.arg('now')
+    ORDER BY next_attempt_at
+    LIMIT sqlc.arg('batch_size')
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: UpdateWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = $2, attempts = $3, next_attempt_at = $4, response_status = $5,
+    last_error = $6, updated_at = $7, delivered_at = $8
+WHERE id = $1;
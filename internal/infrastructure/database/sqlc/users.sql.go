@@ -12,21 +12,10 @@ import (
 	"github.com/google/uuid"
 )
 
-const countUsers = `-- name: CountUsers :one
-SELECT COUNT(*) FROM users
-`
-
-func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countUsers)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
-}
-
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (id, name, email, password_hash, active, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, name, email, password_hash, active, created_at, updated_at
+INSERT INTO users (id, name, email, password_hash, active, created_at, updated_at, role, category, card_number, blocked)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING id, name, email, password_hash, active, created_at, updated_at, role, category, card_number, blocked
 `
 
 type CreateUserParams struct {
@@ -37,6 +26,10 @@ type CreateUserParams struct {
 	Active       bool      `json:"active"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
+	Role         string    `json:"role"`
+	Category     string    `json:"category"`
+	CardNumber   string    `json:"card_number"`
+	Blocked      bool      `json:"blocked"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
@@ -48,6 +41,10 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		arg.Active,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.Role,
+		arg.Category,
+		arg.CardNumber,
+		arg.Blocked,
 	)
 	var i User
 	err := row.Scan(
@@ -58,6 +55,10 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Active,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
+		&i.Category,
+		&i.CardNumber,
+		&i.Blocked,
 	)
 	return i, err
 }
@@ -71,8 +72,31 @@ func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const getUserByCardNumber = `-- name: GetUserByCardNumber :one
+SELECT id, name, email, password_hash, active, created_at, updated_at, role, category, card_number, blocked FROM users WHERE card_number = $1
+`
+
+func (q *Queries) GetUserByCardNumber(ctx context.Context, cardNumber string) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByCardNumber, cardNumber)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.Category,
+		&i.CardNumber,
+		&i.Blocked,
+	)
+	return i, err
+}
+
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, name, email, password_hash, active, created_at, updated_at FROM users WHERE email = $1
+SELECT id, name, email, password_hash, active, created_at, updated_at, role, category, card_number, blocked FROM users WHERE email = $1
 `
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
@@ -86,12 +110,16 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.Active,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
+		&i.Category,
+		&i.CardNumber,
+		&i.Blocked,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, name, email, password_hash, active, created_at, updated_at FROM users WHERE id = $1
+SELECT id, name, email, password_hash, active, created_at, updated_at, role, category, card_number, blocked FROM users WHERE id = $1
 `
 
 func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
@@ -105,65 +133,33 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.Active,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
+		&i.Category,
+		&i.CardNumber,
+		&i.Blocked,
 	)
 	return i, err
 }
 
-const listUsers = `-- name: ListUsers :many
-SELECT id, name, email, password_hash, active, created_at, updated_at FROM users
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
-`
-
-type ListUsersParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
-}
-
-func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
-	rows, err := q.db.QueryContext(ctx, listUsers, arg.Limit, arg.Offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []User{}
-	for rows.Next() {
-		var i User
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Email,
-			&i.PasswordHash,
-			&i.Active,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const updateUser = `-- name: UpdateUser :one
 UPDATE users
-SET name = $2, email = $3, active = $4, updated_at = $5
+SET name = $2, email = $3, active = $4, updated_at = $5, role = $6,
+    password_hash = $7, category = $8, card_number = $9, blocked = $10
 WHERE id = $1
-RETURNING id, name, email, password_hash, active, created_at, updated_at
+RETURNING id, name, email, password_hash, active, created_at, updated_at, role, category, card_number, blocked
 `
 
 type UpdateUserParams struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Active    bool      `json:"active"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	Active       bool      `json:"active"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Role         string    `json:"role"`
+	PasswordHash string    `json:"password_hash"`
+	Category     string    `json:"category"`
+	CardNumber   string    `json:"card_number"`
+	Blocked      bool      `json:"blocked"`
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
@@ -173,6 +169,11 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		arg.Email,
 		arg.Active,
 		arg.UpdatedAt,
+		arg.Role,
+		arg.PasswordHash,
+		arg.Category,
+		arg.CardNumber,
+		arg.Blocked,
 	)
 	var i User
 	err := row.Scan(
@@ -183,6 +184,10 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.Active,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
+		&i.Category,
+		&i.CardNumber,
+		&i.Blocked,
 	)
 	return i, err
 }
@@ -0,0 +1,55 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"time"
+
+	"bookhub/internal/domain/entity"
+)
+
+const (
+	// SpecVersion is the CloudEvents version of the envelope.
+	SpecVersion = "1.0"
+	// EventVersion versions the BookHub event types and their data. A change
+	// that breaks consumers gets a new version, published as a new type.
+	EventVersion = "v1"
+	// ContentType is the content type of structured-mode CloudEvents.
+	ContentType = "application/cloudevents+json"
+)
+
+// Envelope is the CloudEvents 1.0 JSON envelope events are published in.
+// Subject is the aggregate the event happened to, and ID the event's ID,
+// which stays the same when the event is published again.
+type Envelope struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// NewEnvelope wraps event for publishing from source.
+func NewEnvelope(source string, event *entity.Event) (*Envelope, error) {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &Envelope{
+		SpecVersion:     SpecVersion,
+		ID:              event.ID.String(),
+		Source:          source,
+		Type:            Type(event.Type),
+		Subject:         event.AggregateID.String(),
+		Time:            event.OccurredAt.UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+	}, nil
+}
+
+// Type is the CloudEvents type of eventType, e.g. "bookhub.loan.borrowed.v1".
+func Type(eventType entity.EventType) string {
+	return "bookhub." + string(eventType) + "." + EventVersion
+}
@@ -0,0 +1,45 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	"bookhub/internal/domain/entity"
+)
+
+// MemoryPublisher keeps the envelopes it publishes in memory, for tests.
+type MemoryPublisher struct {
+	source string
+
+	mu        sync.Mutex
+	envelopes []*Envelope
+}
+
+func NewMemoryPublisher(source string) *MemoryPublisher {
+	return &MemoryPublisher{
+		source: source,
+	}
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, event *entity.Event) error {
+	envelope, err := NewEnvelope(p.source, event)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.envelopes = append(p.envelopes, envelope)
+	return nil
+}
+
+// Envelopes returns what was published so far, in order.
+func (p *MemoryPublisher) Envelopes() []*Envelope {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*Envelope(nil), p.envelopes...)
+}
+
+func (p *MemoryPublisher) Close() error {
+	return nil
+}
@@ -0,0 +1,96 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type NATSConfig struct {
+	URL string
+	// Stream is the JetStream stream that stores the events. It is created,
+	// or updated to cover SubjectPrefix, on connect.
+	Stream string
+	// SubjectPrefix comes before the event type in the subject, as in
+	// "bookhub.events.loan.borrowed".
+	SubjectPrefix string
+	// Timeout bounds each publish, including the wait for the stream's ack.
+	Timeout time.Duration
+}
+
+// NATSPublisher publishes events to a JetStream stream. It returns once the
+// stream has stored the event, and sets the event ID as the message ID so
+// the stream drops an event published again within its duplicate window.
+type NATSPublisher struct {
+	conn   *nats.Conn
+	js     jetstream.JetStream
+	source string
+	cfg    NATSConfig
+}
+
+func NewNATSPublisher(ctx context.Context, cfg NATSConfig, source string) (*NATSPublisher, error) {
+	conn, err := nats.Connect(cfg.URL, nats.Name("bookhub"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     cfg.Stream,
+		Subjects: []string{cfg.SubjectPrefix + ".>"},
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to set up stream %s: %w", cfg.Stream, err)
+	}
+
+	return &NATSPublisher{
+		conn:   conn,
+		js:     js,
+		source: source,
+		cfg:    cfg,
+	}, nil
+}
+
+func (p *NATSPublisher) Publish(ctx context.Context, event *entity.Event) error {
+	envelope, err := NewEnvelope(p.source, event)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(p.Subject(event.Type))
+	msg.Header.Set("Content-Type", ContentType)
+	msg.Data = body
+
+	if p.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.cfg.Timeout)
+		defer cancel()
+	}
+	_, err = p.js.PublishMsg(ctx, msg, jetstream.WithMsgID(envelope.ID))
+	return err
+}
+
+// Subject is where events of eventType are published.
+func (p *NATSPublisher) Subject(eventType entity.EventType) string {
+	return p.cfg.SubjectPrefix + "." + string(eventType)
+}
+
+// Close waits for pending messages to be sent and closes the connection.
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
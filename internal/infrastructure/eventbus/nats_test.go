@@ -0,0 +1,106 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// runNATSServer starts an embedded nats-server with JetStream on a random
+// port and returns its URL.
+func runNATSServer(t *testing.T) string {
+	t.Helper()
+	srv, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatalf("server.NewServer() unexpected error = %v", err)
+	}
+	go srv.Start()
+	if !srv.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats-server did not start")
+	}
+	t.Cleanup(srv.Shutdown)
+	return srv.ClientURL()
+}
+
+func TestNATSPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+	publisher, err := New(ctx, Config{
+		Publisher: PublisherNATS,
+		Source:    testSource,
+		NATS: NATSConfig{
+			URL:           runNATSServer(t),
+			Stream:        "BOOKHUB_EVENTS",
+			SubjectPrefix: "bookhub.events",
+			Timeout:       5 * time.Second,
+		},
+	})
+	if err != nil {
+		t.Fatalf("New() unexpected error = %v", err)
+	}
+	defer publisher.Close()
+
+	event := newTestEvent()
+	// The stream keeps one copy of an event published twice
+	for range 2 {
+		if err := publisher.Publish(ctx, event); err != nil {
+			t.Fatalf("NATSPublisher.Publish() unexpected error = %v", err)
+		}
+	}
+
+	js, err := jetstream.New(publisher.(*NATSPublisher).conn)
+	if err != nil {
+		t.Fatalf("jetstream.New() unexpected error = %v", err)
+	}
+	stream, err := js.Stream(ctx, "BOOKHUB_EVENTS")
+	if err != nil {
+		t.Fatalf("js.Stream() unexpected error = %v", err)
+	}
+	info, err := stream.Info(ctx)
+	if err != nil {
+		t.Fatalf("stream.Info() unexpected error = %v", err)
+	}
+	if info.State.Msgs != 1 {
+		t.Errorf("stream holds %d messages, want 1", info.State.Msgs)
+	}
+
+	msg, err := stream.GetMsg(ctx, 1)
+	if err != nil {
+		t.Fatalf("stream.GetMsg() unexpected error = %v", err)
+	}
+	if msg.Subject != "bookhub.events.loan.borrowed" {
+		t.Errorf("message subject = %q, want %q", msg.Subject, "bookhub.events.loan.borrowed")
+	}
+	if got := msg.Header.Get("Content-Type"); got != ContentType {
+		t.Errorf("message Content-Type = %q, want %q", got, ContentType)
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(msg.Data, &envelope); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error = %v", err)
+	}
+	if envelope.ID != event.ID.String() || envelope.Type != "bookhub.loan.borrowed.v1" || envelope.Source != testSource {
+		t.Errorf("envelope = %+v, want the event %s of type bookhub.loan.borrowed.v1 from %s", envelope, event.ID, testSource)
+	}
+}
+
+func TestNewNATSPublisher_Unreachable(t *testing.T) {
+	_, err := NewNATSPublisher(context.Background(), NATSConfig{
+		URL:           "nats://127.0.0.1:1",
+		Stream:        "BOOKHUB_EVENTS",
+		SubjectPrefix: "bookhub.events",
+	}, testSource)
+	if err == nil {
+		t.Error("NewNATSPublisher() expected an error for an unreachable server")
+	}
+}
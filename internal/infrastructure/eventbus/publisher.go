@@ -0,0 +1,54 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"bookhub/internal/domain/entity"
+)
+
+var ErrUnknownPublisher = errors.New("unknown event publisher")
+
+const (
+	PublisherNone = "none"
+	PublisherNATS = "nats"
+)
+
+// Publisher streams domain events to a message broker.
+type Publisher interface {
+	Publish(ctx context.Context, event *entity.Event) error
+	// Close releases the connection to the broker.
+	Close() error
+}
+
+type Config struct {
+	// Publisher is one of none or nats.
+	Publisher string
+	// Source is the CloudEvents source of the events, e.g. "/bookhub".
+	Source string
+	NATS   NATSConfig
+}
+
+// New returns the publisher of the configured broker. With none, events
+// are only delivered to webhooks.
+func New(ctx context.Context, cfg Config) (Publisher, error) {
+	switch cfg.Publisher {
+	case PublisherNone:
+		return nopPublisher{}, nil
+	case PublisherNATS:
+		return NewNATSPublisher(ctx, cfg.NATS, cfg.Source)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPublisher, cfg.Publisher)
+	}
+}
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(ctx context.Context, event *entity.Event) error {
+	return nil
+}
+
+func (nopPublisher) Close() error {
+	return nil
+}
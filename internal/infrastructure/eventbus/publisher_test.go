@@ -0,0 +1,106 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+const testSource = "/bookhub/test"
+
+func newTestEvent() *entity.Event {
+	loanID := uuid.New()
+	return entity.NewEvent(entity.EventLoanBorrowed, loanID, map[string]string{"id": loanID.String()})
+}
+
+func TestNew_UnknownPublisher(t *testing.T) {
+	_, err := New(context.Background(), Config{Publisher: "carrier-pigeon"})
+	if !errors.Is(err, ErrUnknownPublisher) {
+		t.Errorf("New() error = %v, want %v", err, ErrUnknownPublisher)
+	}
+}
+
+func TestNew_None(t *testing.T) {
+	publisher, err := New(context.Background(), Config{Publisher: PublisherNone})
+	if err != nil {
+		t.Fatalf("New() unexpected error = %v", err)
+	}
+	if err := publisher.Publish(context.Background(), newTestEvent()); err != nil {
+		t.Errorf("Publish() unexpected error = %v", err)
+	}
+}
+
+func TestNewEnvelope(t *testing.T) {
+	event := newTestEvent()
+
+	envelope, err := NewEnvelope(testSource, event)
+	if err != nil {
+		t.Fatalf("NewEnvelope() unexpected error = %v", err)
+	}
+
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error = %v", err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error = %v", err)
+	}
+
+	want := map[string]any{
+		"specversion":     "1.0",
+		"id":              event.ID.String(),
+		"source":          testSource,
+		"type":            "bookhub.loan.borrowed.v1",
+		"subject":         event.AggregateID.String(),
+		"time":            event.OccurredAt.UTC().Format(time.RFC3339Nano),
+		"datacontenttype": "application/json",
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("envelope[%q] = %v, want %v", key, got[key], value)
+		}
+	}
+	if data, _ := got["data"].(map[string]any); data["id"] != event.AggregateID.String() {
+		t.Errorf("envelope data = %v, want the event data", got["data"])
+	}
+}
+
+// Events coming back from the outbox carry their data already encoded.
+func TestNewEnvelope_EncodedData(t *testing.T) {
+	event := newTestEvent()
+	event.Data = json.RawMessage(`{"id":"` + event.AggregateID.String() + `"}`)
+
+	envelope, err := NewEnvelope(testSource, event)
+	if err != nil {
+		t.Fatalf("NewEnvelope() unexpected error = %v", err)
+	}
+	if string(envelope.Data) != string(event.Data.(json.RawMessage)) {
+		t.Errorf("envelope.Data = %s, want %s", envelope.Data, event.Data)
+	}
+}
+
+func TestMemoryPublisher_Publish(t *testing.T) {
+	publisher := NewMemoryPublisher(testSource)
+	first, second := newTestEvent(), newTestEvent()
+
+	for _, event := range []*entity.Event{first, second} {
+		if err := publisher.Publish(context.Background(), event); err != nil {
+			t.Fatalf("MemoryPublisher.Publish() unexpected error = %v", err)
+		}
+	}
+
+	envelopes := publisher.Envelopes()
+	if len(envelopes) != 2 {
+		t.Fatalf("MemoryPublisher.Envelopes() returned %d envelopes, want 2", len(envelopes))
+	}
+	if envelopes[0].ID != first.ID.String() || envelopes[1].ID != second.ID.String() {
+		t.Errorf("MemoryPublisher.Envelopes() = [%s %s], want [%s %s]", envelopes[0].ID, envelopes[1].ID, first.ID, second.ID)
+	}
+}
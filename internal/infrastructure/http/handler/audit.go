@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"net/http"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Audit handlers
+
+func (h *Handler) ListAuditEntries(c *gin.Context, params generated.ListAuditEntriesParams) {
+	page := 1
+	limit := 10
+
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	filter := repository.AuditFilter{
+		Action: params.Action,
+		From:   params.From,
+		To:     params.To,
+	}
+	if params.ActorId != nil {
+		id := uuid.UUID(*params.ActorId)
+		filter.ActorID = &id
+	}
+	if params.EntityType != nil {
+		entityType := string(*params.EntityType)
+		filter.EntityType = &entityType
+	}
+	if params.EntityId != nil {
+		id := uuid.UUID(*params.EntityId)
+		filter.EntityID = &id
+	}
+
+	entries, total, err := h.auditUseCase.List(c.Request.Context(), page, limit, filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("failed to list audit entries"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+		return
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	c.JSON(http.StatusOK, generated.AuditEntryListResponse{
+		Data:       auditEntriesToResponse(entries),
+		Pagination: paginationResponse(page, limit, total, totalPages),
+	})
+}
+
+func (h *Handler) VerifyAuditChain(c *gin.Context) {
+	verification, err := h.auditUseCase.Verify(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("failed to verify the audit chain"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+		return
+	}
+
+	valid := verification.Valid()
+	response := &generated.AuditVerification{
+		Valid:    &valid,
+		Checked:  &verification.Checked,
+		BrokenAt: verification.BrokenAt,
+	}
+	if !valid {
+		response.Reason = &verification.Reason
+	}
+
+	c.JSON(http.StatusOK, generated.AuditVerificationResponse{
+		Data: response,
+	})
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func createTestAuditEntry() *entity.AuditEntry {
+	actorID := uuid.New()
+	entry := entity.NewAuditEntry(&actorID, entity.AuditUserDisabled, entity.AuditEntityUser, uuid.New(),
+		map[string]entity.AuditChange{"active": {Before: true, After: false}}, "req-42")
+	entry.Chain(0, "")
+	return entry
+}
+
+func TestListAuditEntries_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	entry := createTestAuditEntry()
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	entityType := entity.AuditEntityUser
+
+	m.auditUseCase.EXPECT().
+		List(gomock.Any(), 2, 5, repository.AuditFilter{
+			ActorID:    entry.ActorID,
+			EntityType: &entityType,
+			From:       &from,
+		}).
+		Return([]*entity.AuditEntry{entry}, 6, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/audit?page=2&limit=5&entity_type=user&from=2024-03-01T00:00:00Z&actor_id="+entry.ActorID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.AuditEntryListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+	got := (*response.Data)[0]
+	assert.Equal(t, entry.Hash, *got.Hash)
+	assert.Equal(t, "req-42", *got.RequestId)
+	assert.Equal(t, false, *(*got.Changes)["active"].After)
+	assert.Equal(t, 2, *response.Pagination.TotalPages)
+}
+
+func TestListAuditEntries_InvalidActorID(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	req := httptest.NewRequest(http.MethodGet, "/audit?actor_id=not-a-uuid", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestVerifyAuditChain_Valid(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	m.auditUseCase.EXPECT().
+		Verify(gomock.Any()).
+		Return(&usecase.AuditVerification{Checked: 3}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/audit/verify", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.AuditVerificationResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.True(t, *response.Data.Valid)
+	assert.Equal(t, 3, *response.Data.Checked)
+	assert.Nil(t, response.Data.BrokenAt)
+}
+
+func TestVerifyAuditChain_Broken(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	brokenAt := int64(4)
+	m.auditUseCase.EXPECT().
+		Verify(gomock.Any()).
+		Return(&usecase.AuditVerification{Checked: 3, BrokenAt: &brokenAt, Reason: entity.ErrAuditHashMismatch.Error()}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/audit/verify", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.AuditVerificationResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.False(t, *response.Data.Valid)
+	assert.Equal(t, brokenAt, *response.Data.BrokenAt)
+	assert.Equal(t, entity.ErrAuditHashMismatch.Error(), *response.Data.Reason)
+}
@@ -31,7 +31,7 @@ func (h *Handler) Login(c *gin.Context) {
 		return
 	}
 
-	token, expiresAt, err := h.jwtService.GenerateToken(user.ID, user.Email)
+	token, expiresAt, err := h.jwtService.GenerateToken(user.ID, user.Email, user.Role)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
 			Error: strPtr("failed to generate token"),
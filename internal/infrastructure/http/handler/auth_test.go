@@ -28,7 +28,7 @@ func TestLogin_Success(t *testing.T) {
 		ValidateCredentials(gomock.Any(), "test@example.com", "password123").
 		Return(user, nil)
 	mockJWTService.EXPECT().
-		GenerateToken(user.ID, user.Email).
+		GenerateToken(user.ID, user.Email, user.Role).
 		Return("test-token", expiresAt, nil)
 
 	reqBody := generated.LoginRequest{
@@ -106,7 +106,7 @@ func TestLogin_TokenGenerationError(t *testing.T) {
 		ValidateCredentials(gomock.Any(), "test@example.com", "password123").
 		Return(user, nil)
 	mockJWTService.EXPECT().
-		GenerateToken(user.ID, user.Email).
+		GenerateToken(user.ID, user.Email, user.Role).
 		Return("", time.Time{}, errors.New("token generation failed"))
 
 	reqBody := generated.LoginRequest{
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"bookhub/api/generated"
+	"bookhub/internal/domain/repository"
 	"bookhub/internal/usecase"
 
 	"github.com/gin-gonic/gin"
@@ -24,12 +25,42 @@ func (h *Handler) ListBooks(c *gin.Context, params generated.ListBooksParams) {
 		limit = *params.Limit
 	}
 
-	books, total, err := h.bookUseCase.List(c.Request.Context(), page, limit, params.Available)
+	var filter repository.BookFilter
+	if params.Available != nil {
+		filter.AvailableOnly = *params.Available
+	}
+	if params.BranchId != nil {
+		id := uuid.UUID(*params.BranchId)
+		filter.BranchID = &id
+	}
+
+	if params.Author != nil {
+		filter.Author = *params.Author
+	}
+	filter.PublishedFrom = params.PublishedFrom
+	filter.PublishedTo = params.PublishedTo
+
+	if params.Q != nil {
+		if params.Sort != nil {
+			c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+				Error: strPtr("sort cannot be combined with q"),
+				Code:  strPtr("VALIDATION_ERROR"),
+			})
+			return
+		}
+		h.searchBooks(c, page, limit, repository.BookSearch{BookFilter: filter, Query: *params.Q})
+		return
+	}
+
+	sort, ok := parseSort(c, params.Sort, repository.BookSortFields)
+	if !ok {
+		return
+	}
+	filter.Sort = sort
+
+	books, total, err := h.bookUseCase.List(c.Request.Context(), page, limit, filter)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
-			Error: strPtr("failed to list books"),
-			Code:  strPtr("INTERNAL_ERROR"),
-		})
+		handleBookError(c, err)
 		return
 	}
 
@@ -41,6 +72,41 @@ func (h *Handler) ListBooks(c *gin.Context, params generated.ListBooksParams) {
 	})
 }
 
+func (h *Handler) searchBooks(c *gin.Context, page, limit int, search repository.BookSearch) {
+	matches, total, err := h.bookUseCase.Search(c.Request.Context(), page, limit, search)
+	if err != nil {
+		handleBookError(c, err)
+		return
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	c.JSON(http.StatusOK, generated.BookListResponse{
+		Data:       bookMatchesToResponse(matches),
+		Pagination: paginationResponse(page, limit, total, totalPages),
+	})
+}
+
+func (h *Handler) SuggestBooks(c *gin.Context, params generated.SuggestBooksParams) {
+	limit := 10
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	suggestions, err := h.bookUseCase.Suggest(c.Request.Context(), params.Prefix, limit)
+	if err != nil {
+		handleBookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.BookSuggestionsResponse{
+		Data: &generated.BookSuggestions{
+			Titles:  suggestions.Titles,
+			Authors: suggestions.Authors,
+		},
+	})
+}
+
 func (h *Handler) CreateBook(c *gin.Context) {
 	var req generated.CreateBookRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -56,12 +122,19 @@ func (h *Handler) CreateBook(c *gin.Context) {
 		publishedYear = *req.PublishedYear
 	}
 
+	category := ""
+	if req.Category != nil {
+		category = *req.Category
+	}
+
 	book, err := h.bookUseCase.Create(c.Request.Context(), usecase.CreateBookInput{
 		Title:         req.Title,
 		Author:        req.Author,
 		ISBN:          req.Isbn,
 		PublishedYear: publishedYear,
 		TotalCopies:   req.TotalCopies,
+		Category:      category,
+		BranchID:      (*uuid.UUID)(req.BranchId),
 	})
 	if err != nil {
 		handleBookError(c, err)
@@ -93,3 +166,55 @@ func (h *Handler) GetBookById(c *gin.Context, id openapi_types.UUID) {
 		Data: bookToResponse(book),
 	})
 }
+
+func (h *Handler) UpdateBook(c *gin.Context, id openapi_types.UUID) {
+	var req generated.UpdateBookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	book, err := h.bookUseCase.Update(c.Request.Context(), uuid.UUID(id), usecase.UpdateBookInput{
+		Title:         req.Title,
+		Author:        req.Author,
+		ISBN:          req.Isbn,
+		PublishedYear: req.PublishedYear,
+		Category:      req.Category,
+		TotalCopies:   req.TotalCopies,
+		BranchID:      (*uuid.UUID)(req.BranchId),
+	})
+	if err != nil {
+		handleBookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.BookResponse{
+		Data: bookToResponse(book),
+	})
+}
+
+func (h *Handler) WithdrawBook(c *gin.Context, id openapi_types.UUID) {
+	book, err := h.bookUseCase.Withdraw(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleBookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.BookResponse{
+		Data: bookToResponse(book),
+	})
+}
+
+func (h *Handler) DeleteBook(c *gin.Context, id openapi_types.UUID) {
+	if err := h.bookUseCase.Delete(c.Request.Context(), uuid.UUID(id)); err != nil {
+		handleBookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.MessageResponse{
+		Message: strPtr("book deleted successfully"),
+	})
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"net/http"
+
+	"bookhub/api/generated"
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Book copy handlers
+
+func (h *Handler) ListBookCopies(c *gin.Context, id openapi_types.UUID) {
+	copies, err := h.copyUseCase.List(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleBookCopyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.BookCopyListResponse{
+		Data: bookCopiesToResponse(copies),
+	})
+}
+
+func (h *Handler) CreateBookCopy(c *gin.Context, id openapi_types.UUID) {
+	var req generated.CreateBookCopyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	input := usecase.CreateBookCopyInput{
+		Barcode:  req.Barcode,
+		BranchID: (*uuid.UUID)(req.BranchId),
+	}
+	if req.Location != nil {
+		input.Location = *req.Location
+	}
+	if req.Condition != nil {
+		input.Condition = string(*req.Condition)
+	}
+
+	bookCopy, err := h.copyUseCase.Create(c.Request.Context(), uuid.UUID(id), input)
+	if err != nil {
+		handleBookCopyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, generated.BookCopyResponse{
+		Data: bookCopyToResponse(bookCopy),
+	})
+}
+
+func (h *Handler) GetBookCopy(c *gin.Context, id openapi_types.UUID, copyId openapi_types.UUID) {
+	bookCopy, err := h.copyUseCase.GetByID(c.Request.Context(), uuid.UUID(id), uuid.UUID(copyId))
+	if err != nil {
+		handleBookCopyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.BookCopyResponse{
+		Data: bookCopyToResponse(bookCopy),
+	})
+}
+
+func (h *Handler) UpdateBookCopy(c *gin.Context, id openapi_types.UUID, copyId openapi_types.UUID) {
+	var req generated.UpdateBookCopyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	input := usecase.UpdateBookCopyInput{
+		Location: req.Location,
+	}
+	if req.Condition != nil {
+		input.Condition = strPtr(string(*req.Condition))
+	}
+	if req.Status != nil {
+		input.Status = strPtr(string(*req.Status))
+	}
+
+	bookCopy, err := h.copyUseCase.Update(c.Request.Context(), uuid.UUID(id), uuid.UUID(copyId), input)
+	if err != nil {
+		handleBookCopyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.BookCopyResponse{
+		Data: bookCopyToResponse(bookCopy),
+	})
+}
+
+func (h *Handler) DeleteBookCopy(c *gin.Context, id openapi_types.UUID, copyId openapi_types.UUID) {
+	if err := h.copyUseCase.Delete(c.Request.Context(), uuid.UUID(id), uuid.UUID(copyId)); err != nil {
+		handleBookCopyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.MessageResponse{
+		Message: strPtr("book copy deleted successfully"),
+	})
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListBookCopies_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	bookID := uuid.New()
+	bookCopy, _ := entity.NewBookCopy(bookID, uuid.New(), "9780132350884-001", "A-3", "")
+
+	m.bookCopyUseCase.EXPECT().
+		List(gomock.Any(), bookID).
+		Return([]*entity.BookCopy{bookCopy}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/books/"+bookID.String()+"/copies", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.BookCopyListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+	assert.Equal(t, "9780132350884-001", *(*response.Data)[0].Barcode)
+	assert.Equal(t, generated.BookCopyStatus(entity.CopyStatusAvailable), *(*response.Data)[0].Status)
+}
+
+func TestCreateBookCopy_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	bookID := uuid.New()
+	input := usecase.CreateBookCopyInput{
+		Barcode:   "BH-000123",
+		Location:  "A-3",
+		Condition: entity.CopyConditionNew,
+	}
+	bookCopy, _ := entity.NewBookCopy(bookID, uuid.New(), input.Barcode, input.Location, input.Condition)
+
+	m.bookCopyUseCase.EXPECT().
+		Create(gomock.Any(), bookID, input).
+		Return(bookCopy, nil)
+
+	condition := generated.BookCopyCondition(entity.CopyConditionNew)
+	body, _ := json.Marshal(generated.CreateBookCopyRequest{
+		Barcode:   "BH-000123",
+		Location:  strPtr("A-3"),
+		Condition: &condition,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/books/"+bookID.String()+"/copies", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response generated.BookCopyResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "BH-000123", *response.Data.Barcode)
+}
+
+func TestCreateBookCopy_BarcodeExists(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	bookID := uuid.New()
+
+	m.bookCopyUseCase.EXPECT().
+		Create(gomock.Any(), bookID, gomock.Any()).
+		Return(nil, entity.ErrBarcodeAlreadyExists)
+
+	body, _ := json.Marshal(generated.CreateBookCopyRequest{Barcode: "BH-000123"})
+
+	req := httptest.NewRequest(http.MethodPost, "/books/"+bookID.String()+"/copies", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "BARCODE_EXISTS", *response.Code)
+}
+
+func TestUpdateBookCopy_InCirculation(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	bookID := uuid.New()
+	copyID := uuid.New()
+
+	m.bookCopyUseCase.EXPECT().
+		Update(gomock.Any(), bookID, copyID, usecase.UpdateBookCopyInput{Status: strPtr(entity.CopyStatusWithdrawn)}).
+		Return(nil, entity.ErrCopyInCirculation)
+
+	status := generated.UpdateBookCopyRequestStatusWithdrawn
+	body, _ := json.Marshal(generated.UpdateBookCopyRequest{Status: &status})
+
+	req := httptest.NewRequest(http.MethodPut, "/books/"+bookID.String()+"/copies/"+copyID.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "COPY_IN_CIRCULATION", *response.Code)
+}
+
+func TestDeleteBookCopy_NotFound(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	bookID := uuid.New()
+	copyID := uuid.New()
+
+	m.bookCopyUseCase.EXPECT().
+		Delete(gomock.Any(), bookID, copyID).
+		Return(entity.ErrBookCopyNotFound)
+
+	req := httptest.NewRequest(http.MethodDelete, "/books/"+bookID.String()+"/copies/"+copyID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -10,6 +10,7 @@ import (
 
 	"bookhub/api/generated"
 	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
 	"bookhub/internal/usecase"
 
 	"github.com/google/uuid"
@@ -25,7 +26,7 @@ func TestListBooks_Success(t *testing.T) {
 	books := []*entity.Book{createTestBook(), createTestBook()}
 
 	mockBookUseCase.EXPECT().
-		List(gomock.Any(), 1, 10, (*bool)(nil)).
+		List(gomock.Any(), 1, 10, repository.BookFilter{}).
 		Return(books, 2, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/books", nil)
@@ -47,10 +48,9 @@ func TestListBooks_WithAvailableFilter(t *testing.T) {
 	router := setupTestRouter(handler)
 
 	books := []*entity.Book{createTestBook()}
-	available := true
 
 	mockBookUseCase.EXPECT().
-		List(gomock.Any(), 1, 10, &available).
+		List(gomock.Any(), 1, 10, repository.BookFilter{AvailableOnly: true}).
 		Return(books, 1, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/books?available=true", nil)
@@ -61,13 +61,63 @@ func TestListBooks_WithAvailableFilter(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestListBooks_WithBranchFilter(t *testing.T) {
+	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	branchID := uuid.New()
+	book := createTestBook()
+	book.Branches = []entity.BranchAvailability{
+		{BookID: book.ID, BranchID: branchID, TotalCopies: 2, AvailableCopies: 1},
+	}
+
+	mockBookUseCase.EXPECT().
+		List(gomock.Any(), 1, 10, repository.BookFilter{BranchID: &branchID}).
+		Return([]*entity.Book{book}, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/books?branch_id="+branchID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.BookListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	branches := *(*response.Data)[0].Branches
+	assert.Len(t, branches, 1)
+	assert.Equal(t, branchID, *branches[0].BranchId)
+	assert.Equal(t, 1, *branches[0].AvailableCopies)
+}
+
+func TestListBooks_UnknownBranch(t *testing.T) {
+	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	branchID := uuid.New()
+
+	mockBookUseCase.EXPECT().
+		List(gomock.Any(), 1, 10, repository.BookFilter{BranchID: &branchID}).
+		Return(nil, 0, entity.ErrBranchNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/books?branch_id="+branchID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
 func TestListBooks_Error(t *testing.T) {
 	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
 	defer ctrl.Finish()
 	router := setupTestRouter(handler)
 
 	mockBookUseCase.EXPECT().
-		List(gomock.Any(), 1, 10, (*bool)(nil)).
+		List(gomock.Any(), 1, 10, repository.BookFilter{}).
 		Return(nil, 0, errors.New("database error"))
 
 	req := httptest.NewRequest(http.MethodGet, "/books", nil)
@@ -78,6 +128,180 @@ func TestListBooks_Error(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
 
+func TestListBooks_Search(t *testing.T) {
+	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	book := createTestBook()
+	from, to := 1850, 1950
+
+	mockBookUseCase.EXPECT().
+		Search(gomock.Any(), 1, 10, repository.BookSearch{
+			BookFilter: repository.BookFilter{
+				AvailableOnly: true,
+				Author:        "machado",
+				PublishedFrom: &from,
+				PublishedTo:   &to,
+			},
+			Query: "dom casmurro",
+		}).
+		Return([]*repository.BookMatch{{
+			Book:            book,
+			Score:           0.8,
+			TitleHighlight:  "Dom <mark>Casmurro</mark>",
+			AuthorHighlight: book.Author,
+		}}, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/books?q=dom+casmurro&author=machado&published_from=1850&published_to=1950&available=true", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.BookListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+	match := (*response.Data)[0].Match
+	assert.NotNil(t, match)
+	assert.Equal(t, 0.8, *match.Score)
+	assert.Equal(t, "Dom <mark>Casmurro</mark>", *match.TitleHighlight)
+}
+
+func TestListBooks_SearchInvalidQuery(t *testing.T) {
+	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	mockBookUseCase.EXPECT().
+		Search(gomock.Any(), 1, 10, gomock.Any()).
+		Return(nil, 0, entity.ErrInvalidSearchQuery)
+
+	req := httptest.NewRequest(http.MethodGet, "/books?q=%3F", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestListBooks_FiltersAndSort(t *testing.T) {
+	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	book := createTestBook()
+	from, to := 1850, 1950
+
+	mockBookUseCase.EXPECT().
+		List(gomock.Any(), 1, 10, repository.BookFilter{
+			Author:        "machado",
+			PublishedFrom: &from,
+			PublishedTo:   &to,
+			Sort: []repository.SortField{
+				{Field: "published_year", Desc: true},
+				{Field: "title"},
+			},
+		}).
+		Return([]*entity.Book{book}, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/books?author=machado&published_from=1850&published_to=1950&sort=-published_year,title", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestListBooks_InvalidSort(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	req := httptest.NewRequest(http.MethodGet, "/books?sort=isbn", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "VALIDATION_ERROR", *response.Code)
+}
+
+func TestListBooks_SortWithQuery(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	req := httptest.NewRequest(http.MethodGet, "/books?q=casmurro&sort=title", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSuggestBooks_Success(t *testing.T) {
+	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	mockBookUseCase.EXPECT().
+		Suggest(gomock.Any(), "mem", 5).
+		Return(&usecase.BookSuggestions{
+			Titles:  []string{"Memorial do Convento", "Memórias Póstumas de Brás Cubas"},
+			Authors: []string{},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/books/suggest?prefix=mem&limit=5", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.BookSuggestionsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Memorial do Convento", "Memórias Póstumas de Brás Cubas"}, response.Data.Titles)
+	assert.Empty(t, response.Data.Authors)
+}
+
+func TestSuggestBooks_InvalidPrefix(t *testing.T) {
+	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	mockBookUseCase.EXPECT().
+		Suggest(gomock.Any(), "m", 10).
+		Return(nil, entity.ErrInvalidSuggestPrefix)
+
+	req := httptest.NewRequest(http.MethodGet, "/books/suggest?prefix=m", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSuggestBooks_MissingPrefix(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	req := httptest.NewRequest(http.MethodGet, "/books/suggest", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 func TestCreateBook_Success(t *testing.T) {
 	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
 	defer ctrl.Finish()
@@ -187,3 +411,144 @@ func TestGetBookById_NotFound(t *testing.T) {
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
+
+func TestUpdateBook_Success(t *testing.T) {
+	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	book := createTestBook()
+	title := "Renamed Book"
+	totalCopies := 5
+
+	mockBookUseCase.EXPECT().
+		Update(gomock.Any(), book.ID, usecase.UpdateBookInput{
+			Title:       &title,
+			TotalCopies: &totalCopies,
+		}).
+		Return(book, nil)
+
+	body, _ := json.Marshal(generated.UpdateBookRequest{
+		Title:       &title,
+		TotalCopies: &totalCopies,
+	})
+
+	req := httptest.NewRequest(http.MethodPut, "/books/"+book.ID.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.BookResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.NotNil(t, response.Data)
+}
+
+func TestUpdateBook_CopiesInCirculation(t *testing.T) {
+	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	bookID := uuid.New()
+	totalCopies := 1
+
+	mockBookUseCase.EXPECT().
+		Update(gomock.Any(), bookID, gomock.Any()).
+		Return(nil, entity.ErrCopiesInCirculation)
+
+	body, _ := json.Marshal(generated.UpdateBookRequest{TotalCopies: &totalCopies})
+
+	req := httptest.NewRequest(http.MethodPut, "/books/"+bookID.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestWithdrawBook_Success(t *testing.T) {
+	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	book := createTestBook()
+	if err := book.Withdraw(); err != nil {
+		t.Fatal(err)
+	}
+
+	mockBookUseCase.EXPECT().
+		Withdraw(gomock.Any(), book.ID).
+		Return(book, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/books/"+book.ID.String()+"/withdraw", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.BookResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.NotNil(t, response.Data.WithdrawnAt)
+}
+
+func TestDeleteBook_Success(t *testing.T) {
+	handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	bookID := uuid.New()
+
+	mockBookUseCase.EXPECT().
+		Delete(gomock.Any(), bookID).
+		Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/books/"+bookID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestDeleteBook_Conflicts(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{"active loans", entity.ErrBookHasActiveLoans, "BOOK_HAS_ACTIVE_LOANS"},
+		{"history", entity.ErrBookHasHistory, "BOOK_HAS_HISTORY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, _, mockBookUseCase, _, _, ctrl := setupTestHandler(t)
+			defer ctrl.Finish()
+			router := setupTestRouter(handler)
+
+			bookID := uuid.New()
+
+			mockBookUseCase.EXPECT().
+				Delete(gomock.Any(), bookID).
+				Return(tt.err)
+
+			req := httptest.NewRequest(http.MethodDelete, "/books/"+bookID.String(), nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusConflict, w.Code)
+
+			var response generated.ErrorResponse
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, *response.Code)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"net/http"
+
+	"bookhub/api/generated"
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Branch handlers
+
+func (h *Handler) ListBranches(c *gin.Context) {
+	branches, err := h.branchUseCase.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("failed to list branches"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.BranchListResponse{
+		Data: branchesToResponse(branches),
+	})
+}
+
+func (h *Handler) CreateBranch(c *gin.Context) {
+	var req generated.CreateBranchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	input := usecase.CreateBranchInput{
+		Code: req.Code,
+		Name: req.Name,
+	}
+	if req.Address != nil {
+		input.Address = *req.Address
+	}
+
+	branch, err := h.branchUseCase.Create(c.Request.Context(), input)
+	if err != nil {
+		handleBranchError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, generated.BranchResponse{
+		Data: branchToResponse(branch),
+	})
+}
+
+func (h *Handler) GetBranchById(c *gin.Context, id openapi_types.UUID) {
+	branch, err := h.branchUseCase.GetByID(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleBranchError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.BranchResponse{
+		Data: branchToResponse(branch),
+	})
+}
+
+func (h *Handler) UpdateBranch(c *gin.Context, id openapi_types.UUID) {
+	var req generated.UpdateBranchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	branch, err := h.branchUseCase.Update(c.Request.Context(), uuid.UUID(id), usecase.UpdateBranchInput{
+		Name:    req.Name,
+		Address: req.Address,
+	})
+	if err != nil {
+		handleBranchError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.BranchResponse{
+		Data: branchToResponse(branch),
+	})
+}
+
+func (h *Handler) DeleteBranch(c *gin.Context, id openapi_types.UUID) {
+	if err := h.branchUseCase.Delete(c.Request.Context(), uuid.UUID(id)); err != nil {
+		handleBranchError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.MessageResponse{
+		Message: strPtr("branch deleted successfully"),
+	})
+}
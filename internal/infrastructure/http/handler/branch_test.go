@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListBranches_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouterAs(m.handler, uuid.New(), entity.RoleMember)
+
+	branch, _ := entity.NewBranch(entity.DefaultBranchCode, "Main Library", "")
+
+	m.branchUseCase.EXPECT().
+		List(gomock.Any()).
+		Return([]*entity.Branch{branch}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/branches", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.BranchListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+	assert.Equal(t, entity.DefaultBranchCode, *(*response.Data)[0].Code)
+}
+
+func TestCreateBranch_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	input := usecase.CreateBranchInput{Code: "NORTH", Name: "North Branch", Address: "1 North St"}
+	branch, _ := entity.NewBranch(input.Code, input.Name, input.Address)
+
+	m.branchUseCase.EXPECT().
+		Create(gomock.Any(), input).
+		Return(branch, nil)
+
+	body, _ := json.Marshal(generated.CreateBranchRequest{
+		Code:    input.Code,
+		Name:    input.Name,
+		Address: &input.Address,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/branches", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response generated.BranchResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "NORTH", *response.Data.Code)
+}
+
+func TestCreateBranch_CodeAlreadyExists(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	m.branchUseCase.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrBranchCodeAlreadyExists)
+
+	body, _ := json.Marshal(generated.CreateBranchRequest{Code: "MAIN", Name: "Main Library"})
+
+	req := httptest.NewRequest(http.MethodPost, "/branches", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "BRANCH_CODE_EXISTS", *response.Code)
+}
+
+func TestDeleteBranch_InUse(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	branchID := uuid.New()
+
+	m.branchUseCase.EXPECT().
+		Delete(gomock.Any(), branchID).
+		Return(entity.ErrBranchInUse)
+
+	req := httptest.NewRequest(http.MethodDelete, "/branches/"+branchID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "BRANCH_IN_USE", *response.Code)
+}
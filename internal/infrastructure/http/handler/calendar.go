@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"bookhub/api/generated"
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Calendar handlers
+
+func (h *Handler) GetBranchOpeningHours(c *gin.Context, id openapi_types.UUID) {
+	hours, err := h.calendarUseCase.GetOpeningHours(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleCalendarError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.OpeningHoursResponse{
+		Data: openingHoursToResponse(hours),
+	})
+}
+
+func (h *Handler) SetBranchOpeningHours(c *gin.Context, id openapi_types.UUID) {
+	var req generated.SetOpeningHoursRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	input := make([]usecase.OpeningHoursInput, len(req.Hours))
+	for i, h := range req.Hours {
+		input[i] = usecase.OpeningHoursInput{
+			Weekday:  weekdayFromOpenAPI(h.Weekday),
+			OpensAt:  h.OpensAt,
+			ClosesAt: h.ClosesAt,
+		}
+	}
+
+	hours, err := h.calendarUseCase.SetOpeningHours(c.Request.Context(), uuid.UUID(id), input)
+	if err != nil {
+		handleCalendarError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.OpeningHoursResponse{
+		Data: openingHoursToResponse(hours),
+	})
+}
+
+func (h *Handler) ListBranchClosedDates(c *gin.Context, id openapi_types.UUID, params generated.ListBranchClosedDatesParams) {
+	var from, to *time.Time
+	if params.From != nil {
+		from = &params.From.Time
+	}
+	if params.To != nil {
+		to = &params.To.Time
+	}
+
+	closedDates, err := h.calendarUseCase.ListClosedDates(c.Request.Context(), uuid.UUID(id), from, to)
+	if err != nil {
+		handleCalendarError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.ClosedDateListResponse{
+		Data: closedDatesToResponse(closedDates),
+	})
+}
+
+func (h *Handler) AddBranchClosedDate(c *gin.Context, id openapi_types.UUID) {
+	var req generated.CreateClosedDateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	input := usecase.AddClosedDateInput{Date: req.Date.Time}
+	if req.Reason != nil {
+		input.Reason = *req.Reason
+	}
+
+	closedDate, err := h.calendarUseCase.AddClosedDate(c.Request.Context(), uuid.UUID(id), input)
+	if err != nil {
+		handleCalendarError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, generated.ClosedDateResponse{
+		Data: closedDateToResponse(closedDate),
+	})
+}
+
+func (h *Handler) RemoveBranchClosedDate(c *gin.Context, id openapi_types.UUID, closedDateId openapi_types.UUID) {
+	if err := h.calendarUseCase.RemoveClosedDate(c.Request.Context(), uuid.UUID(id), uuid.UUID(closedDateId)); err != nil {
+		handleCalendarError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.MessageResponse{
+		Message: strPtr("closed date removed successfully"),
+	})
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSetBranchOpeningHours_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	branchID := uuid.New()
+	saturday, _ := entity.NewOpeningHours(branchID, time.Saturday, "09:00", "13:00")
+
+	m.calendarUseCase.EXPECT().
+		SetOpeningHours(gomock.Any(), branchID, []usecase.OpeningHoursInput{
+			{Weekday: time.Saturday, OpensAt: "09:00", ClosesAt: "13:00"},
+		}).
+		Return([]*entity.OpeningHours{saturday}, nil)
+
+	body, _ := json.Marshal(generated.SetOpeningHoursRequest{
+		Hours: []generated.OpeningHours{
+			{Weekday: generated.Saturday, OpensAt: "09:00", ClosesAt: "13:00"},
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodPut, "/branches/"+branchID.String()+"/hours", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.OpeningHoursResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+	assert.Equal(t, generated.Saturday, (*response.Data)[0].Weekday)
+	assert.Equal(t, "13:00", (*response.Data)[0].ClosesAt)
+}
+
+func TestSetBranchOpeningHours_DuplicateWeekday(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	m.calendarUseCase.EXPECT().
+		SetOpeningHours(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrDuplicateWeekday)
+
+	body, _ := json.Marshal(generated.SetOpeningHoursRequest{
+		Hours: []generated.OpeningHours{
+			{Weekday: generated.Monday, OpensAt: "09:00", ClosesAt: "12:00"},
+			{Weekday: generated.Monday, OpensAt: "14:00", ClosesAt: "18:00"},
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodPut, "/branches/"+uuid.New().String()+"/hours", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAddBranchClosedDate_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	branchID := uuid.New()
+	christmas := time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)
+	closedDate, _ := entity.NewClosedDate(branchID, christmas, "Natal")
+
+	m.calendarUseCase.EXPECT().
+		AddClosedDate(gomock.Any(), branchID, usecase.AddClosedDateInput{Date: christmas, Reason: "Natal"}).
+		Return(closedDate, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/branches/"+branchID.String()+"/closed-dates", bytes.NewBufferString(`{"date":"2025-12-25","reason":"Natal"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response generated.ClosedDateResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-12-25", response.Data.Date.String())
+}
+
+func TestAddBranchClosedDate_AlreadyExists(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	m.calendarUseCase.EXPECT().
+		AddClosedDate(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrClosedDateAlreadyExists)
+
+	req := httptest.NewRequest(http.MethodPost, "/branches/"+uuid.New().String()+"/closed-dates", bytes.NewBufferString(`{"date":"2025-12-25"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestListBranchClosedDates_Range(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouterAs(m.handler, uuid.New(), entity.RoleMember)
+
+	branchID := uuid.New()
+	from := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	m.calendarUseCase.EXPECT().
+		ListClosedDates(gomock.Any(), branchID, &from, &to).
+		Return([]*entity.ClosedDate{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/branches/"+branchID.String()+"/closed-dates?from=2025-12-01&to=2025-12-31", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestRemoveBranchClosedDate_NotFound(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	m.calendarUseCase.EXPECT().
+		RemoveClosedDate(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(entity.ErrClosedDateNotFound)
+
+	req := httptest.NewRequest(http.MethodDelete, "/branches/"+uuid.New().String()+"/closed-dates/"+uuid.New().String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
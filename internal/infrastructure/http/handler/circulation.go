@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"bookhub/api/generated"
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Circulation desk handlers
+
+func (h *Handler) CheckOut(c *gin.Context) {
+	var req generated.CheckOutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	var dueDate *time.Time
+	if req.DueDate != nil {
+		t := req.DueDate.Time
+		dueDate = &t
+	}
+
+	loan, err := h.loanUseCase.CheckOut(c.Request.Context(), usecase.CheckOutInput{
+		CardNumber: req.CardNumber,
+		Barcode:    req.Barcode,
+		DueDate:    dueDate,
+	})
+	if err != nil {
+		handleLoanError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, generated.LoanResponse{
+		Data: loanToResponse(loan),
+	})
+}
+
+func (h *Handler) CheckIn(c *gin.Context) {
+	var req generated.CheckInRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	loan, err := h.loanUseCase.CheckIn(c.Request.Context(), usecase.CheckInInput{
+		Barcode:    req.Barcode,
+		ReturnedAt: req.ReturnedAt,
+		Damage:     damageReport(req.Damaged, req.DamageChargeCents),
+	})
+	if err != nil {
+		handleLoanError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.LoanResponse{
+		Data: loanToResponse(loan),
+	})
+}
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCheckOut_Success(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	loanWithDetails := createTestLoanWithDetails(uuid.New(), uuid.New())
+
+	mockLoanUseCase.EXPECT().
+		CheckOut(gomock.Any(), usecase.CheckOutInput{CardNumber: "0004821937", Barcode: "9780132350884-001"}).
+		Return(loanWithDetails, nil)
+
+	body, _ := json.Marshal(generated.CheckOutRequest{
+		CardNumber: "0004821937",
+		Barcode:    "9780132350884-001",
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/circulation/checkout", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response generated.LoanResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.NotNil(t, response.Data)
+}
+
+func TestCheckOut_UnknownBarcode(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	mockLoanUseCase.EXPECT().
+		CheckOut(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrBookCopyNotFound)
+
+	body, _ := json.Marshal(generated.CheckOutRequest{
+		CardNumber: "0004821937",
+		Barcode:    "UNKNOWN",
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/circulation/checkout", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestCheckOut_CopyUnavailable(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	mockLoanUseCase.EXPECT().
+		CheckOut(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrCopyNotAvailable)
+
+	body, _ := json.Marshal(generated.CheckOutRequest{
+		CardNumber: "0004821937",
+		Barcode:    "9780132350884-001",
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/circulation/checkout", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "COPY_UNAVAILABLE", *response.Code)
+}
+
+func TestCheckOut_InvalidBody(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	req := httptest.NewRequest(http.MethodPost, "/circulation/checkout", bytes.NewBufferString("invalid json"))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestCheckIn_Backdated(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	loanWithDetails := createTestLoanWithDetails(uuid.New(), uuid.New())
+	returnedAt := time.Date(2026, 3, 14, 8, 0, 0, 0, time.UTC)
+	loanWithDetails.Loan.ReturnedAt = &returnedAt
+	loanWithDetails.Loan.Status = entity.LoanStatusReturned
+
+	mockLoanUseCase.EXPECT().
+		CheckIn(gomock.Any(), usecase.CheckInInput{Barcode: "9780132350884-001", ReturnedAt: &returnedAt}).
+		Return(loanWithDetails, nil)
+
+	body, _ := json.Marshal(generated.CheckInRequest{
+		Barcode:    "9780132350884-001",
+		ReturnedAt: &returnedAt,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/circulation/checkin", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.LoanResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.True(t, returnedAt.Equal(*response.Data.ReturnedAt))
+}
+
+func TestCheckIn_Damaged(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	loanWithDetails := createTestLoanWithDetails(uuid.New(), uuid.New())
+	loanWithDetails.Loan.Status = entity.LoanStatusDamaged
+
+	mockLoanUseCase.EXPECT().
+		CheckIn(gomock.Any(), usecase.CheckInInput{Barcode: "9780132350884-001", Damage: &usecase.DamageReport{}}).
+		Return(loanWithDetails, nil)
+
+	damaged := true
+	body, _ := json.Marshal(generated.CheckInRequest{
+		Barcode: "9780132350884-001",
+		Damaged: &damaged,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/circulation/checkin", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestCheckIn_CopyNotOnLoan(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	mockLoanUseCase.EXPECT().
+		CheckIn(gomock.Any(), usecase.CheckInInput{Barcode: "9780132350884-001"}).
+		Return(nil, entity.ErrCopyNotOnLoan)
+
+	body, _ := json.Marshal(generated.CheckInRequest{Barcode: "9780132350884-001"})
+
+	req := httptest.NewRequest(http.MethodPost, "/circulation/checkin", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "COPY_NOT_ON_LOAN", *response.Code)
+}
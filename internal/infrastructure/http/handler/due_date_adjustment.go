@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net/http"
+
+	"bookhub/api/generated"
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Due date adjustment handlers
+
+func (h *Handler) AdjustLoanDueDates(c *gin.Context) {
+	var req generated.AdjustDueDatesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	input := usecase.AdjustDueDatesInput{
+		DueFrom: req.DueFrom.Time,
+		DueTo:   req.DueTo.Time,
+	}
+	if req.BranchId != nil {
+		branchID := uuid.UUID(*req.BranchId)
+		input.BranchID = &branchID
+	}
+	if req.BookId != nil {
+		bookID := uuid.UUID(*req.BookId)
+		input.BookID = &bookID
+	}
+	if req.ShiftDays != nil {
+		input.ShiftDays = *req.ShiftDays
+	}
+	if req.NewDueDate != nil {
+		newDueDate := req.NewDueDate.Time
+		input.NewDueDate = &newDueDate
+	}
+	if req.Reason != nil {
+		input.Reason = *req.Reason
+	}
+
+	adjustment, err := h.dueDateAdjustmentUseCase.AdjustDueDates(c.Request.Context(), input)
+	if err != nil {
+		handleDueDateAdjustmentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, generated.DueDateAdjustmentResponse{
+		Data: dueDateAdjustmentToResponse(adjustment),
+	})
+}
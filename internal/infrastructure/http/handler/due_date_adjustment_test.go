@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdjustLoanDueDates_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	branchID := uuid.New()
+	dueFrom := time.Date(2099, 3, 2, 0, 0, 0, 0, time.UTC)
+	dueTo := time.Date(2099, 3, 6, 0, 0, 0, 0, time.UTC)
+	adjustment, _ := entity.NewDueDateAdjustment(uuid.New(), dueFrom, dueTo, 7, nil, "Branch flooded")
+	adjustment.BranchID = &branchID
+	adjustment.LoansAdjusted = 12
+
+	m.dueDateAdjustmentUseCase.EXPECT().
+		AdjustDueDates(gomock.Any(), usecase.AdjustDueDatesInput{
+			DueFrom:   dueFrom,
+			DueTo:     dueTo,
+			BranchID:  &branchID,
+			ShiftDays: 7,
+			Reason:    "Branch flooded",
+		}).
+		Return(adjustment, nil)
+
+	shiftDays := 7
+	reason := "Branch flooded"
+	body, _ := json.Marshal(generated.AdjustDueDatesRequest{
+		DueFrom:   openapi_types.Date{Time: dueFrom},
+		DueTo:     openapi_types.Date{Time: dueTo},
+		BranchId:  &branchID,
+		ShiftDays: &shiftDays,
+		Reason:    &reason,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/loans/due-date-adjustments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response generated.DueDateAdjustmentResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, *response.Data.LoansAdjusted)
+	assert.Equal(t, 7, *response.Data.ShiftDays)
+	assert.Nil(t, response.Data.NewDueDate)
+}
+
+func TestAdjustLoanDueDates_InvalidRange(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	m.dueDateAdjustmentUseCase.EXPECT().
+		AdjustDueDates(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrInvalidDueDateRange)
+
+	shiftDays := 7
+	body, _ := json.Marshal(generated.AdjustDueDatesRequest{
+		DueFrom:   openapi_types.Date{Time: time.Date(2099, 3, 6, 0, 0, 0, 0, time.UTC)},
+		DueTo:     openapi_types.Date{Time: time.Date(2099, 3, 2, 0, 0, 0, 0, time.UTC)},
+		ShiftDays: &shiftDays,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/loans/due-date-adjustments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAdjustLoanDueDates_BookNotFound(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	m.dueDateAdjustmentUseCase.EXPECT().
+		AdjustDueDates(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrBookNotFound)
+
+	bookID := uuid.New()
+	shiftDays := 7
+	body, _ := json.Marshal(generated.AdjustDueDatesRequest{
+		DueFrom:   openapi_types.Date{Time: time.Date(2099, 3, 2, 0, 0, 0, 0, time.UTC)},
+		DueTo:     openapi_types.Date{Time: time.Date(2099, 3, 6, 0, 0, 0, 0, time.UTC)},
+		BookId:    &bookID,
+		ShiftDays: &shiftDays,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/loans/due-date-adjustments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
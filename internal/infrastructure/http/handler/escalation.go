@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Escalation handlers
+
+func (h *Handler) ListLoanEscalations(c *gin.Context, id openapi_types.UUID) {
+	loanID, err := uuid.Parse(id.String())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid loan ID"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	if !entity.IsStaffRole(callerRole(c)) {
+		existing, err := h.loanUseCase.GetByID(c.Request.Context(), loanID)
+		if err != nil {
+			handleLoanError(c, err)
+			return
+		}
+		if !requireSelfOrRole(c, existing.Loan.UserID) {
+			return
+		}
+	}
+
+	escalations, err := h.escalationUseCase.ListLoanEscalations(c.Request.Context(), loanID)
+	if err != nil {
+		handleLoanError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.LoanEscalationListResponse{
+		Data: loanEscalationsToResponse(escalations),
+	})
+}
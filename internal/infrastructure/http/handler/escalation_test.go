@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListLoanEscalations_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	loan := createTestLoan(uuid.New(), uuid.New())
+	escalations := []*entity.LoanEscalation{
+		entity.NewLoanEscalation(loan, 1, entity.EscalationNotice, 7),
+		entity.NewLoanEscalation(loan, 2, entity.EscalationBlock, 14),
+	}
+
+	m.escalationUseCase.EXPECT().
+		ListLoanEscalations(gomock.Any(), loan.ID).
+		Return(escalations, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/loans/"+loan.ID.String()+"/escalations", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.LoanEscalationListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 2)
+	assert.Equal(t, generated.LoanEscalationActionBlock, *(*response.Data)[1].Action)
+	assert.Equal(t, 14, *(*response.Data)[1].DaysOverdue)
+}
+
+func TestListLoanEscalations_MemberOwnLoan(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+
+	userID := uuid.New()
+	router := setupTestRouterAs(m.handler, userID, entity.RoleMember)
+	loan := createTestLoanWithDetails(userID, uuid.New())
+
+	m.loanUseCase.EXPECT().
+		GetByID(gomock.Any(), loan.Loan.ID).
+		Return(loan, nil)
+	m.escalationUseCase.EXPECT().
+		ListLoanEscalations(gomock.Any(), loan.Loan.ID).
+		Return([]*entity.LoanEscalation{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/loans/"+loan.Loan.ID.String()+"/escalations", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestListLoanEscalations_MemberOtherLoanForbidden(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouterAs(m.handler, uuid.New(), entity.RoleMember)
+
+	loan := createTestLoanWithDetails(uuid.New(), uuid.New())
+
+	m.loanUseCase.EXPECT().
+		GetByID(gomock.Any(), loan.Loan.ID).
+		Return(loan, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/loans/"+loan.Loan.ID.String()+"/escalations", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestListLoanEscalations_NotFound(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	loanID := uuid.New()
+
+	m.escalationUseCase.EXPECT().
+		ListLoanEscalations(gomock.Any(), loanID).
+		Return(nil, entity.ErrLoanNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/loans/"+loanID.String()+"/escalations", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"net/http"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Fine handlers
+
+func (h *Handler) ListFines(c *gin.Context, params generated.ListFinesParams) {
+	page := 1
+	limit := 10
+
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	var filter repository.FineFilter
+	if params.UserId != nil {
+		id := uuid.UUID(*params.UserId)
+		filter.UserID = &id
+	}
+	if params.Status != nil {
+		s := string(*params.Status)
+		filter.Status = &s
+	}
+
+	// Members only see their own fines
+	if !entity.IsStaffRole(callerRole(c)) {
+		id, _ := callerID(c)
+		if filter.UserID != nil && *filter.UserID != id {
+			respondForbidden(c)
+			return
+		}
+		filter.UserID = &id
+	}
+
+	fines, total, err := h.fineUseCase.List(c.Request.Context(), page, limit, filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("failed to list fines"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+		return
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	c.JSON(http.StatusOK, generated.FineListResponse{
+		Data:       finesToResponse(fines),
+		Pagination: paginationResponse(page, limit, total, totalPages),
+	})
+}
+
+func (h *Handler) GetFineById(c *gin.Context, id openapi_types.UUID) {
+	fine, err := h.fineUseCase.GetByID(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleFineError(c, err)
+		return
+	}
+
+	if !requireSelfOrRole(c, fine.UserID, entity.RoleAdmin, entity.RoleLibrarian) {
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.FineResponse{
+		Data: fineToResponse(fine),
+	})
+}
+
+func (h *Handler) PayFine(c *gin.Context, id openapi_types.UUID) {
+	var req generated.PayFineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	fine, err := h.fineUseCase.Pay(c.Request.Context(), uuid.UUID(id), req.AmountCents)
+	if err != nil {
+		handleFineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.FineResponse{
+		Data: fineToResponse(fine),
+	})
+}
+
+func (h *Handler) WaiveFine(c *gin.Context, id openapi_types.UUID) {
+	fine, err := h.fineUseCase.Waive(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleFineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.FineResponse{
+		Data: fineToResponse(fine),
+	})
+}
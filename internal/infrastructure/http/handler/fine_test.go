@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListFines_MemberSeesOwnFines(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+
+	userID := uuid.New()
+	router := setupTestRouterAs(m.handler, userID, entity.RoleMember)
+
+	fines := []*entity.Fine{entity.NewFine(userID, uuid.New(), entity.FineReasonOverdue, 300)}
+
+	m.fineUseCase.EXPECT().
+		List(gomock.Any(), 1, 10, repository.FineFilter{UserID: &userID}).
+		Return(fines, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/fines", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.FineListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+	assert.Equal(t, int64(300), *(*response.Data)[0].BalanceCents)
+}
+
+func TestListFines_MemberOtherUserForbidden(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouterAs(m.handler, uuid.New(), entity.RoleMember)
+
+	req := httptest.NewRequest(http.MethodGet, "/fines?user_id="+uuid.New().String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestGetFineById_MemberOtherUsersFineForbidden(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouterAs(m.handler, uuid.New(), entity.RoleMember)
+
+	fine := entity.NewFine(uuid.New(), uuid.New(), entity.FineReasonOverdue, 300)
+
+	m.fineUseCase.EXPECT().
+		GetByID(gomock.Any(), fine.ID).
+		Return(fine, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/fines/"+fine.ID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestPayFine_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	fine := entity.NewFine(uuid.New(), uuid.New(), entity.FineReasonOverdue, 300)
+	paid := *fine
+	_ = paid.Pay(100)
+
+	m.fineUseCase.EXPECT().
+		Pay(gomock.Any(), fine.ID, int64(100)).
+		Return(&paid, nil)
+
+	body, _ := json.Marshal(generated.PayFineRequest{AmountCents: 100})
+
+	req := httptest.NewRequest(http.MethodPost, "/fines/"+fine.ID.String()+"/payments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.FineResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), *response.Data.PaidCents)
+	assert.Equal(t, int64(200), *response.Data.BalanceCents)
+	assert.Equal(t, generated.Open, *response.Data.Status)
+}
+
+func TestPayFine_ExceedsBalance(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	fineID := uuid.New()
+
+	m.fineUseCase.EXPECT().
+		Pay(gomock.Any(), fineID, int64(5000)).
+		Return(nil, entity.ErrPaymentExceedsBalance)
+
+	body, _ := json.Marshal(generated.PayFineRequest{AmountCents: 5000})
+
+	req := httptest.NewRequest(http.MethodPost, "/fines/"+fineID.String()+"/payments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "PAYMENT_EXCEEDS_BALANCE", *response.Code)
+}
+
+func TestWaiveFine_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	fine := entity.NewFine(uuid.New(), uuid.New(), entity.FineReasonOverdue, 300)
+	waived := *fine
+	_ = waived.Waive()
+
+	m.fineUseCase.EXPECT().
+		Waive(gomock.Any(), fine.ID).
+		Return(&waived, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/fines/"+fine.ID.String()+"/waive", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.FineResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, generated.Waived, *response.Data.Status)
+	assert.Equal(t, int64(0), *response.Data.BalanceCents)
+}
+
+func TestWaiveFine_NotFound(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	fineID := uuid.New()
+
+	m.fineUseCase.EXPECT().
+		Waive(gomock.Any(), fineID).
+		Return(nil, entity.ErrFineNotFound)
+
+	req := httptest.NewRequest(http.MethodPatch, "/fines/"+fineID.String()+"/waive", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -6,23 +6,56 @@ import (
 )
 
 type Handler struct {
-	userUseCase usecase.UserUseCase
-	bookUseCase usecase.BookUseCase
-	loanUseCase usecase.LoanUseCase
-	jwtService  auth.JWTService
+	userUseCase              usecase.UserUseCase
+	bookUseCase              usecase.BookUseCase
+	loanUseCase              usecase.LoanUseCase
+	holdUseCase              usecase.HoldUseCase
+	fineUseCase              usecase.FineUseCase
+	policyUseCase            usecase.LoanPolicyUseCase
+	copyUseCase              usecase.BookCopyUseCase
+	branchUseCase            usecase.BranchUseCase
+	transferUseCase          usecase.TransferUseCase
+	calendarUseCase          usecase.CalendarUseCase
+	dueDateAdjustmentUseCase usecase.DueDateAdjustmentUseCase
+	escalationUseCase        usecase.EscalationUseCase
+	webhookUseCase           usecase.WebhookUseCase
+	auditUseCase             usecase.AuditUseCase
+	jwtService               auth.JWTService
 }
 
 func NewHandler(
 	userUseCase usecase.UserUseCase,
 	bookUseCase usecase.BookUseCase,
 	loanUseCase usecase.LoanUseCase,
+	holdUseCase usecase.HoldUseCase,
+	fineUseCase usecase.FineUseCase,
+	policyUseCase usecase.LoanPolicyUseCase,
+	copyUseCase usecase.BookCopyUseCase,
+	branchUseCase usecase.BranchUseCase,
+	transferUseCase usecase.TransferUseCase,
+	calendarUseCase usecase.CalendarUseCase,
+	dueDateAdjustmentUseCase usecase.DueDateAdjustmentUseCase,
+	escalationUseCase usecase.EscalationUseCase,
+	webhookUseCase usecase.WebhookUseCase,
+	auditUseCase usecase.AuditUseCase,
 	jwtService auth.JWTService,
 ) *Handler {
 	return &Handler{
-		userUseCase: userUseCase,
-		bookUseCase: bookUseCase,
-		loanUseCase: loanUseCase,
-		jwtService:  jwtService,
+		userUseCase:              userUseCase,
+		bookUseCase:              bookUseCase,
+		loanUseCase:              loanUseCase,
+		holdUseCase:              holdUseCase,
+		fineUseCase:              fineUseCase,
+		policyUseCase:            policyUseCase,
+		copyUseCase:              copyUseCase,
+		branchUseCase:            branchUseCase,
+		transferUseCase:          transferUseCase,
+		calendarUseCase:          calendarUseCase,
+		dueDateAdjustmentUseCase: dueDateAdjustmentUseCase,
+		escalationUseCase:        escalationUseCase,
+		webhookUseCase:           webhookUseCase,
+		auditUseCase:             auditUseCase,
+		jwtService:               jwtService,
 	}
 }
 
@@ -7,7 +7,9 @@ import (
 	"bookhub/api/generated"
 	"bookhub/internal/domain/entity"
 	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/http/middleware"
 	"bookhub/internal/mocks"
+	"bookhub/internal/usecase"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -15,22 +17,82 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
-func setupTestHandler(t *testing.T) (*Handler, *mocks.MockUserUseCase, *mocks.MockBookUseCase, *mocks.MockLoanUseCase, *mocks.MockJWTService, *gomock.Controller) {
+// handlerMocks is a Handler wired to a mock for every dependency, so each
+// test sets expectations only on the mocks it exercises.
+type handlerMocks struct {
+	handler *Handler
+	ctrl    *gomock.Controller
+
+	userUseCase              *mocks.MockUserUseCase
+	bookUseCase              *mocks.MockBookUseCase
+	loanUseCase              *mocks.MockLoanUseCase
+	holdUseCase              *mocks.MockHoldUseCase
+	fineUseCase              *mocks.MockFineUseCase
+	loanPolicyUseCase        *mocks.MockLoanPolicyUseCase
+	bookCopyUseCase          *mocks.MockBookCopyUseCase
+	branchUseCase            *mocks.MockBranchUseCase
+	transferUseCase          *mocks.MockTransferUseCase
+	calendarUseCase          *mocks.MockCalendarUseCase
+	dueDateAdjustmentUseCase *mocks.MockDueDateAdjustmentUseCase
+	escalationUseCase        *mocks.MockEscalationUseCase
+	webhookUseCase           *mocks.MockWebhookUseCase
+	auditUseCase             *mocks.MockAuditUseCase
+	jwtService               *mocks.MockJWTService
+}
+
+func setupHandlerMocks(t *testing.T) *handlerMocks {
 	ctrl := gomock.NewController(t)
 
-	mockUserUseCase := mocks.NewMockUserUseCase(ctrl)
-	mockBookUseCase := mocks.NewMockBookUseCase(ctrl)
-	mockLoanUseCase := mocks.NewMockLoanUseCase(ctrl)
-	mockJWTService := mocks.NewMockJWTService(ctrl)
+	m := &handlerMocks{
+		ctrl:                     ctrl,
+		userUseCase:              mocks.NewMockUserUseCase(ctrl),
+		bookUseCase:              mocks.NewMockBookUseCase(ctrl),
+		loanUseCase:              mocks.NewMockLoanUseCase(ctrl),
+		holdUseCase:              mocks.NewMockHoldUseCase(ctrl),
+		fineUseCase:              mocks.NewMockFineUseCase(ctrl),
+		loanPolicyUseCase:        mocks.NewMockLoanPolicyUseCase(ctrl),
+		bookCopyUseCase:          mocks.NewMockBookCopyUseCase(ctrl),
+		branchUseCase:            mocks.NewMockBranchUseCase(ctrl),
+		transferUseCase:          mocks.NewMockTransferUseCase(ctrl),
+		calendarUseCase:          mocks.NewMockCalendarUseCase(ctrl),
+		dueDateAdjustmentUseCase: mocks.NewMockDueDateAdjustmentUseCase(ctrl),
+		escalationUseCase:        mocks.NewMockEscalationUseCase(ctrl),
+		webhookUseCase:           mocks.NewMockWebhookUseCase(ctrl),
+		auditUseCase:             mocks.NewMockAuditUseCase(ctrl),
+		jwtService:               mocks.NewMockJWTService(ctrl),
+	}
+	m.handler = NewHandler(m.userUseCase, m.bookUseCase, m.loanUseCase, m.holdUseCase, m.fineUseCase, m.loanPolicyUseCase, m.bookCopyUseCase, m.branchUseCase, m.transferUseCase, m.calendarUseCase, m.dueDateAdjustmentUseCase, m.escalationUseCase, m.webhookUseCase, m.auditUseCase, m.jwtService)
+	return m
+}
 
-	handler := NewHandler(mockUserUseCase, mockBookUseCase, mockLoanUseCase, mockJWTService)
-	return handler, mockUserUseCase, mockBookUseCase, mockLoanUseCase, mockJWTService, ctrl
+func setupTestHandler(t *testing.T) (*Handler, *mocks.MockUserUseCase, *mocks.MockBookUseCase, *mocks.MockLoanUseCase, *mocks.MockJWTService, *gomock.Controller) {
+	m := setupHandlerMocks(t)
+	return m.handler, m.userUseCase, m.bookUseCase, m.loanUseCase, m.jwtService, m.ctrl
 }
 
+// setupTestRouter serves handler as an admin, so tests exercise the handlers
+// without running into role checks.
 func setupTestRouter(handler *Handler) *gin.Engine {
+	return setupTestRouterAs(handler, uuid.New(), entity.RoleAdmin)
+}
+
+// setupTestRouterAs serves handler as if the JWT middleware had authenticated
+// userID with role.
+func setupTestRouterAs(handler *Handler, userID uuid.UUID, role string) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
-	generated.RegisterHandlers(router, handler)
+	generated.RegisterHandlersWithOptions(router, handler, generated.GinServerOptions{
+		Middlewares: []generated.MiddlewareFunc{
+			func(c *gin.Context) {
+				c.Set(middleware.UserIDKey, userID)
+				c.Set(middleware.UserRoleKey, role)
+				c.Request = c.Request.WithContext(usecase.ContextWithCaller(c.Request.Context(), usecase.Caller{
+					UserID: userID,
+					Role:   role,
+				}))
+			},
+		},
+	})
 	return router
 }
 
@@ -40,6 +102,7 @@ func createTestUser() *entity.User {
 		Name:         "Test User",
 		Email:        "test@example.com",
 		PasswordHash: "hashedpassword123",
+		Role:         entity.RoleMember,
 		Active:       true,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
@@ -81,6 +144,14 @@ func createTestLoanWithDetails(userID, bookID uuid.UUID) *repository.LoanWithDet
 	}
 }
 
+func createTestHold(userID, bookID uuid.UUID, position int) *repository.HoldWithPosition {
+	hold := entity.NewHold(userID, bookID)
+	return &repository.HoldWithPosition{
+		Hold:     hold,
+		Position: position,
+	}
+}
+
 func TestNewHandler(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -88,9 +159,20 @@ func TestNewHandler(t *testing.T) {
 	mockUserUseCase := mocks.NewMockUserUseCase(ctrl)
 	mockBookUseCase := mocks.NewMockBookUseCase(ctrl)
 	mockLoanUseCase := mocks.NewMockLoanUseCase(ctrl)
+	mockHoldUseCase := mocks.NewMockHoldUseCase(ctrl)
+	mockFineUseCase := mocks.NewMockFineUseCase(ctrl)
+	mockLoanPolicyUseCase := mocks.NewMockLoanPolicyUseCase(ctrl)
+	mockBookCopyUseCase := mocks.NewMockBookCopyUseCase(ctrl)
+	mockBranchUseCase := mocks.NewMockBranchUseCase(ctrl)
+	mockTransferUseCase := mocks.NewMockTransferUseCase(ctrl)
+	mockCalendarUseCase := mocks.NewMockCalendarUseCase(ctrl)
+	mockDueDateAdjustmentUseCase := mocks.NewMockDueDateAdjustmentUseCase(ctrl)
+	mockEscalationUseCase := mocks.NewMockEscalationUseCase(ctrl)
+	mockWebhookUseCase := mocks.NewMockWebhookUseCase(ctrl)
+	mockAuditUseCase := mocks.NewMockAuditUseCase(ctrl)
 	mockJWTService := mocks.NewMockJWTService(ctrl)
 
-	handler := NewHandler(mockUserUseCase, mockBookUseCase, mockLoanUseCase, mockJWTService)
+	handler := NewHandler(mockUserUseCase, mockBookUseCase, mockLoanUseCase, mockHoldUseCase, mockFineUseCase, mockLoanPolicyUseCase, mockBookCopyUseCase, mockBranchUseCase, mockTransferUseCase, mockCalendarUseCase, mockDueDateAdjustmentUseCase, mockEscalationUseCase, mockWebhookUseCase, mockAuditUseCase, mockJWTService)
 
 	assert.NotNil(t, handler)
 	assert.Equal(t, mockJWTService, handler.JWTService())
@@ -2,11 +2,15 @@ package handler
 
 import (
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"bookhub/api/generated"
 	"bookhub/internal/domain/entity"
 	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/http/middleware"
+	"bookhub/internal/usecase"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -31,17 +35,102 @@ func emailToOpenAPI(email string) *openapi_types.Email {
 	return &oaEmail
 }
 
+// callerID returns the authenticated user set by the JWT middleware.
+func callerID(c *gin.Context) (uuid.UUID, bool) {
+	id, ok := c.Get(middleware.UserIDKey)
+	if !ok {
+		return uuid.Nil, false
+	}
+	userID, ok := id.(uuid.UUID)
+	return userID, ok
+}
+
+func callerRole(c *gin.Context) string {
+	return c.GetString(middleware.UserRoleKey)
+}
+
+// requireSelfOrRole lets the request through when the caller is ownerID or has
+// one of roles. Otherwise it writes a 403 response and returns false.
+func requireSelfOrRole(c *gin.Context, ownerID uuid.UUID, roles ...string) bool {
+	if slices.Contains(roles, callerRole(c)) {
+		return true
+	}
+	if id, ok := callerID(c); ok && id == ownerID {
+		return true
+	}
+	respondForbidden(c)
+	return false
+}
+
+func respondForbidden(c *gin.Context) {
+	c.JSON(http.StatusForbidden, generated.ErrorResponse{
+		Error: strPtr("insufficient permissions for this operation"),
+		Code:  strPtr("FORBIDDEN"),
+	})
+}
+
+// bindOptionalJSON binds the request body into obj when one was sent. It
+// answers 400 and returns false when the body is not valid JSON.
+func bindOptionalJSON(c *gin.Context, obj any) bool {
+	if c.Request.ContentLength == 0 {
+		return true
+	}
+	if err := c.ShouldBindJSON(obj); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return false
+	}
+	return true
+}
+
+// parseSort reads a sort query parameter against the fields allowed for a
+// list. It answers 400 and returns false when the parameter is invalid.
+func parseSort(c *gin.Context, sort *string, allowed []string) ([]repository.SortField, bool) {
+	if sort == nil {
+		return nil, true
+	}
+	fields, err := repository.ParseSort(*sort, allowed)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("VALIDATION_ERROR"),
+		})
+		return nil, false
+	}
+	return fields, true
+}
+
+// damageReport reads the damaged-on-return option of a return request. It
+// is nil when the book came back intact.
+func damageReport(damaged *bool, chargeCents *int64) *usecase.DamageReport {
+	if damaged == nil || !*damaged {
+		return nil
+	}
+	report := &usecase.DamageReport{}
+	if chargeCents != nil {
+		report.ChargeCents = *chargeCents
+	}
+	return report
+}
+
 func userToResponse(user *entity.User) *generated.User {
 	if user == nil {
 		return nil
 	}
+	role := generated.UserRole(user.Role)
 	return &generated.User{
-		Id:        uuidToOpenAPI(user.ID),
-		Name:      &user.Name,
-		Email:     emailToOpenAPI(user.Email),
-		Active:    &user.Active,
-		CreatedAt: &user.CreatedAt,
-		UpdatedAt: &user.UpdatedAt,
+		Id:         uuidToOpenAPI(user.ID),
+		Name:       &user.Name,
+		Email:      emailToOpenAPI(user.Email),
+		Role:       &role,
+		Category:   &user.Category,
+		CardNumber: &user.CardNumber,
+		Active:     &user.Active,
+		Blocked:    &user.Blocked,
+		CreatedAt:  &user.CreatedAt,
+		UpdatedAt:  &user.UpdatedAt,
 	}
 }
 
@@ -67,9 +156,12 @@ func bookToResponse(book *entity.Book) *generated.Book {
 		Author:             &book.Author,
 		Isbn:               &book.ISBN,
 		PublishedYear:      &book.PublishedYear,
+		Category:           &book.Category,
 		TotalCopies:        &book.TotalCopies,
 		AvailableCopies:    &book.AvailableCopies,
 		AvailabilityStatus: &status,
+		Branches:           branchAvailabilityToResponse(book.Branches),
+		WithdrawnAt:        book.WithdrawnAt,
 		CreatedAt:          &book.CreatedAt,
 		UpdatedAt:          &book.UpdatedAt,
 	}
@@ -86,21 +178,221 @@ func booksToResponse(books []*entity.Book) *[]generated.Book {
 	return &result
 }
 
+func bookMatchesToResponse(matches []*repository.BookMatch) *[]generated.Book {
+	result := make([]generated.Book, len(matches))
+	for i, match := range matches {
+		b := bookToResponse(match.Book)
+		if b != nil {
+			b.Match = &generated.BookSearchMatch{
+				Score:           &match.Score,
+				TitleHighlight:  &match.TitleHighlight,
+				AuthorHighlight: &match.AuthorHighlight,
+			}
+			result[i] = *b
+		}
+	}
+	return &result
+}
+
+func bookCopyToResponse(bookCopy *entity.BookCopy) *generated.BookCopy {
+	if bookCopy == nil {
+		return nil
+	}
+	condition := generated.BookCopyCondition(bookCopy.Condition)
+	status := generated.BookCopyStatus(bookCopy.Status)
+	return &generated.BookCopy{
+		Id:        uuidToOpenAPI(bookCopy.ID),
+		BookId:    uuidToOpenAPI(bookCopy.BookID),
+		BranchId:  uuidToOpenAPI(bookCopy.BranchID),
+		Barcode:   &bookCopy.Barcode,
+		Location:  &bookCopy.Location,
+		Condition: &condition,
+		Status:    &status,
+		CreatedAt: &bookCopy.CreatedAt,
+		UpdatedAt: &bookCopy.UpdatedAt,
+	}
+}
+
+func bookCopiesToResponse(copies []*entity.BookCopy) *[]generated.BookCopy {
+	result := make([]generated.BookCopy, len(copies))
+	for i, bookCopy := range copies {
+		if resp := bookCopyToResponse(bookCopy); resp != nil {
+			result[i] = *resp
+		}
+	}
+	return &result
+}
+
+func branchToResponse(branch *entity.Branch) *generated.Branch {
+	if branch == nil {
+		return nil
+	}
+	return &generated.Branch{
+		Id:        uuidToOpenAPI(branch.ID),
+		Code:      &branch.Code,
+		Name:      &branch.Name,
+		Address:   &branch.Address,
+		CreatedAt: &branch.CreatedAt,
+		UpdatedAt: &branch.UpdatedAt,
+	}
+}
+
+func branchesToResponse(branches []*entity.Branch) *[]generated.Branch {
+	result := make([]generated.Branch, len(branches))
+	for i, branch := range branches {
+		if resp := branchToResponse(branch); resp != nil {
+			result[i] = *resp
+		}
+	}
+	return &result
+}
+
+func branchAvailabilityToResponse(availability []entity.BranchAvailability) *[]generated.BranchAvailability {
+	if availability == nil {
+		return nil
+	}
+	result := make([]generated.BranchAvailability, len(availability))
+	for i := range availability {
+		result[i] = generated.BranchAvailability{
+			BranchId:        uuidToOpenAPI(availability[i].BranchID),
+			TotalCopies:     &availability[i].TotalCopies,
+			AvailableCopies: &availability[i].AvailableCopies,
+		}
+	}
+	return &result
+}
+
+func transferToResponse(transfer *entity.Transfer) *generated.Transfer {
+	if transfer == nil {
+		return nil
+	}
+	status := generated.TransferStatus(transfer.Status)
+	return &generated.Transfer{
+		Id:           uuidToOpenAPI(transfer.ID),
+		CopyId:       uuidToOpenAPI(transfer.CopyID),
+		BookId:       uuidToOpenAPI(transfer.BookID),
+		FromBranchId: uuidToOpenAPI(transfer.FromBranchID),
+		ToBranchId:   uuidToOpenAPI(transfer.ToBranchID),
+		Status:       &status,
+		RequestedAt:  &transfer.RequestedAt,
+		ShippedAt:    transfer.ShippedAt,
+		ReceivedAt:   transfer.ReceivedAt,
+		UpdatedAt:    &transfer.UpdatedAt,
+	}
+}
+
+func transfersToResponse(transfers []*entity.Transfer) *[]generated.Transfer {
+	result := make([]generated.Transfer, len(transfers))
+	for i, transfer := range transfers {
+		if resp := transferToResponse(transfer); resp != nil {
+			result[i] = *resp
+		}
+	}
+	return &result
+}
+
+func weekdayToOpenAPI(weekday time.Weekday) generated.Weekday {
+	return generated.Weekday(strings.ToLower(weekday.String()))
+}
+
+// weekdayFromOpenAPI maps an API weekday to time.Weekday, giving -1 for
+// values outside the enum so that entity validation rejects them.
+func weekdayFromOpenAPI(weekday generated.Weekday) time.Weekday {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if weekdayToOpenAPI(day) == weekday {
+			return day
+		}
+	}
+	return -1
+}
+
+func openingHoursToResponse(hours []*entity.OpeningHours) *[]generated.OpeningHours {
+	result := make([]generated.OpeningHours, len(hours))
+	for i, h := range hours {
+		result[i] = generated.OpeningHours{
+			Weekday:  weekdayToOpenAPI(h.Weekday),
+			OpensAt:  entity.FormatClockTime(h.OpensAt),
+			ClosesAt: entity.FormatClockTime(h.ClosesAt),
+		}
+	}
+	return &result
+}
+
+func closedDateToResponse(closedDate *entity.ClosedDate) *generated.ClosedDate {
+	if closedDate == nil {
+		return nil
+	}
+	return &generated.ClosedDate{
+		Id:        uuidToOpenAPI(closedDate.ID),
+		BranchId:  uuidToOpenAPI(closedDate.BranchID),
+		Date:      dateToOpenAPI(&closedDate.Date),
+		Reason:    &closedDate.Reason,
+		CreatedAt: &closedDate.CreatedAt,
+	}
+}
+
+func closedDatesToResponse(closedDates []*entity.ClosedDate) *[]generated.ClosedDate {
+	result := make([]generated.ClosedDate, len(closedDates))
+	for i, closedDate := range closedDates {
+		if resp := closedDateToResponse(closedDate); resp != nil {
+			result[i] = *resp
+		}
+	}
+	return &result
+}
+
+func dateToOpenAPI(t *time.Time) *openapi_types.Date {
+	if t == nil {
+		return nil
+	}
+	return &openapi_types.Date{Time: *t}
+}
+
+func dueDateAdjustmentToResponse(adjustment *entity.DueDateAdjustment) *generated.DueDateAdjustment {
+	if adjustment == nil {
+		return nil
+	}
+	resp := &generated.DueDateAdjustment{
+		Id:            uuidToOpenAPI(adjustment.ID),
+		DueFrom:       dateToOpenAPI(&adjustment.DueFrom),
+		DueTo:         dateToOpenAPI(&adjustment.DueTo),
+		NewDueDate:    dateToOpenAPI(adjustment.NewDueDate),
+		Reason:        &adjustment.Reason,
+		LoansAdjusted: &adjustment.LoansAdjusted,
+		AdjustedBy:    uuidToOpenAPI(adjustment.AdjustedBy),
+		CreatedAt:     &adjustment.CreatedAt,
+	}
+	if adjustment.BranchID != nil {
+		resp.BranchId = uuidToOpenAPI(*adjustment.BranchID)
+	}
+	if adjustment.BookID != nil {
+		resp.BookId = uuidToOpenAPI(*adjustment.BookID)
+	}
+	if adjustment.NewDueDate == nil {
+		resp.ShiftDays = &adjustment.ShiftDays
+	}
+	return resp
+}
+
 func loanToResponse(loan *repository.LoanWithDetails) *generated.Loan {
 	if loan == nil || loan.Loan == nil {
 		return nil
 	}
 	status := generated.LoanStatus(loan.Loan.Status)
+	daysOverdue := loan.Loan.DaysOverdue(time.Now())
 
 	result := &generated.Loan{
-		Id:         uuidToOpenAPI(loan.Loan.ID),
-		UserId:     uuidToOpenAPI(loan.Loan.UserID),
-		UserName:   &loan.UserName,
-		BookId:     uuidToOpenAPI(loan.Loan.BookID),
-		BookTitle:  &loan.BookTitle,
-		BorrowedAt: &loan.Loan.BorrowedAt,
-		DueDate:    &loan.Loan.DueDate,
-		Status:     &status,
+		Id:           uuidToOpenAPI(loan.Loan.ID),
+		UserId:       uuidToOpenAPI(loan.Loan.UserID),
+		UserName:     &loan.UserName,
+		BookId:       uuidToOpenAPI(loan.Loan.BookID),
+		BookTitle:    &loan.BookTitle,
+		CopyId:       loan.Loan.CopyID,
+		BorrowedAt:   &loan.Loan.BorrowedAt,
+		DueDate:      &loan.Loan.DueDate,
+		Status:       &status,
+		RenewalCount: &loan.Loan.RenewalCount,
+		DaysOverdue:  &daysOverdue,
 	}
 
 	if loan.Loan.ReturnedAt != nil {
@@ -121,6 +413,232 @@ func loansToResponse(loans []*repository.LoanWithDetails) *[]generated.Loan {
 	return &result
 }
 
+func loanEscalationsToResponse(escalations []*entity.LoanEscalation) *[]generated.LoanEscalation {
+	result := make([]generated.LoanEscalation, len(escalations))
+	for i, escalation := range escalations {
+		action := generated.LoanEscalationAction(escalation.Action)
+		result[i] = generated.LoanEscalation{
+			Id:          uuidToOpenAPI(escalation.ID),
+			LoanId:      uuidToOpenAPI(escalation.LoanID),
+			UserId:      uuidToOpenAPI(escalation.UserID),
+			Level:       &escalation.Level,
+			Action:      &action,
+			DaysOverdue: &escalation.DaysOverdue,
+			CreatedAt:   &escalation.CreatedAt,
+		}
+	}
+	return &result
+}
+
+func holdToResponse(hold *repository.HoldWithPosition) *generated.Hold {
+	if hold == nil || hold.Hold == nil {
+		return nil
+	}
+	status := generated.HoldStatus(hold.Hold.Status)
+
+	result := &generated.Hold{
+		Id:             uuidToOpenAPI(hold.Hold.ID),
+		UserId:         uuidToOpenAPI(hold.Hold.UserID),
+		BookId:         uuidToOpenAPI(hold.Hold.BookID),
+		Status:         &status,
+		CreatedAt:      &hold.Hold.CreatedAt,
+		ReadyAt:        hold.Hold.ReadyAt,
+		PickupDeadline: hold.Hold.PickupDeadline,
+	}
+
+	if hold.Position > 0 {
+		result.QueuePosition = &hold.Position
+	}
+
+	return result
+}
+
+func holdsToResponse(holds []*repository.HoldWithPosition) *[]generated.Hold {
+	result := make([]generated.Hold, len(holds))
+	for i, hold := range holds {
+		h := holdToResponse(hold)
+		if h != nil {
+			result[i] = *h
+		}
+	}
+	return &result
+}
+
+func fineToResponse(fine *entity.Fine) *generated.Fine {
+	if fine == nil {
+		return nil
+	}
+	reason := generated.FineReason(fine.Reason)
+	status := generated.FineStatus(fine.Status)
+	balance := fine.BalanceCents()
+
+	return &generated.Fine{
+		Id:           uuidToOpenAPI(fine.ID),
+		UserId:       uuidToOpenAPI(fine.UserID),
+		LoanId:       uuidToOpenAPI(fine.LoanID),
+		Reason:       &reason,
+		AmountCents:  &fine.AmountCents,
+		PaidCents:    &fine.PaidCents,
+		BalanceCents: &balance,
+		Status:       &status,
+		CreatedAt:    &fine.CreatedAt,
+		UpdatedAt:    &fine.UpdatedAt,
+	}
+}
+
+func finesToResponse(fines []*entity.Fine) *[]generated.Fine {
+	result := make([]generated.Fine, len(fines))
+	for i, fine := range fines {
+		f := fineToResponse(fine)
+		if f != nil {
+			result[i] = *f
+		}
+	}
+	return &result
+}
+
+func loanPolicyToResponse(policy *entity.LoanPolicy) *generated.LoanPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &generated.LoanPolicy{
+		Id:             uuidToOpenAPI(policy.ID),
+		PatronCategory: &policy.PatronCategory,
+		ItemCategory:   &policy.ItemCategory,
+		MaxLoans:       &policy.MaxLoans,
+		LoanDays:       &policy.LoanDays,
+		MaxRenewals:    &policy.MaxRenewals,
+		CreatedAt:      &policy.CreatedAt,
+		UpdatedAt:      &policy.UpdatedAt,
+	}
+}
+
+func loanPoliciesToResponse(policies []*entity.LoanPolicy) *[]generated.LoanPolicy {
+	result := make([]generated.LoanPolicy, len(policies))
+	for i, policy := range policies {
+		p := loanPolicyToResponse(policy)
+		if p != nil {
+			result[i] = *p
+		}
+	}
+	return &result
+}
+
+func webhookToResponse(subscription *entity.WebhookSubscription) *generated.Webhook {
+	if subscription == nil {
+		return nil
+	}
+	events := eventTypesToOpenAPI(subscription.Events)
+	return &generated.Webhook{
+		Id:        uuidToOpenAPI(subscription.ID),
+		Url:       &subscription.URL,
+		Events:    &events,
+		Active:    &subscription.Active,
+		CreatedAt: &subscription.CreatedAt,
+		UpdatedAt: &subscription.UpdatedAt,
+	}
+}
+
+func webhooksToResponse(subscriptions []*entity.WebhookSubscription) *[]generated.Webhook {
+	result := make([]generated.Webhook, len(subscriptions))
+	for i, subscription := range subscriptions {
+		w := webhookToResponse(subscription)
+		if w != nil {
+			result[i] = *w
+		}
+	}
+	return &result
+}
+
+func webhookDeliveryToResponse(delivery *entity.WebhookDelivery) *generated.WebhookDelivery {
+	if delivery == nil {
+		return nil
+	}
+	eventType := generated.EventType(delivery.EventType)
+	status := generated.WebhookDeliveryStatus(delivery.Status)
+	return &generated.WebhookDelivery{
+		Id:             uuidToOpenAPI(delivery.ID),
+		SubscriptionId: uuidToOpenAPI(delivery.SubscriptionID),
+		EventId:        uuidToOpenAPI(delivery.EventID),
+		EventType:      &eventType,
+		Status:         &status,
+		Attempts:       &delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      &delivery.LastError,
+		CreatedAt:      &delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
+
+func webhookDeliveriesToResponse(deliveries []*entity.WebhookDelivery) *[]generated.WebhookDelivery {
+	result := make([]generated.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		d := webhookDeliveryToResponse(delivery)
+		if d != nil {
+			result[i] = *d
+		}
+	}
+	return &result
+}
+
+func eventTypesToOpenAPI(events []entity.EventType) []generated.EventType {
+	result := make([]generated.EventType, len(events))
+	for i, eventType := range events {
+		result[i] = generated.EventType(eventType)
+	}
+	return result
+}
+
+func eventTypesFromOpenAPI(events []generated.EventType) []entity.EventType {
+	result := make([]entity.EventType, len(events))
+	for i, eventType := range events {
+		result[i] = entity.EventType(eventType)
+	}
+	return result
+}
+
+func auditEntryToResponse(entry *entity.AuditEntry) *generated.AuditEntry {
+	if entry == nil {
+		return nil
+	}
+	entityType := generated.AuditEntityType(entry.EntityType)
+	changes := make(map[string]generated.AuditChange, len(entry.Changes))
+	for name, change := range entry.Changes {
+		changes[name] = generated.AuditChange{
+			Before: &change.Before,
+			After:  &change.After,
+		}
+	}
+	response := &generated.AuditEntry{
+		Id:         uuidToOpenAPI(entry.ID),
+		Sequence:   &entry.Sequence,
+		Action:     &entry.Action,
+		EntityType: &entityType,
+		EntityId:   uuidToOpenAPI(entry.EntityID),
+		Changes:    &changes,
+		RequestId:  &entry.RequestID,
+		CreatedAt:  &entry.CreatedAt,
+		PrevHash:   &entry.PrevHash,
+		Hash:       &entry.Hash,
+	}
+	if entry.ActorID != nil {
+		response.ActorId = uuidToOpenAPI(*entry.ActorID)
+	}
+	return response
+}
+
+func auditEntriesToResponse(entries []*entity.AuditEntry) *[]generated.AuditEntry {
+	result := make([]generated.AuditEntry, len(entries))
+	for i, entry := range entries {
+		e := auditEntryToResponse(entry)
+		if e != nil {
+			result[i] = *e
+		}
+	}
+	return &result
+}
+
 func paginationResponse(page, limit, total, totalPages int) *generated.Pagination {
 	return &generated.Pagination{
 		Page:       &page,
@@ -142,12 +660,32 @@ func handleUserError(c *gin.Context, err error) {
 			Error: strPtr("email already exists"),
 			Code:  strPtr("EMAIL_EXISTS"),
 		})
+	case entity.ErrCardNumberAlreadyExists:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("card number already in use"),
+			Code:  strPtr("CARD_NUMBER_EXISTS"),
+		})
 	case entity.ErrUserDisabled:
 		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
 			Error: strPtr("user is already disabled"),
 			Code:  strPtr("USER_DISABLED"),
 		})
-	case entity.ErrInvalidUserName, entity.ErrInvalidUserEmail, entity.ErrInvalidUserPassword:
+	case entity.ErrUserNotBlocked:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("user is not blocked"),
+			Code:  strPtr("USER_NOT_BLOCKED"),
+		})
+	case entity.ErrInvalidCurrentPassword:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("current password is incorrect"),
+			Code:  strPtr("INVALID_CURRENT_PASSWORD"),
+		})
+	case usecase.ErrUnauthenticated:
+		c.JSON(http.StatusUnauthorized, generated.ErrorResponse{
+			Error: strPtr("authentication required"),
+			Code:  strPtr("UNAUTHORIZED"),
+		})
+	case entity.ErrInvalidUserName, entity.ErrInvalidUserEmail, entity.ErrInvalidUserPassword, entity.ErrInvalidUserRole, entity.ErrInvalidCategory, entity.ErrInvalidCardNumber:
 		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
 			Error: strPtr(err.Error()),
 			Code:  strPtr("VALIDATION_ERROR"),
@@ -167,11 +705,96 @@ func handleBookError(c *gin.Context, err error) {
 			Error: strPtr("book not found"),
 			Code:  strPtr("NOT_FOUND"),
 		})
-	case entity.ErrInvalidBookTitle, entity.ErrInvalidBookAuthor, entity.ErrInvalidBookISBN, entity.ErrInvalidTotalCopies:
+	case entity.ErrBranchNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("branch not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrInvalidBookTitle, entity.ErrInvalidBookAuthor, entity.ErrInvalidBookISBN, entity.ErrInvalidTotalCopies, entity.ErrInvalidCategory, entity.ErrCopiesInCirculation, entity.ErrInvalidSearchQuery, entity.ErrInvalidYearRange, entity.ErrInvalidSuggestPrefix:
 		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
 			Error: strPtr(err.Error()),
 			Code:  strPtr("VALIDATION_ERROR"),
 		})
+	case entity.ErrCopyInCirculation:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("a copy of the book is in transit"),
+			Code:  strPtr("COPY_IN_CIRCULATION"),
+		})
+	case entity.ErrBookHasActiveLoans:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("BOOK_HAS_ACTIVE_LOANS"),
+		})
+	case entity.ErrBookHasHistory:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("BOOK_HAS_HISTORY"),
+		})
+	case entity.ErrBookWithdrawn:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("BOOK_WITHDRAWN"),
+		})
+	case entity.ErrConcurrentModification:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("book was modified concurrently, please retry"),
+			Code:  strPtr("CONCURRENT_MODIFICATION"),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("internal server error"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+	}
+}
+
+func handleBookCopyError(c *gin.Context, err error) {
+	switch err {
+	case entity.ErrBookCopyNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("book copy not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrBookNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("book not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrBranchNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("branch not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrBarcodeAlreadyExists:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("barcode already in use"),
+			Code:  strPtr("BARCODE_EXISTS"),
+		})
+	case entity.ErrBookWithdrawn:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("BOOK_WITHDRAWN"),
+		})
+	case entity.ErrInvalidBarcode, entity.ErrInvalidCopyLocation, entity.ErrInvalidCopyCondition, entity.ErrInvalidCopyStatus:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("VALIDATION_ERROR"),
+		})
+	case entity.ErrCopyInCirculation:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("copy is on loan, set aside for a hold or in transit"),
+			Code:  strPtr("COPY_IN_CIRCULATION"),
+		})
+	case entity.ErrCopyHasOpenTransfer:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("copy has an open transfer"),
+			Code:  strPtr("OPEN_TRANSFER_EXISTS"),
+		})
+	case entity.ErrConcurrentModification:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("book was modified concurrently, please retry"),
+			Code:  strPtr("CONCURRENT_MODIFICATION"),
+		})
 	default:
 		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
 			Error: strPtr("internal server error"),
@@ -197,16 +820,41 @@ func handleLoanError(c *gin.Context, err error) {
 			Error: strPtr("book not found"),
 			Code:  strPtr("NOT_FOUND"),
 		})
+	case entity.ErrBookCopyNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("book copy not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
 	case entity.ErrBookNotAvailable:
 		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
 			Error: strPtr("book is not available - all copies are borrowed"),
 			Code:  strPtr("BOOK_UNAVAILABLE"),
 		})
+	case entity.ErrCopyNotAvailable:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("copy is on loan, set aside for another patron or out of circulation"),
+			Code:  strPtr("COPY_UNAVAILABLE"),
+		})
+	case entity.ErrCopyNotOnLoan:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("copy is not on loan"),
+			Code:  strPtr("COPY_NOT_ON_LOAN"),
+		})
+	case entity.ErrInvalidReturnDate, entity.ErrInvalidChargeAmount:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("VALIDATION_ERROR"),
+		})
 	case entity.ErrUserDisabled:
 		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
 			Error: strPtr("user is disabled"),
 			Code:  strPtr("USER_DISABLED"),
 		})
+	case entity.ErrUserBlocked:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("user is blocked from borrowing until staff lift the block"),
+			Code:  strPtr("USER_BLOCKED"),
+		})
 	case entity.ErrLoanAlreadyReturned:
 		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
 			Error: strPtr("loan has already been returned"),
@@ -217,6 +865,332 @@ func handleLoanError(c *gin.Context, err error) {
 			Error: strPtr("user already has an active loan for this book"),
 			Code:  strPtr("ACTIVE_LOAN_EXISTS"),
 		})
+	case entity.ErrMaxRenewalsReached:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("loan has reached the maximum number of renewals"),
+			Code:  strPtr("MAX_RENEWALS_REACHED"),
+		})
+	case entity.ErrLoanTooOverdue:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("loan is overdue beyond the renewal grace period"),
+			Code:  strPtr("LOAN_TOO_OVERDUE"),
+		})
+	case entity.ErrBookHasPendingHolds:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("book has pending holds by other users"),
+			Code:  strPtr("PENDING_HOLDS"),
+		})
+	case entity.ErrOutstandingFines:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("user has outstanding fines above the allowed limit"),
+			Code:  strPtr("OUTSTANDING_FINES"),
+		})
+	case entity.ErrLoanLimitReached:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("user has reached the maximum number of concurrent loans"),
+			Code:  strPtr("LOAN_LIMIT_REACHED"),
+		})
+	case usecase.ErrUnauthenticated:
+		c.JSON(http.StatusUnauthorized, generated.ErrorResponse{
+			Error: strPtr("authentication required"),
+			Code:  strPtr("UNAUTHORIZED"),
+		})
+	case entity.ErrConcurrentModification:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("book was modified concurrently, please retry"),
+			Code:  strPtr("CONCURRENT_MODIFICATION"),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("internal server error"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+	}
+}
+
+func handleHoldError(c *gin.Context, err error) {
+	switch err {
+	case entity.ErrHoldNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("hold not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrUserNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("user not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrBookNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("book not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrUserDisabled:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("user is disabled"),
+			Code:  strPtr("USER_DISABLED"),
+		})
+	case entity.ErrBookWithdrawn:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("BOOK_WITHDRAWN"),
+		})
+	case entity.ErrBookAvailableForLoan:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("book has available copies, borrow it instead"),
+			Code:  strPtr("BOOK_AVAILABLE"),
+		})
+	case entity.ErrUserHasActiveLoan:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("user already has an active loan for this book"),
+			Code:  strPtr("ACTIVE_LOAN_EXISTS"),
+		})
+	case entity.ErrUserHasActiveHold:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("user already has an active hold for this book"),
+			Code:  strPtr("ACTIVE_HOLD_EXISTS"),
+		})
+	case entity.ErrHoldNotActive:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("hold is no longer active"),
+			Code:  strPtr("HOLD_NOT_ACTIVE"),
+		})
+	case entity.ErrConcurrentModification:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("book was modified concurrently, please retry"),
+			Code:  strPtr("CONCURRENT_MODIFICATION"),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("internal server error"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+	}
+}
+
+func handleFineError(c *gin.Context, err error) {
+	switch err {
+	case entity.ErrFineNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("fine not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrFineNotOpen:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("fine is already paid or waived"),
+			Code:  strPtr("FINE_NOT_OPEN"),
+		})
+	case entity.ErrInvalidPaymentAmount:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("payment amount must be positive"),
+			Code:  strPtr("INVALID_AMOUNT"),
+		})
+	case entity.ErrPaymentExceedsBalance:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("payment exceeds the fine balance"),
+			Code:  strPtr("PAYMENT_EXCEEDS_BALANCE"),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("internal server error"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+	}
+}
+
+func handleLoanPolicyError(c *gin.Context, err error) {
+	switch err {
+	case entity.ErrLoanPolicyNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("loan policy not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrLoanPolicyAlreadyExists:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("a loan policy already exists for this patron and item category"),
+			Code:  strPtr("LOAN_POLICY_EXISTS"),
+		})
+	case entity.ErrInvalidCategory, entity.ErrInvalidLoanPolicy:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("VALIDATION_ERROR"),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("internal server error"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+	}
+}
+
+func handleBranchError(c *gin.Context, err error) {
+	switch err {
+	case entity.ErrBranchNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("branch not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrBranchCodeAlreadyExists:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("branch code already in use"),
+			Code:  strPtr("BRANCH_CODE_EXISTS"),
+		})
+	case entity.ErrBranchInUse:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("branch still holds copies or has transfers on record"),
+			Code:  strPtr("BRANCH_IN_USE"),
+		})
+	case entity.ErrDefaultBranchRequired:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("the default branch cannot be deleted"),
+			Code:  strPtr("DEFAULT_BRANCH"),
+		})
+	case entity.ErrInvalidBranchCode, entity.ErrInvalidBranchName, entity.ErrInvalidBranchAddress:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("VALIDATION_ERROR"),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("internal server error"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+	}
+}
+
+func handleTransferError(c *gin.Context, err error) {
+	switch err {
+	case entity.ErrTransferNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("transfer not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrBookCopyNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("book copy not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrBranchNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("branch not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrTransferToSameBranch:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("copy is already at the destination branch"),
+			Code:  strPtr("SAME_BRANCH"),
+		})
+	case entity.ErrCopyHasOpenTransfer:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("copy already has an open transfer"),
+			Code:  strPtr("OPEN_TRANSFER_EXISTS"),
+		})
+	case entity.ErrCopyNotAvailable:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("copy is not on the shelf"),
+			Code:  strPtr("COPY_UNAVAILABLE"),
+		})
+	case entity.ErrTransferNotRequested:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("transfer has already been shipped or closed"),
+			Code:  strPtr("TRANSFER_NOT_REQUESTED"),
+		})
+	case entity.ErrTransferNotInTransit:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("transfer is not in transit"),
+			Code:  strPtr("TRANSFER_NOT_IN_TRANSIT"),
+		})
+	case entity.ErrConcurrentModification:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("book was modified concurrently, please retry"),
+			Code:  strPtr("CONCURRENT_MODIFICATION"),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("internal server error"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+	}
+}
+
+func handleCalendarError(c *gin.Context, err error) {
+	switch err {
+	case entity.ErrBranchNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("branch not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrClosedDateNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("closed date not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrClosedDateAlreadyExists:
+		c.JSON(http.StatusConflict, generated.ErrorResponse{
+			Error: strPtr("branch is already closed on this date"),
+			Code:  strPtr("CLOSED_DATE_EXISTS"),
+		})
+	case entity.ErrInvalidWeekday, entity.ErrInvalidClockTime, entity.ErrInvalidOpeningHours,
+		entity.ErrDuplicateWeekday, entity.ErrInvalidClosedDateReason:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("VALIDATION_ERROR"),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("internal server error"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+	}
+}
+
+func handleDueDateAdjustmentError(c *gin.Context, err error) {
+	switch err {
+	case entity.ErrBranchNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("branch not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrBookNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("book not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case usecase.ErrUnauthenticated:
+		c.JSON(http.StatusUnauthorized, generated.ErrorResponse{
+			Error: strPtr("authentication required"),
+			Code:  strPtr("UNAUTHORIZED"),
+		})
+	case entity.ErrInvalidDueDateRange, entity.ErrInvalidDueDateAdjustment, entity.ErrInvalidAdjustmentReason:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("VALIDATION_ERROR"),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("internal server error"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+	}
+}
+
+func handleWebhookError(c *gin.Context, err error) {
+	switch err {
+	case entity.ErrWebhookNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("webhook not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrWebhookDeliveryNotFound:
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error: strPtr("webhook delivery not found"),
+			Code:  strPtr("NOT_FOUND"),
+		})
+	case entity.ErrInvalidWebhookURL, entity.ErrInvalidWebhookEvents, entity.ErrInvalidWebhookSecret:
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr(err.Error()),
+			Code:  strPtr("VALIDATION_ERROR"),
+		})
 	default:
 		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
 			Error: strPtr("internal server error"),
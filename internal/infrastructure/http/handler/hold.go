@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"net/http"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Hold handlers
+
+func (h *Handler) ListHolds(c *gin.Context, params generated.ListHoldsParams) {
+	page := 1
+	limit := 10
+
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	var filter repository.HoldFilter
+	if params.UserId != nil {
+		id := uuid.UUID(*params.UserId)
+		filter.UserID = &id
+	}
+	if params.BookId != nil {
+		id := uuid.UUID(*params.BookId)
+		filter.BookID = &id
+	}
+	if params.Status != nil {
+		s := string(*params.Status)
+		filter.Status = &s
+	}
+
+	// Members only see their own holds
+	if !entity.IsStaffRole(callerRole(c)) {
+		id, _ := callerID(c)
+		if filter.UserID != nil && *filter.UserID != id {
+			respondForbidden(c)
+			return
+		}
+		filter.UserID = &id
+	}
+
+	holds, total, err := h.holdUseCase.List(c.Request.Context(), page, limit, filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("failed to list holds"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+		return
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	c.JSON(http.StatusOK, generated.HoldListResponse{
+		Data:       holdsToResponse(holds),
+		Pagination: paginationResponse(page, limit, total, totalPages),
+	})
+}
+
+func (h *Handler) PlaceHold(c *gin.Context) {
+	var req generated.PlaceHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	userID := uuid.UUID(req.UserId)
+	if !requireSelfOrRole(c, userID, entity.RoleAdmin, entity.RoleLibrarian) {
+		return
+	}
+
+	hold, err := h.holdUseCase.PlaceHold(c.Request.Context(), usecase.PlaceHoldInput{
+		UserID: userID,
+		BookID: uuid.UUID(req.BookId),
+	})
+	if err != nil {
+		handleHoldError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, generated.HoldResponse{
+		Data: holdToResponse(hold),
+	})
+}
+
+func (h *Handler) GetHoldById(c *gin.Context, id openapi_types.UUID) {
+	hold, err := h.holdUseCase.GetByID(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleHoldError(c, err)
+		return
+	}
+
+	if !requireSelfOrRole(c, hold.Hold.UserID, entity.RoleAdmin, entity.RoleLibrarian) {
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.HoldResponse{
+		Data: holdToResponse(hold),
+	})
+}
+
+func (h *Handler) CancelHold(c *gin.Context, id openapi_types.UUID) {
+	holdID := uuid.UUID(id)
+
+	if !entity.IsStaffRole(callerRole(c)) {
+		existing, err := h.holdUseCase.GetByID(c.Request.Context(), holdID)
+		if err != nil {
+			handleHoldError(c, err)
+			return
+		}
+		if !requireSelfOrRole(c, existing.Hold.UserID) {
+			return
+		}
+	}
+
+	hold, err := h.holdUseCase.CancelHold(c.Request.Context(), holdID)
+	if err != nil {
+		handleHoldError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.HoldResponse{
+		Data: holdToResponse(hold),
+	})
+}
@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPlaceHold_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+
+	userID := uuid.New()
+	bookID := uuid.New()
+	router := setupTestRouterAs(m.handler, userID, entity.RoleMember)
+
+	m.holdUseCase.EXPECT().
+		PlaceHold(gomock.Any(), usecase.PlaceHoldInput{UserID: userID, BookID: bookID}).
+		Return(createTestHold(userID, bookID, 2), nil)
+
+	body, _ := json.Marshal(generated.PlaceHoldRequest{
+		UserId: openapi_types.UUID(userID),
+		BookId: openapi_types.UUID(bookID),
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/holds", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response generated.HoldResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *response.Data.QueuePosition)
+	assert.Equal(t, generated.HoldStatusWaiting, *response.Data.Status)
+}
+
+func TestPlaceHold_BookAvailable(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	m.holdUseCase.EXPECT().
+		PlaceHold(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrBookAvailableForLoan)
+
+	body, _ := json.Marshal(generated.PlaceHoldRequest{
+		UserId: openapi_types.UUID(uuid.New()),
+		BookId: openapi_types.UUID(uuid.New()),
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/holds", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "BOOK_AVAILABLE", *response.Code)
+}
+
+func TestPlaceHold_MemberForOtherUserForbidden(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouterAs(m.handler, uuid.New(), entity.RoleMember)
+
+	body, _ := json.Marshal(generated.PlaceHoldRequest{
+		UserId: openapi_types.UUID(uuid.New()),
+		BookId: openapi_types.UUID(uuid.New()),
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/holds", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestListHolds_MemberSeesOwnHolds(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+
+	userID := uuid.New()
+	router := setupTestRouterAs(m.handler, userID, entity.RoleMember)
+
+	holds := []*repository.HoldWithPosition{
+		createTestHold(userID, uuid.New(), 1),
+	}
+
+	m.holdUseCase.EXPECT().
+		List(gomock.Any(), 1, 10, repository.HoldFilter{UserID: &userID}).
+		Return(holds, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/holds", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.HoldListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+	assert.Equal(t, 1, *(*response.Data)[0].QueuePosition)
+}
+
+func TestListHolds_MemberOtherUserForbidden(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouterAs(m.handler, uuid.New(), entity.RoleMember)
+
+	req := httptest.NewRequest(http.MethodGet, "/holds?user_id="+uuid.New().String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestGetHoldById_NotFound(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	holdID := uuid.New()
+
+	m.holdUseCase.EXPECT().
+		GetByID(gomock.Any(), holdID).
+		Return(nil, entity.ErrHoldNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/holds/"+holdID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestCancelHold_MemberOwnHold(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+
+	userID := uuid.New()
+	router := setupTestRouterAs(m.handler, userID, entity.RoleMember)
+
+	hold := createTestHold(userID, uuid.New(), 1)
+	cancelled := createTestHold(userID, hold.Hold.BookID, 0)
+	cancelled.Hold.Status = entity.HoldStatusCancelled
+
+	m.holdUseCase.EXPECT().
+		GetByID(gomock.Any(), hold.Hold.ID).
+		Return(hold, nil)
+	m.holdUseCase.EXPECT().
+		CancelHold(gomock.Any(), hold.Hold.ID).
+		Return(cancelled, nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/holds/"+hold.Hold.ID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.HoldResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, generated.HoldStatusCancelled, *response.Data.Status)
+	assert.Nil(t, response.Data.QueuePosition)
+}
+
+func TestCancelHold_MemberOtherUsersHoldForbidden(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouterAs(m.handler, uuid.New(), entity.RoleMember)
+
+	hold := createTestHold(uuid.New(), uuid.New(), 1)
+
+	m.holdUseCase.EXPECT().
+		GetByID(gomock.Any(), hold.Hold.ID).
+		Return(hold, nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/holds/"+hold.Hold.ID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestCancelHold_AlreadyClosed(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	holdID := uuid.New()
+
+	m.holdUseCase.EXPECT().
+		CancelHold(gomock.Any(), holdID).
+		Return(nil, entity.ErrHoldNotActive)
+
+	req := httptest.NewRequest(http.MethodDelete, "/holds/"+holdID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
 	"bookhub/internal/usecase"
 
 	"github.com/gin-gonic/gin"
@@ -33,13 +35,39 @@ func (h *Handler) ListLoans(c *gin.Context, params generated.ListLoansParams) {
 		}
 	}
 
-	var status *string
+	// Members only see their own loans
+	if !entity.IsStaffRole(callerRole(c)) {
+		id, _ := callerID(c)
+		if userID != nil && *userID != id {
+			respondForbidden(c)
+			return
+		}
+		userID = &id
+	}
+
+	sort, ok := parseSort(c, params.Sort, repository.LoanSortFields)
+	if !ok {
+		return
+	}
+	filter := repository.LoanFilter{
+		UserID:       userID,
+		BorrowedFrom: params.BorrowedFrom,
+		BorrowedTo:   params.BorrowedTo,
+		ReturnedFrom: params.ReturnedFrom,
+		ReturnedTo:   params.ReturnedTo,
+		DueBefore:    params.DueBefore,
+		Sort:         sort,
+	}
+	if params.BookId != nil {
+		id := uuid.UUID(*params.BookId)
+		filter.BookID = &id
+	}
 	if params.Status != nil {
 		s := string(*params.Status)
-		status = &s
+		filter.Status = &s
 	}
 
-	loans, total, err := h.loanUseCase.List(c.Request.Context(), page, limit, userID, status)
+	loans, total, err := h.loanUseCase.List(c.Request.Context(), page, limit, filter)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
 			Error: strPtr("failed to list loans"),
@@ -75,6 +103,10 @@ func (h *Handler) BorrowBook(c *gin.Context) {
 		return
 	}
 
+	if !requireSelfOrRole(c, userID, entity.RoleAdmin, entity.RoleLibrarian) {
+		return
+	}
+
 	bookID, err := uuid.Parse(req.BookId.String())
 	if err != nil {
 		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
@@ -86,6 +118,11 @@ func (h *Handler) BorrowBook(c *gin.Context) {
 
 	var dueDate *time.Time
 	if req.DueDate != nil {
+		// Patrons get the due date of their loan policy
+		if !entity.IsStaffRole(callerRole(c)) {
+			respondForbidden(c)
+			return
+		}
 		t := req.DueDate.Time
 		dueDate = &t
 	}
@@ -115,7 +152,95 @@ func (h *Handler) ReturnBook(c *gin.Context, id openapi_types.UUID) {
 		return
 	}
 
-	loan, err := h.loanUseCase.ReturnBook(c.Request.Context(), loanID)
+	var req generated.ReturnBookRequest
+	if !bindOptionalJSON(c, &req) {
+		return
+	}
+	damage := damageReport(req.Damaged, req.DamageChargeCents)
+
+	if !entity.IsStaffRole(callerRole(c)) {
+		// Only staff inspect a returned book for damage
+		if damage != nil {
+			respondForbidden(c)
+			return
+		}
+		existing, err := h.loanUseCase.GetByID(c.Request.Context(), loanID)
+		if err != nil {
+			handleLoanError(c, err)
+			return
+		}
+		if !requireSelfOrRole(c, existing.Loan.UserID) {
+			return
+		}
+	}
+
+	var loan *repository.LoanWithDetails
+	if damage != nil {
+		loan, err = h.loanUseCase.ReturnDamaged(c.Request.Context(), loanID, *damage)
+	} else {
+		loan, err = h.loanUseCase.ReturnBook(c.Request.Context(), loanID)
+	}
+	if err != nil {
+		handleLoanError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.LoanResponse{
+		Data: loanToResponse(loan),
+	})
+}
+
+func (h *Handler) DeclareLoanLost(c *gin.Context, id openapi_types.UUID) {
+	loanID, err := uuid.Parse(id.String())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid loan ID"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	var req generated.DeclareLostRequest
+	if !bindOptionalJSON(c, &req) {
+		return
+	}
+
+	loan, err := h.loanUseCase.DeclareLost(c.Request.Context(), usecase.DeclareLostInput{
+		LoanID:           loanID,
+		ReplacementCents: req.ReplacementCents,
+	})
+	if err != nil {
+		handleLoanError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.LoanResponse{
+		Data: loanToResponse(loan),
+	})
+}
+
+func (h *Handler) RenewLoan(c *gin.Context, id openapi_types.UUID) {
+	loanID, err := uuid.Parse(id.String())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid loan ID"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	if !entity.IsStaffRole(callerRole(c)) {
+		existing, err := h.loanUseCase.GetByID(c.Request.Context(), loanID)
+		if err != nil {
+			handleLoanError(c, err)
+			return
+		}
+		if !requireSelfOrRole(c, existing.Loan.UserID) {
+			return
+		}
+	}
+
+	loan, err := h.loanUseCase.RenewLoan(c.Request.Context(), loanID)
 	if err != nil {
 		handleLoanError(c, err)
 		return
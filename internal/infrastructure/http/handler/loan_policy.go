@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"net/http"
+
+	"bookhub/api/generated"
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Loan policy handlers
+
+func (h *Handler) ListLoanPolicies(c *gin.Context) {
+	policies, err := h.policyUseCase.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("failed to list loan policies"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.LoanPolicyListResponse{
+		Data: loanPoliciesToResponse(policies),
+	})
+}
+
+func (h *Handler) CreateLoanPolicy(c *gin.Context) {
+	var req generated.CreateLoanPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	policy, err := h.policyUseCase.Create(c.Request.Context(), usecase.CreateLoanPolicyInput{
+		PatronCategory: req.PatronCategory,
+		ItemCategory:   req.ItemCategory,
+		MaxLoans:       req.MaxLoans,
+		LoanDays:       req.LoanDays,
+		MaxRenewals:    req.MaxRenewals,
+	})
+	if err != nil {
+		handleLoanPolicyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, generated.LoanPolicyResponse{
+		Data: loanPolicyToResponse(policy),
+	})
+}
+
+func (h *Handler) GetLoanPolicyById(c *gin.Context, id openapi_types.UUID) {
+	policy, err := h.policyUseCase.GetByID(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleLoanPolicyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.LoanPolicyResponse{
+		Data: loanPolicyToResponse(policy),
+	})
+}
+
+func (h *Handler) UpdateLoanPolicy(c *gin.Context, id openapi_types.UUID) {
+	var req generated.UpdateLoanPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	policy, err := h.policyUseCase.Update(c.Request.Context(), uuid.UUID(id), usecase.UpdateLoanPolicyInput{
+		MaxLoans:    req.MaxLoans,
+		LoanDays:    req.LoanDays,
+		MaxRenewals: req.MaxRenewals,
+	})
+	if err != nil {
+		handleLoanPolicyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.LoanPolicyResponse{
+		Data: loanPolicyToResponse(policy),
+	})
+}
+
+func (h *Handler) DeleteLoanPolicy(c *gin.Context, id openapi_types.UUID) {
+	if err := h.policyUseCase.Delete(c.Request.Context(), uuid.UUID(id)); err != nil {
+		handleLoanPolicyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.MessageResponse{
+		Message: strPtr("loan policy deleted successfully"),
+	})
+}
@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListLoanPolicies_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	policy, _ := entity.NewLoanPolicy("student", entity.AnyCategory, 3, 7, 1)
+
+	m.loanPolicyUseCase.EXPECT().
+		List(gomock.Any()).
+		Return([]*entity.LoanPolicy{policy}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/loan-policies", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.LoanPolicyListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+	assert.Equal(t, "student", *(*response.Data)[0].PatronCategory)
+	assert.Equal(t, 3, *(*response.Data)[0].MaxLoans)
+}
+
+func TestCreateLoanPolicy_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	input := usecase.CreateLoanPolicyInput{
+		PatronCategory: "student",
+		ItemCategory:   "reference",
+		MaxLoans:       0,
+		LoanDays:       1,
+		MaxRenewals:    0,
+	}
+	policy, _ := entity.NewLoanPolicy(input.PatronCategory, input.ItemCategory, input.MaxLoans, input.LoanDays, input.MaxRenewals)
+
+	m.loanPolicyUseCase.EXPECT().
+		Create(gomock.Any(), input).
+		Return(policy, nil)
+
+	body, _ := json.Marshal(generated.CreateLoanPolicyRequest{
+		PatronCategory: "student",
+		ItemCategory:   "reference",
+		MaxLoans:       0,
+		LoanDays:       1,
+		MaxRenewals:    0,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/loan-policies", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response generated.LoanPolicyResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "reference", *response.Data.ItemCategory)
+}
+
+func TestCreateLoanPolicy_AlreadyExists(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	m.loanPolicyUseCase.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrLoanPolicyAlreadyExists)
+
+	body, _ := json.Marshal(generated.CreateLoanPolicyRequest{
+		PatronCategory: "student",
+		ItemCategory:   entity.AnyCategory,
+		MaxLoans:       3,
+		LoanDays:       7,
+		MaxRenewals:    1,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/loan-policies", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "LOAN_POLICY_EXISTS", *response.Code)
+}
+
+func TestUpdateLoanPolicy_InvalidLimits(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	policyID := uuid.New()
+	loanDays := 0
+
+	m.loanPolicyUseCase.EXPECT().
+		Update(gomock.Any(), policyID, usecase.UpdateLoanPolicyInput{LoanDays: &loanDays}).
+		Return(nil, entity.ErrInvalidLoanPolicy)
+
+	body, _ := json.Marshal(generated.UpdateLoanPolicyRequest{LoanDays: &loanDays})
+
+	req := httptest.NewRequest(http.MethodPut, "/loan-policies/"+policyID.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestDeleteLoanPolicy_NotFound(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	policyID := uuid.New()
+
+	m.loanPolicyUseCase.EXPECT().
+		Delete(gomock.Any(), policyID).
+		Return(entity.ErrLoanPolicyNotFound)
+
+	req := httptest.NewRequest(http.MethodDelete, "/loan-policies/"+policyID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"bookhub/api/generated"
 	"bookhub/internal/domain/entity"
@@ -32,7 +33,7 @@ func TestListLoans_Success(t *testing.T) {
 	}
 
 	mockLoanUseCase.EXPECT().
-		List(gomock.Any(), 1, 10, (*uuid.UUID)(nil), (*string)(nil)).
+		List(gomock.Any(), 1, 10, repository.LoanFilter{}).
 		Return(loans, 2, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/loans", nil)
@@ -48,6 +49,38 @@ func TestListLoans_Success(t *testing.T) {
 	assert.Len(t, *response.Data, 2)
 }
 
+func TestListLoans_MemberSeesOwnLoans(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	router := setupTestRouterAs(handler, userID, entity.RoleMember)
+
+	mockLoanUseCase.EXPECT().
+		List(gomock.Any(), 1, 10, repository.LoanFilter{UserID: &userID}).
+		Return([]*repository.LoanWithDetails{createTestLoanWithDetails(userID, uuid.New())}, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/loans", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestListLoans_MemberOtherUserForbidden(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleMember)
+
+	req := httptest.NewRequest(http.MethodGet, "/loans?user_id="+uuid.New().String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
 func TestListLoans_WithStatusFilter(t *testing.T) {
 	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
 	defer ctrl.Finish()
@@ -59,7 +92,7 @@ func TestListLoans_WithStatusFilter(t *testing.T) {
 	status := "active"
 
 	mockLoanUseCase.EXPECT().
-		List(gomock.Any(), 1, 10, (*uuid.UUID)(nil), &status).
+		List(gomock.Any(), 1, 10, repository.LoanFilter{Status: &status}).
 		Return(loans, 1, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/loans?status=active", nil)
@@ -70,13 +103,80 @@ func TestListLoans_WithStatusFilter(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestListLoans_WithBookAndDateFilters(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	bookID := uuid.New()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dueBefore := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	mockLoanUseCase.EXPECT().
+		List(gomock.Any(), 1, 10, repository.LoanFilter{
+			BookID:       &bookID,
+			BorrowedFrom: &from,
+			DueBefore:    &dueBefore,
+			Sort:         []repository.SortField{{Field: "due_date"}},
+		}).
+		Return([]*repository.LoanWithDetails{createTestLoanWithDetails(uuid.New(), bookID)}, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/loans?book_id="+bookID.String()+"&borrowed_from=2024-01-01T00:00:00Z&due_before=2024-02-01T00:00:00Z&sort=due_date", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestListLoans_InvalidSort(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	req := httptest.NewRequest(http.MethodGet, "/loans?sort=user_name", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestListLoans_OverdueFilter(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	loan := createTestLoanWithDetails(uuid.New(), uuid.New())
+	loan.Loan.Status = entity.LoanStatusOverdue
+	loan.Loan.DueDate = time.Now().Add(-49 * time.Hour)
+	status := "overdue"
+
+	mockLoanUseCase.EXPECT().
+		List(gomock.Any(), 1, 10, repository.LoanFilter{Status: &status}).
+		Return([]*repository.LoanWithDetails{loan}, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/loans?status=overdue", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.LoanListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, generated.LoanStatusOverdue, *(*response.Data)[0].Status)
+	assert.Equal(t, 3, *(*response.Data)[0].DaysOverdue)
+}
+
 func TestListLoans_Error(t *testing.T) {
 	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
 	defer ctrl.Finish()
 	router := setupTestRouter(handler)
 
 	mockLoanUseCase.EXPECT().
-		List(gomock.Any(), 1, 10, (*uuid.UUID)(nil), (*string)(nil)).
+		List(gomock.Any(), 1, 10, repository.LoanFilter{}).
 		Return(nil, 0, errors.New("database error"))
 
 	req := httptest.NewRequest(http.MethodGet, "/loans", nil)
@@ -189,6 +289,64 @@ func TestBorrowBook_UserHasActiveLoan(t *testing.T) {
 	assert.Equal(t, "user already has an active loan for this book", *response.Error)
 }
 
+func TestBorrowBook_OutstandingFines(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	mockLoanUseCase.EXPECT().
+		BorrowBook(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrOutstandingFines)
+
+	reqBody := generated.BorrowBookRequest{
+		UserId: openapi_types.UUID(uuid.New()),
+		BookId: openapi_types.UUID(uuid.New()),
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := httptest.NewRequest(http.MethodPost, "/loans/borrow", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "OUTSTANDING_FINES", *response.Code)
+}
+
+func TestBorrowBook_LoanLimitReached(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	mockLoanUseCase.EXPECT().
+		BorrowBook(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrLoanLimitReached)
+
+	reqBody := generated.BorrowBookRequest{
+		UserId: openapi_types.UUID(uuid.New()),
+		BookId: openapi_types.UUID(uuid.New()),
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := httptest.NewRequest(http.MethodPost, "/loans/borrow", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "LOAN_LIMIT_REACHED", *response.Code)
+}
+
 func TestBorrowBook_UserNotFound(t *testing.T) {
 	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
 	defer ctrl.Finish()
@@ -216,6 +374,134 @@ func TestBorrowBook_UserNotFound(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
 
+func TestBorrowBook_ConcurrentModification(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	mockLoanUseCase.EXPECT().
+		BorrowBook(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrConcurrentModification)
+
+	reqBody := generated.BorrowBookRequest{
+		UserId: openapi_types.UUID(uuid.New()),
+		BookId: openapi_types.UUID(uuid.New()),
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := httptest.NewRequest(http.MethodPost, "/loans/borrow", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "CONCURRENT_MODIFICATION", *response.Code)
+}
+
+func TestBorrowBook_MemberForOtherUserForbidden(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleMember)
+
+	reqBody := generated.BorrowBookRequest{
+		UserId: openapi_types.UUID(uuid.New()),
+		BookId: openapi_types.UUID(uuid.New()),
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := httptest.NewRequest(http.MethodPost, "/loans/borrow", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestBorrowBook_MemberDueDateForbidden(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	router := setupTestRouterAs(handler, userID, entity.RoleMember)
+
+	reqBody := generated.BorrowBookRequest{
+		UserId:  openapi_types.UUID(userID),
+		BookId:  openapi_types.UUID(uuid.New()),
+		DueDate: &openapi_types.Date{Time: time.Now().AddDate(1, 0, 0)},
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := httptest.NewRequest(http.MethodPost, "/loans/borrow", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestBorrowBook_LibrarianSetsDueDate(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleLibrarian)
+
+	userID := uuid.New()
+	bookID := uuid.New()
+	due := time.Date(2030, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	mockLoanUseCase.EXPECT().
+		BorrowBook(gomock.Any(), usecase.BorrowBookInput{UserID: userID, BookID: bookID, DueDate: &due}).
+		Return(createTestLoanWithDetails(userID, bookID), nil)
+
+	reqBody := generated.BorrowBookRequest{
+		UserId:  openapi_types.UUID(userID),
+		BookId:  openapi_types.UUID(bookID),
+		DueDate: &openapi_types.Date{Time: due},
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := httptest.NewRequest(http.MethodPost, "/loans/borrow", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestBorrowBook_LibrarianForOtherUser(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleLibrarian)
+
+	userID := uuid.New()
+	bookID := uuid.New()
+
+	mockLoanUseCase.EXPECT().
+		BorrowBook(gomock.Any(), gomock.Any()).
+		Return(createTestLoanWithDetails(userID, bookID), nil)
+
+	reqBody := generated.BorrowBookRequest{
+		UserId: openapi_types.UUID(userID),
+		BookId: openapi_types.UUID(bookID),
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := httptest.NewRequest(http.MethodPost, "/loans/borrow", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
 func TestBorrowBook_InvalidRequestBody(t *testing.T) {
 	handler, _, _, _, _, ctrl := setupTestHandler(t)
 	defer ctrl.Finish()
@@ -257,6 +543,35 @@ func TestReturnBook_Success(t *testing.T) {
 	assert.NotNil(t, response.Data)
 }
 
+func TestReturnBook_OverdueLoan(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	loanWithDetails := createTestLoanWithDetails(uuid.New(), uuid.New())
+	loanID := loanWithDetails.Loan.ID
+	returnedAt := time.Now()
+	loanWithDetails.Loan.DueDate = returnedAt.AddDate(0, 0, -2)
+	loanWithDetails.Loan.ReturnedAt = &returnedAt
+	loanWithDetails.Loan.Status = entity.LoanStatusReturned
+
+	mockLoanUseCase.EXPECT().
+		ReturnBook(gomock.Any(), loanID).
+		Return(loanWithDetails, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/loans/"+loanID.String()+"/return", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.LoanResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *response.Data.DaysOverdue)
+}
+
 func TestReturnBook_NotFound(t *testing.T) {
 	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
 	defer ctrl.Finish()
@@ -300,6 +615,49 @@ func TestReturnBook_AlreadyReturned(t *testing.T) {
 	assert.Equal(t, "loan has already been returned", *response.Error)
 }
 
+func TestReturnBook_MemberOwnLoan(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	router := setupTestRouterAs(handler, userID, entity.RoleMember)
+
+	loan := createTestLoanWithDetails(userID, uuid.New())
+
+	mockLoanUseCase.EXPECT().
+		GetByID(gomock.Any(), loan.Loan.ID).
+		Return(loan, nil)
+	mockLoanUseCase.EXPECT().
+		ReturnBook(gomock.Any(), loan.Loan.ID).
+		Return(loan, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/loans/"+loan.Loan.ID.String()+"/return", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestReturnBook_MemberOtherUsersLoanForbidden(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleMember)
+
+	loan := createTestLoanWithDetails(uuid.New(), uuid.New())
+
+	mockLoanUseCase.EXPECT().
+		GetByID(gomock.Any(), loan.Loan.ID).
+		Return(loan, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/loans/"+loan.Loan.ID.String()+"/return", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
 func TestReturnBook_InvalidID(t *testing.T) {
 	handler, _, _, _, _, ctrl := setupTestHandler(t)
 	defer ctrl.Finish()
@@ -312,3 +670,188 @@ func TestReturnBook_InvalidID(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
+
+func TestReturnBook_Damaged(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	loan := createTestLoanWithDetails(uuid.New(), uuid.New())
+	loan.Loan.Status = entity.LoanStatusDamaged
+
+	mockLoanUseCase.EXPECT().
+		ReturnDamaged(gomock.Any(), loan.Loan.ID, usecase.DamageReport{ChargeCents: 1500}).
+		Return(loan, nil)
+
+	damaged := true
+	charge := int64(1500)
+	body, _ := json.Marshal(generated.ReturnBookRequest{
+		Damaged:           &damaged,
+		DamageChargeCents: &charge,
+	})
+
+	req := httptest.NewRequest(http.MethodPatch, "/loans/"+loan.Loan.ID.String()+"/return", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.LoanResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, generated.LoanStatusDamaged, *response.Data.Status)
+}
+
+func TestReturnBook_MemberCannotReportDamage(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleMember)
+
+	damaged := true
+	body, _ := json.Marshal(generated.ReturnBookRequest{Damaged: &damaged})
+
+	req := httptest.NewRequest(http.MethodPatch, "/loans/"+uuid.New().String()+"/return", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestDeclareLoanLost_Success(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	loan := createTestLoanWithDetails(uuid.New(), uuid.New())
+	loan.Loan.Status = entity.LoanStatusLost
+	replacement := int64(4500)
+
+	mockLoanUseCase.EXPECT().
+		DeclareLost(gomock.Any(), usecase.DeclareLostInput{LoanID: loan.Loan.ID, ReplacementCents: &replacement}).
+		Return(loan, nil)
+
+	body, _ := json.Marshal(generated.DeclareLostRequest{ReplacementCents: &replacement})
+
+	req := httptest.NewRequest(http.MethodPatch, "/loans/"+loan.Loan.ID.String()+"/lost", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.LoanResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, generated.LoanStatusLost, *response.Data.Status)
+}
+
+func TestDeclareLoanLost_WithoutBody(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	loanID := uuid.New()
+
+	mockLoanUseCase.EXPECT().
+		DeclareLost(gomock.Any(), usecase.DeclareLostInput{LoanID: loanID}).
+		Return(nil, entity.ErrLoanAlreadyReturned)
+
+	req := httptest.NewRequest(http.MethodPatch, "/loans/"+loanID.String()+"/lost", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRenewLoan_Success(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	loan := createTestLoanWithDetails(uuid.New(), uuid.New())
+	loan.Loan.RenewalCount = 1
+
+	mockLoanUseCase.EXPECT().
+		RenewLoan(gomock.Any(), loan.Loan.ID).
+		Return(loan, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/loans/"+loan.Loan.ID.String()+"/renew", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.LoanResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *response.Data.RenewalCount)
+}
+
+func TestRenewLoan_MaxRenewalsReached(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	loanID := uuid.New()
+
+	mockLoanUseCase.EXPECT().
+		RenewLoan(gomock.Any(), loanID).
+		Return(nil, entity.ErrMaxRenewalsReached)
+
+	req := httptest.NewRequest(http.MethodPatch, "/loans/"+loanID.String()+"/renew", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "MAX_RENEWALS_REACHED", *response.Code)
+}
+
+func TestRenewLoan_PendingHolds(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	loanID := uuid.New()
+
+	mockLoanUseCase.EXPECT().
+		RenewLoan(gomock.Any(), loanID).
+		Return(nil, entity.ErrBookHasPendingHolds)
+
+	req := httptest.NewRequest(http.MethodPatch, "/loans/"+loanID.String()+"/renew", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestRenewLoan_MemberOtherUsersLoanForbidden(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleMember)
+
+	loan := createTestLoanWithDetails(uuid.New(), uuid.New())
+
+	mockLoanUseCase.EXPECT().
+		GetByID(gomock.Any(), loan.Loan.ID).
+		Return(loan, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/loans/"+loan.Loan.ID.String()+"/renew", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
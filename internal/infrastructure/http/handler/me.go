@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"net/http"
+
+	"bookhub/api/generated"
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Self-service handlers for the authenticated user
+
+func (h *Handler) GetMe(c *gin.Context) {
+	user, err := h.userUseCase.GetProfile(c.Request.Context())
+	if err != nil {
+		handleUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.UserResponse{
+		Data: userToResponse(user),
+	})
+}
+
+func (h *Handler) UpdateMe(c *gin.Context) {
+	var req generated.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	input := usecase.UpdateProfileInput{
+		Name: req.Name,
+	}
+	if req.Email != nil {
+		emailStr := string(*req.Email)
+		input.Email = &emailStr
+	}
+
+	user, err := h.userUseCase.UpdateProfile(c.Request.Context(), input)
+	if err != nil {
+		handleUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.UserResponse{
+		Data: userToResponse(user),
+	})
+}
+
+func (h *Handler) ChangeMyPassword(c *gin.Context) {
+	var req generated.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	err := h.userUseCase.ChangePassword(c.Request.Context(), usecase.ChangePasswordInput{
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		handleUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.MessageResponse{
+		Message: strPtr("password changed successfully"),
+	})
+}
+
+func (h *Handler) ListMyLoans(c *gin.Context, params generated.ListMyLoansParams) {
+	page := 1
+	limit := 10
+
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	var status *string
+	if params.Status != nil {
+		s := string(*params.Status)
+		status = &s
+	}
+
+	loans, total, err := h.loanUseCase.ListCallerLoans(c.Request.Context(), page, limit, status)
+	if err != nil {
+		handleLoanError(c, err)
+		return
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	c.JSON(http.StatusOK, generated.LoanListResponse{
+		Data:       loansToResponse(loans),
+		Pagination: paginationResponse(page, limit, total, totalPages),
+	})
+}
+
+func (h *Handler) BorrowForMe(c *gin.Context) {
+	var req generated.BorrowForMeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	bookID, err := uuid.Parse(req.BookId.String())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid book ID"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	loan, err := h.loanUseCase.BorrowForCaller(c.Request.Context(), usecase.BorrowForCallerInput{
+		BookID: bookID,
+	})
+	if err != nil {
+		handleLoanError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, generated.LoanResponse{
+		Data: loanToResponse(loan),
+	})
+}
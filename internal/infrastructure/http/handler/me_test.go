@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetMe_Success(t *testing.T) {
+	handler, mockUserUseCase, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+
+	user := createTestUser()
+	router := setupTestRouterAs(handler, user.ID, entity.RoleMember)
+
+	mockUserUseCase.EXPECT().
+		GetProfile(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) (*entity.User, error) {
+			caller, ok := usecase.CallerFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, user.ID, caller.UserID)
+			return user, nil
+		})
+
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.UserResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID.String(), response.Data.Id.String())
+}
+
+func TestGetMe_Unauthenticated(t *testing.T) {
+	handler, mockUserUseCase, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	mockUserUseCase.EXPECT().
+		GetProfile(gomock.Any()).
+		Return(nil, usecase.ErrUnauthenticated)
+
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestUpdateMe_Success(t *testing.T) {
+	handler, mockUserUseCase, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+
+	user := createTestUser()
+	router := setupTestRouterAs(handler, user.ID, entity.RoleMember)
+	updatedName := "Updated Name"
+
+	mockUserUseCase.EXPECT().
+		UpdateProfile(gomock.Any(), usecase.UpdateProfileInput{Name: &updatedName}).
+		Return(user, nil)
+
+	body, _ := json.Marshal(generated.UpdateProfileRequest{Name: &updatedName})
+
+	req := httptest.NewRequest(http.MethodPatch, "/me", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestChangeMyPassword_Success(t *testing.T) {
+	handler, mockUserUseCase, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleMember)
+
+	mockUserUseCase.EXPECT().
+		ChangePassword(gomock.Any(), usecase.ChangePasswordInput{
+			CurrentPassword: "password123",
+			NewPassword:     "newpassword123",
+		}).
+		Return(nil)
+
+	body, _ := json.Marshal(generated.ChangePasswordRequest{
+		CurrentPassword: "password123",
+		NewPassword:     "newpassword123",
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/me/password", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestChangeMyPassword_WrongCurrentPassword(t *testing.T) {
+	handler, mockUserUseCase, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleMember)
+
+	mockUserUseCase.EXPECT().
+		ChangePassword(gomock.Any(), gomock.Any()).
+		Return(entity.ErrInvalidCurrentPassword)
+
+	body, _ := json.Marshal(generated.ChangePasswordRequest{
+		CurrentPassword: "wrongpassword",
+		NewPassword:     "newpassword123",
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/me/password", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "INVALID_CURRENT_PASSWORD", *response.Code)
+}
+
+func TestListMyLoans_Success(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	router := setupTestRouterAs(handler, userID, entity.RoleMember)
+
+	loans := []*repository.LoanWithDetails{
+		createTestLoanWithDetails(userID, uuid.New()),
+	}
+	status := "active"
+
+	mockLoanUseCase.EXPECT().
+		ListCallerLoans(gomock.Any(), 1, 10, &status).
+		Return(loans, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/me/loans?status=active", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.LoanListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+}
+
+func TestBorrowForMe_Success(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	bookID := uuid.New()
+	router := setupTestRouterAs(handler, userID, entity.RoleMember)
+
+	mockLoanUseCase.EXPECT().
+		BorrowForCaller(gomock.Any(), usecase.BorrowForCallerInput{BookID: bookID}).
+		Return(createTestLoanWithDetails(userID, bookID), nil)
+
+	body, _ := json.Marshal(generated.BorrowForMeRequest{
+		BookId: openapi_types.UUID(bookID),
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/me/loans", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestBorrowForMe_BookNotAvailable(t *testing.T) {
+	handler, _, _, mockLoanUseCase, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleMember)
+
+	mockLoanUseCase.EXPECT().
+		BorrowForCaller(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrBookNotAvailable)
+
+	body, _ := json.Marshal(generated.BorrowForMeRequest{
+		BookId: openapi_types.UUID(uuid.New()),
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/me/loans", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
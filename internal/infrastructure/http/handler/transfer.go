@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"net/http"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Transfer handlers
+
+func (h *Handler) ListTransfers(c *gin.Context, params generated.ListTransfersParams) {
+	page := 1
+	limit := 10
+
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	var filter repository.TransferFilter
+	if params.BranchId != nil {
+		id := uuid.UUID(*params.BranchId)
+		filter.BranchID = &id
+	}
+	if params.Status != nil {
+		s := string(*params.Status)
+		filter.Status = &s
+	}
+
+	transfers, total, err := h.transferUseCase.List(c.Request.Context(), page, limit, filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("failed to list transfers"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+		return
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	c.JSON(http.StatusOK, generated.TransferListResponse{
+		Data:       transfersToResponse(transfers),
+		Pagination: paginationResponse(page, limit, total, totalPages),
+	})
+}
+
+func (h *Handler) RequestTransfer(c *gin.Context) {
+	var req generated.RequestTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	transfer, err := h.transferUseCase.Request(c.Request.Context(), usecase.RequestTransferInput{
+		CopyID:     uuid.UUID(req.CopyId),
+		ToBranchID: uuid.UUID(req.ToBranchId),
+	})
+	if err != nil {
+		handleTransferError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, generated.TransferResponse{
+		Data: transferToResponse(transfer),
+	})
+}
+
+func (h *Handler) GetTransferById(c *gin.Context, id openapi_types.UUID) {
+	transfer, err := h.transferUseCase.GetByID(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleTransferError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.TransferResponse{
+		Data: transferToResponse(transfer),
+	})
+}
+
+func (h *Handler) ShipTransfer(c *gin.Context, id openapi_types.UUID) {
+	transfer, err := h.transferUseCase.Ship(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleTransferError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.TransferResponse{
+		Data: transferToResponse(transfer),
+	})
+}
+
+func (h *Handler) ReceiveTransfer(c *gin.Context, id openapi_types.UUID) {
+	transfer, err := h.transferUseCase.Receive(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleTransferError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.TransferResponse{
+		Data: transferToResponse(transfer),
+	})
+}
+
+func (h *Handler) CancelTransfer(c *gin.Context, id openapi_types.UUID) {
+	transfer, err := h.transferUseCase.Cancel(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleTransferError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.TransferResponse{
+		Data: transferToResponse(transfer),
+	})
+}
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func createTestTransfer() *entity.Transfer {
+	bookCopy, _ := entity.NewBookCopy(uuid.New(), uuid.New(), "9780132350884-001", "", "")
+	transfer, _ := entity.NewTransfer(bookCopy, uuid.New())
+	return transfer
+}
+
+func TestListTransfers_WithFilters(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	transfer := createTestTransfer()
+	branchID := transfer.ToBranchID
+	status := entity.TransferStatusRequested
+
+	m.transferUseCase.EXPECT().
+		List(gomock.Any(), 1, 10, repository.TransferFilter{BranchID: &branchID, Status: &status}).
+		Return([]*entity.Transfer{transfer}, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/transfers?branch_id="+branchID.String()+"&status=requested", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.TransferListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+	assert.Equal(t, generated.TransferStatusRequested, *(*response.Data)[0].Status)
+}
+
+func TestRequestTransfer_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	transfer := createTestTransfer()
+
+	m.transferUseCase.EXPECT().
+		Request(gomock.Any(), usecase.RequestTransferInput{CopyID: transfer.CopyID, ToBranchID: transfer.ToBranchID}).
+		Return(transfer, nil)
+
+	body, _ := json.Marshal(generated.RequestTransferRequest{
+		CopyId:     transfer.CopyID,
+		ToBranchId: transfer.ToBranchID,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/transfers", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response generated.TransferResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, transfer.FromBranchID, *response.Data.FromBranchId)
+}
+
+func TestRequestTransfer_OpenTransferExists(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	m.transferUseCase.EXPECT().
+		Request(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrCopyHasOpenTransfer)
+
+	body, _ := json.Marshal(generated.RequestTransferRequest{CopyId: uuid.New(), ToBranchId: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodPost, "/transfers", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestShipTransfer_CopyUnavailable(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	transferID := uuid.New()
+
+	m.transferUseCase.EXPECT().
+		Ship(gomock.Any(), transferID).
+		Return(nil, entity.ErrCopyNotAvailable)
+
+	req := httptest.NewRequest(http.MethodPatch, "/transfers/"+transferID.String()+"/ship", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "COPY_UNAVAILABLE", *response.Code)
+}
+
+func TestReceiveTransfer_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	transfer := createTestTransfer()
+	_ = transfer.Ship()
+	_ = transfer.Receive()
+
+	m.transferUseCase.EXPECT().
+		Receive(gomock.Any(), transfer.ID).
+		Return(transfer, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/transfers/"+transfer.ID.String()+"/receive", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.TransferResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, generated.TransferStatusReceived, *response.Data.Status)
+	assert.NotNil(t, response.Data.ReceivedAt)
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
 	"bookhub/internal/usecase"
 
 	"github.com/gin-gonic/gin"
@@ -24,7 +26,16 @@ func (h *Handler) ListUsers(c *gin.Context, params generated.ListUsersParams) {
 		limit = *params.Limit
 	}
 
-	users, total, err := h.userUseCase.List(c.Request.Context(), page, limit)
+	sort, ok := parseSort(c, params.Sort, repository.UserSortFields)
+	if !ok {
+		return
+	}
+	filter := repository.UserFilter{Active: params.Active, Sort: sort}
+	if params.Q != nil {
+		filter.Query = *params.Q
+	}
+
+	users, total, err := h.userUseCase.List(c.Request.Context(), page, limit, filter)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
 			Error: strPtr("failed to list users"),
@@ -51,11 +62,22 @@ func (h *Handler) CreateUser(c *gin.Context) {
 		return
 	}
 
-	user, err := h.userUseCase.Create(c.Request.Context(), usecase.CreateUserInput{
+	input := usecase.CreateUserInput{
 		Name:     req.Name,
 		Email:    string(req.Email),
 		Password: req.Password,
-	})
+	}
+	if req.Role != nil {
+		input.Role = string(*req.Role)
+	}
+	if req.Category != nil {
+		input.Category = *req.Category
+	}
+	if req.CardNumber != nil {
+		input.CardNumber = *req.CardNumber
+	}
+
+	user, err := h.userUseCase.Create(c.Request.Context(), input)
 	if err != nil {
 		handleUserError(c, err)
 		return
@@ -76,6 +98,10 @@ func (h *Handler) GetUserById(c *gin.Context, id openapi_types.UUID) {
 		return
 	}
 
+	if !requireSelfOrRole(c, userID, entity.RoleAdmin, entity.RoleLibrarian) {
+		return
+	}
+
 	user, err := h.userUseCase.GetByID(c.Request.Context(), userID)
 	if err != nil {
 		handleUserError(c, err)
@@ -97,6 +123,10 @@ func (h *Handler) UpdateUser(c *gin.Context, id openapi_types.UUID) {
 		return
 	}
 
+	if !requireSelfOrRole(c, userID, entity.RoleAdmin) {
+		return
+	}
+
 	var req generated.UpdateUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
@@ -106,6 +136,13 @@ func (h *Handler) UpdateUser(c *gin.Context, id openapi_types.UUID) {
 		return
 	}
 
+	// Users may edit their own profile, but only admins hand out roles,
+	// patron categories and library cards
+	if (req.Role != nil || req.Category != nil || req.CardNumber != nil) && callerRole(c) != entity.RoleAdmin {
+		respondForbidden(c)
+		return
+	}
+
 	input := usecase.UpdateUserInput{}
 	if req.Name != nil {
 		input.Name = req.Name
@@ -114,6 +151,16 @@ func (h *Handler) UpdateUser(c *gin.Context, id openapi_types.UUID) {
 		emailStr := string(*req.Email)
 		input.Email = &emailStr
 	}
+	if req.Role != nil {
+		roleStr := string(*req.Role)
+		input.Role = &roleStr
+	}
+	if req.Category != nil {
+		input.Category = req.Category
+	}
+	if req.CardNumber != nil {
+		input.CardNumber = req.CardNumber
+	}
 
 	user, err := h.userUseCase.Update(c.Request.Context(), userID, input)
 	if err != nil {
@@ -145,3 +192,24 @@ func (h *Handler) DisableUser(c *gin.Context, id openapi_types.UUID) {
 		Message: strPtr("user disabled successfully"),
 	})
 }
+
+func (h *Handler) UnblockUser(c *gin.Context, id openapi_types.UUID) {
+	userID, err := uuid.Parse(id.String())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid user ID"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	user, err := h.userUseCase.Unblock(c.Request.Context(), userID)
+	if err != nil {
+		handleUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.UserResponse{
+		Data: userToResponse(user),
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	"bookhub/api/generated"
 	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
 	"bookhub/internal/usecase"
 
 	"github.com/google/uuid"
@@ -25,7 +26,7 @@ func TestListUsers_Success(t *testing.T) {
 	users := []*entity.User{createTestUser(), createTestUser()}
 
 	mockUserUseCase.EXPECT().
-		List(gomock.Any(), 1, 10).
+		List(gomock.Any(), 1, 10, repository.UserFilter{}).
 		Return(users, 2, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/users", nil)
@@ -50,7 +51,7 @@ func TestListUsers_WithPagination(t *testing.T) {
 	users := []*entity.User{createTestUser()}
 
 	mockUserUseCase.EXPECT().
-		List(gomock.Any(), 2, 5).
+		List(gomock.Any(), 2, 5, repository.UserFilter{}).
 		Return(users, 10, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/users?page=2&limit=5", nil)
@@ -68,13 +69,49 @@ func TestListUsers_WithPagination(t *testing.T) {
 	assert.Equal(t, 10, *response.Pagination.Total)
 }
 
+func TestListUsers_WithFiltersAndSort(t *testing.T) {
+	handler, mockUserUseCase, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	active := false
+
+	mockUserUseCase.EXPECT().
+		List(gomock.Any(), 1, 10, repository.UserFilter{
+			Active: &active,
+			Query:  "john",
+			Sort:   []repository.SortField{{Field: "name"}, {Field: "created_at", Desc: true}},
+		}).
+		Return([]*entity.User{createTestUser()}, 1, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/users?active=false&q=john&sort=name,-created_at", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestListUsers_InvalidSort(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	req := httptest.NewRequest(http.MethodGet, "/users?sort=name,name", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 func TestListUsers_Error(t *testing.T) {
 	handler, mockUserUseCase, _, _, _, ctrl := setupTestHandler(t)
 	defer ctrl.Finish()
 	router := setupTestRouter(handler)
 
 	mockUserUseCase.EXPECT().
-		List(gomock.Any(), 1, 10).
+		List(gomock.Any(), 1, 10, repository.UserFilter{}).
 		Return(nil, 0, errors.New("database error"))
 
 	req := httptest.NewRequest(http.MethodGet, "/users", nil)
@@ -208,6 +245,43 @@ func TestGetUserById_NotFound(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
 
+func TestGetUserById_MemberOwnProfile(t *testing.T) {
+	handler, mockUserUseCase, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+
+	user := createTestUser()
+	router := setupTestRouterAs(handler, user.ID, entity.RoleMember)
+
+	mockUserUseCase.EXPECT().
+		GetByID(gomock.Any(), user.ID).
+		Return(user, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/"+user.ID.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGetUserById_MemberOtherProfileForbidden(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleMember)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/"+uuid.New().String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	var response generated.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "FORBIDDEN", *response.Code)
+}
+
 func TestGetUserById_InvalidID(t *testing.T) {
 	handler, _, _, _, _, ctrl := setupTestHandler(t)
 	defer ctrl.Finish()
@@ -273,6 +347,61 @@ func TestUpdateUser_NotFound(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
 
+func TestUpdateUser_LibrarianOtherProfileForbidden(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouterAs(handler, uuid.New(), entity.RoleLibrarian)
+
+	updatedName := "Updated Name"
+	body, _ := json.Marshal(generated.UpdateUserRequest{Name: &updatedName})
+
+	req := httptest.NewRequest(http.MethodPut, "/users/"+uuid.New().String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestUpdateUser_MemberCannotChangeOwnRole(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	router := setupTestRouterAs(handler, userID, entity.RoleMember)
+
+	role := generated.Admin
+	body, _ := json.Marshal(generated.UpdateUserRequest{Role: &role})
+
+	req := httptest.NewRequest(http.MethodPut, "/users/"+userID.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestUpdateUser_MemberCannotChangeOwnCategory(t *testing.T) {
+	handler, _, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	router := setupTestRouterAs(handler, userID, entity.RoleMember)
+
+	category := "staff"
+	body, _ := json.Marshal(generated.UpdateUserRequest{Category: &category})
+
+	req := httptest.NewRequest(http.MethodPut, "/users/"+userID.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
 func TestDisableUser_Success(t *testing.T) {
 	handler, mockUserUseCase, _, _, _, ctrl := setupTestHandler(t)
 	defer ctrl.Finish()
@@ -334,3 +463,46 @@ func TestDisableUser_AlreadyDisabled(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
+
+func TestUnblockUser_Success(t *testing.T) {
+	handler, mockUserUseCase, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	user := createTestUser()
+
+	mockUserUseCase.EXPECT().
+		Unblock(gomock.Any(), user.ID).
+		Return(user, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/users/"+user.ID.String()+"/unblock", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.UserResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.False(t, *response.Data.Blocked)
+}
+
+func TestUnblockUser_NotBlocked(t *testing.T) {
+	handler, mockUserUseCase, _, _, _, ctrl := setupTestHandler(t)
+	defer ctrl.Finish()
+	router := setupTestRouter(handler)
+
+	userID := uuid.New()
+
+	mockUserUseCase.EXPECT().
+		Unblock(gomock.Any(), userID).
+		Return(nil, entity.ErrUserNotBlocked)
+
+	req := httptest.NewRequest(http.MethodPatch, "/users/"+userID.String()+"/unblock", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
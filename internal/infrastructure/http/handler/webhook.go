@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"net/http"
+
+	"bookhub/api/generated"
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Webhook handlers
+
+func (h *Handler) ListWebhooks(c *gin.Context) {
+	subscriptions, err := h.webhookUseCase.ListSubscriptions(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error: strPtr("failed to list webhooks"),
+			Code:  strPtr("INTERNAL_ERROR"),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.WebhookListResponse{
+		Data: webhooksToResponse(subscriptions),
+	})
+}
+
+func (h *Handler) CreateWebhook(c *gin.Context) {
+	var req generated.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	subscription, err := h.webhookUseCase.CreateSubscription(c.Request.Context(), usecase.CreateWebhookInput{
+		URL:    req.Url,
+		Events: eventTypesFromOpenAPI(req.Events),
+		Secret: req.Secret,
+	})
+	if err != nil {
+		handleWebhookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, generated.WebhookResponse{
+		Data: webhookToResponse(subscription),
+	})
+}
+
+func (h *Handler) GetWebhookById(c *gin.Context, id openapi_types.UUID) {
+	subscription, err := h.webhookUseCase.GetSubscription(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		handleWebhookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.WebhookResponse{
+		Data: webhookToResponse(subscription),
+	})
+}
+
+func (h *Handler) UpdateWebhook(c *gin.Context, id openapi_types.UUID) {
+	var req generated.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error: strPtr("invalid request body"),
+			Code:  strPtr("BAD_REQUEST"),
+		})
+		return
+	}
+
+	input := usecase.UpdateWebhookInput{
+		URL:    req.Url,
+		Secret: req.Secret,
+		Active: req.Active,
+	}
+	if req.Events != nil {
+		input.Events = eventTypesFromOpenAPI(*req.Events)
+	}
+
+	subscription, err := h.webhookUseCase.UpdateSubscription(c.Request.Context(), uuid.UUID(id), input)
+	if err != nil {
+		handleWebhookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.WebhookResponse{
+		Data: webhookToResponse(subscription),
+	})
+}
+
+func (h *Handler) DeleteWebhook(c *gin.Context, id openapi_types.UUID) {
+	if err := h.webhookUseCase.DeleteSubscription(c.Request.Context(), uuid.UUID(id)); err != nil {
+		handleWebhookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, generated.MessageResponse{
+		Message: strPtr("webhook deleted successfully"),
+	})
+}
+
+func (h *Handler) ListWebhookDeliveries(c *gin.Context, id openapi_types.UUID, params generated.ListWebhookDeliveriesParams) {
+	page := 1
+	limit := 10
+
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	deliveries, total, err := h.webhookUseCase.ListDeliveries(c.Request.Context(), uuid.UUID(id), page, limit)
+	if err != nil {
+		handleWebhookError(c, err)
+		return
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	c.JSON(http.StatusOK, generated.WebhookDeliveryListResponse{
+		Data:       webhookDeliveriesToResponse(deliveries),
+		Pagination: paginationResponse(page, limit, total, totalPages),
+	})
+}
+
+func (h *Handler) RedeliverWebhook(c *gin.Context, id openapi_types.UUID, deliveryId openapi_types.UUID) {
+	delivery, err := h.webhookUseCase.Redeliver(c.Request.Context(), uuid.UUID(id), uuid.UUID(deliveryId))
+	if err != nil {
+		handleWebhookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, generated.WebhookDeliveryResponse{
+		Data: webhookDeliveryToResponse(delivery),
+	})
+}
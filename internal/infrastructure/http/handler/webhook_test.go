@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"bookhub/api/generated"
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const testWebhookSecret = "0123456789abcdef"
+
+func createTestWebhook(t *testing.T) *entity.WebhookSubscription {
+	t.Helper()
+	subscription, err := entity.NewWebhookSubscription("https://example.com/hooks", []entity.EventType{entity.EventLoanBorrowed}, testWebhookSecret)
+	assert.NoError(t, err)
+	return subscription
+}
+
+func TestListWebhooks_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	subscription := createTestWebhook(t)
+
+	m.webhookUseCase.EXPECT().
+		ListSubscriptions(gomock.Any()).
+		Return([]*entity.WebhookSubscription{subscription}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), testWebhookSecret)
+
+	var response generated.WebhookListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+	assert.Equal(t, []generated.EventType{generated.LoanBorrowed}, *(*response.Data)[0].Events)
+}
+
+func TestCreateWebhook_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	subscription := createTestWebhook(t)
+
+	m.webhookUseCase.EXPECT().
+		CreateSubscription(gomock.Any(), usecase.CreateWebhookInput{
+			URL:    "https://example.com/hooks",
+			Events: []entity.EventType{entity.EventLoanBorrowed},
+			Secret: testWebhookSecret,
+		}).
+		Return(subscription, nil)
+
+	body, _ := json.Marshal(generated.CreateWebhookRequest{
+		Url:    "https://example.com/hooks",
+		Events: []generated.EventType{generated.LoanBorrowed},
+		Secret: testWebhookSecret,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.NotContains(t, w.Body.String(), testWebhookSecret)
+
+	var response generated.WebhookResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/hooks", *response.Data.Url)
+	assert.True(t, *response.Data.Active)
+}
+
+func TestCreateWebhook_InvalidURL(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	m.webhookUseCase.EXPECT().
+		CreateSubscription(gomock.Any(), gomock.Any()).
+		Return(nil, entity.ErrInvalidWebhookURL)
+
+	body, _ := json.Marshal(generated.CreateWebhookRequest{
+		Url:    "ftp://example.com",
+		Events: []generated.EventType{generated.LoanBorrowed},
+		Secret: testWebhookSecret,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "VALIDATION_ERROR")
+}
+
+func TestGetWebhookById_NotFound(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	id := uuid.New()
+
+	m.webhookUseCase.EXPECT().
+		GetSubscription(gomock.Any(), id).
+		Return(nil, entity.ErrWebhookNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/"+id.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestUpdateWebhook_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	subscription := createTestWebhook(t)
+	subscription.Active = false
+	active := false
+
+	m.webhookUseCase.EXPECT().
+		UpdateSubscription(gomock.Any(), subscription.ID, usecase.UpdateWebhookInput{
+			Events: []entity.EventType{entity.EventLoanBorrowed, entity.EventLoanReturned},
+			Active: &active,
+		}).
+		Return(subscription, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/webhooks/"+subscription.ID.String(),
+		strings.NewReader(`{"events":["loan.borrowed","loan.returned"],"active":false}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.WebhookResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.False(t, *response.Data.Active)
+}
+
+func TestDeleteWebhook_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	id := uuid.New()
+
+	m.webhookUseCase.EXPECT().
+		DeleteSubscription(gomock.Any(), id).
+		Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/webhooks/"+id.String(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestListWebhookDeliveries_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	subscription := createTestWebhook(t)
+	delivery := entity.NewWebhookDelivery(subscription.ID, uuid.New(), entity.EventLoanBorrowed, []byte(`{}`))
+
+	m.webhookUseCase.EXPECT().
+		ListDeliveries(gomock.Any(), subscription.ID, 2, 5).
+		Return([]*entity.WebhookDelivery{delivery}, 6, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/"+subscription.ID.String()+"/deliveries?page=2&limit=5", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.WebhookDeliveryListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, *response.Data, 1)
+	assert.Equal(t, generated.Pending, *(*response.Data)[0].Status)
+	assert.Equal(t, 2, *response.Pagination.TotalPages)
+}
+
+func TestRedeliverWebhook_Success(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	subscription := createTestWebhook(t)
+	delivery := entity.NewWebhookDelivery(subscription.ID, uuid.New(), entity.EventLoanBorrowed, []byte(`{}`))
+	again := delivery.Redeliver()
+
+	m.webhookUseCase.EXPECT().
+		Redeliver(gomock.Any(), subscription.ID, delivery.ID).
+		Return(again, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/"+subscription.ID.String()+"/deliveries/"+delivery.ID.String()+"/redeliver", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response generated.WebhookDeliveryResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, again.ID, uuid.UUID(*response.Data.Id))
+	assert.Equal(t, delivery.EventID, uuid.UUID(*response.Data.EventId))
+}
+
+func TestRedeliverWebhook_DeliveryNotFound(t *testing.T) {
+	m := setupHandlerMocks(t)
+	defer m.ctrl.Finish()
+	router := setupTestRouter(m.handler)
+
+	id, deliveryID := uuid.New(), uuid.New()
+
+	m.webhookUseCase.EXPECT().
+		Redeliver(gomock.Any(), id, deliveryID).
+		Return(nil, entity.ErrWebhookDeliveryNotFound)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/"+id.String()+"/deliveries/"+deliveryID.String()+"/redeliver", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -2,10 +2,12 @@ package middleware
 
 import (
 	"net/http"
+	"slices"
 	"strings"
 
 	"bookhub/api/generated"
 	"bookhub/internal/infrastructure/auth"
+	"bookhub/internal/usecase"
 
 	"github.com/gin-gonic/gin"
 )
@@ -15,16 +17,19 @@ const (
 	BearerPrefix        = "Bearer "
 	UserIDKey           = "user_id"
 	UserEmailKey        = "user_email"
+	UserRoleKey         = "user_role"
 )
 
 // JWTAuthWithOpenAPI creates a middleware that checks authentication based on OpenAPI spec.
 // It only validates JWT tokens for routes that have security defined in the OpenAPI specification.
 // Routes without security (like /auth/login) will pass through without authentication.
+// The scopes of a route list the roles allowed to call it; an empty list admits any
+// authenticated user.
 func JWTAuthWithOpenAPI(jwtService auth.JWTService) generated.MiddlewareFunc {
 	return func(c *gin.Context) {
 		// Check if this route requires authentication by looking for BearerAuthScopes
 		// The oapi-codegen sets this value only for routes with security defined in OpenAPI
-		_, exists := c.Get(generated.BearerAuthScopes)
+		scopes, exists := c.Get(generated.BearerAuthScopes)
 		if !exists {
 			// Route does not require authentication
 			c.Next()
@@ -59,8 +64,23 @@ func JWTAuthWithOpenAPI(jwtService auth.JWTService) generated.MiddlewareFunc {
 			return
 		}
 
+		if roles, _ := scopes.([]string); len(roles) > 0 && !slices.Contains(roles, claims.Role) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "insufficient permissions for this operation",
+				"code":  "FORBIDDEN",
+			})
+			return
+		}
+
 		c.Set(UserIDKey, claims.UserID)
 		c.Set(UserEmailKey, claims.Email)
+		c.Set(UserRoleKey, claims.Role)
+
+		// Use cases read the caller from the request context
+		c.Request = c.Request.WithContext(usecase.ContextWithCaller(c.Request.Context(), usecase.Caller{
+			UserID: claims.UserID,
+			Role:   claims.Role,
+		}))
 		c.Next()
 	}
 }
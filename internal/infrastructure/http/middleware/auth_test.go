@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bookhub/api/generated"
+	"bookhub/internal/infrastructure/auth"
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupAuthRouter mimics the generated wrappers: scopes are set on the context
+// before the middleware runs, and nil scopes mean a public route.
+func setupAuthRouter(jwtService auth.JWTService, scopes []string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/resource", func(c *gin.Context) {
+		if scopes != nil {
+			c.Set(generated.BearerAuthScopes, scopes)
+		}
+		JWTAuthWithOpenAPI(jwtService)(c)
+		if c.IsAborted() {
+			return
+		}
+		caller, _ := usecase.CallerFromContext(c.Request.Context())
+		c.JSON(http.StatusOK, gin.H{
+			"role":        c.GetString(UserRoleKey),
+			"caller_id":   caller.UserID,
+			"caller_role": caller.Role,
+		})
+	})
+	return router
+}
+
+func TestJWTAuthWithOpenAPI(t *testing.T) {
+	jwtService := auth.NewJWTService(auth.JWTConfig{
+		SecretKey:     "test-secret-key",
+		TokenDuration: time.Hour,
+		Issuer:        "bookhub-test",
+	})
+
+	tokenFor := func(role string) string {
+		token, _, _ := jwtService.GenerateToken(uuid.New(), "test@example.com", role)
+		return token
+	}
+
+	tests := []struct {
+		name   string
+		scopes []string
+		token  string
+		want   int
+	}{
+		{"public route", nil, "", http.StatusOK},
+		{"missing token", []string{}, "", http.StatusUnauthorized},
+		{"any authenticated user", []string{}, tokenFor("member"), http.StatusOK},
+		{"role in scopes", []string{"admin", "librarian"}, tokenFor("librarian"), http.StatusOK},
+		{"role not in scopes", []string{"admin", "librarian"}, tokenFor("member"), http.StatusForbidden},
+		{"token without role", []string{"admin"}, tokenFor(""), http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupAuthRouter(jwtService, tt.scopes)
+
+			req := httptest.NewRequest(http.MethodGet, "/resource", nil)
+			if tt.token != "" {
+				req.Header.Set(AuthorizationHeader, BearerPrefix+tt.token)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
+
+func TestJWTAuthWithOpenAPI_SetsCaller(t *testing.T) {
+	jwtService := auth.NewJWTService(auth.JWTConfig{
+		SecretKey:     "test-secret-key",
+		TokenDuration: time.Hour,
+		Issuer:        "bookhub-test",
+	})
+
+	userID := uuid.New()
+	token, _, _ := jwtService.GenerateToken(userID, "test@example.com", "librarian")
+	router := setupAuthRouter(jwtService, []string{})
+
+	req := httptest.NewRequest(http.MethodGet, "/resource", nil)
+	req.Header.Set(AuthorizationHeader, BearerPrefix+token)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]string
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "librarian", response["role"])
+	assert.Equal(t, userID.String(), response["caller_id"])
+	assert.Equal(t, "librarian", response["caller_role"])
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	RequestIDKey    = "request_id"
+	// maxRequestIDLength caps the IDs taken from clients, which the audit
+	// log stores.
+	maxRequestIDLength = 100
+)
+
+// RequestID tags each request with the ID the client sent in X-Request-ID,
+// or a new one, and echoes it back in the response. Use cases read it from
+// the request context.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = uuid.NewString()
+		}
+
+		c.Set(RequestIDKey, requestID)
+		c.Header(RequestIDHeader, requestID)
+		c.Request = c.Request.WithContext(usecase.ContextWithRequestID(c.Request.Context(), requestID))
+		c.Next()
+	}
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"bookhub/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/resource", func(c *gin.Context) {
+		c.String(http.StatusOK, usecase.RequestIDFromContext(c.Request.Context()))
+	})
+
+	tests := []struct {
+		name     string
+		header   string
+		generate bool
+	}{
+		{"client ID is kept", "req-42", false},
+		{"missing ID is generated", "", true},
+		{"oversized ID is replaced", strings.Repeat("x", maxRequestIDLength+1), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/resource", nil)
+			if tt.header != "" {
+				req.Header.Set(RequestIDHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			got := w.Header().Get(RequestIDHeader)
+			assert.Equal(t, got, w.Body.String())
+			if tt.generate {
+				_, err := uuid.Parse(got)
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, tt.header, got)
+			}
+		})
+	}
+}
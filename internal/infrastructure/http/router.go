@@ -17,6 +17,7 @@ func NewRouter(h *handler.Handler) *gin.Engine {
 	router := gin.Default()
 
 	router.Use(gin.Recovery())
+	router.Use(middleware.RequestID())
 
 	router.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{"status": "ok"})
@@ -0,0 +1,35 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScheduler_Every(t *testing.T) {
+	scheduler := NewScheduler()
+
+	var runs atomic.Int32
+	scheduler.Every("test", 5*time.Millisecond, func(ctx context.Context) error {
+		runs.Add(1)
+		return errors.New("keeps running after errors")
+	})
+
+	deadline := time.Now().Add(time.Second)
+	for runs.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	scheduler.Stop()
+
+	if got := runs.Load(); got < 3 {
+		t.Errorf("Scheduler.Every() runs = %v, want at least 3", got)
+	}
+
+	stopped := runs.Load()
+	time.Sleep(20 * time.Millisecond)
+	if got := runs.Load(); got != stopped {
+		t.Errorf("Scheduler.Stop() runs after stop = %v, want %v", got, stopped)
+	}
+}
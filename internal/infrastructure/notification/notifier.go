@@ -0,0 +1,60 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+var ErrUnknownChannel = errors.New("unknown notification channel")
+
+const (
+	ChannelSMTP    = "smtp"
+	ChannelWebhook = "webhook"
+	ChannelFile    = "file"
+	ChannelLog     = "log"
+)
+
+// Message is a notice addressed to one patron. HTML is an optional
+// alternative to the plain text body.
+type Message struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Text    string `json:"text"`
+	HTML    string `json:"html,omitempty"`
+}
+
+// Notifier delivers messages to patrons over one channel.
+type Notifier interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+type Config struct {
+	// Channel is one of smtp, webhook, file or log.
+	Channel    string
+	SMTP       SMTPConfig
+	WebhookURL string
+	// FilePath is where the file channel appends messages.
+	FilePath string
+}
+
+// New returns the notifier of the configured channel.
+func New(cfg Config) (Notifier, error) {
+	switch cfg.Channel {
+	case ChannelSMTP:
+		return NewSMTPNotifier(cfg.SMTP), nil
+	case ChannelWebhook:
+		return NewWebhookNotifier(cfg.WebhookURL, nil), nil
+	case ChannelFile:
+		file, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return NewWriterNotifier(file), nil
+	case ChannelLog:
+		return NewWriterNotifier(os.Stdout), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownChannel, cfg.Channel)
+	}
+}
@@ -0,0 +1,153 @@
+package notification
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+var testMessage = Message{
+	To:      "ana@example.com",
+	Subject: "Lembrete: \"Dom Casmurro\" vence amanhã",
+	Text:    "Olá, Ana!",
+	HTML:    "<p>Olá, Ana!</p>",
+}
+
+func TestNew_UnknownChannel(t *testing.T) {
+	_, err := New(Config{Channel: "pigeon"})
+	if !errors.Is(err, ErrUnknownChannel) {
+		t.Errorf("New() error = %v, want %v", err, ErrUnknownChannel)
+	}
+}
+
+func TestWriterNotifier_Send(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := NewWriterNotifier(&buf).Send(context.Background(), testMessage); err != nil {
+		t.Fatalf("writerNotifier.Send() unexpected error = %v", err)
+	}
+
+	for _, want := range []string{"To: ana@example.com", "Subject: " + testMessage.Subject, "Olá, Ana!"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("writerNotifier.Send() wrote %q, want it to contain %q", buf.String(), want)
+		}
+	}
+}
+
+func TestWebhookNotifier_Send(t *testing.T) {
+	var received Message
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	if err := NewWebhookNotifier(server.URL, server.Client()).Send(context.Background(), testMessage); err != nil {
+		t.Fatalf("webhookNotifier.Send() unexpected error = %v", err)
+	}
+	if received != testMessage {
+		t.Errorf("webhookNotifier.Send() posted %+v, want %+v", received, testMessage)
+	}
+}
+
+func TestWebhookNotifier_SendRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	if err := NewWebhookNotifier(server.URL, server.Client()).Send(context.Background(), testMessage); err == nil {
+		t.Error("webhookNotifier.Send() expected error for a 502 response")
+	}
+}
+
+func TestSMTPNotifier_Send(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() unexpected error = %v", err)
+	}
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go serveOneSMTPSession(listener, received)
+
+	addr := listener.Addr().(*net.TCPAddr)
+	notifier := NewSMTPNotifier(SMTPConfig{
+		Host: "127.0.0.1",
+		Port: addr.Port,
+		From: "noreply@bookhub.local",
+	})
+
+	if err := notifier.Send(context.Background(), testMessage); err != nil {
+		t.Fatalf("smtpNotifier.Send() unexpected error = %v", err)
+	}
+
+	data := <-received
+	for _, want := range []string{
+		"To: ana@example.com",
+		"Subject: =?utf-8?q?",
+		"multipart/alternative",
+		"text/plain; charset=utf-8",
+		"text/html; charset=utf-8",
+	} {
+		if !strings.Contains(data, want) {
+			t.Errorf("smtpNotifier.Send() data = %q, want it to contain %q", data, want)
+		}
+	}
+}
+
+// serveOneSMTPSession plays a minimal SMTP server without TLS or auth, as
+// local test servers do, and hands over the message data it receives.
+func serveOneSMTPSession(listener net.Listener, received chan<- string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(s string) { _, _ = conn.Write([]byte(s + "\r\n")) }
+
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "DATA"):
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			received <- data.String()
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "QUIT"):
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// Reminder sends due date reminders and overdue notices for checked out
+// loans. Each notice is claimed in the notice repository before it is sent,
+// so it goes out once even when several API instances run the jobs.
+type Reminder struct {
+	noticeRepo   repository.LoanNoticeRepository
+	loanRepo     repository.LoanRepository
+	userRepo     repository.UserRepository
+	bookRepo     repository.BookRepository
+	notifier     Notifier
+	templates    *Templates
+	reminderDays int
+	location     *time.Location
+}
+
+// NewReminder reminds patrons of loans due within reminderDays days. Due
+// dates in the notices are shown in location.
+func NewReminder(
+	noticeRepo repository.LoanNoticeRepository,
+	loanRepo repository.LoanRepository,
+	userRepo repository.UserRepository,
+	bookRepo repository.BookRepository,
+	notifier Notifier,
+	templates *Templates,
+	reminderDays int,
+	location *time.Location,
+) *Reminder {
+	if location == nil {
+		location = time.UTC
+	}
+	return &Reminder{
+		noticeRepo:   noticeRepo,
+		loanRepo:     loanRepo,
+		userRepo:     userRepo,
+		bookRepo:     bookRepo,
+		notifier:     notifier,
+		templates:    templates,
+		reminderDays: reminderDays,
+		location:     location,
+	}
+}
+
+// SendDueReminders notifies the patrons of loans due within the reminder
+// window and returns how many reminders were sent.
+func (r *Reminder) SendDueReminders(ctx context.Context) (int, error) {
+	now := time.Now()
+	loans, err := r.loanRepo.ListActiveDue(ctx, repository.DueLoanFilter{
+		DueFrom:   now,
+		DueBefore: now.AddDate(0, 0, r.reminderDays),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return r.send(ctx, loans, entity.NoticeDueReminder, now)
+}
+
+// SendOverdueNotices notifies the patrons of loans past their due date and
+// returns how many notices were sent.
+func (r *Reminder) SendOverdueNotices(ctx context.Context) (int, error) {
+	now := time.Now()
+	loans, err := r.loanRepo.ListActiveDue(ctx, repository.DueLoanFilter{
+		DueBefore: now,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return r.send(ctx, loans, entity.NoticeOverdue, now)
+}
+
+// send delivers a notice of kind for each loan not noticed yet. A failed
+// delivery releases its claim so the next run tries again; the errors are
+// joined once every loan was attempted.
+func (r *Reminder) send(ctx context.Context, loans []*entity.Loan, kind entity.NoticeKind, now time.Time) (int, error) {
+	users := make(map[uuid.UUID]*entity.User)
+	books := make(map[uuid.UUID]*entity.Book)
+
+	sent := 0
+	var errs []error
+	for _, loan := range loans {
+		msg, ok, err := r.render(ctx, loan, kind, now, users, books)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		notice := entity.NewLoanNotice(loan, kind)
+		claimed, err := r.noticeRepo.Claim(ctx, notice)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := r.notifier.Send(ctx, msg); err != nil {
+			errs = append(errs, err)
+			if err := r.noticeRepo.Delete(ctx, notice.ID); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		sent++
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// render builds the notice of kind for loan. It reports false for loans of
+// patrons that are gone or deactivated, who are not notified.
+func (r *Reminder) render(
+	ctx context.Context,
+	loan *entity.Loan,
+	kind entity.NoticeKind,
+	now time.Time,
+	users map[uuid.UUID]*entity.User,
+	books map[uuid.UUID]*entity.Book,
+) (Message, bool, error) {
+	user, book, err := r.recipient(ctx, loan, users, books)
+	if err != nil || user == nil {
+		return Message{}, false, err
+	}
+
+	msg, err := r.templates.Render(string(kind), user.Email, r.noticeData(loan, user, book, now))
+	if err != nil {
+		return Message{}, false, err
+	}
+	return msg, true, nil
+}
+
+// NotifyEscalation tells the patron about a step of the overdue escalation
+// ladder taken against their loan, using the escalation_ACTION template.
+// fine is the replacement bill of a loan declared lost.
+func (r *Reminder) NotifyEscalation(ctx context.Context, loan *entity.Loan, escalation *entity.LoanEscalation, fine *entity.Fine) error {
+	users := make(map[uuid.UUID]*entity.User)
+	books := make(map[uuid.UUID]*entity.Book)
+	user, book, err := r.recipient(ctx, loan, users, books)
+	if err != nil || user == nil {
+		return err
+	}
+
+	data := r.noticeData(loan, user, book, escalation.CreatedAt)
+	data.DaysOverdue = escalation.DaysOverdue
+	data.Level = escalation.Level
+	if fine != nil {
+		data.Charge = FormatCents(fine.AmountCents)
+	}
+
+	msg, err := r.templates.Render("escalation_"+string(escalation.Action), user.Email, data)
+	if err != nil {
+		return err
+	}
+	return r.notifier.Send(ctx, msg)
+}
+
+// recipient looks up the patron and book of loan, caching them in users and
+// books. The user is nil when the patron is gone or deactivated, or the
+// book is gone, and nobody is notified.
+func (r *Reminder) recipient(
+	ctx context.Context,
+	loan *entity.Loan,
+	users map[uuid.UUID]*entity.User,
+	books map[uuid.UUID]*entity.Book,
+) (*entity.User, *entity.Book, error) {
+	user, ok := users[loan.UserID]
+	if !ok {
+		var err error
+		if user, err = r.userRepo.GetByID(ctx, loan.UserID); err != nil {
+			return nil, nil, err
+		}
+		users[loan.UserID] = user
+	}
+	if user == nil || !user.Active {
+		return nil, nil, nil
+	}
+
+	book, ok := books[loan.BookID]
+	if !ok {
+		var err error
+		if book, err = r.bookRepo.GetByID(ctx, loan.BookID); err != nil {
+			return nil, nil, err
+		}
+		books[loan.BookID] = book
+	}
+	if book == nil {
+		return nil, nil, nil
+	}
+
+	return user, book, nil
+}
+
+func (r *Reminder) noticeData(loan *entity.Loan, user *entity.User, book *entity.Book, now time.Time) NoticeData {
+	due := loan.DueDate.In(r.location)
+	return NoticeData{
+		Name:        user.Name,
+		BookTitle:   book.Title,
+		DueDate:     due,
+		DaysLeft:    int(entity.CalendarDay(due).Sub(entity.CalendarDay(now.In(r.location))) / (24 * time.Hour)),
+		DaysOverdue: loan.DaysOverdue(now),
+	}
+}
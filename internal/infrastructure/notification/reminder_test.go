@@ -0,0 +1,252 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type fakeLoanRepository struct {
+	repository.LoanRepository
+	loans []*entity.Loan
+}
+
+func (f *fakeLoanRepository) ListActiveDue(ctx context.Context, filter repository.DueLoanFilter) ([]*entity.Loan, error) {
+	var loans []*entity.Loan
+	for _, loan := range f.loans {
+		if loan.IsActive() && !loan.DueDate.Before(filter.DueFrom) && loan.DueDate.Before(filter.DueBefore) {
+			loans = append(loans, loan)
+		}
+	}
+	return loans, nil
+}
+
+type fakeUserRepository struct {
+	repository.UserRepository
+	users map[uuid.UUID]*entity.User
+}
+
+func (f *fakeUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	return f.users[id], nil
+}
+
+type fakeBookRepository struct {
+	repository.BookRepository
+	books map[uuid.UUID]*entity.Book
+}
+
+func (f *fakeBookRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Book, error) {
+	return f.books[id], nil
+}
+
+type noticeKey struct {
+	loanID uuid.UUID
+	kind   entity.NoticeKind
+	due    time.Time
+}
+
+type fakeLoanNoticeRepository struct {
+	notices map[noticeKey]uuid.UUID
+}
+
+func (f *fakeLoanNoticeRepository) Claim(ctx context.Context, notice *entity.LoanNotice) (bool, error) {
+	key := noticeKey{notice.LoanID, notice.Kind, notice.DueDate}
+	if _, exists := f.notices[key]; exists {
+		return false, nil
+	}
+	f.notices[key] = notice.ID
+	return true, nil
+}
+
+func (f *fakeLoanNoticeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	for key, noticeID := range f.notices {
+		if noticeID == id {
+			delete(f.notices, key)
+		}
+	}
+	return nil
+}
+
+type fakeNotifier struct {
+	sent []Message
+	err  error
+}
+
+func (f *fakeNotifier) Send(ctx context.Context, msg Message) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+type reminderFixture struct {
+	reminder *Reminder
+	loans    *fakeLoanRepository
+	notices  *fakeLoanNoticeRepository
+	notifier *fakeNotifier
+	user     *entity.User
+	book     *entity.Book
+}
+
+func newReminderFixture(t *testing.T) *reminderFixture {
+	t.Helper()
+
+	templates, err := LoadTemplates()
+	if err != nil {
+		t.Fatalf("LoadTemplates() unexpected error = %v", err)
+	}
+
+	user := &entity.User{ID: uuid.New(), Name: "Ana", Email: "ana@example.com", Active: true}
+	book := &entity.Book{ID: uuid.New(), Title: "Dom Casmurro"}
+
+	f := &reminderFixture{
+		loans:    &fakeLoanRepository{},
+		notices:  &fakeLoanNoticeRepository{notices: make(map[noticeKey]uuid.UUID)},
+		notifier: &fakeNotifier{},
+		user:     user,
+		book:     book,
+	}
+	f.reminder = NewReminder(
+		f.notices,
+		f.loans,
+		&fakeUserRepository{users: map[uuid.UUID]*entity.User{user.ID: user}},
+		&fakeBookRepository{books: map[uuid.UUID]*entity.Book{book.ID: book}},
+		f.notifier,
+		templates,
+		3,
+		time.UTC,
+	)
+	return f
+}
+
+func (f *reminderFixture) addLoan(due time.Time) *entity.Loan {
+	loan := &entity.Loan{
+		ID:         uuid.New(),
+		UserID:     f.user.ID,
+		BookID:     f.book.ID,
+		BorrowedAt: due.AddDate(0, 0, -14),
+		DueDate:    due,
+		Status:     entity.LoanStatusActive,
+	}
+	f.loans.loans = append(f.loans.loans, loan)
+	return loan
+}
+
+func TestReminder_SendDueReminders(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reminds each loan once per due date", func(t *testing.T) {
+		f := newReminderFixture(t)
+		soon := f.addLoan(time.Now().Add(36 * time.Hour))
+		f.addLoan(time.Now().AddDate(0, 0, 10))
+
+		sent, err := f.reminder.SendDueReminders(ctx)
+		if err != nil {
+			t.Fatalf("Reminder.SendDueReminders() unexpected error = %v", err)
+		}
+		if sent != 1 || len(f.notifier.sent) != 1 {
+			t.Fatalf("Reminder.SendDueReminders() sent = %v, want %v", sent, 1)
+		}
+		if f.notifier.sent[0].To != f.user.Email {
+			t.Errorf("Reminder.SendDueReminders() to = %v, want %v", f.notifier.sent[0].To, f.user.Email)
+		}
+
+		sent, _ = f.reminder.SendDueReminders(ctx)
+		if sent != 0 {
+			t.Errorf("Reminder.SendDueReminders() second run sent = %v, want %v", sent, 0)
+		}
+
+		// A renewal moves the due date, which earns a new reminder
+		soon.DueDate = soon.DueDate.Add(24 * time.Hour)
+		sent, _ = f.reminder.SendDueReminders(ctx)
+		if sent != 1 {
+			t.Errorf("Reminder.SendDueReminders() after renewal sent = %v, want %v", sent, 1)
+		}
+	})
+
+	t.Run("failed delivery is retried", func(t *testing.T) {
+		f := newReminderFixture(t)
+		f.addLoan(time.Now().Add(36 * time.Hour))
+		f.notifier.err = errors.New("smtp server unavailable")
+
+		sent, err := f.reminder.SendDueReminders(ctx)
+		if err == nil || sent != 0 {
+			t.Fatalf("Reminder.SendDueReminders() = %v, %v, want 0 and an error", sent, err)
+		}
+
+		f.notifier.err = nil
+		sent, err = f.reminder.SendDueReminders(ctx)
+		if err != nil || sent != 1 {
+			t.Errorf("Reminder.SendDueReminders() retry = %v, %v, want 1 and no error", sent, err)
+		}
+	})
+
+	t.Run("inactive patron", func(t *testing.T) {
+		f := newReminderFixture(t)
+		f.addLoan(time.Now().Add(36 * time.Hour))
+		f.user.Active = false
+
+		sent, err := f.reminder.SendDueReminders(ctx)
+		if err != nil || sent != 0 {
+			t.Errorf("Reminder.SendDueReminders() = %v, %v, want 0 and no error", sent, err)
+		}
+	})
+}
+
+func TestReminder_SendOverdueNotices(t *testing.T) {
+	ctx := context.Background()
+	f := newReminderFixture(t)
+	f.addLoan(time.Now().Add(-50 * time.Hour))
+	f.addLoan(time.Now().Add(36 * time.Hour))
+
+	sent, err := f.reminder.SendOverdueNotices(ctx)
+	if err != nil {
+		t.Fatalf("Reminder.SendOverdueNotices() unexpected error = %v", err)
+	}
+	if sent != 1 {
+		t.Fatalf("Reminder.SendOverdueNotices() sent = %v, want %v", sent, 1)
+	}
+	if want := `Empréstimo atrasado: "Dom Casmurro"`; f.notifier.sent[0].Subject != want {
+		t.Errorf("Reminder.SendOverdueNotices() subject = %q, want %q", f.notifier.sent[0].Subject, want)
+	}
+
+	sent, _ = f.reminder.SendOverdueNotices(ctx)
+	if sent != 0 {
+		t.Errorf("Reminder.SendOverdueNotices() second run sent = %v, want %v", sent, 0)
+	}
+}
+
+func TestReminder_NotifyEscalation(t *testing.T) {
+	ctx := context.Background()
+	f := newReminderFixture(t)
+	loan := f.addLoan(time.Now().AddDate(0, 0, -30))
+	escalation := entity.NewLoanEscalation(loan, 3, entity.EscalationLost, 30)
+	fine := entity.NewFine(loan.UserID, loan.ID, entity.FineReasonLost, 4550)
+
+	if err := f.reminder.NotifyEscalation(ctx, loan, escalation, fine); err != nil {
+		t.Fatalf("Reminder.NotifyEscalation() unexpected error = %v", err)
+	}
+	if len(f.notifier.sent) != 1 {
+		t.Fatalf("Reminder.NotifyEscalation() sent = %v, want %v", len(f.notifier.sent), 1)
+	}
+	msg := f.notifier.sent[0]
+	if want := `Livro dado como perdido: "Dom Casmurro"`; msg.Subject != want {
+		t.Errorf("Reminder.NotifyEscalation() subject = %q, want %q", msg.Subject, want)
+	}
+	if !strings.Contains(msg.Text, "multa de R$ 45,50") {
+		t.Errorf("Reminder.NotifyEscalation() text = %q, want it to bill R$ 45,50", msg.Text)
+	}
+
+	f.user.Active = false
+	if err := f.reminder.NotifyEscalation(ctx, loan, escalation, fine); err != nil || len(f.notifier.sent) != 1 {
+		t.Errorf("Reminder.NotifyEscalation() inactive patron = %v sent, %v, want nothing sent", len(f.notifier.sent), err)
+	}
+}
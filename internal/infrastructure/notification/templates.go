@@ -0,0 +1,88 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// NoticeData fills the notice templates. DueDate is in the library time
+// zone. Level and Charge are only set for escalation notices: the step of
+// the ladder reached and, for a book declared lost, the replacement billed.
+type NoticeData struct {
+	Name        string
+	BookTitle   string
+	DueDate     time.Time
+	DaysLeft    int
+	DaysOverdue int
+	Level       int
+	Charge      string
+}
+
+// FormatCents writes an amount in cents as Brazilian reais, e.g. R$ 1.234,50.
+func FormatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	units := strconv.FormatInt(cents/100, 10)
+	var grouped strings.Builder
+	for i, digit := range units {
+		if i > 0 && (len(units)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+		grouped.WriteRune(digit)
+	}
+	return fmt.Sprintf("%sR$ %s,%02d", sign, grouped.String(), cents%100)
+}
+
+// Templates renders the notices kept in the templates directory. Each
+// notice NAME defines NAME.subject and NAME.text in NAME.txt, and NAME.html
+// in NAME.html.
+type Templates struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+func LoadTemplates() (*Templates, error) {
+	text, err := texttemplate.ParseFS(templateFS, "templates/*.txt")
+	if err != nil {
+		return nil, err
+	}
+	html, err := htmltemplate.ParseFS(templateFS, "templates/*.html")
+	if err != nil {
+		return nil, err
+	}
+	return &Templates{text: text, html: html}, nil
+}
+
+// Render builds the message of notice name addressed to to.
+func (t *Templates) Render(name, to string, data NoticeData) (Message, error) {
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, name+".subject", data); err != nil {
+		return Message{}, err
+	}
+	if err := t.text.ExecuteTemplate(&text, name+".text", data); err != nil {
+		return Message{}, err
+	}
+	if err := t.html.ExecuteTemplate(&html, name+".html", data); err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,80 @@
+package notification
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTemplates_Render(t *testing.T) {
+	templates, err := LoadTemplates()
+	if err != nil {
+		t.Fatalf("LoadTemplates() unexpected error = %v", err)
+	}
+
+	data := NoticeData{
+		Name:        "Ana",
+		BookTitle:   "Dom Casmurro <1899>",
+		DueDate:     time.Date(2025, 12, 22, 18, 0, 0, 0, time.UTC),
+		DaysLeft:    1,
+		DaysOverdue: 3,
+		Level:       2,
+		Charge:      "R$ 45,00",
+	}
+
+	tests := []struct {
+		name        string
+		wantSubject string
+		wantText    string
+	}{
+		{"due_reminder", `Lembrete: "Dom Casmurro <1899>" vence amanhã`, "vence em 22/12/2025 às 18:00"},
+		{"overdue_notice", `Empréstimo atrasado: "Dom Casmurro <1899>"`, "atrasado há 3 dia(s)"},
+		{"escalation_notice", `2º aviso de atraso: "Dom Casmurro <1899>"`, "Este é o 2º aviso"},
+		{"escalation_block", `Empréstimos bloqueados: "Dom Casmurro <1899>" segue atrasado`, "novos empréstimos estão bloqueados"},
+		{"escalation_lost", `Livro dado como perdido: "Dom Casmurro <1899>"`, "multa de R$ 45,00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := templates.Render(tt.name, "ana@example.com", data)
+			if err != nil {
+				t.Fatalf("Templates.Render() unexpected error = %v", err)
+			}
+			if msg.To != "ana@example.com" {
+				t.Errorf("Templates.Render() to = %v, want %v", msg.To, "ana@example.com")
+			}
+			if msg.Subject != tt.wantSubject {
+				t.Errorf("Templates.Render() subject = %q, want %q", msg.Subject, tt.wantSubject)
+			}
+			if !strings.Contains(msg.Text, tt.wantText) {
+				t.Errorf("Templates.Render() text = %q, want it to contain %q", msg.Text, tt.wantText)
+			}
+			if !strings.Contains(msg.HTML, "Dom Casmurro &lt;1899&gt;") {
+				t.Errorf("Templates.Render() html = %q, want the title escaped", msg.HTML)
+			}
+		})
+	}
+
+	if _, err := templates.Render("unknown", "ana@example.com", data); err == nil {
+		t.Error("Templates.Render() expected error for an unknown notice")
+	}
+}
+
+func TestFormatCents(t *testing.T) {
+	tests := []struct {
+		cents int64
+		want  string
+	}{
+		{0, "R$ 0,00"},
+		{4550, "R$ 45,50"},
+		{123450, "R$ 1.234,50"},
+		{100000000, "R$ 1.000.000,00"},
+		{-75, "-R$ 0,75"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatCents(tt.cents); got != tt.want {
+			t.Errorf("FormatCents(%v) = %q, want %q", tt.cents, got, tt.want)
+		}
+	}
+}
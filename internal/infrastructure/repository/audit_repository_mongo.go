@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	auditLogCollection   = "audit_log"
+	auditChainCollection = "audit_chain"
+	// auditChainHeadID is the _id of the one document in the audit_chain
+	// collection, which holds the sequence and hash of the last entry.
+	auditChainHeadID = "head"
+)
+
+type mongoAuditRepository struct {
+	entries *mongo.Collection
+	chain   *mongo.Collection
+}
+
+func NewMongoAuditRepository(db *mongo.Database) repository.AuditRepository {
+	return &mongoAuditRepository{
+		entries: db.Collection(auditLogCollection),
+		chain:   db.Collection(auditChainCollection),
+	}
+}
+
+// Append moves the chain head along with the insert, at the end of the
+// transaction in ctx so the head document is written as late as possible.
+// Every append writes it, so two transactions appending at once hit a write
+// conflict and the later one is retried from the new head.
+func (r *mongoAuditRepository) Append(ctx context.Context, entry *entity.AuditEntry) error {
+	return beforeCommit(ctx, func(ctx context.Context) error {
+		return r.append(ctx, entry)
+	})
+}
+
+func (r *mongoAuditRepository) append(ctx context.Context, entry *entity.AuditEntry) error {
+	var head auditChainHeadDocument
+	err := r.chain.FindOne(ctx, bson.M{"_id": auditChainHeadID}).Decode(&head)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+	entry.Chain(head.Sequence, head.Hash)
+
+	doc, err := toAuditEntryDocument(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := r.entries.InsertOne(ctx, doc); err != nil {
+		return err
+	}
+
+	_, err = r.chain.UpdateOne(ctx,
+		bson.M{"_id": auditChainHeadID},
+		bson.M{"$set": bson.M{"sequence": entry.Sequence, "hash": entry.Hash}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *mongoAuditRepository) List(ctx context.Context, page, limit int, filter repository.AuditFilter) ([]*entity.AuditEntry, int, error) {
+	skip := int64((page - 1) * limit)
+	limitInt64 := int64(limit)
+
+	query := bson.M{}
+	if filter.ActorID != nil {
+		query["actorid"] = *filter.ActorID
+	}
+	if filter.Action != nil {
+		query["action"] = *filter.Action
+	}
+	if filter.EntityType != nil {
+		query["entitytype"] = *filter.EntityType
+	}
+	if filter.EntityID != nil {
+		query["entityid"] = *filter.EntityID
+	}
+	if filter.From != nil || filter.To != nil {
+		createdAt := bson.M{}
+		if filter.From != nil {
+			createdAt["$gte"] = *filter.From
+		}
+		if filter.To != nil {
+			createdAt["$lte"] = *filter.To
+		}
+		query["createdat"] = createdAt
+	}
+
+	opts := options.Find().
+		SetSkip(skip).
+		SetLimit(limitInt64).
+		SetSort(bson.D{{Key: "sequence", Value: -1}})
+
+	entries, err := r.find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := r.entries.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entries, int(count), nil
+}
+
+func (r *mongoAuditRepository) ListChain(ctx context.Context, afterSequence int64, limit int) ([]*entity.AuditEntry, error) {
+	opts := options.Find().
+		SetLimit(int64(limit)).
+		SetSort(bson.D{{Key: "sequence", Value: 1}})
+	return r.find(ctx, bson.M{"sequence": bson.M{"$gt": afterSequence}}, opts)
+}
+
+func (r *mongoAuditRepository) find(ctx context.Context, query bson.M, opts *options.FindOptions) ([]*entity.AuditEntry, error) {
+	cursor, err := r.entries.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []auditEntryDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*entity.AuditEntry, len(docs))
+	for i, doc := range docs {
+		entry, err := doc.toEntity()
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = entry
+	}
+	return entries, nil
+}
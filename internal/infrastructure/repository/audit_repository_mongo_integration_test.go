@@ -0,0 +1,18 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+
+	"bookhub/internal/infrastructure/repository"
+)
+
+func TestMongoAuditRepository(t *testing.T) {
+	CleanupMongo(t)
+
+	runAuditRepositoryTest(t,
+		repository.NewMongoAuditRepository(MongoTestDB),
+		repository.NewMongoTxManager(MongoTestDB),
+	)
+}
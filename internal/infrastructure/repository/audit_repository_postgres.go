@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresAuditRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresAuditRepository(db *sql.DB) repository.AuditRepository {
+	return &postgresAuditRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+// Append waits until the end of the transaction in ctx, so the audit lock is
+// the last one it takes and is held only while it commits. The lock keeps
+// other appends away from the head of the chain until then; outside a
+// transaction it is released as soon as it is taken.
+func (r *postgresAuditRepository) Append(ctx context.Context, entry *entity.AuditEntry) error {
+	return beforeCommit(ctx, func(ctx context.Context) error {
+		return r.append(ctx, entry)
+	})
+}
+
+func (r *postgresAuditRepository) append(ctx context.Context, entry *entity.AuditEntry) error {
+	if err := r.q(ctx).LockAuditLog(ctx); err != nil {
+		return err
+	}
+
+	var prevSequence int64
+	var prevHash string
+	last, err := r.q(ctx).GetLastAuditEntry(ctx)
+	switch {
+	case err == nil:
+		prevSequence, prevHash = last.Sequence, last.Hash
+	case err != sql.ErrNoRows:
+		return err
+	}
+	entry.Chain(prevSequence, prevHash)
+
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return err
+	}
+
+	var actorID uuid.NullUUID
+	if entry.ActorID != nil {
+		actorID = uuid.NullUUID{UUID: *entry.ActorID, Valid: true}
+	}
+
+	return r.q(ctx).CreateAuditEntry(ctx, sqlc.CreateAuditEntryParams{
+		ID:         entry.ID,
+		Sequence:   entry.Sequence,
+		ActorID:    actorID,
+		Action:     entry.Action,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Changes:    changes,
+		RequestID:  entry.RequestID,
+		CreatedAt:  entry.CreatedAt,
+		PrevHash:   entry.PrevHash,
+		Hash:       entry.Hash,
+	})
+}
+
+func (r *postgresAuditRepository) List(ctx context.Context, page, limit int, filter repository.AuditFilter) ([]*entity.AuditEntry, int, error) {
+	offset := (page - 1) * limit
+
+	var actorID, entityID uuid.NullUUID
+	if filter.ActorID != nil {
+		actorID = uuid.NullUUID{UUID: *filter.ActorID, Valid: true}
+	}
+	if filter.EntityID != nil {
+		entityID = uuid.NullUUID{UUID: *filter.EntityID, Valid: true}
+	}
+	var action, entityType sql.NullString
+	if filter.Action != nil {
+		action = sql.NullString{String: *filter.Action, Valid: true}
+	}
+	if filter.EntityType != nil {
+		entityType = sql.NullString{String: *filter.EntityType, Valid: true}
+	}
+	from, to := r.toNullTime(filter.From), r.toNullTime(filter.To)
+
+	rows, err := r.q(ctx).ListAuditEntries(ctx, sqlc.ListAuditEntriesParams{
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+		ActorID:    actorID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		From:       from,
+		To:         to,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := r.q(ctx).CountAuditEntries(ctx, sqlc.CountAuditEntriesParams{
+		ActorID:    actorID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		From:       from,
+		To:         to,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entries, err := r.toEntities(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, int(count), nil
+}
+
+func (r *postgresAuditRepository) ListChain(ctx context.Context, afterSequence int64, limit int) ([]*entity.AuditEntry, error) {
+	rows, err := r.q(ctx).ListAuditChain(ctx, sqlc.ListAuditChainParams{
+		Sequence: afterSequence,
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntities(rows)
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresAuditRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresAuditRepository) toEntities(rows []sqlc.AuditLog) ([]*entity.AuditEntry, error) {
+	entries := make([]*entity.AuditEntry, len(rows))
+	for i, row := range rows {
+		entry, err := r.toEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = entry
+	}
+	return entries, nil
+}
+
+func (r *postgresAuditRepository) toEntity(row sqlc.AuditLog) (*entity.AuditEntry, error) {
+	var changes map[string]entity.AuditChange
+	if err := json.Unmarshal(row.Changes, &changes); err != nil {
+		return nil, err
+	}
+
+	var actorID *uuid.UUID
+	if row.ActorID.Valid {
+		actorID = &row.ActorID.UUID
+	}
+
+	return &entity.AuditEntry{
+		ID:         row.ID,
+		Sequence:   row.Sequence,
+		ActorID:    actorID,
+		Action:     row.Action,
+		EntityType: row.EntityType,
+		EntityID:   row.EntityID,
+		Changes:    changes,
+		RequestID:  row.RequestID,
+		CreatedAt:  row.CreatedAt,
+		PrevHash:   row.PrevHash,
+		Hash:       row.Hash,
+	}, nil
+}
+
+func (r *postgresAuditRepository) toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{Valid: false}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
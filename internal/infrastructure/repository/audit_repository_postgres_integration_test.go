@@ -0,0 +1,86 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// appendTestAuditEntry appends an entry for entityID in its own
+// transaction, as the use cases do.
+func appendTestAuditEntry(t *testing.T, repo domainrepo.AuditRepository, txManager domainrepo.TxManager, actorID *uuid.UUID, action string, entityID uuid.UUID) *entity.AuditEntry {
+	t.Helper()
+	changes, err := entity.DiffAuditStates(nil, map[string]any{"title": "Dom Casmurro", "total_copies": 2})
+	require.NoError(t, err)
+	entry := entity.NewAuditEntry(actorID, action, entity.AuditEntityBook, entityID, changes, "req-1")
+	require.NoError(t, txManager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return repo.Append(ctx, entry)
+	}))
+	return entry
+}
+
+// runAuditRepositoryTest checks chaining, filtering and that entries read
+// back still verify, which the hash depends on.
+func runAuditRepositoryTest(t *testing.T, repo domainrepo.AuditRepository, txManager domainrepo.TxManager) {
+	ctx := context.Background()
+
+	actorID, bookID := uuid.New(), uuid.New()
+	first := appendTestAuditEntry(t, repo, txManager, &actorID, entity.AuditBookCreated, bookID)
+	second := appendTestAuditEntry(t, repo, txManager, nil, entity.AuditBookCreated, uuid.New())
+	third := appendTestAuditEntry(t, repo, txManager, &actorID, entity.AuditUserUpdated, uuid.New())
+
+	assert.Equal(t, int64(1), first.Sequence)
+	assert.Equal(t, first.Hash, second.PrevHash)
+	assert.Equal(t, second.Hash, third.PrevHash)
+
+	chain, err := repo.ListChain(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, chain, 3)
+	var prev *entity.AuditEntry
+	for _, entry := range chain {
+		assert.NoError(t, entry.VerifyAfter(prev))
+		prev = entry
+	}
+	assert.Nil(t, chain[1].ActorID)
+
+	chain, err = repo.ListChain(ctx, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, chain, 1)
+	assert.Equal(t, third.ID, chain[0].ID)
+
+	entries, total, err := repo.List(ctx, 1, 10, domainrepo.AuditFilter{ActorID: &actorID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, third.ID, entries[0].ID, "newest first")
+
+	entries, total, err = repo.List(ctx, 1, 10, domainrepo.AuditFilter{EntityID: &bookID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, first.ID, entries[0].ID)
+	assert.Equal(t, entity.AuditChange{After: "Dom Casmurro"}, entries[0].Changes["title"])
+
+	action := entity.AuditBookCreated
+	future := time.Now().Add(time.Hour)
+	_, total, err = repo.List(ctx, 1, 10, domainrepo.AuditFilter{Action: &action, From: &future})
+	require.NoError(t, err)
+	assert.Equal(t, 0, total)
+}
+
+func TestPostgresAuditRepository(t *testing.T) {
+	CleanupPostgres(t)
+
+	runAuditRepositoryTest(t,
+		repository.NewPostgresAuditRepository(PostgresTestDB),
+		repository.NewPostgresTxManager(PostgresTestDB),
+	)
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const bookCopiesCollection = "book_copies"
+
+type mongoBookCopyRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoBookCopyRepository(db *mongo.Database) repository.BookCopyRepository {
+	return &mongoBookCopyRepository{
+		collection: db.Collection(bookCopiesCollection),
+	}
+}
+
+func (r *mongoBookCopyRepository) Create(ctx context.Context, bookCopy *entity.BookCopy) error {
+	doc := toBookCopyDocument(bookCopy)
+	_, err := r.collection.InsertOne(ctx, doc)
+	return err
+}
+
+func (r *mongoBookCopyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.BookCopy, error) {
+	return r.findOne(ctx, bson.M{"id": id}, options.FindOne())
+}
+
+func (r *mongoBookCopyRepository) GetByBarcode(ctx context.Context, barcode string) (*entity.BookCopy, error) {
+	return r.findOne(ctx, bson.M{"barcode": barcode}, options.FindOne())
+}
+
+func (r *mongoBookCopyRepository) ListByBook(ctx context.Context, bookID uuid.UUID) ([]*entity.BookCopy, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "barcode", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{"bookid": bookID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []bookCopyDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	copies := make([]*entity.BookCopy, len(docs))
+	for i, doc := range docs {
+		copies[i] = doc.toEntity()
+	}
+	return copies, nil
+}
+
+func (r *mongoBookCopyRepository) FindByStatus(ctx context.Context, bookID uuid.UUID, status string) (*entity.BookCopy, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "barcode", Value: 1}})
+	return r.findOne(ctx, bson.M{"bookid": bookID, "status": status}, opts)
+}
+
+func (r *mongoBookCopyRepository) CountByBranch(ctx context.Context, branchID uuid.UUID) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"branchid": branchID})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *mongoBookCopyRepository) AvailabilityByBranch(ctx context.Context, bookIDs []uuid.UUID) ([]entity.BranchAvailability, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"bookid": bson.M{"$in": bookIDs},
+			"status": bson.M{"$ne": entity.CopyStatusWithdrawn},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"bookid": "$bookid", "branchid": "$branchid"},
+			"total": bson.M{"$sum": 1},
+			"available": bson.M{"$sum": bson.M{
+				"$cond": bson.A{bson.M{"$eq": bson.A{"$status", entity.CopyStatusAvailable}}, 1, 0},
+			}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.bookid", Value: 1}, {Key: "_id.branchid", Value: 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []struct {
+		ID struct {
+			BookID   uuid.UUID `bson:"bookid"`
+			BranchID uuid.UUID `bson:"branchid"`
+		} `bson:"_id"`
+		Total     int `bson:"total"`
+		Available int `bson:"available"`
+	}
+	if err := cursor.All(ctx, &result); err != nil {
+		return nil, err
+	}
+
+	availability := make([]entity.BranchAvailability, len(result))
+	for i, row := range result {
+		availability[i] = entity.BranchAvailability{
+			BookID:          row.ID.BookID,
+			BranchID:        row.ID.BranchID,
+			TotalCopies:     row.Total,
+			AvailableCopies: row.Available,
+		}
+	}
+	return availability, nil
+}
+
+func (r *mongoBookCopyRepository) Update(ctx context.Context, bookCopy *entity.BookCopy) error {
+	filter := bson.M{"id": bookCopy.ID}
+	update := bson.M{
+		"$set": bson.M{
+			"branchid":  bookCopy.BranchID,
+			"location":  bookCopy.Location,
+			"condition": bookCopy.Condition,
+			"status":    bookCopy.Status,
+			"updatedat": bookCopy.UpdatedAt,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (r *mongoBookCopyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"id": id})
+	return err
+}
+
+func (r *mongoBookCopyRepository) findOne(ctx context.Context, filter bson.M, opts *options.FindOneOptions) (*entity.BookCopy, error) {
+	var doc bookCopyDocument
+	err := r.collection.FindOne(ctx, filter, opts).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
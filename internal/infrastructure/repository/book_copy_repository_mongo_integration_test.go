@@ -0,0 +1,106 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMongoBookCopyRepository_CRUD(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	bookRepo := repository.NewMongoBookRepository(MongoTestDB)
+	repo := repository.NewMongoBookCopyRepository(MongoTestDB)
+
+	book := CreateTestBook("Test Book", "Author", "1234567890")
+	require.NoError(t, bookRepo.Create(ctx, book))
+	branch := CreateTestBranch("TEST", "Test Branch")
+	require.NoError(t, repository.NewMongoBranchRepository(MongoTestDB).Create(ctx, branch))
+
+	bookCopy, err := entity.NewBookCopy(book.ID, branch.ID, "BC-001", "A-1", "")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, bookCopy))
+
+	retrieved, err := repo.GetByID(ctx, bookCopy.ID)
+	assert.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, book.ID, retrieved.BookID)
+	assert.Equal(t, "A-1", retrieved.Location)
+	assert.Equal(t, entity.CopyConditionGood, retrieved.Condition)
+	assert.Equal(t, entity.CopyStatusAvailable, retrieved.Status)
+
+	byBarcode, err := repo.GetByBarcode(ctx, "BC-001")
+	assert.NoError(t, err)
+	require.NotNil(t, byBarcode)
+	assert.Equal(t, bookCopy.ID, byBarcode.ID)
+
+	require.NoError(t, retrieved.Update("B-2", entity.CopyConditionFair, entity.CopyStatusInRepair))
+	require.NoError(t, repo.Update(ctx, retrieved))
+
+	retrieved, err = repo.GetByID(ctx, bookCopy.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "B-2", retrieved.Location)
+	assert.Equal(t, entity.CopyConditionFair, retrieved.Condition)
+	assert.Equal(t, entity.CopyStatusInRepair, retrieved.Status)
+
+	require.NoError(t, repo.Delete(ctx, bookCopy.ID))
+
+	retrieved, err = repo.GetByID(ctx, bookCopy.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestMongoBookCopyRepository_GetByIDNotFound(t *testing.T) {
+	CleanupMongo(t)
+
+	repo := repository.NewMongoBookCopyRepository(MongoTestDB)
+
+	retrieved, err := repo.GetByID(context.Background(), uuid.New())
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestMongoBookCopyRepository_ListAndFindByStatus(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	bookRepo := repository.NewMongoBookRepository(MongoTestDB)
+	repo := repository.NewMongoBookCopyRepository(MongoTestDB)
+
+	book := CreateTestBook("Test Book", "Author", "1234567890")
+	require.NoError(t, bookRepo.Create(ctx, book))
+	branch := CreateTestBranch("TEST", "Test Branch")
+	require.NoError(t, repository.NewMongoBranchRepository(MongoTestDB).Create(ctx, branch))
+
+	for _, barcode := range []string{"BC-003", "BC-001", "BC-002"} {
+		bookCopy, err := entity.NewBookCopy(book.ID, branch.ID, barcode, "", "")
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, bookCopy))
+	}
+
+	copies, err := repo.ListByBook(ctx, book.ID)
+	assert.NoError(t, err)
+	require.Len(t, copies, 3)
+	assert.Equal(t, "BC-001", copies[0].Barcode)
+
+	require.NoError(t, copies[0].CheckOut())
+	require.NoError(t, repo.Update(ctx, copies[0]))
+
+	available, err := repo.FindByStatus(ctx, book.ID, entity.CopyStatusAvailable)
+	assert.NoError(t, err)
+	require.NotNil(t, available)
+	assert.Equal(t, "BC-002", available.Barcode)
+
+	onHold, err := repo.FindByStatus(ctx, book.ID, entity.CopyStatusOnHold)
+	assert.NoError(t, err)
+	assert.Nil(t, onHold)
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresBookCopyRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresBookCopyRepository(db *sql.DB) repository.BookCopyRepository {
+	return &postgresBookCopyRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresBookCopyRepository) Create(ctx context.Context, bookCopy *entity.BookCopy) error {
+	_, err := r.q(ctx).CreateBookCopy(ctx, sqlc.CreateBookCopyParams{
+		ID:        bookCopy.ID,
+		BookID:    bookCopy.BookID,
+		BranchID:  bookCopy.BranchID,
+		Barcode:   bookCopy.Barcode,
+		Location:  bookCopy.Location,
+		Condition: bookCopy.Condition,
+		Status:    bookCopy.Status,
+		CreatedAt: bookCopy.CreatedAt,
+		UpdatedAt: bookCopy.UpdatedAt,
+	})
+	return err
+}
+
+func (r *postgresBookCopyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.BookCopy, error) {
+	row, err := r.q(ctx).GetBookCopyByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresBookCopyRepository) GetByBarcode(ctx context.Context, barcode string) (*entity.BookCopy, error) {
+	row, err := r.q(ctx).GetBookCopyByBarcode(ctx, barcode)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresBookCopyRepository) ListByBook(ctx context.Context, bookID uuid.UUID) ([]*entity.BookCopy, error) {
+	rows, err := r.q(ctx).ListBookCopiesByBook(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	copies := make([]*entity.BookCopy, len(rows))
+	for i, row := range rows {
+		copies[i] = r.toEntity(row)
+	}
+	return copies, nil
+}
+
+func (r *postgresBookCopyRepository) FindByStatus(ctx context.Context, bookID uuid.UUID, status string) (*entity.BookCopy, error) {
+	row, err := r.q(ctx).FindBookCopyByStatus(ctx, sqlc.FindBookCopyByStatusParams{
+		BookID: bookID,
+		Status: status,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresBookCopyRepository) CountByBranch(ctx context.Context, branchID uuid.UUID) (int, error) {
+	count, err := r.q(ctx).CountBookCopiesByBranch(ctx, branchID)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *postgresBookCopyRepository) AvailabilityByBranch(ctx context.Context, bookIDs []uuid.UUID) ([]entity.BranchAvailability, error) {
+	rows, err := r.q(ctx).ListBranchAvailability(ctx, bookIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := make([]entity.BranchAvailability, len(rows))
+	for i, row := range rows {
+		availability[i] = entity.BranchAvailability{
+			BookID:          row.BookID,
+			BranchID:        row.BranchID,
+			TotalCopies:     int(row.TotalCopies),
+			AvailableCopies: int(row.AvailableCopies),
+		}
+	}
+	return availability, nil
+}
+
+func (r *postgresBookCopyRepository) Update(ctx context.Context, bookCopy *entity.BookCopy) error {
+	_, err := r.q(ctx).UpdateBookCopy(ctx, sqlc.UpdateBookCopyParams{
+		ID:        bookCopy.ID,
+		BranchID:  bookCopy.BranchID,
+		Location:  bookCopy.Location,
+		Condition: bookCopy.Condition,
+		Status:    bookCopy.Status,
+		UpdatedAt: bookCopy.UpdatedAt,
+	})
+	return err
+}
+
+func (r *postgresBookCopyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.q(ctx).DeleteBookCopy(ctx, id)
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresBookCopyRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresBookCopyRepository) toEntity(row sqlc.BookCopy) *entity.BookCopy {
+	return &entity.BookCopy{
+		ID:        row.ID,
+		BookID:    row.BookID,
+		BranchID:  row.BranchID,
+		Barcode:   row.Barcode,
+		Location:  row.Location,
+		Condition: row.Condition,
+		Status:    row.Status,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
@@ -3,6 +3,10 @@ package repository
 import (
 	"context"
 	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"bookhub/internal/domain/entity"
 	"bookhub/internal/domain/repository"
@@ -11,17 +15,33 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 )
 
 const booksCollection = "books"
 
+// bookSortKeys maps the repository.BookSortFields to document keys.
+var bookSortKeys = map[string]string{
+	"title":          "title",
+	"author":         "author",
+	"published_year": "publishedyear",
+	"created_at":     "createdat",
+}
+
+// suggestCollation compares strings ignoring case and accents.
+var suggestCollation = &options.Collation{Locale: "pt", Strength: 1}
+
 type mongoBookRepository struct {
 	collection *mongo.Collection
+	copies     *mongo.Collection
 }
 
 func NewMongoBookRepository(db *mongo.Database) repository.BookRepository {
 	return &mongoBookRepository{
 		collection: db.Collection(booksCollection),
+		copies:     db.Collection(bookCopiesCollection),
 	}
 }
 
@@ -55,21 +75,25 @@ func (r *mongoBookRepository) GetByISBN(ctx context.Context, isbn string) (*enti
 	return doc.toEntity(), nil
 }
 
-func (r *mongoBookRepository) List(ctx context.Context, page, limit int, availableOnly *bool) ([]*entity.Book, int, error) {
+func (r *mongoBookRepository) List(ctx context.Context, page, limit int, filter repository.BookFilter) ([]*entity.Book, int, error) {
 	skip := int64((page - 1) * limit)
 	limitInt64 := int64(limit)
 
-	filter := bson.M{}
-	if availableOnly != nil && *availableOnly {
-		filter["availablecopies"] = bson.M{"$gt": 0}
+	query, err := r.filterQuery(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	sort, err := sortDocument(filter.Sort, bookDefaultSort, bookSortKeys)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	opts := options.Find().
 		SetSkip(skip).
 		SetLimit(limitInt64).
-		SetSort(bson.D{{Key: "createdat", Value: -1}})
+		SetSort(sort)
 
-	cursor, err := r.collection.Find(ctx, filter, opts)
+	cursor, err := r.collection.Find(ctx, query, opts)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -85,7 +109,7 @@ func (r *mongoBookRepository) List(ctx context.Context, page, limit int, availab
 		books[i] = doc.toEntity()
 	}
 
-	count, err := r.collection.CountDocuments(ctx, filter)
+	count, err := r.collection.CountDocuments(ctx, query)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -93,25 +117,279 @@ func (r *mongoBookRepository) List(ctx context.Context, page, limit int, availab
 	return books, int(count), nil
 }
 
+func (r *mongoBookRepository) Search(ctx context.Context, page, limit int, search repository.BookSearch) ([]*repository.BookMatch, int, error) {
+	skip := int64((page - 1) * limit)
+	limitInt64 := int64(limit)
+
+	query, err := r.filterQuery(ctx, search.BookFilter)
+	if err != nil {
+		return nil, 0, err
+	}
+	// Served by the books text index, which matches any of the terms.
+	query["$text"] = bson.M{"$search": search.Query}
+
+	score := bson.M{"$meta": "textScore"}
+	opts := options.Find().
+		SetSkip(skip).
+		SetLimit(limitInt64).
+		SetProjection(bson.M{"score": score}).
+		SetSort(bson.D{{Key: "score", Value: score}, {Key: "title", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []bookSearchDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, 0, err
+	}
+
+	terms := searchTerms(search.Query)
+	matches := make([]*repository.BookMatch, len(docs))
+	for i, doc := range docs {
+		matches[i] = &repository.BookMatch{
+			Book:            doc.toEntity(),
+			Score:           doc.Score,
+			TitleHighlight:  highlightTerms(doc.Title, terms),
+			AuthorHighlight: highlightTerms(doc.Author, terms),
+		}
+	}
+
+	count, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return matches, int(count), nil
+}
+
+func (r *mongoBookRepository) SuggestTitles(ctx context.Context, prefix string, limit int) ([]string, error) {
+	return r.suggest(ctx, "title", prefix, limit)
+}
+
+func (r *mongoBookRepository) SuggestAuthors(ctx context.Context, prefix string, limit int) ([]string, error) {
+	return r.suggest(ctx, "author", prefix, limit)
+}
+
+// suggest returns the distinct values of field starting with prefix. The
+// range and sort run under suggestCollation so they are served by the
+// books_title_suggest and books_author_suggest indexes, which ignore case
+// and accents.
+func (r *mongoBookRepository) suggest(ctx context.Context, field, prefix string, limit int) ([]string, error) {
+	query := bson.M{
+		"withdrawnat": nil,
+		// U+FFFF sorts after every character under the collation
+		field: bson.M{"$gte": prefix, "$lt": prefix + "\uffff"},
+	}
+	opts := options.Find().
+		SetCollation(suggestCollation).
+		SetSort(bson.D{{Key: field, Value: 1}}).
+		SetProjection(bson.M{"_id": 0, field: 1}).
+		SetBatchSize(int32(limit * 2))
+
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	// Books sharing a title or author come out together; stop reading once
+	// enough distinct values were seen.
+	values := make([]string, 0, limit)
+	seen := make(map[string]bool, limit)
+	for len(values) < limit && cursor.Next(ctx) {
+		value, ok := cursor.Current.Lookup(field).StringValueOK()
+		if !ok || seen[value] {
+			continue
+		}
+		seen[value] = true
+		values = append(values, value)
+	}
+	return values, cursor.Err()
+}
+
+// filterQuery matches the listed books narrowed by filter.
+func (r *mongoBookRepository) filterQuery(ctx context.Context, filter repository.BookFilter) (bson.M, error) {
+	// Withdrawn books are kept for their history but not listed.
+	query := bson.M{"withdrawnat": nil}
+	switch {
+	case filter.BranchID != nil:
+		// Books are kept at a branch through their copies.
+		copyFilter := bson.M{"branchid": *filter.BranchID, "status": bson.M{"$ne": entity.CopyStatusWithdrawn}}
+		if filter.AvailableOnly {
+			copyFilter["status"] = entity.CopyStatusAvailable
+		}
+		bookIDs, err := r.copies.Distinct(ctx, "bookid", copyFilter)
+		if err != nil {
+			return nil, err
+		}
+		query["id"] = bson.M{"$in": bookIDs}
+	case filter.AvailableOnly:
+		query["availablecopies"] = bson.M{"$gt": 0}
+	}
+	if filter.Author != "" {
+		query["author"] = bson.M{"$regex": accentInsensitivePattern(filter.Author), "$options": "i"}
+	}
+	published := bson.M{}
+	if filter.PublishedFrom != nil {
+		published["$gte"] = *filter.PublishedFrom
+	}
+	if filter.PublishedTo != nil {
+		published["$lte"] = *filter.PublishedTo
+	}
+	if len(published) > 0 {
+		query["publishedyear"] = published
+	}
+	return query, nil
+}
+
 func (r *mongoBookRepository) Update(ctx context.Context, book *entity.Book) error {
-	filter := bson.M{"id": book.ID}
+	filter := bson.M{"id": book.ID, "version": book.Version}
+	if book.Version == 0 {
+		// Documents written before versioning was introduced have no version field
+		filter["version"] = bson.M{"$exists": false}
+	}
 	update := bson.M{
 		"$set": bson.M{
 			"title":           book.Title,
 			"author":          book.Author,
 			"isbn":            book.ISBN,
 			"publishedyear":   book.PublishedYear,
+			"category":        book.Category,
 			"totalcopies":     book.TotalCopies,
 			"availablecopies": book.AvailableCopies,
 			"updatedat":       book.UpdatedAt,
+			"withdrawnat":     book.WithdrawnAt,
 		},
+		"$inc": bson.M{"version": 1},
 	}
 
-	_, err := r.collection.UpdateOne(ctx, filter, update)
-	return err
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return entity.ErrConcurrentModification
+	}
+	book.Version++
+	return nil
 }
 
 func (r *mongoBookRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	_, err := r.collection.DeleteOne(ctx, bson.M{"id": id})
 	return err
 }
+
+// bookSearchDocument is a book found by text search with its score.
+type bookSearchDocument struct {
+	bookDocument `bson:",inline"`
+	Score        float64 `bson:"score"`
+}
+
+// accentVariants lists the accented letters Portuguese text may use in place
+// of each plain letter.
+var accentVariants = map[rune]string{
+	'a': "aáàâãä",
+	'e': "eéèêë",
+	'i': "iíìîï",
+	'o': "oóòôõö",
+	'u': "uúùûü",
+	'c': "cç",
+	'n': "nñ",
+}
+
+// accentInsensitivePattern builds a regular expression matching s
+// regardless of accents; it is meant to be used case-insensitively.
+func accentInsensitivePattern(s string) string {
+	var b strings.Builder
+	for _, c := range foldText(s) {
+		variants, ok := accentVariants[c]
+		if !ok {
+			b.WriteString(regexp.QuoteMeta(string(c)))
+			continue
+		}
+		b.WriteString("[" + variants + strings.ToUpper(variants) + "]")
+	}
+	return b.String()
+}
+
+// foldText lowercases s and strips its accents.
+func foldText(s string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), s)
+	if err != nil {
+		folded = s
+	}
+	return strings.ToLower(folded)
+}
+
+// searchTerms splits a text query into folded words, skipping the short
+// ones text search ignores as stop words.
+func searchTerms(q string) []string {
+	var terms []string
+	for _, word := range strings.FieldsFunc(foldText(q), isNotWordRune) {
+		if utf8.RuneCountInString(word) >= 3 {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+// highlightTerms wraps in <mark> tags the words of text that match one of
+// terms. Text search matches word stems, so a word also matches when it
+// differs from a term only in a short ending, like a plural.
+func highlightTerms(text string, terms []string) string {
+	var b strings.Builder
+	rest := text
+	for rest != "" {
+		start := strings.IndexFunc(rest, func(c rune) bool { return !isNotWordRune(c) })
+		if start < 0 {
+			b.WriteString(rest)
+			break
+		}
+		b.WriteString(rest[:start])
+		rest = rest[start:]
+
+		end := strings.IndexFunc(rest, isNotWordRune)
+		if end < 0 {
+			end = len(rest)
+		}
+		word := rest[:end]
+		rest = rest[end:]
+
+		if matchesAnyTerm(foldText(word), terms) {
+			b.WriteString("<mark>" + word + "</mark>")
+		} else {
+			b.WriteString(word)
+		}
+	}
+	return b.String()
+}
+
+func matchesAnyTerm(word string, terms []string) bool {
+	for _, term := range terms {
+		if word == term {
+			return true
+		}
+		shorter := min(utf8.RuneCountInString(word), utf8.RuneCountInString(term))
+		if shorter >= 4 && commonPrefixLen(word, term) >= max(4, shorter-2) {
+			return true
+		}
+	}
+	return false
+}
+
+// commonPrefixLen counts the runes a and b start with in common.
+func commonPrefixLen(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	n := 0
+	for n < len(ar) && n < len(br) && ar[n] == br[n] {
+		n++
+	}
+	return n
+}
+
+func isNotWordRune(c rune) bool {
+	return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+}
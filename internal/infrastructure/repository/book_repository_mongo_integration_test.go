@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
 	"bookhub/internal/infrastructure/repository"
 
 	"github.com/google/uuid"
@@ -92,18 +93,17 @@ func TestMongoBookRepository_List(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	result, count, err := repo.List(ctx, 1, 10, nil)
+	result, count, err := repo.List(ctx, 1, 10, domainrepo.BookFilter{})
 	assert.NoError(t, err)
 	assert.Equal(t, 3, count)
 	assert.Len(t, result, 3)
 
-	result, count, err = repo.List(ctx, 1, 2, nil)
+	result, count, err = repo.List(ctx, 1, 2, domainrepo.BookFilter{})
 	assert.NoError(t, err)
 	assert.Equal(t, 3, count)
 	assert.Len(t, result, 2)
 
-	availableOnly := true
-	result, count, err = repo.List(ctx, 1, 10, &availableOnly)
+	result, count, err = repo.List(ctx, 1, 10, domainrepo.BookFilter{AvailableOnly: true})
 	assert.NoError(t, err)
 	assert.Equal(t, 2, count)
 	assert.Len(t, result, 2)
@@ -134,6 +134,34 @@ func TestMongoBookRepository_Update(t *testing.T) {
 	assert.Equal(t, 3, retrieved.AvailableCopies)
 }
 
+func TestMongoBookRepository_UpdateStaleVersion(t *testing.T) {
+	CleanupMongo(t)
+
+	repo := repository.NewMongoBookRepository(MongoTestDB)
+	ctx := context.Background()
+
+	book := CreateTestBook("Versioned Book", "Author", "1234567898")
+	require.NoError(t, repo.Create(ctx, book))
+
+	first, err := repo.GetByID(ctx, book.ID)
+	require.NoError(t, err)
+	second, err := repo.GetByID(ctx, book.ID)
+	require.NoError(t, err)
+
+	first.AvailableCopies--
+	require.NoError(t, repo.Update(ctx, first))
+	assert.Equal(t, 2, first.Version)
+
+	second.AvailableCopies--
+	err = repo.Update(ctx, second)
+	assert.ErrorIs(t, err, entity.ErrConcurrentModification)
+
+	retrieved, err := repo.GetByID(ctx, book.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, retrieved.AvailableCopies)
+	assert.Equal(t, 2, retrieved.Version)
+}
+
 func TestMongoBookRepository_Delete(t *testing.T) {
 	CleanupMongo(t)
 
@@ -151,3 +179,29 @@ func TestMongoBookRepository_Delete(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, retrieved)
 }
+
+func TestMongoBookRepository_ListSkipsWithdrawn(t *testing.T) {
+	CleanupMongo(t)
+
+	repo := repository.NewMongoBookRepository(MongoTestDB)
+	ctx := context.Background()
+
+	listed := CreateTestBook("Listed Book", "Author", "1234567894")
+	withdrawn := CreateTestBook("Withdrawn Book", "Author", "1234567895")
+	require.NoError(t, repo.Create(ctx, listed))
+	require.NoError(t, repo.Create(ctx, withdrawn))
+
+	require.NoError(t, withdrawn.Withdraw())
+	require.NoError(t, repo.Update(ctx, withdrawn))
+
+	result, count, err := repo.List(ctx, 1, 10, domainrepo.BookFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, result, 1)
+	assert.Equal(t, listed.ID, result[0].ID)
+
+	retrieved, err := repo.GetByID(ctx, withdrawn.ID)
+	assert.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.NotNil(t, retrieved.WithdrawnAt)
+}
@@ -3,6 +3,9 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"strings"
+	"time"
+	"unicode"
 
 	"bookhub/internal/domain/entity"
 	"bookhub/internal/domain/repository"
@@ -11,18 +14,31 @@ import (
 	"github.com/google/uuid"
 )
 
+// bookColumns are the books columns in the order sqlc.Book is scanned.
+const bookColumns = "id, title, author, isbn, published_year, total_copies, available_copies, created_at, updated_at, version, category, withdrawn_at"
+
+// bookSortColumns maps the repository.BookSortFields to columns.
+var bookSortColumns = map[string]sortColumn{
+	"title":          {name: "title"},
+	"author":         {name: "author"},
+	"published_year": {name: "published_year", nullable: true},
+	"created_at":     {name: "created_at"},
+}
+
 type postgresBookRepository struct {
+	db      *sql.DB
 	queries *sqlc.Queries
 }
 
 func NewPostgresBookRepository(db *sql.DB) repository.BookRepository {
 	return &postgresBookRepository{
+		db:      db,
 		queries: sqlc.New(db),
 	}
 }
 
 func (r *postgresBookRepository) Create(ctx context.Context, book *entity.Book) error {
-	_, err := r.queries.CreateBook(ctx, sqlc.CreateBookParams{
+	_, err := r.q(ctx).CreateBook(ctx, sqlc.CreateBookParams{
 		ID:              book.ID,
 		Title:           book.Title,
 		Author:          book.Author,
@@ -32,12 +48,14 @@ func (r *postgresBookRepository) Create(ctx context.Context, book *entity.Book)
 		AvailableCopies: int32(book.AvailableCopies),
 		CreatedAt:       book.CreatedAt,
 		UpdatedAt:       book.UpdatedAt,
+		Version:         int32(book.Version),
+		Category:        book.Category,
 	})
 	return err
 }
 
 func (r *postgresBookRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Book, error) {
-	row, err := r.queries.GetBookByID(ctx, id)
+	row, err := r.q(ctx).GetBookByID(ctx, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -48,7 +66,7 @@ func (r *postgresBookRepository) GetByID(ctx context.Context, id uuid.UUID) (*en
 }
 
 func (r *postgresBookRepository) GetByISBN(ctx context.Context, isbn string) (*entity.Book, error) {
-	row, err := r.queries.GetBookByISBN(ctx, isbn)
+	row, err := r.q(ctx).GetBookByISBN(ctx, isbn)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -58,47 +76,146 @@ func (r *postgresBookRepository) GetByISBN(ctx context.Context, isbn string) (*e
 	return r.toEntity(row), nil
 }
 
-func (r *postgresBookRepository) List(ctx context.Context, page, limit int, availableOnly *bool) ([]*entity.Book, int, error) {
+func (r *postgresBookRepository) List(ctx context.Context, page, limit int, filter repository.BookFilter) ([]*entity.Book, int, error) {
 	offset := (page - 1) * limit
 
-	var rows []sqlc.Book
-	var count int64
-	var err error
+	orderBy, err := orderByClause(filter.Sort, bookDefaultSort, bookSortColumns, "id")
+	if err != nil {
+		return nil, 0, err
+	}
 
-	if availableOnly != nil && *availableOnly {
-		rows, err = r.queries.ListAvailableBooks(ctx, sqlc.ListAvailableBooksParams{
-			Limit:  int32(limit),
-			Offset: int32(offset),
-		})
-		if err != nil {
-			return nil, 0, err
+	// Withdrawn books are kept for their history but not listed.
+	q := newListQuery("books")
+	q.where("withdrawn_at IS NULL")
+	switch {
+	case filter.BranchID != nil:
+		// Books are kept at a branch through their copies.
+		copyStatus := "c.status <> 'withdrawn'"
+		if filter.AvailableOnly {
+			copyStatus = "c.status = 'available'"
 		}
-		count, err = r.queries.CountAvailableBooks(ctx)
-	} else {
-		rows, err = r.queries.ListBooks(ctx, sqlc.ListBooksParams{
-			Limit:  int32(limit),
-			Offset: int32(offset),
-		})
-		if err != nil {
+		q.where("EXISTS (SELECT 1 FROM book_copies c WHERE c.book_id = books.id AND c.branch_id = ? AND "+copyStatus+")", *filter.BranchID)
+	case filter.AvailableOnly:
+		q.where("available_copies > 0")
+	}
+	if filter.Author != "" {
+		q.where("unaccent(author) ILIKE '%' || unaccent(?::text) || '%'", escapeLike(filter.Author))
+	}
+	if filter.PublishedFrom != nil {
+		q.where("published_year >= ?", *filter.PublishedFrom)
+	}
+	if filter.PublishedTo != nil {
+		q.where("published_year <= ?", *filter.PublishedTo)
+	}
+
+	db := dbFromContext(ctx, r.db)
+	query, args := q.selectPage(bookColumns, orderBy, limit, offset)
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var books []*entity.Book
+	for rows.Next() {
+		var row sqlc.Book
+		if err := rows.Scan(
+			&row.ID,
+			&row.Title,
+			&row.Author,
+			&row.Isbn,
+			&row.PublishedYear,
+			&row.TotalCopies,
+			&row.AvailableCopies,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+			&row.Version,
+			&row.Category,
+			&row.WithdrawnAt,
+		); err != nil {
 			return nil, 0, err
 		}
-		count, err = r.queries.CountBooks(ctx)
+		books = append(books, r.toEntity(row))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	var count int
+	query, args = q.count()
+	if err := db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return nil, 0, err
+	}
+
+	return books, count, nil
+}
+
+func (r *postgresBookRepository) Search(ctx context.Context, page, limit int, search repository.BookSearch) ([]*repository.BookMatch, int, error) {
+	offset := (page - 1) * limit
+
+	query := r.anyTermQuery(search.Query)
+	author := sql.NullString{String: search.Author, Valid: search.Author != ""}
+	publishedFrom := r.toNullInt32(search.PublishedFrom)
+	publishedTo := r.toNullInt32(search.PublishedTo)
+	var branchID uuid.NullUUID
+	if search.BranchID != nil {
+		branchID = uuid.NullUUID{UUID: *search.BranchID, Valid: true}
 	}
 
+	rows, err := r.q(ctx).SearchBooks(ctx, sqlc.SearchBooksParams{
+		Limit:         int32(limit),
+		Offset:        int32(offset),
+		Query:         query,
+		Author:        author,
+		PublishedFrom: publishedFrom,
+		PublishedTo:   publishedTo,
+		BranchID:      branchID,
+		AvailableOnly: search.AvailableOnly,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := r.q(ctx).CountSearchBooks(ctx, sqlc.CountSearchBooksParams{
+		Query:         query,
+		Author:        author,
+		PublishedFrom: publishedFrom,
+		PublishedTo:   publishedTo,
+		BranchID:      branchID,
+		AvailableOnly: search.AvailableOnly,
+	})
 	if err != nil {
 		return nil, 0, err
 	}
 
-	books := make([]*entity.Book, len(rows))
+	matches := make([]*repository.BookMatch, len(rows))
 	for i, row := range rows {
-		books[i] = r.toEntity(row)
+		matches[i] = &repository.BookMatch{
+			Book:            r.toEntity(row.Book),
+			Score:           row.Rank,
+			TitleHighlight:  row.TitleHighlight,
+			AuthorHighlight: row.AuthorHighlight,
+		}
 	}
 
-	return books, int(count), nil
+	return matches, int(count), nil
+}
+
+func (r *postgresBookRepository) SuggestTitles(ctx context.Context, prefix string, limit int) ([]string, error) {
+	return r.q(ctx).SuggestBookTitles(ctx, sqlc.SuggestBookTitlesParams{
+		Prefix: escapeLike(prefix),
+		Limit:  int32(limit),
+	})
+}
+
+func (r *postgresBookRepository) SuggestAuthors(ctx context.Context, prefix string, limit int) ([]string, error) {
+	return r.q(ctx).SuggestBookAuthors(ctx, sqlc.SuggestBookAuthorsParams{
+		Prefix: escapeLike(prefix),
+		Limit:  int32(limit),
+	})
 }
 
 func (r *postgresBookRepository) Update(ctx context.Context, book *entity.Book) error {
-	_, err := r.queries.UpdateBook(ctx, sqlc.UpdateBookParams{
+	row, err := r.q(ctx).UpdateBook(ctx, sqlc.UpdateBookParams{
 		ID:              book.ID,
 		Title:           book.Title,
 		Author:          book.Author,
@@ -107,12 +224,28 @@ func (r *postgresBookRepository) Update(ctx context.Context, book *entity.Book)
 		TotalCopies:     int32(book.TotalCopies),
 		AvailableCopies: int32(book.AvailableCopies),
 		UpdatedAt:       book.UpdatedAt,
+		Version:         int32(book.Version),
+		Category:        book.Category,
+		WithdrawnAt:     r.toNullTime(book.WithdrawnAt),
 	})
-	return err
+	if err != nil {
+		// No row matched id and version: someone else updated the book first
+		if err == sql.ErrNoRows {
+			return entity.ErrConcurrentModification
+		}
+		return err
+	}
+	book.Version = int(row.Version)
+	return nil
 }
 
 func (r *postgresBookRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.queries.DeleteBook(ctx, id)
+	return r.q(ctx).DeleteBook(ctx, id)
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresBookRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
 }
 
 func (r *postgresBookRepository) toEntity(row sqlc.Book) *entity.Book {
@@ -127,9 +260,43 @@ func (r *postgresBookRepository) toEntity(row sqlc.Book) *entity.Book {
 		Author:          row.Author,
 		ISBN:            row.Isbn,
 		PublishedYear:   publishedYear,
+		Category:        row.Category,
 		TotalCopies:     int(row.TotalCopies),
 		AvailableCopies: int(row.AvailableCopies),
 		CreatedAt:       row.CreatedAt,
 		UpdatedAt:       row.UpdatedAt,
+		Version:         int(row.Version),
+		WithdrawnAt:     r.fromNullTime(row.WithdrawnAt),
+	}
+}
+
+func (r *postgresBookRepository) toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{Valid: false}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func (r *postgresBookRepository) fromNullTime(nt sql.NullTime) *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+	return &nt.Time
+}
+
+// anyTermQuery rewrites q for websearch_to_tsquery so that books matching
+// any of its words are found, as Mongo text search does. Punctuation is
+// dropped so it can't turn into phrase or negation operators.
+func (r *postgresBookRepository) anyTermQuery(q string) string {
+	terms := strings.FieldsFunc(q, func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+	return strings.Join(terms, " or ")
+}
+
+func (r *postgresBookRepository) toNullInt32(n *int) sql.NullInt32 {
+	if n == nil {
+		return sql.NullInt32{Valid: false}
 	}
+	return sql.NullInt32{Int32: int32(*n), Valid: true}
 }
@@ -0,0 +1,167 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// searchCorpus is the catalog both Search implementations are checked
+// against.
+var searchCorpus = []struct {
+	title, author, isbn string
+	year                int
+}{
+	{"Dom Casmurro", "Machado de Assis", "9788535910414", 1899},
+	{"Memórias Póstumas de Brás Cubas", "Machado de Assis", "9788535910421", 1881},
+	{"O Cortiço", "Aluísio Azevedo", "9788535910438", 1890},
+	{"Ensaio sobre a Cegueira", "José Saramago", "9788535910445", 1995},
+	{"Memorial do Convento", "José Saramago", "9788535910452", 1982},
+	{"Vidas Secas", "Graciliano Ramos", "9788535910469", 1938},
+	{"Grande Sertão: Veredas", "João Guimarães Rosa", "9788535910476", 1956},
+	{"A Hora da Estrela", "Clarice Lispector", "9788535910483", 1977},
+}
+
+// runBookSearch loads searchCorpus into repo and checks matching, ranking,
+// highlighting and filtering.
+func runBookSearch(t *testing.T, repo domainrepo.BookRepository) {
+	ctx := context.Background()
+
+	byTitle := make(map[string]*entity.Book, len(searchCorpus))
+	for _, b := range searchCorpus {
+		book := CreateTestBook(b.title, b.author, b.isbn)
+		book.PublishedYear = b.year
+		require.NoError(t, repo.Create(ctx, book))
+		byTitle[b.title] = book
+	}
+
+	search := func(s domainrepo.BookSearch) ([]*domainrepo.BookMatch, []string, int) {
+		t.Helper()
+		matches, total, err := repo.Search(ctx, 1, 10, s)
+		require.NoError(t, err)
+		titles := make([]string, len(matches))
+		for i, match := range matches {
+			titles[i] = match.Book.Title
+		}
+		return matches, titles, total
+	}
+
+	t.Run("matches title words and highlights them", func(t *testing.T) {
+		matches, titles, total := search(domainrepo.BookSearch{Query: "casmurro"})
+		assert.Equal(t, 1, total)
+		require.Equal(t, []string{"Dom Casmurro"}, titles)
+		assert.Equal(t, "Dom <mark>Casmurro</mark>", matches[0].TitleHighlight)
+		assert.Equal(t, "Machado de Assis", matches[0].AuthorHighlight)
+	})
+
+	t.Run("ignores case and accents", func(t *testing.T) {
+		matches, titles, _ := search(domainrepo.BookSearch{Query: "SERTAO"})
+		require.Equal(t, []string{"Grande Sertão: Veredas"}, titles)
+		assert.Equal(t, "Grande <mark>Sertão</mark>: Veredas", matches[0].TitleHighlight)
+
+		_, titles, _ = search(domainrepo.BookSearch{Query: "cortico"})
+		assert.Equal(t, []string{"O Cortiço"}, titles)
+	})
+
+	t.Run("matches authors", func(t *testing.T) {
+		matches, titles, total := search(domainrepo.BookSearch{Query: "saramago"})
+		assert.Equal(t, 2, total)
+		assert.ElementsMatch(t, []string{"Ensaio sobre a Cegueira", "Memorial do Convento"}, titles)
+		for _, match := range matches {
+			assert.Equal(t, "José <mark>Saramago</mark>", match.AuthorHighlight)
+		}
+	})
+
+	t.Run("matches the ISBN", func(t *testing.T) {
+		_, titles, _ := search(domainrepo.BookSearch{Query: "9788535910469"})
+		assert.Equal(t, []string{"Vidas Secas"}, titles)
+	})
+
+	t.Run("ranks books matching more terms and in the title first", func(t *testing.T) {
+		matches, titles, total := search(domainrepo.BookSearch{Query: "machado casmurro"})
+		assert.Equal(t, 2, total)
+		require.Len(t, titles, 2)
+		assert.Equal(t, "Dom Casmurro", titles[0])
+		assert.Greater(t, matches[0].Score, matches[1].Score)
+	})
+
+	t.Run("filters by author", func(t *testing.T) {
+		_, _, total := search(domainrepo.BookSearch{Query: "machado saramago"})
+		assert.Equal(t, 4, total)
+
+		_, titles, total := search(domainrepo.BookSearch{BookFilter: domainrepo.BookFilter{Author: "jose"}, Query: "machado saramago"})
+		assert.Equal(t, 2, total)
+		assert.ElementsMatch(t, []string{"Ensaio sobre a Cegueira", "Memorial do Convento"}, titles)
+	})
+
+	t.Run("filters by published year range", func(t *testing.T) {
+		from, to := 1890, 1990
+		_, titles, total := search(domainrepo.BookSearch{BookFilter: domainrepo.BookFilter{PublishedFrom: &from, PublishedTo: &to}, Query: "machado saramago"})
+		assert.Equal(t, 2, total)
+		assert.ElementsMatch(t, []string{"Dom Casmurro", "Memorial do Convento"}, titles)
+	})
+
+	t.Run("paginates", func(t *testing.T) {
+		matches, total, err := repo.Search(ctx, 2, 3, domainrepo.BookSearch{Query: "machado saramago"})
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+		assert.Len(t, matches, 1)
+	})
+
+	t.Run("filters available books", func(t *testing.T) {
+		book := byTitle["O Cortiço"]
+		book.AvailableCopies = 0
+		require.NoError(t, repo.Update(ctx, book))
+
+		_, _, total := search(domainrepo.BookSearch{BookFilter: domainrepo.BookFilter{AvailableOnly: true}, Query: "cortico"})
+		assert.Equal(t, 0, total)
+	})
+
+	t.Run("skips withdrawn books", func(t *testing.T) {
+		book := byTitle["Vidas Secas"]
+		require.NoError(t, book.Withdraw())
+		require.NoError(t, repo.Update(ctx, book))
+
+		_, _, total := search(domainrepo.BookSearch{Query: "secas"})
+		assert.Equal(t, 0, total)
+	})
+
+	t.Run("finds nothing for unknown words", func(t *testing.T) {
+		matches, _, total := search(domainrepo.BookSearch{Query: "inexistente"})
+		assert.Equal(t, 0, total)
+		assert.Empty(t, matches)
+	})
+}
+
+func TestPostgresBookRepository_Search(t *testing.T) {
+	CleanupPostgres(t)
+
+	runBookSearch(t, repository.NewPostgresBookRepository(PostgresTestDB))
+}
+
+func TestMongoBookRepository_Search(t *testing.T) {
+	CleanupMongo(t)
+
+	// Mirrors the text index created by migrations/mongo/init-db.js
+	_, err := MongoTestDB.Collection("books").Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "title", Value: "text"}, {Key: "author", Value: "text"}, {Key: "isbn", Value: "text"}},
+		Options: options.Index().
+			SetName("books_text").
+			SetWeights(bson.M{"title": 10, "isbn": 10, "author": 5}).
+			SetDefaultLanguage("portuguese"),
+	})
+	require.NoError(t, err)
+
+	runBookSearch(t, repository.NewMongoBookRepository(MongoTestDB))
+}
@@ -0,0 +1,267 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// runBookSuggest loads searchCorpus into repo and checks prefix completion of
+// titles and authors.
+func runBookSuggest(t *testing.T, repo domainrepo.BookRepository) {
+	ctx := context.Background()
+
+	byTitle := make(map[string]*entity.Book, len(searchCorpus))
+	for _, b := range searchCorpus {
+		book := CreateTestBook(b.title, b.author, b.isbn)
+		require.NoError(t, repo.Create(ctx, book))
+		byTitle[b.title] = book
+	}
+
+	t.Run("completes titles in alphabetical order ignoring accents", func(t *testing.T) {
+		titles, err := repo.SuggestTitles(ctx, "mem", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Memorial do Convento", "Memórias Póstumas de Brás Cubas"}, titles)
+
+		titles, err = repo.SuggestTitles(ctx, "grande sertao", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Grande Sertão: Veredas"}, titles)
+	})
+
+	t.Run("completes authors once ignoring case", func(t *testing.T) {
+		authors, err := repo.SuggestAuthors(ctx, "JOSE", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"José Saramago"}, authors)
+
+		authors, err = repo.SuggestAuthors(ctx, "gra", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Graciliano Ramos"}, authors)
+	})
+
+	t.Run("matches only the start", func(t *testing.T) {
+		titles, err := repo.SuggestTitles(ctx, "casmurro", 10)
+		require.NoError(t, err)
+		assert.Empty(t, titles)
+	})
+
+	t.Run("treats wildcards literally", func(t *testing.T) {
+		titles, err := repo.SuggestTitles(ctx, "m%", 10)
+		require.NoError(t, err)
+		assert.Empty(t, titles)
+
+		titles, err = repo.SuggestTitles(ctx, "m_m", 10)
+		require.NoError(t, err)
+		assert.Empty(t, titles)
+
+		for _, b := range []struct{ title, isbn string }{
+			{"100% Kotlin", "9788535910001"},
+			{"1000 Poemas", "9788535910002"},
+			{"snake_case na Prática", "9788535910003"},
+			{"Snakes of Brazil", "9788535910004"},
+		} {
+			require.NoError(t, repo.Create(ctx, CreateTestBook(b.title, "Author", b.isbn)))
+		}
+
+		titles, err = repo.SuggestTitles(ctx, "100%", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"100% Kotlin"}, titles)
+
+		titles, err = repo.SuggestTitles(ctx, "snake_", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"snake_case na Prática"}, titles)
+	})
+
+	t.Run("limits suggestions", func(t *testing.T) {
+		titles, err := repo.SuggestTitles(ctx, "me", 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Memorial do Convento"}, titles)
+	})
+
+	t.Run("skips withdrawn books", func(t *testing.T) {
+		book := byTitle["Vidas Secas"]
+		require.NoError(t, book.Withdraw())
+		require.NoError(t, repo.Update(ctx, book))
+
+		titles, err := repo.SuggestTitles(ctx, "vidas", 10)
+		require.NoError(t, err)
+		assert.Empty(t, titles)
+	})
+}
+
+func TestPostgresBookRepository_Suggest(t *testing.T) {
+	CleanupPostgres(t)
+
+	runBookSuggest(t, repository.NewPostgresBookRepository(PostgresTestDB))
+}
+
+func TestMongoBookRepository_Suggest(t *testing.T) {
+	CleanupMongo(t)
+	createMongoSuggestIndexes(t)
+
+	runBookSuggest(t, repository.NewMongoBookRepository(MongoTestDB))
+}
+
+// createMongoSuggestIndexes mirrors the suggest indexes created by
+// migrations/mongo/init-db.js.
+func createMongoSuggestIndexes(tb testing.TB) {
+	tb.Helper()
+	collation := &options.Collation{Locale: "pt", Strength: 1}
+	_, err := MongoTestDB.Collection("books").Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "title", Value: 1}}, Options: options.Index().SetName("books_title_suggest").SetCollation(collation)},
+		{Keys: bson.D{{Key: "author", Value: 1}}, Options: options.Index().SetName("books_author_suggest").SetCollation(collation)},
+	})
+	require.NoError(tb, err)
+}
+
+const (
+	// suggestCatalogSize is how many books the suggest benchmarks seed.
+	suggestCatalogSize = 100_000
+	// suggestBudget is the most a suggest request may take on average.
+	suggestBudget = 50 * time.Millisecond
+)
+
+// suggestPrefixes are typed into the benchmarks, from short prefixes
+// matching a large part of the catalog to selective ones.
+var suggestPrefixes = []string{"es", "mar", "jo", "jose", "ines", "cami", "sertao", "zz"}
+
+var (
+	catalogWords = []string{
+		"Amor", "Bosque", "Caminho", "Cidade", "Destino", "Estrela", "Estrada", "Floresta",
+		"Guerra", "História", "Ilha", "Jardim", "Lua", "Mar", "Margem", "Memória",
+		"Noite", "Ouro", "Palavra", "Rio", "Sertão", "Tempo", "Vento", "Viagem",
+		"Casa", "Sombra", "Espelho", "Janela", "Montanha", "Ponte", "Silêncio", "Verão",
+		"Inverno", "Cinza", "Fogo", "Água", "Terra", "Céu", "Porto", "Segredo",
+	}
+	catalogLinks       = []string{"do", "da", "de", "sem", "e o"}
+	catalogFirstNames  = []string{"José", "João", "Maria", "Ana", "Inês", "Conceição", "Antônio", "Lúcia", "Machado", "Clarice", "Jorge", "Cecília", "Rubem", "Lygia", "Érico"}
+	catalogMiddleNames = []string{"de", "da", "dos", "Ribeiro", "Amado", "Vaz", "Telles"}
+	catalogLastNames   = []string{"Saramago", "Assis", "Lispector", "Andrade", "Meireles", "Braga", "Veríssimo", "Queiroz", "Ramos", "Rosa", "Azevedo", "Bandeira", "Alencar"}
+)
+
+// catalogBook builds the i-th book of a synthetic catalog of mostly distinct
+// titles sharing words and a few thousand authors.
+func catalogBook(i int) *entity.Book {
+	n := len(catalogWords)
+	title := fmt.Sprintf("%s %s %s %d",
+		catalogWords[i%n],
+		catalogLinks[(i/n)%len(catalogLinks)],
+		strings.ToLower(catalogWords[(i/(n*len(catalogLinks)))%n]),
+		i/(n*len(catalogLinks)*n)+1)
+	author := fmt.Sprintf("%s %s %s",
+		catalogFirstNames[i%len(catalogFirstNames)],
+		catalogMiddleNames[(i/7)%len(catalogMiddleNames)],
+		catalogLastNames[(i/11)%len(catalogLastNames)])
+
+	book := CreateTestBook(title, author, fmt.Sprintf("979%010d", i))
+	book.PublishedYear = 1900 + i%125
+	return book
+}
+
+// benchmarkSuggest times what GET /books/suggest does for each of
+// suggestPrefixes and fails when a prefix takes longer than suggestBudget.
+func benchmarkSuggest(b *testing.B, repo domainrepo.BookRepository) {
+	ctx := context.Background()
+	for _, prefix := range suggestPrefixes {
+		b.Run(prefix, func(b *testing.B) {
+			suggest := func() {
+				if _, err := repo.SuggestTitles(ctx, prefix, 10); err != nil {
+					b.Fatal(err)
+				}
+				if _, err := repo.SuggestAuthors(ctx, prefix, 10); err != nil {
+					b.Fatal(err)
+				}
+			}
+			// Warm the caches so the first run of b.N=1 isn't an outlier
+			suggest()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				suggest()
+			}
+			b.StopTimer()
+
+			perOp := b.Elapsed() / time.Duration(b.N)
+			b.ReportMetric(float64(perOp.Microseconds())/1000, "ms/op")
+			if perOp > suggestBudget {
+				b.Fatalf("suggest %q took %v, want under %v", prefix, perOp, suggestBudget)
+			}
+		})
+	}
+}
+
+func BenchmarkPostgresBookRepository_Suggest(b *testing.B) {
+	ctx := context.Background()
+	CleanupPostgres(b)
+
+	// Multi-row inserts; 11 columns keep a batch under the 65535 parameter limit
+	const batch = 1000
+	for start := 0; start < suggestCatalogSize; start += batch {
+		var values []string
+		var args []any
+		for i := start; i < min(start+batch, suggestCatalogSize); i++ {
+			book := catalogBook(i)
+			p := len(args)
+			values = append(values, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+				p+1, p+2, p+3, p+4, p+5, p+6, p+7, p+8, p+9, p+10, p+11))
+			args = append(args, book.ID, book.Title, book.Author, book.ISBN, book.PublishedYear, book.Category,
+				book.TotalCopies, book.AvailableCopies, book.CreatedAt, book.UpdatedAt, book.Version)
+		}
+		_, err := PostgresTestDB.ExecContext(ctx, `INSERT INTO books
+			(id, title, author, isbn, published_year, category, total_copies, available_copies, created_at, updated_at, version)
+			VALUES `+strings.Join(values, ", "), args...)
+		require.NoError(b, err)
+	}
+	_, err := PostgresTestDB.ExecContext(ctx, "ANALYZE books")
+	require.NoError(b, err)
+	b.Cleanup(func() { CleanupPostgres(b) })
+
+	benchmarkSuggest(b, repository.NewPostgresBookRepository(PostgresTestDB))
+}
+
+func BenchmarkMongoBookRepository_Suggest(b *testing.B) {
+	ctx := context.Background()
+	CleanupMongo(b)
+	createMongoSuggestIndexes(b)
+
+	books := MongoTestDB.Collection("books")
+
+	const batch = 1000
+	for start := 0; start < suggestCatalogSize; start += batch {
+		var docs []any
+		for i := start; i < min(start+batch, suggestCatalogSize); i++ {
+			book := catalogBook(i)
+			docs = append(docs, bson.M{
+				"id":              book.ID,
+				"title":           book.Title,
+				"author":          book.Author,
+				"isbn":            book.ISBN,
+				"publishedyear":   book.PublishedYear,
+				"category":        book.Category,
+				"totalcopies":     book.TotalCopies,
+				"availablecopies": book.AvailableCopies,
+				"createdat":       book.CreatedAt,
+				"updatedat":       book.UpdatedAt,
+				"version":         book.Version,
+			})
+		}
+		_, err := books.InsertMany(ctx, docs)
+		require.NoError(b, err)
+	}
+	b.Cleanup(func() { CleanupMongo(b) })
+
+	benchmarkSuggest(b, repository.NewMongoBookRepository(MongoTestDB))
+}
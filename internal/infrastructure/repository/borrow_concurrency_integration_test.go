@@ -0,0 +1,278 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/repository"
+	"bookhub/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	concurrentBorrowers = 10
+	concurrentCopies    = 3
+)
+
+// runConcurrentBorrows lets concurrentBorrowers distinct users race for a book
+// with concurrentCopies copies and checks that exactly that many loans win.
+func runConcurrentBorrows(
+	t *testing.T,
+	loanRepo domainrepo.LoanRepositoryWithDetails,
+	bookRepo domainrepo.BookRepository,
+	copyRepo domainrepo.BookCopyRepository,
+	branchRepo domainrepo.BranchRepository,
+	userRepo domainrepo.UserRepository,
+	holdRepo domainrepo.HoldRepository,
+	fineRepo domainrepo.FineRepository,
+	policyRepo domainrepo.LoanPolicyRepository,
+	calendarRepo domainrepo.CalendarRepository,
+	outboxRepo domainrepo.OutboxRepository,
+	auditRepo domainrepo.AuditRepository,
+	txManager domainrepo.TxManager,
+) {
+	ctx := context.Background()
+	outboxUC := usecase.NewOutboxUseCase(outboxRepo, nil, usecase.OutboxRules{})
+	auditUC := usecase.NewAuditUseCase(auditRepo, txManager)
+	loanUC := usecase.NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, holdRepo, fineRepo, policyRepo, calendarRepo, txManager, outboxUC, auditUC, usecase.LoanRules{MaxLoans: 1, LoanDays: 14})
+
+	book := CreateTestBook("Contended Book", "Author", "9999999999")
+	book.TotalCopies = concurrentCopies
+	book.AvailableCopies = concurrentCopies
+	require.NoError(t, bookRepo.Create(ctx, book))
+	branch := CreateTestBranch("TEST", "Test Branch")
+	require.NoError(t, branchRepo.Create(ctx, branch))
+	for n := 1; n <= concurrentCopies; n++ {
+		bookCopy, err := entity.NewBookCopy(book.ID, branch.ID, entity.DefaultCopyBarcode(book.ISBN, n), "", "")
+		require.NoError(t, err)
+		require.NoError(t, copyRepo.Create(ctx, bookCopy))
+	}
+
+	users := make([]*entity.User, concurrentBorrowers)
+	for i := range users {
+		users[i] = CreateTestUser(fmt.Sprintf("Borrower %d", i), fmt.Sprintf("borrower%d@example.com", i))
+		require.NoError(t, userRepo.Create(ctx, users[i]))
+	}
+
+	errs := make([]error, concurrentBorrowers)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i, user := range users {
+		wg.Add(1)
+		go func(i int, user *entity.User) {
+			defer wg.Done()
+			<-start
+			_, errs[i] = loanUC.BorrowBook(ctx, usecase.BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		}(i, user)
+	}
+	close(start)
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.ErrorIs(t, err, entity.ErrBookNotAvailable)
+	}
+	assert.Equal(t, concurrentCopies, succeeded)
+
+	retrieved, err := bookRepo.GetByID(ctx, book.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, retrieved.AvailableCopies)
+
+	status := entity.LoanStatusActive
+	_, count, err := loanRepo.List(ctx, 1, concurrentBorrowers, domainrepo.LoanFilter{Status: &status})
+	require.NoError(t, err)
+	assert.Equal(t, concurrentCopies, count)
+
+	// Only the winning borrows left an event behind
+	now := time.Now()
+	events, err := outboxRepo.ClaimPending(ctx, now, now.Add(time.Minute), concurrentBorrowers)
+	require.NoError(t, err)
+	assert.Len(t, events, concurrentCopies)
+
+	// The winners' audit entries still form a single chain
+	verification, err := auditUC.Verify(ctx)
+	require.NoError(t, err)
+	assert.True(t, verification.Valid(), verification.Reason)
+	assert.Equal(t, concurrentCopies, verification.Checked)
+}
+
+// runConcurrentPickups races patrons picking up copies set aside for their
+// holds against patrons borrowing from the shelf. A pickup audits its hold
+// before it touches the book and a shelf borrow touches the book first, so
+// every borrow must still go through without a deadlock.
+func runConcurrentPickups(
+	t *testing.T,
+	loanRepo domainrepo.LoanRepositoryWithDetails,
+	bookRepo domainrepo.BookRepository,
+	copyRepo domainrepo.BookCopyRepository,
+	branchRepo domainrepo.BranchRepository,
+	userRepo domainrepo.UserRepository,
+	holdRepo domainrepo.HoldRepository,
+	fineRepo domainrepo.FineRepository,
+	policyRepo domainrepo.LoanPolicyRepository,
+	calendarRepo domainrepo.CalendarRepository,
+	outboxRepo domainrepo.OutboxRepository,
+	auditRepo domainrepo.AuditRepository,
+	txManager domainrepo.TxManager,
+) {
+	const heldCopies, shelfCopies = 2, 2
+
+	ctx := context.Background()
+	outboxUC := usecase.NewOutboxUseCase(outboxRepo, nil, usecase.OutboxRules{})
+	auditUC := usecase.NewAuditUseCase(auditRepo, txManager)
+	rules := usecase.LoanRules{MaxLoans: 1, LoanDays: 14, HoldPickupWindow: time.Hour}
+	loanUC := usecase.NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, holdRepo, fineRepo, policyRepo, calendarRepo, txManager, outboxUC, auditUC, rules)
+	holdUC := usecase.NewHoldUseCase(holdRepo, bookRepo, copyRepo, userRepo, loanRepo, txManager, outboxUC, auditUC, rules.HoldPickupWindow)
+
+	book := CreateTestBook("Contended Book", "Author", "9999999999")
+	book.TotalCopies = heldCopies + shelfCopies
+	book.AvailableCopies = heldCopies + shelfCopies
+	require.NoError(t, bookRepo.Create(ctx, book))
+	branch := CreateTestBranch("TEST", "Test Branch")
+	require.NoError(t, branchRepo.Create(ctx, branch))
+	for n := 1; n <= book.TotalCopies; n++ {
+		bookCopy, err := entity.NewBookCopy(book.ID, branch.ID, entity.DefaultCopyBarcode(book.ISBN, n), "", "")
+		require.NoError(t, err)
+		require.NoError(t, copyRepo.Create(ctx, bookCopy))
+	}
+
+	newUser := func(name string) *entity.User {
+		user := CreateTestUser(name, fmt.Sprintf("%s@example.com", name))
+		require.NoError(t, userRepo.Create(ctx, user))
+		return user
+	}
+
+	// Lend every copy, queue the hold patrons, then bring the copies back so
+	// the first ones are set aside and the rest go back on the shelf.
+	loans := make([]*domainrepo.LoanWithDetails, book.TotalCopies)
+	for i := range loans {
+		var err error
+		loans[i], err = loanUC.BorrowBook(ctx, usecase.BorrowBookInput{UserID: newUser(fmt.Sprintf("lender%d", i)).ID, BookID: book.ID})
+		require.NoError(t, err)
+	}
+	borrowers := make([]*entity.User, 0, heldCopies+shelfCopies)
+	for i := 0; i < heldCopies; i++ {
+		user := newUser(fmt.Sprintf("holder%d", i))
+		_, err := holdUC.PlaceHold(ctx, usecase.PlaceHoldInput{UserID: user.ID, BookID: book.ID})
+		require.NoError(t, err)
+		borrowers = append(borrowers, user)
+	}
+	for _, loan := range loans {
+		_, err := loanUC.ReturnBook(ctx, loan.Loan.ID)
+		require.NoError(t, err)
+	}
+	for i := 0; i < shelfCopies; i++ {
+		borrowers = append(borrowers, newUser(fmt.Sprintf("walkin%d", i)))
+	}
+
+	errs := make([]error, len(borrowers))
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i, user := range borrowers {
+		wg.Add(1)
+		go func(i int, user *entity.User) {
+			defer wg.Done()
+			<-start
+			_, errs[i] = loanUC.BorrowBook(ctx, usecase.BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		}(i, user)
+	}
+	close(start)
+	wg.Wait()
+
+	for i, err := range errs {
+		assert.NoError(t, err, "borrower %d", i)
+	}
+
+	verification, err := auditUC.Verify(ctx)
+	require.NoError(t, err)
+	assert.True(t, verification.Valid(), verification.Reason)
+}
+
+func TestPostgres_ConcurrentBorrows(t *testing.T) {
+	CleanupPostgres(t)
+
+	runConcurrentBorrows(t,
+		repository.NewPostgresLoanRepository(PostgresTestDB),
+		repository.NewPostgresBookRepository(PostgresTestDB),
+		repository.NewPostgresBookCopyRepository(PostgresTestDB),
+		repository.NewPostgresBranchRepository(PostgresTestDB),
+		repository.NewPostgresUserRepository(PostgresTestDB),
+		repository.NewPostgresHoldRepository(PostgresTestDB),
+		repository.NewPostgresFineRepository(PostgresTestDB),
+		repository.NewPostgresLoanPolicyRepository(PostgresTestDB),
+		repository.NewPostgresCalendarRepository(PostgresTestDB),
+		repository.NewPostgresOutboxRepository(PostgresTestDB),
+		repository.NewPostgresAuditRepository(PostgresTestDB),
+		repository.NewPostgresTxManager(PostgresTestDB),
+	)
+}
+
+func TestMongo_ConcurrentBorrows(t *testing.T) {
+	CleanupMongo(t)
+
+	runConcurrentBorrows(t,
+		repository.NewMongoLoanRepository(MongoTestDB),
+		repository.NewMongoBookRepository(MongoTestDB),
+		repository.NewMongoBookCopyRepository(MongoTestDB),
+		repository.NewMongoBranchRepository(MongoTestDB),
+		repository.NewMongoUserRepository(MongoTestDB),
+		repository.NewMongoHoldRepository(MongoTestDB),
+		repository.NewMongoFineRepository(MongoTestDB),
+		repository.NewMongoLoanPolicyRepository(MongoTestDB),
+		repository.NewMongoCalendarRepository(MongoTestDB),
+		repository.NewMongoOutboxRepository(MongoTestDB),
+		repository.NewMongoAuditRepository(MongoTestDB),
+		repository.NewMongoTxManager(MongoTestDB),
+	)
+}
+
+func TestPostgres_ConcurrentPickups(t *testing.T) {
+	CleanupPostgres(t)
+
+	runConcurrentPickups(t,
+		repository.NewPostgresLoanRepository(PostgresTestDB),
+		repository.NewPostgresBookRepository(PostgresTestDB),
+		repository.NewPostgresBookCopyRepository(PostgresTestDB),
+		repository.NewPostgresBranchRepository(PostgresTestDB),
+		repository.NewPostgresUserRepository(PostgresTestDB),
+		repository.NewPostgresHoldRepository(PostgresTestDB),
+		repository.NewPostgresFineRepository(PostgresTestDB),
+		repository.NewPostgresLoanPolicyRepository(PostgresTestDB),
+		repository.NewPostgresCalendarRepository(PostgresTestDB),
+		repository.NewPostgresOutboxRepository(PostgresTestDB),
+		repository.NewPostgresAuditRepository(PostgresTestDB),
+		repository.NewPostgresTxManager(PostgresTestDB),
+	)
+}
+
+func TestMongo_ConcurrentPickups(t *testing.T) {
+	CleanupMongo(t)
+
+	runConcurrentPickups(t,
+		repository.NewMongoLoanRepository(MongoTestDB),
+		repository.NewMongoBookRepository(MongoTestDB),
+		repository.NewMongoBookCopyRepository(MongoTestDB),
+		repository.NewMongoBranchRepository(MongoTestDB),
+		repository.NewMongoUserRepository(MongoTestDB),
+		repository.NewMongoHoldRepository(MongoTestDB),
+		repository.NewMongoFineRepository(MongoTestDB),
+		repository.NewMongoLoanPolicyRepository(MongoTestDB),
+		repository.NewMongoCalendarRepository(MongoTestDB),
+		repository.NewMongoOutboxRepository(MongoTestDB),
+		repository.NewMongoAuditRepository(MongoTestDB),
+		repository.NewMongoTxManager(MongoTestDB),
+	)
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const branchesCollection = "branches"
+
+type mongoBranchRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoBranchRepository(db *mongo.Database) repository.BranchRepository {
+	return &mongoBranchRepository{
+		collection: db.Collection(branchesCollection),
+	}
+}
+
+func (r *mongoBranchRepository) Create(ctx context.Context, branch *entity.Branch) error {
+	doc := toBranchDocument(branch)
+	_, err := r.collection.InsertOne(ctx, doc)
+	return err
+}
+
+func (r *mongoBranchRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Branch, error) {
+	return r.findOne(ctx, bson.M{"id": id})
+}
+
+func (r *mongoBranchRepository) GetByCode(ctx context.Context, code string) (*entity.Branch, error) {
+	return r.findOne(ctx, bson.M{"code": code})
+}
+
+func (r *mongoBranchRepository) List(ctx context.Context) ([]*entity.Branch, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "code", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []branchDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	branches := make([]*entity.Branch, len(docs))
+	for i, doc := range docs {
+		branches[i] = doc.toEntity()
+	}
+	return branches, nil
+}
+
+func (r *mongoBranchRepository) Update(ctx context.Context, branch *entity.Branch) error {
+	filter := bson.M{"id": branch.ID}
+	update := bson.M{
+		"$set": bson.M{
+			"name":      branch.Name,
+			"address":   branch.Address,
+			"updatedat": branch.UpdatedAt,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (r *mongoBranchRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"id": id})
+	return err
+}
+
+func (r *mongoBranchRepository) findOne(ctx context.Context, filter bson.M) (*entity.Branch, error) {
+	var doc branchDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresBranchRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresBranchRepository(db *sql.DB) repository.BranchRepository {
+	return &postgresBranchRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresBranchRepository) Create(ctx context.Context, branch *entity.Branch) error {
+	_, err := r.q(ctx).CreateBranch(ctx, sqlc.CreateBranchParams{
+		ID:        branch.ID,
+		Code:      branch.Code,
+		Name:      branch.Name,
+		Address:   branch.Address,
+		CreatedAt: branch.CreatedAt,
+		UpdatedAt: branch.UpdatedAt,
+	})
+	return err
+}
+
+func (r *postgresBranchRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Branch, error) {
+	row, err := r.q(ctx).GetBranchByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresBranchRepository) GetByCode(ctx context.Context, code string) (*entity.Branch, error) {
+	row, err := r.q(ctx).GetBranchByCode(ctx, code)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresBranchRepository) List(ctx context.Context) ([]*entity.Branch, error) {
+	rows, err := r.q(ctx).ListBranches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	branches := make([]*entity.Branch, len(rows))
+	for i, row := range rows {
+		branches[i] = r.toEntity(row)
+	}
+	return branches, nil
+}
+
+func (r *postgresBranchRepository) Update(ctx context.Context, branch *entity.Branch) error {
+	_, err := r.q(ctx).UpdateBranch(ctx, sqlc.UpdateBranchParams{
+		ID:        branch.ID,
+		Name:      branch.Name,
+		Address:   branch.Address,
+		UpdatedAt: branch.UpdatedAt,
+	})
+	return err
+}
+
+func (r *postgresBranchRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.q(ctx).DeleteBranch(ctx, id)
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresBranchRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresBranchRepository) toEntity(row sqlc.Branch) *entity.Branch {
+	return &entity.Branch{
+		ID:        row.ID,
+		Code:      row.Code,
+		Name:      row.Name,
+		Address:   row.Address,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
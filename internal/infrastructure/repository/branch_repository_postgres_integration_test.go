@@ -0,0 +1,137 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresBranchRepository_CRUD(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresBranchRepository(PostgresTestDB)
+
+	branch := CreateTestBranch("NORTH", "North Branch")
+	branch.Address = "1 North St"
+	require.NoError(t, repo.Create(ctx, branch))
+
+	retrieved, err := repo.GetByID(ctx, branch.ID)
+	assert.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, "NORTH", retrieved.Code)
+	assert.Equal(t, "1 North St", retrieved.Address)
+
+	byCode, err := repo.GetByCode(ctx, "NORTH")
+	assert.NoError(t, err)
+	require.NotNil(t, byCode)
+	assert.Equal(t, branch.ID, byCode.ID)
+
+	require.NoError(t, retrieved.Update("North Side Branch", ""))
+	require.NoError(t, repo.Update(ctx, retrieved))
+
+	retrieved, err = repo.GetByID(ctx, branch.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "North Side Branch", retrieved.Name)
+	assert.Empty(t, retrieved.Address)
+
+	require.NoError(t, repo.Delete(ctx, branch.ID))
+
+	retrieved, err = repo.GetByID(ctx, branch.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestPostgresBranchRepository_GetByIDNotFound(t *testing.T) {
+	CleanupPostgres(t)
+
+	repo := repository.NewPostgresBranchRepository(PostgresTestDB)
+
+	retrieved, err := repo.GetByID(context.Background(), uuid.New())
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestPostgresBranchRepository_ListByCode(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresBranchRepository(PostgresTestDB)
+
+	for _, code := range []string{"SOUTH", "EAST", "NORTH"} {
+		require.NoError(t, repo.Create(ctx, CreateTestBranch(code, code+" Branch")))
+	}
+
+	branches, err := repo.List(ctx)
+	assert.NoError(t, err)
+	require.Len(t, branches, 3)
+	assert.Equal(t, "EAST", branches[0].Code)
+	assert.Equal(t, "NORTH", branches[1].Code)
+	assert.Equal(t, "SOUTH", branches[2].Code)
+}
+
+func TestPostgresBookCopyRepository_ByBranch(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	bookRepo := repository.NewPostgresBookRepository(PostgresTestDB)
+	copyRepo := repository.NewPostgresBookCopyRepository(PostgresTestDB)
+	branchRepo := repository.NewPostgresBranchRepository(PostgresTestDB)
+
+	book := CreateTestBook("Test Book", "Author", "1234567890")
+	require.NoError(t, bookRepo.Create(ctx, book))
+	north := CreateTestBranch("NORTH", "North Branch")
+	south := CreateTestBranch("SOUTH", "South Branch")
+	require.NoError(t, branchRepo.Create(ctx, north))
+	require.NoError(t, branchRepo.Create(ctx, south))
+
+	copies := []struct {
+		branch *entity.Branch
+		status string
+	}{
+		{north, entity.CopyStatusAvailable},
+		{north, entity.CopyStatusOnLoan},
+		{north, entity.CopyStatusWithdrawn},
+		{south, entity.CopyStatusAvailable},
+	}
+	for i, c := range copies {
+		bookCopy, err := entity.NewBookCopy(book.ID, c.branch.ID, entity.DefaultCopyBarcode(book.ISBN, i+1), "", "")
+		require.NoError(t, err)
+		bookCopy.Status = c.status
+		require.NoError(t, copyRepo.Create(ctx, bookCopy))
+	}
+
+	count, err := copyRepo.CountByBranch(ctx, north.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	availability, err := copyRepo.AvailabilityByBranch(ctx, []uuid.UUID{book.ID})
+	assert.NoError(t, err)
+	require.Len(t, availability, 2)
+	byBranch := make(map[uuid.UUID]entity.BranchAvailability)
+	for _, a := range availability {
+		byBranch[a.BranchID] = a
+	}
+	assert.Equal(t, 2, byBranch[north.ID].TotalCopies)
+	assert.Equal(t, 1, byBranch[north.ID].AvailableCopies)
+	assert.Equal(t, 1, byBranch[south.ID].TotalCopies)
+	assert.Equal(t, 1, byBranch[south.ID].AvailableCopies)
+
+	other := CreateTestBook("Other Book", "Author", "0987654321")
+	require.NoError(t, bookRepo.Create(ctx, other))
+
+	books, total, err := bookRepo.List(ctx, 1, 10, domainrepo.BookFilter{BranchID: &south.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, books, 1)
+	assert.Equal(t, book.ID, books[0].ID)
+}
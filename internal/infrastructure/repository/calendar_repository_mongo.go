@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	openingHoursCollection = "opening_hours"
+	closedDatesCollection  = "closed_dates"
+)
+
+type mongoCalendarRepository struct {
+	openingHours *mongo.Collection
+	closedDates  *mongo.Collection
+}
+
+func NewMongoCalendarRepository(db *mongo.Database) repository.CalendarRepository {
+	return &mongoCalendarRepository{
+		openingHours: db.Collection(openingHoursCollection),
+		closedDates:  db.Collection(closedDatesCollection),
+	}
+}
+
+func (r *mongoCalendarRepository) ListOpeningHours(ctx context.Context, branchID uuid.UUID) ([]*entity.OpeningHours, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "weekday", Value: 1}})
+
+	cursor, err := r.openingHours.Find(ctx, bson.M{"branchid": branchID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []openingHoursDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	hours := make([]*entity.OpeningHours, len(docs))
+	for i, doc := range docs {
+		hours[i] = doc.toEntity()
+	}
+	return hours, nil
+}
+
+func (r *mongoCalendarRepository) ReplaceOpeningHours(ctx context.Context, branchID uuid.UUID, hours []*entity.OpeningHours) error {
+	if _, err := r.openingHours.DeleteMany(ctx, bson.M{"branchid": branchID}); err != nil {
+		return err
+	}
+	if len(hours) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(hours))
+	for i, h := range hours {
+		doc := toOpeningHoursDocument(h)
+		doc.BranchID = branchID
+		docs[i] = doc
+	}
+	_, err := r.openingHours.InsertMany(ctx, docs)
+	return err
+}
+
+func (r *mongoCalendarRepository) CreateClosedDate(ctx context.Context, closedDate *entity.ClosedDate) error {
+	doc := toClosedDateDocument(closedDate)
+	_, err := r.closedDates.InsertOne(ctx, doc)
+	return err
+}
+
+func (r *mongoCalendarRepository) GetClosedDate(ctx context.Context, id uuid.UUID) (*entity.ClosedDate, error) {
+	return r.findClosedDate(ctx, bson.M{"id": id})
+}
+
+func (r *mongoCalendarRepository) GetClosedDateByDay(ctx context.Context, branchID uuid.UUID, date time.Time) (*entity.ClosedDate, error) {
+	return r.findClosedDate(ctx, bson.M{"branchid": branchID, "date": entity.CalendarDay(date)})
+}
+
+func (r *mongoCalendarRepository) ListClosedDates(ctx context.Context, branchID uuid.UUID, from time.Time, to *time.Time) ([]*entity.ClosedDate, error) {
+	dateFilter := bson.M{"$gte": entity.CalendarDay(from)}
+	if to != nil {
+		dateFilter["$lte"] = entity.CalendarDay(*to)
+	}
+	filter := bson.M{"branchid": branchID, "date": dateFilter}
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}})
+
+	cursor, err := r.closedDates.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []closedDateDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	closedDates := make([]*entity.ClosedDate, len(docs))
+	for i, doc := range docs {
+		closedDates[i] = doc.toEntity()
+	}
+	return closedDates, nil
+}
+
+func (r *mongoCalendarRepository) DeleteClosedDate(ctx context.Context, id uuid.UUID) error {
+	_, err := r.closedDates.DeleteOne(ctx, bson.M{"id": id})
+	return err
+}
+
+func (r *mongoCalendarRepository) findClosedDate(ctx context.Context, filter bson.M) (*entity.ClosedDate, error) {
+	var doc closedDateDocument
+	err := r.closedDates.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
@@ -0,0 +1,94 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMongoCalendarRepository_OpeningHours(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	branchRepo := repository.NewMongoBranchRepository(MongoTestDB)
+	repo := repository.NewMongoCalendarRepository(MongoTestDB)
+
+	branch := CreateTestBranch("NORTH", "North Branch")
+	require.NoError(t, branchRepo.Create(ctx, branch))
+
+	saturday, _ := entity.NewOpeningHours(branch.ID, time.Saturday, "09:00", "13:00")
+	monday, _ := entity.NewOpeningHours(branch.ID, time.Monday, "09:00", "18:00")
+	require.NoError(t, repo.ReplaceOpeningHours(ctx, branch.ID, []*entity.OpeningHours{saturday, monday}))
+
+	hours, err := repo.ListOpeningHours(ctx, branch.ID)
+	assert.NoError(t, err)
+	require.Len(t, hours, 2)
+	assert.Equal(t, time.Monday, hours[0].Weekday)
+	assert.Equal(t, 18*60, hours[0].ClosesAt)
+	assert.Equal(t, time.Saturday, hours[1].Weekday)
+
+	tuesday, _ := entity.NewOpeningHours(branch.ID, time.Tuesday, "10:00", "19:30")
+	require.NoError(t, repo.ReplaceOpeningHours(ctx, branch.ID, []*entity.OpeningHours{tuesday}))
+
+	hours, err = repo.ListOpeningHours(ctx, branch.ID)
+	assert.NoError(t, err)
+	require.Len(t, hours, 1)
+	assert.Equal(t, time.Tuesday, hours[0].Weekday)
+	assert.Equal(t, 10*60, hours[0].OpensAt)
+}
+
+func TestMongoCalendarRepository_ClosedDates(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	branchRepo := repository.NewMongoBranchRepository(MongoTestDB)
+	repo := repository.NewMongoCalendarRepository(MongoTestDB)
+
+	branch := CreateTestBranch("NORTH", "North Branch")
+	require.NoError(t, branchRepo.Create(ctx, branch))
+
+	christmas, _ := entity.NewClosedDate(branch.ID, time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), "Natal")
+	newYear, _ := entity.NewClosedDate(branch.ID, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), "")
+	require.NoError(t, repo.CreateClosedDate(ctx, newYear))
+	require.NoError(t, repo.CreateClosedDate(ctx, christmas))
+
+	retrieved, err := repo.GetClosedDate(ctx, christmas.ID)
+	assert.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.True(t, retrieved.Date.Equal(christmas.Date))
+	assert.Equal(t, "Natal", retrieved.Reason)
+
+	byDay, err := repo.GetClosedDateByDay(ctx, branch.ID, christmas.Date)
+	assert.NoError(t, err)
+	require.NotNil(t, byDay)
+	assert.Equal(t, christmas.ID, byDay.ID)
+
+	all, err := repo.ListClosedDates(ctx, branch.ID, christmas.Date, nil)
+	assert.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, christmas.ID, all[0].ID)
+
+	until := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	december, err := repo.ListClosedDates(ctx, branch.ID, christmas.Date, &until)
+	assert.NoError(t, err)
+	assert.Len(t, december, 1)
+
+	require.NoError(t, repo.DeleteClosedDate(ctx, christmas.ID))
+
+	retrieved, err = repo.GetClosedDate(ctx, christmas.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+
+	missing, err := repo.GetClosedDate(ctx, uuid.New())
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+}
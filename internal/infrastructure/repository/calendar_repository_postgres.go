@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresCalendarRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresCalendarRepository(db *sql.DB) repository.CalendarRepository {
+	return &postgresCalendarRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresCalendarRepository) ListOpeningHours(ctx context.Context, branchID uuid.UUID) ([]*entity.OpeningHours, error) {
+	rows, err := r.q(ctx).ListOpeningHours(ctx, branchID)
+	if err != nil {
+		return nil, err
+	}
+
+	hours := make([]*entity.OpeningHours, len(rows))
+	for i, row := range rows {
+		hours[i] = &entity.OpeningHours{
+			BranchID: row.BranchID,
+			Weekday:  time.Weekday(row.Weekday),
+			OpensAt:  int(row.OpensAt),
+			ClosesAt: int(row.ClosesAt),
+		}
+	}
+	return hours, nil
+}
+
+func (r *postgresCalendarRepository) ReplaceOpeningHours(ctx context.Context, branchID uuid.UUID, hours []*entity.OpeningHours) error {
+	q := r.q(ctx)
+	if err := q.DeleteOpeningHours(ctx, branchID); err != nil {
+		return err
+	}
+	for _, h := range hours {
+		err := q.CreateOpeningHours(ctx, sqlc.CreateOpeningHoursParams{
+			BranchID: branchID,
+			Weekday:  int16(h.Weekday),
+			OpensAt:  int16(h.OpensAt),
+			ClosesAt: int16(h.ClosesAt),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *postgresCalendarRepository) CreateClosedDate(ctx context.Context, closedDate *entity.ClosedDate) error {
+	_, err := r.q(ctx).CreateClosedDate(ctx, sqlc.CreateClosedDateParams{
+		ID:        closedDate.ID,
+		BranchID:  closedDate.BranchID,
+		Date:      closedDate.Date,
+		Reason:    closedDate.Reason,
+		CreatedAt: closedDate.CreatedAt,
+	})
+	return err
+}
+
+func (r *postgresCalendarRepository) GetClosedDate(ctx context.Context, id uuid.UUID) (*entity.ClosedDate, error) {
+	row, err := r.q(ctx).GetClosedDateByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.closedDateToEntity(row), nil
+}
+
+func (r *postgresCalendarRepository) GetClosedDateByDay(ctx context.Context, branchID uuid.UUID, date time.Time) (*entity.ClosedDate, error) {
+	row, err := r.q(ctx).GetClosedDateByDay(ctx, sqlc.GetClosedDateByDayParams{
+		BranchID: branchID,
+		Date:     entity.CalendarDay(date),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.closedDateToEntity(row), nil
+}
+
+func (r *postgresCalendarRepository) ListClosedDates(ctx context.Context, branchID uuid.UUID, from time.Time, to *time.Time) ([]*entity.ClosedDate, error) {
+	params := sqlc.ListClosedDatesParams{
+		BranchID: branchID,
+		Date:     entity.CalendarDay(from),
+	}
+	if to != nil {
+		params.Until = sql.NullTime{Time: entity.CalendarDay(*to), Valid: true}
+	}
+
+	rows, err := r.q(ctx).ListClosedDates(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	closedDates := make([]*entity.ClosedDate, len(rows))
+	for i, row := range rows {
+		closedDates[i] = r.closedDateToEntity(row)
+	}
+	return closedDates, nil
+}
+
+func (r *postgresCalendarRepository) DeleteClosedDate(ctx context.Context, id uuid.UUID) error {
+	return r.q(ctx).DeleteClosedDate(ctx, id)
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresCalendarRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresCalendarRepository) closedDateToEntity(row sqlc.ClosedDate) *entity.ClosedDate {
+	return &entity.ClosedDate{
+		ID:        row.ID,
+		BranchID:  row.BranchID,
+		Date:      entity.CalendarDay(row.Date),
+		Reason:    row.Reason,
+		CreatedAt: row.CreatedAt,
+	}
+}
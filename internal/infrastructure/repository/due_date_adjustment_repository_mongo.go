@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const dueDateAdjustmentsCollection = "due_date_adjustments"
+
+type mongoDueDateAdjustmentRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoDueDateAdjustmentRepository(db *mongo.Database) repository.DueDateAdjustmentRepository {
+	return &mongoDueDateAdjustmentRepository{
+		collection: db.Collection(dueDateAdjustmentsCollection),
+	}
+}
+
+func (r *mongoDueDateAdjustmentRepository) Create(ctx context.Context, adjustment *entity.DueDateAdjustment) error {
+	doc := toDueDateAdjustmentDocument(adjustment)
+	_, err := r.collection.InsertOne(ctx, doc)
+	return err
+}
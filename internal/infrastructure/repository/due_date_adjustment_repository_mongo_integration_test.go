@@ -0,0 +1,45 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestMongoDueDateAdjustmentRepository_Create(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	repo := repository.NewMongoDueDateAdjustmentRepository(MongoTestDB)
+
+	adjustment, err := entity.NewDueDateAdjustment(
+		uuid.New(),
+		time.Date(2099, 3, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2099, 3, 6, 0, 0, 0, 0, time.UTC),
+		7,
+		nil,
+		"Branch flooded",
+	)
+	require.NoError(t, err)
+	adjustment.LoansAdjusted = 3
+
+	assert.NoError(t, repo.Create(ctx, adjustment))
+
+	count, err := MongoTestDB.Collection("due_date_adjustments").CountDocuments(ctx, bson.M{
+		"id":            adjustment.ID,
+		"shiftdays":     7,
+		"loansadjusted": 3,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
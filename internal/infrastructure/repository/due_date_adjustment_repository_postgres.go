@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresDueDateAdjustmentRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresDueDateAdjustmentRepository(db *sql.DB) repository.DueDateAdjustmentRepository {
+	return &postgresDueDateAdjustmentRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresDueDateAdjustmentRepository) Create(ctx context.Context, adjustment *entity.DueDateAdjustment) error {
+	_, err := r.q(ctx).CreateDueDateAdjustment(ctx, sqlc.CreateDueDateAdjustmentParams{
+		ID:            adjustment.ID,
+		DueFrom:       adjustment.DueFrom,
+		DueTo:         adjustment.DueTo,
+		BranchID:      r.toNullUUID(adjustment.BranchID),
+		BookID:        r.toNullUUID(adjustment.BookID),
+		ShiftDays:     int32(adjustment.ShiftDays),
+		NewDueDate:    r.toNullTime(adjustment.NewDueDate),
+		Reason:        adjustment.Reason,
+		LoansAdjusted: int32(adjustment.LoansAdjusted),
+		AdjustedBy:    adjustment.AdjustedBy,
+		CreatedAt:     adjustment.CreatedAt,
+	})
+	return err
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresDueDateAdjustmentRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresDueDateAdjustmentRepository) toNullUUID(id *uuid.UUID) uuid.NullUUID {
+	if id == nil {
+		return uuid.NullUUID{Valid: false}
+	}
+	return uuid.NullUUID{UUID: *id, Valid: true}
+}
+
+func (r *postgresDueDateAdjustmentRepository) toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{Valid: false}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
@@ -0,0 +1,49 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDueDateAdjustmentRepository_Create(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresDueDateAdjustmentRepository(PostgresTestDB)
+
+	branchID := uuid.New()
+	newDueDate := time.Date(2099, 3, 9, 0, 0, 0, 0, time.UTC)
+	adjustment, err := entity.NewDueDateAdjustment(
+		uuid.New(),
+		time.Date(2099, 3, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2099, 3, 6, 0, 0, 0, 0, time.UTC),
+		0,
+		&newDueDate,
+		"Branch flooded",
+	)
+	require.NoError(t, err)
+	adjustment.BranchID = &branchID
+	adjustment.LoansAdjusted = 3
+
+	assert.NoError(t, repo.Create(ctx, adjustment))
+
+	var loansAdjusted int
+	var stored time.Time
+	err = PostgresTestDB.QueryRowContext(ctx,
+		"SELECT loans_adjusted, new_due_date FROM due_date_adjustments WHERE id = $1 AND branch_id = $2",
+		adjustment.ID, branchID,
+	).Scan(&loansAdjusted, &stored)
+	require.NoError(t, err)
+	assert.Equal(t, 3, loansAdjusted)
+	assert.True(t, entity.CalendarDay(stored).Equal(newDueDate))
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const finesCollection = "fines"
+
+type mongoFineRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoFineRepository(db *mongo.Database) repository.FineRepository {
+	return &mongoFineRepository{
+		collection: db.Collection(finesCollection),
+	}
+}
+
+func (r *mongoFineRepository) Create(ctx context.Context, fine *entity.Fine) error {
+	doc := toFineDocument(fine)
+	_, err := r.collection.InsertOne(ctx, doc)
+	return err
+}
+
+func (r *mongoFineRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Fine, error) {
+	var doc fineDocument
+	err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
+
+func (r *mongoFineRepository) List(ctx context.Context, page, limit int, filter repository.FineFilter) ([]*entity.Fine, int, error) {
+	skip := int64((page - 1) * limit)
+	limitInt64 := int64(limit)
+
+	query := bson.M{}
+	if filter.UserID != nil {
+		query["userid"] = *filter.UserID
+	}
+	if filter.Status != nil {
+		query["status"] = *filter.Status
+	}
+
+	opts := options.Find().
+		SetSkip(skip).
+		SetLimit(limitInt64).
+		SetSort(bson.D{{Key: "createdat", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []fineDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, 0, err
+	}
+
+	fines := make([]*entity.Fine, len(docs))
+	for i, doc := range docs {
+		fines[i] = doc.toEntity()
+	}
+
+	count, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return fines, int(count), nil
+}
+
+func (r *mongoFineRepository) OutstandingCents(ctx context.Context, userID uuid.UUID) (int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"userid": userID, "status": entity.FineStatusOpen}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   nil,
+			"total": bson.M{"$sum": bson.M{"$subtract": bson.A{"$amountcents", "$paidcents"}}},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []struct {
+		Total int64 `bson:"total"`
+	}
+	if err := cursor.All(ctx, &result); err != nil {
+		return 0, err
+	}
+	if len(result) == 0 {
+		return 0, nil
+	}
+	return result[0].Total, nil
+}
+
+func (r *mongoFineRepository) Update(ctx context.Context, fine *entity.Fine) error {
+	filter := bson.M{"id": fine.ID}
+	update := bson.M{
+		"$set": bson.M{
+			"paidcents": fine.PaidCents,
+			"status":    fine.Status,
+			"updatedat": fine.UpdatedAt,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresFineRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresFineRepository(db *sql.DB) repository.FineRepository {
+	return &postgresFineRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresFineRepository) Create(ctx context.Context, fine *entity.Fine) error {
+	_, err := r.q(ctx).CreateFine(ctx, sqlc.CreateFineParams{
+		ID:          fine.ID,
+		UserID:      fine.UserID,
+		LoanID:      fine.LoanID,
+		Reason:      fine.Reason,
+		AmountCents: fine.AmountCents,
+		PaidCents:   fine.PaidCents,
+		Status:      fine.Status,
+		CreatedAt:   fine.CreatedAt,
+		UpdatedAt:   fine.UpdatedAt,
+	})
+	return err
+}
+
+func (r *postgresFineRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Fine, error) {
+	row, err := r.q(ctx).GetFineByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresFineRepository) List(ctx context.Context, page, limit int, filter repository.FineFilter) ([]*entity.Fine, int, error) {
+	offset := (page - 1) * limit
+
+	var userID uuid.NullUUID
+	if filter.UserID != nil {
+		userID = uuid.NullUUID{UUID: *filter.UserID, Valid: true}
+	}
+	var status sql.NullString
+	if filter.Status != nil {
+		status = sql.NullString{String: *filter.Status, Valid: true}
+	}
+
+	rows, err := r.q(ctx).ListFines(ctx, sqlc.ListFinesParams{
+		Limit:  int32(limit),
+		Offset: int32(offset),
+		UserID: userID,
+		Status: status,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := r.q(ctx).CountFines(ctx, sqlc.CountFinesParams{
+		UserID: userID,
+		Status: status,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fines := make([]*entity.Fine, len(rows))
+	for i, row := range rows {
+		fines[i] = r.toEntity(row)
+	}
+
+	return fines, int(count), nil
+}
+
+func (r *postgresFineRepository) OutstandingCents(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.q(ctx).SumOutstandingFines(ctx, userID)
+}
+
+func (r *postgresFineRepository) Update(ctx context.Context, fine *entity.Fine) error {
+	_, err := r.q(ctx).UpdateFine(ctx, sqlc.UpdateFineParams{
+		ID:        fine.ID,
+		PaidCents: fine.PaidCents,
+		Status:    fine.Status,
+		UpdatedAt: fine.UpdatedAt,
+	})
+	return err
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresFineRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresFineRepository) toEntity(row sqlc.Fine) *entity.Fine {
+	return &entity.Fine{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		LoanID:      row.LoanID,
+		Reason:      row.Reason,
+		AmountCents: row.AmountCents,
+		PaidCents:   row.PaidCents,
+		Status:      row.Status,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
@@ -0,0 +1,108 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createPostgresFineLoan stores a user with a loan that fines can refer to.
+func createPostgresFineLoan(t *testing.T, email, isbn string) *entity.Loan {
+	t.Helper()
+	ctx := context.Background()
+	userRepo := repository.NewPostgresUserRepository(PostgresTestDB)
+	bookRepo := repository.NewPostgresBookRepository(PostgresTestDB)
+	loanRepo := repository.NewPostgresLoanRepository(PostgresTestDB)
+
+	user := CreateTestUser("Fine User PG", email)
+	book := CreateTestBook("Fine Book PG", "Author", isbn)
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, bookRepo.Create(ctx, book))
+
+	loan := CreateTestLoan(user.ID, book.ID)
+	require.NoError(t, loanRepo.Create(ctx, loan))
+	return loan
+}
+
+func TestPostgresFineRepository_CreateAndUpdate(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresFineRepository(PostgresTestDB)
+	loan := createPostgresFineLoan(t, "finepg@example.com", "1234567810")
+
+	fine := entity.NewFine(loan.UserID, loan.ID, entity.FineReasonOverdue, 350)
+	require.NoError(t, repo.Create(ctx, fine))
+
+	retrieved, err := repo.GetByID(ctx, fine.ID)
+	assert.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, int64(350), retrieved.AmountCents)
+	assert.Equal(t, entity.FineStatusOpen, retrieved.Status)
+
+	require.NoError(t, retrieved.Pay(350))
+	require.NoError(t, repo.Update(ctx, retrieved))
+
+	retrieved, err = repo.GetByID(ctx, fine.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(350), retrieved.PaidCents)
+	assert.Equal(t, entity.FineStatusPaid, retrieved.Status)
+}
+
+func TestPostgresFineRepository_GetByIDNotFound(t *testing.T) {
+	CleanupPostgres(t)
+
+	repo := repository.NewPostgresFineRepository(PostgresTestDB)
+
+	retrieved, err := repo.GetByID(context.Background(), uuid.New())
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestPostgresFineRepository_ListAndOutstanding(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresFineRepository(PostgresTestDB)
+	loan := createPostgresFineLoan(t, "finelistpg@example.com", "1234567811")
+	other := createPostgresFineLoan(t, "fineotherpg@example.com", "1234567812")
+
+	partlyPaid := entity.NewFine(loan.UserID, loan.ID, entity.FineReasonOverdue, 500)
+	require.NoError(t, partlyPaid.Pay(200))
+	waived := entity.NewFine(loan.UserID, loan.ID, entity.FineReasonOverdue, 1000)
+	require.NoError(t, waived.Waive())
+	open := entity.NewFine(loan.UserID, loan.ID, entity.FineReasonOverdue, 150)
+	otherUsers := entity.NewFine(other.UserID, other.ID, entity.FineReasonOverdue, 999)
+
+	for _, fine := range []*entity.Fine{partlyPaid, waived, open, otherUsers} {
+		require.NoError(t, repo.Create(ctx, fine))
+	}
+
+	owed, err := repo.OutstandingCents(ctx, loan.UserID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(450), owed)
+
+	fines, total, err := repo.List(ctx, 1, 10, domainrepo.FineFilter{UserID: &loan.UserID})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, total)
+	assert.Len(t, fines, 3)
+
+	status := entity.FineStatusOpen
+	fines, total, err = repo.List(ctx, 1, 10, domainrepo.FineFilter{UserID: &loan.UserID, Status: &status})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, fines, 2)
+
+	owed, err = repo.OutstandingCents(ctx, uuid.New())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), owed)
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const holdsCollection = "holds"
+
+var activeHoldStatuses = bson.M{"$in": []string{entity.HoldStatusWaiting, entity.HoldStatusReady}}
+
+type mongoHoldRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoHoldRepository(db *mongo.Database) repository.HoldRepository {
+	return &mongoHoldRepository{
+		collection: db.Collection(holdsCollection),
+	}
+}
+
+func (r *mongoHoldRepository) Create(ctx context.Context, hold *entity.Hold) error {
+	doc := toHoldDocument(hold)
+	_, err := r.collection.InsertOne(ctx, doc)
+	return err
+}
+
+func (r *mongoHoldRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Hold, error) {
+	return r.findOne(ctx, bson.M{"id": id}, nil)
+}
+
+func (r *mongoHoldRepository) GetActiveByUserAndBook(ctx context.Context, userID, bookID uuid.UUID) (*entity.Hold, error) {
+	filter := bson.M{
+		"userid": userID,
+		"bookid": bookID,
+		"status": activeHoldStatuses,
+	}
+	return r.findOne(ctx, filter, nil)
+}
+
+func (r *mongoHoldRepository) GetNextWaiting(ctx context.Context, bookID uuid.UUID) (*entity.Hold, error) {
+	filter := bson.M{
+		"bookid": bookID,
+		"status": entity.HoldStatusWaiting,
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "createdat", Value: 1}, {Key: "id", Value: 1}})
+	return r.findOne(ctx, filter, opts)
+}
+
+func (r *mongoHoldRepository) HasPendingHolds(ctx context.Context, bookID, excludeUserID uuid.UUID) (bool, error) {
+	filter := bson.M{
+		"bookid": bookID,
+		"userid": bson.M{"$ne": excludeUserID},
+		"status": activeHoldStatuses,
+	}
+	count, err := r.collection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *mongoHoldRepository) CountAhead(ctx context.Context, hold *entity.Hold) (int, error) {
+	filter := bson.M{
+		"bookid": hold.BookID,
+		"status": entity.HoldStatusWaiting,
+		"$or": bson.A{
+			bson.M{"createdat": bson.M{"$lt": hold.CreatedAt}},
+			bson.M{"createdat": hold.CreatedAt, "id": bson.M{"$lt": hold.ID}},
+		},
+	}
+	count, err := r.collection.CountDocuments(ctx, filter)
+	return int(count), err
+}
+
+func (r *mongoHoldRepository) List(ctx context.Context, page, limit int, filter repository.HoldFilter) ([]*entity.Hold, int, error) {
+	skip := int64((page - 1) * limit)
+	limitInt64 := int64(limit)
+
+	query := bson.M{}
+	if filter.UserID != nil {
+		query["userid"] = *filter.UserID
+	}
+	if filter.BookID != nil {
+		query["bookid"] = *filter.BookID
+	}
+	if filter.Status != nil {
+		query["status"] = *filter.Status
+	}
+
+	opts := options.Find().
+		SetSkip(skip).
+		SetLimit(limitInt64).
+		SetSort(bson.D{{Key: "createdat", Value: -1}})
+
+	holds, err := r.find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return holds, int(count), nil
+}
+
+func (r *mongoHoldRepository) ListExpiredPickups(ctx context.Context, now time.Time) ([]*entity.Hold, error) {
+	filter := bson.M{
+		"status":         entity.HoldStatusReady,
+		"pickupdeadline": bson.M{"$lt": now},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "pickupdeadline", Value: 1}})
+	return r.find(ctx, filter, opts)
+}
+
+func (r *mongoHoldRepository) Update(ctx context.Context, hold *entity.Hold) error {
+	filter := bson.M{"id": hold.ID}
+	update := bson.M{
+		"$set": bson.M{
+			"status":         hold.Status,
+			"readyat":        hold.ReadyAt,
+			"pickupdeadline": hold.PickupDeadline,
+			"updatedat":      hold.UpdatedAt,
+			"copyid":         hold.CopyID,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (r *mongoHoldRepository) findOne(ctx context.Context, filter bson.M, opts *options.FindOneOptions) (*entity.Hold, error) {
+	var doc holdDocument
+	err := r.collection.FindOne(ctx, filter, opts).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
+
+func (r *mongoHoldRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.Hold, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []holdDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	holds := make([]*entity.Hold, len(docs))
+	for i, doc := range docs {
+		holds[i] = doc.toEntity()
+	}
+	return holds, nil
+}
@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresHoldRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresHoldRepository(db *sql.DB) repository.HoldRepository {
+	return &postgresHoldRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresHoldRepository) Create(ctx context.Context, hold *entity.Hold) error {
+	_, err := r.q(ctx).CreateHold(ctx, sqlc.CreateHoldParams{
+		ID:             hold.ID,
+		UserID:         hold.UserID,
+		BookID:         hold.BookID,
+		Status:         hold.Status,
+		CreatedAt:      hold.CreatedAt,
+		ReadyAt:        r.toNullTime(hold.ReadyAt),
+		PickupDeadline: r.toNullTime(hold.PickupDeadline),
+		UpdatedAt:      hold.UpdatedAt,
+		CopyID:         r.toNullUUID(hold.CopyID),
+	})
+	return err
+}
+
+func (r *postgresHoldRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Hold, error) {
+	row, err := r.q(ctx).GetHoldByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresHoldRepository) GetActiveByUserAndBook(ctx context.Context, userID, bookID uuid.UUID) (*entity.Hold, error) {
+	row, err := r.q(ctx).GetActiveHoldByUserAndBook(ctx, sqlc.GetActiveHoldByUserAndBookParams{
+		UserID: userID,
+		BookID: bookID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresHoldRepository) GetNextWaiting(ctx context.Context, bookID uuid.UUID) (*entity.Hold, error) {
+	row, err := r.q(ctx).GetNextWaitingHold(ctx, bookID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresHoldRepository) HasPendingHolds(ctx context.Context, bookID, excludeUserID uuid.UUID) (bool, error) {
+	return r.q(ctx).HasPendingHolds(ctx, sqlc.HasPendingHoldsParams{
+		BookID: bookID,
+		UserID: excludeUserID,
+	})
+}
+
+func (r *postgresHoldRepository) CountAhead(ctx context.Context, hold *entity.Hold) (int, error) {
+	count, err := r.q(ctx).CountHoldsAhead(ctx, sqlc.CountHoldsAheadParams{
+		BookID:    hold.BookID,
+		CreatedAt: hold.CreatedAt,
+		ID:        hold.ID,
+	})
+	return int(count), err
+}
+
+func (r *postgresHoldRepository) List(ctx context.Context, page, limit int, filter repository.HoldFilter) ([]*entity.Hold, int, error) {
+	offset := (page - 1) * limit
+
+	userID := r.toNullUUID(filter.UserID)
+	bookID := r.toNullUUID(filter.BookID)
+	var status sql.NullString
+	if filter.Status != nil {
+		status = sql.NullString{String: *filter.Status, Valid: true}
+	}
+
+	rows, err := r.q(ctx).ListHolds(ctx, sqlc.ListHoldsParams{
+		Limit:  int32(limit),
+		Offset: int32(offset),
+		UserID: userID,
+		BookID: bookID,
+		Status: status,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := r.q(ctx).CountHolds(ctx, sqlc.CountHoldsParams{
+		UserID: userID,
+		BookID: bookID,
+		Status: status,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	holds := make([]*entity.Hold, len(rows))
+	for i, row := range rows {
+		holds[i] = r.toEntity(row)
+	}
+
+	return holds, int(count), nil
+}
+
+func (r *postgresHoldRepository) ListExpiredPickups(ctx context.Context, now time.Time) ([]*entity.Hold, error) {
+	rows, err := r.q(ctx).ListExpiredPickups(ctx, sql.NullTime{Time: now, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	holds := make([]*entity.Hold, len(rows))
+	for i, row := range rows {
+		holds[i] = r.toEntity(row)
+	}
+	return holds, nil
+}
+
+func (r *postgresHoldRepository) Update(ctx context.Context, hold *entity.Hold) error {
+	_, err := r.q(ctx).UpdateHold(ctx, sqlc.UpdateHoldParams{
+		ID:             hold.ID,
+		Status:         hold.Status,
+		ReadyAt:        r.toNullTime(hold.ReadyAt),
+		PickupDeadline: r.toNullTime(hold.PickupDeadline),
+		UpdatedAt:      hold.UpdatedAt,
+		CopyID:         r.toNullUUID(hold.CopyID),
+	})
+	return err
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresHoldRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresHoldRepository) toEntity(row sqlc.Hold) *entity.Hold {
+	return &entity.Hold{
+		ID:             row.ID,
+		UserID:         row.UserID,
+		BookID:         row.BookID,
+		Status:         row.Status,
+		CreatedAt:      row.CreatedAt,
+		ReadyAt:        r.fromNullTime(row.ReadyAt),
+		PickupDeadline: r.fromNullTime(row.PickupDeadline),
+		UpdatedAt:      row.UpdatedAt,
+		CopyID:         r.fromNullUUID(row.CopyID),
+	}
+}
+
+func (r *postgresHoldRepository) toNullUUID(id *uuid.UUID) uuid.NullUUID {
+	if id == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *id, Valid: true}
+}
+
+func (r *postgresHoldRepository) fromNullUUID(nu uuid.NullUUID) *uuid.UUID {
+	if !nu.Valid {
+		return nil
+	}
+	return &nu.UUID
+}
+
+func (r *postgresHoldRepository) toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{Valid: false}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func (r *postgresHoldRepository) fromNullTime(nt sql.NullTime) *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+	return &nt.Time
+}
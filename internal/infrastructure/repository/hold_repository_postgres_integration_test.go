@@ -0,0 +1,181 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createPostgresHoldQueue stores a book and count users, each holding it in order.
+func createPostgresHoldQueue(t *testing.T, count int) (*entity.Book, []*entity.Hold) {
+	t.Helper()
+	ctx := context.Background()
+	userRepo := repository.NewPostgresUserRepository(PostgresTestDB)
+	bookRepo := repository.NewPostgresBookRepository(PostgresTestDB)
+	repo := repository.NewPostgresHoldRepository(PostgresTestDB)
+
+	book := CreateTestBook("Hold Book PG", "Author", "1234567880")
+	require.NoError(t, bookRepo.Create(ctx, book))
+
+	holds := make([]*entity.Hold, count)
+	for i := range holds {
+		user := CreateTestUser("Hold User PG", uuid.NewString()+"@example.com")
+		require.NoError(t, userRepo.Create(ctx, user))
+
+		holds[i] = entity.NewHold(user.ID, book.ID)
+		holds[i].CreatedAt = time.Now().Add(time.Duration(i-count) * time.Minute)
+		require.NoError(t, repo.Create(ctx, holds[i]))
+	}
+
+	return book, holds
+}
+
+// createPostgresHoldCopy adds a copy of book to set aside for a hold.
+func createPostgresHoldCopy(t *testing.T, book *entity.Book) *entity.BookCopy {
+	t.Helper()
+	ctx := context.Background()
+
+	branch := CreateTestBranch(uuid.NewString()[:8], "Hold Branch PG")
+	require.NoError(t, repository.NewPostgresBranchRepository(PostgresTestDB).Create(ctx, branch))
+	bookCopy, err := entity.NewBookCopy(book.ID, branch.ID, uuid.NewString()[:8], "", "")
+	require.NoError(t, err)
+	require.NoError(t, repository.NewPostgresBookCopyRepository(PostgresTestDB).Create(ctx, bookCopy))
+	return bookCopy
+}
+
+func TestPostgresHoldRepository_Create(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresHoldRepository(PostgresTestDB)
+	_, holds := createPostgresHoldQueue(t, 1)
+
+	retrieved, err := repo.GetByID(ctx, holds[0].ID)
+	assert.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, holds[0].UserID, retrieved.UserID)
+	assert.Equal(t, entity.HoldStatusWaiting, retrieved.Status)
+	assert.Nil(t, retrieved.PickupDeadline)
+
+	active, err := repo.GetActiveByUserAndBook(ctx, holds[0].UserID, holds[0].BookID)
+	assert.NoError(t, err)
+	require.NotNil(t, active)
+	assert.Equal(t, holds[0].ID, active.ID)
+}
+
+func TestPostgresHoldRepository_GetByIDNotFound(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresHoldRepository(PostgresTestDB)
+
+	retrieved, err := repo.GetByID(ctx, uuid.New())
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestPostgresHoldRepository_Queue(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresHoldRepository(PostgresTestDB)
+	book, holds := createPostgresHoldQueue(t, 3)
+
+	next, err := repo.GetNextWaiting(ctx, book.ID)
+	assert.NoError(t, err)
+	require.NotNil(t, next)
+	assert.Equal(t, holds[0].ID, next.ID)
+
+	for i, hold := range holds {
+		ahead, err := repo.CountAhead(ctx, hold)
+		assert.NoError(t, err)
+		assert.Equal(t, i, ahead)
+	}
+
+	require.NoError(t, holds[0].MarkReady(createPostgresHoldCopy(t, book).ID, time.Now().Add(time.Hour)))
+	require.NoError(t, repo.Update(ctx, holds[0]))
+
+	next, err = repo.GetNextWaiting(ctx, book.ID)
+	assert.NoError(t, err)
+	require.NotNil(t, next)
+	assert.Equal(t, holds[1].ID, next.ID)
+
+	ahead, err := repo.CountAhead(ctx, holds[2])
+	assert.NoError(t, err)
+	assert.Equal(t, 1, ahead)
+}
+
+func TestPostgresHoldRepository_HasPendingHolds(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresHoldRepository(PostgresTestDB)
+	book, holds := createPostgresHoldQueue(t, 1)
+
+	pending, err := repo.HasPendingHolds(ctx, book.ID, uuid.New())
+	assert.NoError(t, err)
+	assert.True(t, pending)
+
+	pending, err = repo.HasPendingHolds(ctx, book.ID, holds[0].UserID)
+	assert.NoError(t, err)
+	assert.False(t, pending)
+}
+
+func TestPostgresHoldRepository_ListExpiredPickups(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresHoldRepository(PostgresTestDB)
+	book, holds := createPostgresHoldQueue(t, 2)
+
+	expiredCopy := createPostgresHoldCopy(t, book)
+	require.NoError(t, holds[0].MarkReady(expiredCopy.ID, time.Now().Add(-time.Minute)))
+	require.NoError(t, repo.Update(ctx, holds[0]))
+	require.NoError(t, holds[1].MarkReady(createPostgresHoldCopy(t, book).ID, time.Now().Add(time.Hour)))
+	require.NoError(t, repo.Update(ctx, holds[1]))
+
+	expired, err := repo.ListExpiredPickups(ctx, time.Now())
+	assert.NoError(t, err)
+	require.Len(t, expired, 1)
+	assert.Equal(t, holds[0].ID, expired[0].ID)
+	assert.NotNil(t, expired[0].PickupDeadline)
+	require.NotNil(t, expired[0].CopyID)
+	assert.Equal(t, expiredCopy.ID, *expired[0].CopyID)
+}
+
+func TestPostgresHoldRepository_List(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresHoldRepository(PostgresTestDB)
+	book, holds := createPostgresHoldQueue(t, 3)
+
+	require.NoError(t, holds[1].Cancel())
+	require.NoError(t, repo.Update(ctx, holds[1]))
+
+	all, total, err := repo.List(ctx, 1, 10, domainrepo.HoldFilter{BookID: &book.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, total)
+	assert.Len(t, all, 3)
+
+	status := entity.HoldStatusWaiting
+	waiting, total, err := repo.List(ctx, 1, 10, domainrepo.HoldFilter{Status: &status})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, waiting, 2)
+
+	own, total, err := repo.List(ctx, 1, 10, domainrepo.HoldFilter{UserID: &holds[2].UserID})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, holds[2].ID, own[0].ID)
+}
@@ -63,7 +63,8 @@ func TestMain(m *testing.M) {
 
 func setupMongoDB(ctx context.Context) error {
 	var err error
-	mongoContainer, err = mongodb.Run(ctx, "mongo:7")
+	// Transactions require a replica set
+	mongoContainer, err = mongodb.Run(ctx, "mongo:7", mongodb.WithReplicaSet("rs0"))
 	if err != nil {
 		return err
 	}
@@ -145,9 +146,15 @@ func runPostgresMigrations(db *sql.DB) error {
 			password_hash VARCHAR(255) NOT NULL,
 			active BOOLEAN NOT NULL DEFAULT TRUE,
 			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
-			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			role VARCHAR(20) NOT NULL DEFAULT 'member',
+			category VARCHAR(50) NOT NULL DEFAULT 'standard',
+			card_number VARCHAR(20) NOT NULL,
+			blocked BOOLEAN NOT NULL DEFAULT FALSE,
+			CONSTRAINT chk_user_role CHECK (role IN ('admin', 'librarian', 'member'))
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_users_email ON users(email)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_users_card_number ON users(card_number)`,
 
 		// Books table
 		`CREATE TABLE IF NOT EXISTS books (
@@ -160,24 +167,262 @@ func runPostgresMigrations(db *sql.DB) error {
 			available_copies INTEGER NOT NULL DEFAULT 1,
 			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
 			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			version INTEGER NOT NULL DEFAULT 1,
+			category VARCHAR(50) NOT NULL DEFAULT 'general',
+			withdrawn_at TIMESTAMP WITH TIME ZONE,
 			CONSTRAINT chk_copies CHECK (available_copies >= 0 AND available_copies <= total_copies)
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_books_isbn ON books(isbn)`,
+		`CREATE EXTENSION IF NOT EXISTS unaccent`,
+		`DO $$
+		BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'bookhub_portuguese') THEN
+				CREATE TEXT SEARCH CONFIGURATION bookhub_portuguese (COPY = portuguese);
+				ALTER TEXT SEARCH CONFIGURATION bookhub_portuguese
+					ALTER MAPPING FOR hword, hword_part, word WITH unaccent, portuguese_stem;
+			END IF;
+		END
+		$$`,
+		`CREATE OR REPLACE FUNCTION books_search_vector(title TEXT, author TEXT, isbn TEXT) RETURNS tsvector
+		LANGUAGE sql IMMUTABLE PARALLEL SAFE
+		AS $$
+			SELECT setweight(to_tsvector('bookhub_portuguese'::regconfig, title), 'A')
+				|| setweight(to_tsvector('bookhub_portuguese'::regconfig, author), 'B')
+				|| setweight(to_tsvector('simple'::regconfig, isbn), 'A')
+		$$`,
+		`CREATE INDEX IF NOT EXISTS idx_books_search ON books USING GIN (books_search_vector(title, author, isbn))`,
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+		`CREATE OR REPLACE FUNCTION books_fold(value TEXT) RETURNS TEXT
+		LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT
+		AS $$
+			SELECT lower(public.unaccent('public.unaccent'::regdictionary, value))
+		$$`,
+		`CREATE INDEX IF NOT EXISTS idx_books_title_trgm ON books USING GIN (books_fold(title) gin_trgm_ops) WHERE withdrawn_at IS NULL`,
+		`CREATE INDEX IF NOT EXISTS idx_books_author_trgm ON books USING GIN (books_fold(author) gin_trgm_ops) WHERE withdrawn_at IS NULL`,
 
 		// Loans table
 		`CREATE TABLE IF NOT EXISTS loans (
 			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
 			user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
-			book_id UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+			book_id UUID NOT NULL REFERENCES books(id) ON DELETE RESTRICT,
 			borrowed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
 			due_date TIMESTAMP WITH TIME ZONE NOT NULL,
 			returned_at TIMESTAMP WITH TIME ZONE,
 			status VARCHAR(20) NOT NULL DEFAULT 'active',
-			CONSTRAINT chk_status CHECK (status IN ('active', 'returned'))
+			renewal_count INTEGER NOT NULL DEFAULT 0,
+			CONSTRAINT chk_status CHECK (status IN ('active', 'overdue', 'returned', 'lost', 'damaged'))
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_loans_user_id ON loans(user_id)`,
 		`CREATE INDEX IF NOT EXISTS idx_loans_book_id ON loans(book_id)`,
 		`CREATE INDEX IF NOT EXISTS idx_loans_status ON loans(status)`,
+
+		// Holds table
+		`CREATE TABLE IF NOT EXISTS holds (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			book_id UUID NOT NULL REFERENCES books(id) ON DELETE RESTRICT,
+			status VARCHAR(20) NOT NULL DEFAULT 'waiting',
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			ready_at TIMESTAMP WITH TIME ZONE,
+			pickup_deadline TIMESTAMP WITH TIME ZONE,
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			CONSTRAINT chk_hold_status CHECK (status IN ('waiting', 'ready', 'fulfilled', 'cancelled', 'expired'))
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_holds_user_book_active ON holds(user_id, book_id) WHERE status IN ('waiting', 'ready')`,
+
+		// Fines table
+		`CREATE TABLE IF NOT EXISTS fines (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			loan_id UUID NOT NULL REFERENCES loans(id) ON DELETE CASCADE,
+			reason VARCHAR(20) NOT NULL,
+			amount_cents BIGINT NOT NULL,
+			paid_cents BIGINT NOT NULL DEFAULT 0,
+			status VARCHAR(20) NOT NULL DEFAULT 'open',
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			CONSTRAINT chk_fine_status CHECK (status IN ('open', 'paid', 'waived')),
+			CONSTRAINT chk_fine_amounts CHECK (amount_cents > 0 AND paid_cents >= 0 AND paid_cents <= amount_cents)
+		)`,
+
+		// Loan policies table
+		`CREATE TABLE IF NOT EXISTS loan_policies (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			patron_category VARCHAR(50) NOT NULL,
+			item_category VARCHAR(50) NOT NULL,
+			max_loans INTEGER NOT NULL,
+			loan_days INTEGER NOT NULL,
+			max_renewals INTEGER NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			CONSTRAINT chk_loan_policy_limits CHECK (max_loans >= 0 AND loan_days >= 1 AND max_renewals >= 0)
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_loan_policies_categories ON loan_policies(patron_category, item_category)`,
+
+		// Branches table
+		`CREATE TABLE IF NOT EXISTS branches (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			code VARCHAR(20) NOT NULL UNIQUE,
+			name VARCHAR(100) NOT NULL,
+			address VARCHAR(255) NOT NULL DEFAULT '',
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+		)`,
+
+		// Book copies table
+		`CREATE TABLE IF NOT EXISTS book_copies (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			book_id UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+			branch_id UUID NOT NULL REFERENCES branches(id) ON DELETE RESTRICT,
+			barcode VARCHAR(50) NOT NULL UNIQUE,
+			location VARCHAR(100) NOT NULL DEFAULT '',
+			condition VARCHAR(20) NOT NULL DEFAULT 'good',
+			status VARCHAR(20) NOT NULL DEFAULT 'available',
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			CONSTRAINT chk_copy_condition CHECK (condition IN ('new', 'good', 'fair', 'poor', 'damaged')),
+			CONSTRAINT chk_copy_status CHECK (status IN ('available', 'on_loan', 'on_hold', 'in_transit', 'in_repair', 'withdrawn'))
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_book_copies_book_status ON book_copies(book_id, status)`,
+		`CREATE INDEX IF NOT EXISTS idx_book_copies_branch_book ON book_copies(branch_id, book_id)`,
+		`ALTER TABLE loans ADD COLUMN IF NOT EXISTS copy_id UUID REFERENCES book_copies(id) ON DELETE SET NULL`,
+
+		// Transfers table
+		`CREATE TABLE IF NOT EXISTS transfers (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			copy_id UUID NOT NULL REFERENCES book_copies(id) ON DELETE CASCADE,
+			book_id UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+			from_branch_id UUID NOT NULL REFERENCES branches(id) ON DELETE RESTRICT,
+			to_branch_id UUID NOT NULL REFERENCES branches(id) ON DELETE RESTRICT,
+			status VARCHAR(20) NOT NULL DEFAULT 'requested',
+			requested_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			shipped_at TIMESTAMP WITH TIME ZONE,
+			received_at TIMESTAMP WITH TIME ZONE,
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			CONSTRAINT chk_transfer_status CHECK (status IN ('requested', 'in_transit', 'received', 'cancelled')),
+			CONSTRAINT chk_transfer_branches CHECK (from_branch_id <> to_branch_id)
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_transfers_open_copy ON transfers(copy_id) WHERE status IN ('requested', 'in_transit')`,
+		`CREATE INDEX IF NOT EXISTS idx_transfers_from_branch ON transfers(from_branch_id, status)`,
+		`CREATE INDEX IF NOT EXISTS idx_transfers_to_branch ON transfers(to_branch_id, status)`,
+
+		// Library calendar tables
+		`CREATE TABLE IF NOT EXISTS opening_hours (
+			branch_id UUID NOT NULL REFERENCES branches(id) ON DELETE CASCADE,
+			weekday SMALLINT NOT NULL,
+			opens_at SMALLINT NOT NULL,
+			closes_at SMALLINT NOT NULL,
+			PRIMARY KEY (branch_id, weekday),
+			CONSTRAINT chk_opening_hours_weekday CHECK (weekday BETWEEN 0 AND 6),
+			CONSTRAINT chk_opening_hours_times CHECK (opens_at >= 0 AND closes_at < 1440 AND opens_at < closes_at)
+		)`,
+		`CREATE TABLE IF NOT EXISTS closed_dates (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			branch_id UUID NOT NULL REFERENCES branches(id) ON DELETE CASCADE,
+			date DATE NOT NULL,
+			reason VARCHAR(255) NOT NULL DEFAULT '',
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			CONSTRAINT uq_closed_dates_branch_date UNIQUE (branch_id, date)
+		)`,
+
+		// Due date adjustments table
+		`CREATE TABLE IF NOT EXISTS due_date_adjustments (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			due_from DATE NOT NULL,
+			due_to DATE NOT NULL,
+			branch_id UUID,
+			book_id UUID,
+			shift_days INTEGER NOT NULL DEFAULT 0,
+			new_due_date DATE,
+			reason VARCHAR(255) NOT NULL DEFAULT '',
+			loans_adjusted INTEGER NOT NULL DEFAULT 0,
+			adjusted_by UUID NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			CONSTRAINT chk_due_date_adjustment_range CHECK (due_from <= due_to),
+			CONSTRAINT chk_due_date_adjustment_change CHECK ((shift_days > 0) <> (new_due_date IS NOT NULL))
+		)`,
+
+		// Loan notices table
+		`CREATE TABLE IF NOT EXISTS loan_notices (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			loan_id UUID NOT NULL REFERENCES loans(id) ON DELETE CASCADE,
+			kind VARCHAR(20) NOT NULL,
+			due_date TIMESTAMP WITH TIME ZONE NOT NULL,
+			sent_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			CONSTRAINT chk_loan_notices_kind CHECK (kind IN ('due_reminder', 'overdue_notice')),
+			CONSTRAINT uq_loan_notices_loan_kind_due UNIQUE (loan_id, kind, due_date)
+		)`,
+
+		// Loan escalations table
+		`CREATE TABLE IF NOT EXISTS loan_escalations (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			loan_id UUID NOT NULL REFERENCES loans(id) ON DELETE CASCADE,
+			user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			level INTEGER NOT NULL,
+			action VARCHAR(20) NOT NULL,
+			days_overdue INTEGER NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			CONSTRAINT chk_loan_escalations_level CHECK (level >= 1),
+			CONSTRAINT chk_loan_escalations_action CHECK (action IN ('notice', 'block', 'lost')),
+			CONSTRAINT uq_loan_escalations_loan_level UNIQUE (loan_id, level)
+		)`,
+
+		// Webhook subscriptions table
+		`CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			url VARCHAR(2048) NOT NULL,
+			events TEXT[] NOT NULL,
+			secret VARCHAR(256) NOT NULL,
+			active BOOLEAN NOT NULL DEFAULT TRUE,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			CONSTRAINT chk_webhook_subscriptions_events CHECK (cardinality(events) > 0)
+		)`,
+
+		// Webhook deliveries table
+		`CREATE TABLE IF NOT EXISTS webhook_deliveries (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			subscription_id UUID NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+			event_id UUID NOT NULL,
+			event_type VARCHAR(50) NOT NULL,
+			payload JSONB NOT NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'pending',
+			attempts INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at TIMESTAMP WITH TIME ZONE,
+			response_status INTEGER,
+			last_error TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			delivered_at TIMESTAMP WITH TIME ZONE,
+			CONSTRAINT chk_webhook_deliveries_status CHECK (status IN ('pending', 'delivered', 'failed'))
+		)`,
+		// Outbox messages table
+		`CREATE TABLE IF NOT EXISTS outbox_messages (
+			id UUID PRIMARY KEY,
+			position BIGSERIAL NOT NULL UNIQUE,
+			event_type VARCHAR(50) NOT NULL,
+			aggregate_id UUID NOT NULL,
+			payload JSONB NOT NULL,
+			occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			last_error TEXT NOT NULL DEFAULT '',
+			locked_until TIMESTAMP WITH TIME ZONE,
+			published_at TIMESTAMP WITH TIME ZONE
+		)`,
+		// Audit log table
+		`CREATE TABLE IF NOT EXISTS audit_log (
+			id UUID PRIMARY KEY,
+			sequence BIGINT NOT NULL UNIQUE,
+			actor_id UUID,
+			action VARCHAR(50) NOT NULL,
+			entity_type VARCHAR(20) NOT NULL,
+			entity_id UUID NOT NULL,
+			changes JSONB NOT NULL,
+			request_id VARCHAR(100) NOT NULL DEFAULT '',
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			prev_hash VARCHAR(64) NOT NULL,
+			hash VARCHAR(64) NOT NULL
+		)`,
 	}
 
 	for _, migration := range migrations {
@@ -205,19 +450,50 @@ func teardown(ctx context.Context) {
 }
 
 // CleanupMongo clears all MongoDB collections between tests
-func CleanupMongo(t *testing.T) {
+func CleanupMongo(t testing.TB) {
 	t.Helper()
 	ctx := context.Background()
 	_ = mongoTestDB.Collection("books").Drop(ctx)
 	_ = mongoTestDB.Collection("users").Drop(ctx)
 	_ = mongoTestDB.Collection("loans").Drop(ctx)
+	_ = mongoTestDB.Collection("holds").Drop(ctx)
+	_ = mongoTestDB.Collection("fines").Drop(ctx)
+	_ = mongoTestDB.Collection("loan_policies").Drop(ctx)
+	_ = mongoTestDB.Collection("book_copies").Drop(ctx)
+	_ = mongoTestDB.Collection("branches").Drop(ctx)
+	_ = mongoTestDB.Collection("transfers").Drop(ctx)
+	_ = mongoTestDB.Collection("opening_hours").Drop(ctx)
+	_ = mongoTestDB.Collection("closed_dates").Drop(ctx)
+	_ = mongoTestDB.Collection("due_date_adjustments").Drop(ctx)
+	_ = mongoTestDB.Collection("loan_notices").Drop(ctx)
+	_ = mongoTestDB.Collection("loan_escalations").Drop(ctx)
+	_ = mongoTestDB.Collection("webhook_deliveries").Drop(ctx)
+	_ = mongoTestDB.Collection("webhook_subscriptions").Drop(ctx)
+	_ = mongoTestDB.Collection("outbox_messages").Drop(ctx)
+	_ = mongoTestDB.Collection("audit_log").Drop(ctx)
+	_ = mongoTestDB.Collection("audit_chain").Drop(ctx)
 }
 
 // CleanupPostgres clears all PostgreSQL tables between tests
-func CleanupPostgres(t *testing.T) {
+func CleanupPostgres(t testing.TB) {
 	t.Helper()
 	// Delete in correct order due to foreign key constraints
+	_, _ = postgresDB.Exec("DELETE FROM audit_log")
+	_, _ = postgresDB.Exec("DELETE FROM outbox_messages")
+	_, _ = postgresDB.Exec("DELETE FROM webhook_deliveries")
+	_, _ = postgresDB.Exec("DELETE FROM webhook_subscriptions")
+	_, _ = postgresDB.Exec("DELETE FROM due_date_adjustments")
+	_, _ = postgresDB.Exec("DELETE FROM fines")
+	_, _ = postgresDB.Exec("DELETE FROM loan_policies")
+	_, _ = postgresDB.Exec("DELETE FROM holds")
+	_, _ = postgresDB.Exec("DELETE FROM loan_notices")
+	_, _ = postgresDB.Exec("DELETE FROM loan_escalations")
 	_, _ = postgresDB.Exec("DELETE FROM loans")
+	_, _ = postgresDB.Exec("DELETE FROM transfers")
+	_, _ = postgresDB.Exec("DELETE FROM book_copies")
+	_, _ = postgresDB.Exec("DELETE FROM opening_hours")
+	_, _ = postgresDB.Exec("DELETE FROM closed_dates")
+	_, _ = postgresDB.Exec("DELETE FROM branches")
 	_, _ = postgresDB.Exec("DELETE FROM books")
 	_, _ = postgresDB.Exec("DELETE FROM users")
 }
@@ -233,10 +509,22 @@ func CreateTestBook(title, author, isbn string) *entity.Book {
 		Author:          author,
 		ISBN:            isbn,
 		PublishedYear:   2024,
+		Category:        entity.DefaultItemCategory,
 		TotalCopies:     5,
 		AvailableCopies: 5,
 		CreatedAt:       time.Now(),
 		UpdatedAt:       time.Now(),
+		Version:         1,
+	}
+}
+
+func CreateTestBranch(code, name string) *entity.Branch {
+	return &entity.Branch{
+		ID:        uuid.New(),
+		Code:      code,
+		Name:      name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
 	}
 }
 
@@ -246,6 +534,9 @@ func CreateTestUser(name, email string) *entity.User {
 		Name:         name,
 		Email:        email,
 		PasswordHash: "hashedpassword123",
+		Role:         entity.RoleMember,
+		Category:     entity.DefaultPatronCategory,
+		CardNumber:   entity.NewCardNumber(),
 		Active:       true,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
@@ -0,0 +1,285 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runBookListFilters loads searchCorpus into repo and checks the filters and
+// sort orders of List.
+func runBookListFilters(t *testing.T, repo domainrepo.BookRepository) {
+	ctx := context.Background()
+
+	for i, b := range searchCorpus {
+		book := CreateTestBook(b.title, b.author, b.isbn)
+		book.PublishedYear = b.year
+		book.CreatedAt = time.Now().Add(time.Duration(i) * time.Minute)
+		require.NoError(t, repo.Create(ctx, book))
+	}
+
+	list := func(filter domainrepo.BookFilter) ([]string, int) {
+		t.Helper()
+		books, total, err := repo.List(ctx, 1, 10, filter)
+		require.NoError(t, err)
+		titles := make([]string, len(books))
+		for i, book := range books {
+			titles[i] = book.Title
+		}
+		return titles, total
+	}
+
+	t.Run("sorts by title by default", func(t *testing.T) {
+		titles, total := list(domainrepo.BookFilter{})
+		assert.Equal(t, len(searchCorpus), total)
+		assert.Equal(t, "A Hora da Estrela", titles[0])
+		assert.Equal(t, "Vidas Secas", titles[len(titles)-1])
+	})
+
+	t.Run("sorts by several fields", func(t *testing.T) {
+		titles, _ := list(domainrepo.BookFilter{Sort: []domainrepo.SortField{
+			{Field: "author", Desc: true},
+			{Field: "published_year"},
+		}})
+		assert.Equal(t, []string{"Memórias Póstumas de Brás Cubas", "Dom Casmurro"}, titles[:2])
+		assert.Equal(t, "O Cortiço", titles[len(titles)-1])
+	})
+
+	t.Run("filters by author ignoring case and accents", func(t *testing.T) {
+		titles, total := list(domainrepo.BookFilter{
+			Author: "JOSE",
+			Sort:   []domainrepo.SortField{{Field: "published_year", Desc: true}},
+		})
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []string{"Ensaio sobre a Cegueira", "Memorial do Convento"}, titles)
+	})
+
+	t.Run("filters by published year range", func(t *testing.T) {
+		from, to := 1890, 1956
+		titles, total := list(domainrepo.BookFilter{
+			PublishedFrom: &from,
+			PublishedTo:   &to,
+			Sort:          []domainrepo.SortField{{Field: "published_year"}},
+		})
+		assert.Equal(t, 4, total)
+		assert.Equal(t, []string{"O Cortiço", "Dom Casmurro", "Vidas Secas", "Grande Sertão: Veredas"}, titles)
+	})
+
+	t.Run("rejects unknown sort fields", func(t *testing.T) {
+		_, _, err := repo.List(ctx, 1, 10, domainrepo.BookFilter{Sort: []domainrepo.SortField{{Field: "isbn"}}})
+		assert.ErrorIs(t, err, domainrepo.ErrInvalidSort)
+	})
+}
+
+func TestPostgresBookRepository_ListFilters(t *testing.T) {
+	CleanupPostgres(t)
+
+	runBookListFilters(t, repository.NewPostgresBookRepository(PostgresTestDB))
+}
+
+func TestMongoBookRepository_ListFilters(t *testing.T) {
+	CleanupMongo(t)
+
+	runBookListFilters(t, repository.NewMongoBookRepository(MongoTestDB))
+}
+
+// runUserListFilters checks the filters and sort orders of the user List.
+func runUserListFilters(t *testing.T, repo domainrepo.UserRepository) {
+	ctx := context.Background()
+
+	for i, u := range []struct {
+		name, email string
+		active      bool
+	}{
+		{"Carla Dias", "carla@example.com", true},
+		{"Ana Souza", "ana@example.com", true},
+		{"Bruno Lima", "bruno@biblioteca.org", false},
+	} {
+		user := CreateTestUser(u.name, u.email)
+		user.Active = u.active
+		user.CreatedAt = time.Now().Add(time.Duration(i) * time.Minute)
+		require.NoError(t, repo.Create(ctx, user))
+	}
+
+	list := func(filter domainrepo.UserFilter) ([]string, int) {
+		t.Helper()
+		users, total, err := repo.List(ctx, 1, 10, filter)
+		require.NoError(t, err)
+		names := make([]string, len(users))
+		for i, user := range users {
+			names[i] = user.Name
+		}
+		return names, total
+	}
+
+	t.Run("sorts newest first by default", func(t *testing.T) {
+		names, total := list(domainrepo.UserFilter{})
+		assert.Equal(t, 3, total)
+		assert.Equal(t, []string{"Bruno Lima", "Ana Souza", "Carla Dias"}, names)
+	})
+
+	t.Run("sorts by name", func(t *testing.T) {
+		names, _ := list(domainrepo.UserFilter{Sort: []domainrepo.SortField{{Field: "name"}}})
+		assert.Equal(t, []string{"Ana Souza", "Bruno Lima", "Carla Dias"}, names)
+	})
+
+	t.Run("filters by active flag", func(t *testing.T) {
+		active := false
+		names, total := list(domainrepo.UserFilter{Active: &active})
+		assert.Equal(t, 1, total)
+		assert.Equal(t, []string{"Bruno Lima"}, names)
+	})
+
+	t.Run("filters by name or email substring", func(t *testing.T) {
+		names, total := list(domainrepo.UserFilter{Query: "SOUZA"})
+		assert.Equal(t, 1, total)
+		assert.Equal(t, []string{"Ana Souza"}, names)
+
+		names, total = list(domainrepo.UserFilter{Query: "example.com", Sort: []domainrepo.SortField{{Field: "email"}}})
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []string{"Ana Souza", "Carla Dias"}, names)
+	})
+
+	t.Run("treats wildcards literally", func(t *testing.T) {
+		_, total := list(domainrepo.UserFilter{Query: "a%a"})
+		assert.Equal(t, 0, total)
+
+		_, total = list(domainrepo.UserFilter{Query: ".*"})
+		assert.Equal(t, 0, total)
+	})
+}
+
+func TestPostgresUserRepository_ListFilters(t *testing.T) {
+	CleanupPostgres(t)
+
+	runUserListFilters(t, repository.NewPostgresUserRepository(PostgresTestDB))
+}
+
+func TestMongoUserRepository_ListFilters(t *testing.T) {
+	CleanupMongo(t)
+
+	runUserListFilters(t, repository.NewMongoUserRepository(MongoTestDB))
+}
+
+// runLoanListFilters checks the filters and sort orders of the loan List and
+// ListWithDetails.
+func runLoanListFilters(t *testing.T, userRepo domainrepo.UserRepository, bookRepo domainrepo.BookRepository, repo domainrepo.LoanRepositoryWithDetails) {
+	ctx := context.Background()
+
+	user := CreateTestUser("Loan Filters User", "loanfilters@example.com")
+	require.NoError(t, userRepo.Create(ctx, user))
+	book1 := CreateTestBook("Loan Filters Book 1", "Author 1", "6666666661")
+	book2 := CreateTestBook("Loan Filters Book 2", "Author 2", "6666666662")
+	require.NoError(t, bookRepo.Create(ctx, book1))
+	require.NoError(t, bookRepo.Create(ctx, book2))
+
+	base := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	loan := func(bookID uuid.UUID, borrowedDay int, returnedDay int) *entity.Loan {
+		l := CreateTestLoan(user.ID, bookID)
+		l.BorrowedAt = base.AddDate(0, 0, borrowedDay)
+		l.DueDate = l.BorrowedAt.AddDate(0, 0, 14)
+		if returnedDay > 0 {
+			returnedAt := base.AddDate(0, 0, returnedDay)
+			l.ReturnedAt = &returnedAt
+			l.Status = entity.LoanStatusReturned
+		}
+		require.NoError(t, repo.Create(ctx, l))
+		return l
+	}
+	first := loan(book1.ID, 0, 10)
+	second := loan(book2.ID, 5, 0)
+	third := loan(book1.ID, 20, 25)
+
+	list := func(filter domainrepo.LoanFilter) ([]uuid.UUID, int) {
+		t.Helper()
+		loans, total, err := repo.List(ctx, 1, 10, filter)
+		require.NoError(t, err)
+		ids := make([]uuid.UUID, len(loans))
+		for i, l := range loans {
+			ids[i] = l.ID
+		}
+		return ids, total
+	}
+
+	t.Run("sorts newest first by default", func(t *testing.T) {
+		ids, total := list(domainrepo.LoanFilter{})
+		assert.Equal(t, 3, total)
+		assert.Equal(t, []uuid.UUID{third.ID, second.ID, first.ID}, ids)
+	})
+
+	t.Run("sorts open loans first by return date", func(t *testing.T) {
+		ids, _ := list(domainrepo.LoanFilter{Sort: []domainrepo.SortField{{Field: "returned_at"}}})
+		assert.Equal(t, []uuid.UUID{second.ID, first.ID, third.ID}, ids)
+
+		ids, _ = list(domainrepo.LoanFilter{Sort: []domainrepo.SortField{{Field: "returned_at", Desc: true}}})
+		assert.Equal(t, []uuid.UUID{third.ID, first.ID, second.ID}, ids)
+	})
+
+	t.Run("filters by book", func(t *testing.T) {
+		ids, total := list(domainrepo.LoanFilter{BookID: &book1.ID, Sort: []domainrepo.SortField{{Field: "due_date"}}})
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []uuid.UUID{first.ID, third.ID}, ids)
+	})
+
+	t.Run("filters by borrow and return dates", func(t *testing.T) {
+		from, to := base.AddDate(0, 0, 1), base.AddDate(0, 0, 20)
+		ids, total := list(domainrepo.LoanFilter{BorrowedFrom: &from, BorrowedTo: &to})
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []uuid.UUID{third.ID, second.ID}, ids)
+
+		returnedFrom, returnedTo := base.AddDate(0, 0, 9), base.AddDate(0, 0, 11)
+		ids, total = list(domainrepo.LoanFilter{ReturnedFrom: &returnedFrom, ReturnedTo: &returnedTo})
+		assert.Equal(t, 1, total)
+		assert.Equal(t, []uuid.UUID{first.ID}, ids)
+	})
+
+	t.Run("filters by due date", func(t *testing.T) {
+		dueBefore := second.DueDate
+		ids, total := list(domainrepo.LoanFilter{DueBefore: &dueBefore})
+		assert.Equal(t, 1, total)
+		assert.Equal(t, []uuid.UUID{first.ID}, ids)
+	})
+
+	t.Run("applies filters to the detailed list", func(t *testing.T) {
+		status := entity.LoanStatusReturned
+		details, total, err := repo.ListWithDetails(ctx, 1, 10, domainrepo.LoanFilter{
+			BookID: &book1.ID,
+			Status: &status,
+			Sort:   []domainrepo.SortField{{Field: "borrowed_at"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		require.Len(t, details, 2)
+		assert.Equal(t, first.ID, details[0].Loan.ID)
+		assert.Equal(t, book1.Title, details[0].BookTitle)
+		assert.Equal(t, user.Name, details[0].UserName)
+	})
+}
+
+func TestPostgresLoanRepository_ListFilters(t *testing.T) {
+	CleanupPostgres(t)
+
+	runLoanListFilters(t,
+		repository.NewPostgresUserRepository(PostgresTestDB),
+		repository.NewPostgresBookRepository(PostgresTestDB),
+		repository.NewPostgresLoanRepository(PostgresTestDB))
+}
+
+func TestMongoLoanRepository_ListFilters(t *testing.T) {
+	CleanupMongo(t)
+
+	runLoanListFilters(t,
+		repository.NewMongoUserRepository(MongoTestDB),
+		repository.NewMongoBookRepository(MongoTestDB),
+		repository.NewMongoLoanRepository(MongoTestDB))
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"bookhub/internal/domain/repository"
+)
+
+// listQuery builds a paginated list query whose filters and ordering are
+// only known at run time, which sqlc's static queries can't express.
+// Conditions take their arguments as ? placeholders, numbered $1, $2, ...
+// in the order they are added.
+type listQuery struct {
+	from       string
+	conditions []string
+	args       []any
+}
+
+func newListQuery(from string) *listQuery {
+	return &listQuery{from: from}
+}
+
+// where adds a condition the rows must meet.
+func (q *listQuery) where(condition string, args ...any) {
+	var b strings.Builder
+	for _, c := range condition {
+		if c == '?' && len(args) > 0 {
+			q.args = append(q.args, args[0])
+			args = args[1:]
+			fmt.Fprintf(&b, "$%d", len(q.args))
+			continue
+		}
+		b.WriteRune(c)
+	}
+	q.conditions = append(q.conditions, b.String())
+}
+
+// selectPage returns the query for one page of columns ordered by orderBy,
+// with its arguments.
+func (q *listQuery) selectPage(columns, orderBy string, limit, offset int) (string, []any) {
+	args := append(append([]any{}, q.args...), limit, offset)
+	query := fmt.Sprintf("SELECT %s FROM %s%s ORDER BY %s LIMIT $%d OFFSET $%d",
+		columns, q.from, q.whereClause(), orderBy, len(args)-1, len(args))
+	return query, args
+}
+
+// count returns the query counting all matching rows, with its arguments.
+func (q *listQuery) count() (string, []any) {
+	return "SELECT COUNT(*) FROM " + q.from + q.whereClause(), q.args
+}
+
+func (q *listQuery) whereClause() string {
+	if len(q.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(q.conditions, " AND ")
+}
+
+// likeEscaper escapes the LIKE wildcards so user input matches literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// sortColumn is the column a sort field orders by. Nullable columns sort
+// NULL first when ascending and last when descending, as Mongo does.
+type sortColumn struct {
+	name     string
+	nullable bool
+}
+
+// orderByClause renders sort, or fallback when it is empty, mapping each
+// field to its column. tiebreak, a unique column, comes last so that rows
+// with equal sort values keep their order from page to page.
+func orderByClause(sort, fallback []repository.SortField, columns map[string]sortColumn, tiebreak string) (string, error) {
+	if len(sort) == 0 {
+		sort = fallback
+	}
+	terms := make([]string, 0, len(sort)+1)
+	for _, field := range sort {
+		column, ok := columns[field.Field]
+		if !ok {
+			return "", fmt.Errorf("%w: unknown field %q", repository.ErrInvalidSort, field.Field)
+		}
+		term := column.name
+		switch {
+		case field.Desc && column.nullable:
+			term += " DESC NULLS LAST"
+		case field.Desc:
+			term += " DESC"
+		case column.nullable:
+			term += " NULLS FIRST"
+		}
+		terms = append(terms, term)
+	}
+	return strings.Join(append(terms, tiebreak), ", "), nil
+}
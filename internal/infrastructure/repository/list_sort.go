@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"fmt"
+
+	"bookhub/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Orderings of the lists when no sort is given.
+var (
+	bookDefaultSort = []repository.SortField{{Field: "title"}}
+	userDefaultSort = []repository.SortField{{Field: "created_at", Desc: true}}
+	loanDefaultSort = []repository.SortField{{Field: "borrowed_at", Desc: true}}
+)
+
+// sortDocument renders sort, or fallback when it is empty, mapping each
+// field to its document key. The id comes last so that documents with equal
+// sort values keep their order from page to page.
+func sortDocument(sort, fallback []repository.SortField, keys map[string]string) (bson.D, error) {
+	if len(sort) == 0 {
+		sort = fallback
+	}
+	doc := make(bson.D, 0, len(sort)+1)
+	for _, field := range sort {
+		key, ok := keys[field.Field]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", repository.ErrInvalidSort, field.Field)
+		}
+		direction := 1
+		if field.Desc {
+			direction = -1
+		}
+		doc = append(doc, bson.E{Key: key, Value: direction})
+	}
+	return append(doc, bson.E{Key: "id", Value: 1}), nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const loanEscalationsCollection = "loan_escalations"
+
+type mongoLoanEscalationRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoLoanEscalationRepository(db *mongo.Database) repository.LoanEscalationRepository {
+	return &mongoLoanEscalationRepository{
+		collection: db.Collection(loanEscalationsCollection),
+	}
+}
+
+// Claim upserts on the loan and level, which the unique index in init-db.js
+// covers, so concurrent claims insert at most one document.
+func (r *mongoLoanEscalationRepository) Claim(ctx context.Context, escalation *entity.LoanEscalation) (bool, error) {
+	doc := toLoanEscalationDocument(escalation)
+	filter := bson.M{"loanid": doc.LoanID, "level": doc.Level}
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{"$setOnInsert": doc}, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return result.UpsertedCount > 0, nil
+}
+
+func (r *mongoLoanEscalationRepository) ListByLoan(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanEscalation, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "level", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{"loanid": loanID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []loanEscalationDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	escalations := make([]*entity.LoanEscalation, len(docs))
+	for i, doc := range docs {
+		escalations[i] = doc.toEntity()
+	}
+	return escalations, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresLoanEscalationRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresLoanEscalationRepository(db *sql.DB) repository.LoanEscalationRepository {
+	return &postgresLoanEscalationRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresLoanEscalationRepository) Claim(ctx context.Context, escalation *entity.LoanEscalation) (bool, error) {
+	claimed, err := r.q(ctx).ClaimLoanEscalation(ctx, sqlc.ClaimLoanEscalationParams{
+		ID:          escalation.ID,
+		LoanID:      escalation.LoanID,
+		UserID:      escalation.UserID,
+		Level:       int32(escalation.Level),
+		Action:      string(escalation.Action),
+		DaysOverdue: int32(escalation.DaysOverdue),
+		CreatedAt:   escalation.CreatedAt,
+	})
+	if err != nil {
+		return false, err
+	}
+	return claimed > 0, nil
+}
+
+func (r *postgresLoanEscalationRepository) ListByLoan(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanEscalation, error) {
+	rows, err := r.q(ctx).ListLoanEscalationsByLoan(ctx, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	escalations := make([]*entity.LoanEscalation, len(rows))
+	for i, row := range rows {
+		escalations[i] = r.toEntity(row)
+	}
+	return escalations, nil
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresLoanEscalationRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresLoanEscalationRepository) toEntity(row sqlc.LoanEscalation) *entity.LoanEscalation {
+	return &entity.LoanEscalation{
+		ID:          row.ID,
+		LoanID:      row.LoanID,
+		UserID:      row.UserID,
+		Level:       int(row.Level),
+		Action:      entity.EscalationAction(row.Action),
+		DaysOverdue: int(row.DaysOverdue),
+		CreatedAt:   row.CreatedAt,
+	}
+}
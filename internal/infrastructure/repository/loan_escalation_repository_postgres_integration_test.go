@@ -0,0 +1,60 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresLoanEscalationRepository_ClaimAndList(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	userRepo := repository.NewPostgresUserRepository(PostgresTestDB)
+	bookRepo := repository.NewPostgresBookRepository(PostgresTestDB)
+	loanRepo := repository.NewPostgresLoanRepository(PostgresTestDB)
+	repo := repository.NewPostgresLoanEscalationRepository(PostgresTestDB)
+
+	user := CreateTestUser("Escalation User PG", "escalationpg@example.com")
+	book := CreateTestBook("Escalation Book PG", "Author", "1234567806")
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, bookRepo.Create(ctx, book))
+
+	loan := CreateTestLoan(user.ID, book.ID)
+	require.NoError(t, loanRepo.Create(ctx, loan))
+
+	claimed, err := repo.Claim(ctx, entity.NewLoanEscalation(loan, 2, entity.EscalationBlock, 14))
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = repo.Claim(ctx, entity.NewLoanEscalation(loan, 1, entity.EscalationNotice, 7))
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+
+	// Each level is taken only once per loan
+	claimed, err = repo.Claim(ctx, entity.NewLoanEscalation(loan, 2, entity.EscalationBlock, 15))
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+
+	escalations, err := repo.ListByLoan(ctx, loan.ID)
+	require.NoError(t, err)
+	require.Len(t, escalations, 2)
+	assert.Equal(t, 1, escalations[0].Level)
+	assert.Equal(t, entity.EscalationBlock, escalations[1].Action)
+	assert.Equal(t, 14, escalations[1].DaysOverdue)
+	assert.Equal(t, user.ID, escalations[1].UserID)
+
+	// Blocking the patron is kept on the user
+	user.Block()
+	require.NoError(t, userRepo.Update(ctx, user))
+	found, err := userRepo.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.True(t, found.Blocked)
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const loanNoticesCollection = "loan_notices"
+
+type mongoLoanNoticeRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoLoanNoticeRepository(db *mongo.Database) repository.LoanNoticeRepository {
+	return &mongoLoanNoticeRepository{
+		collection: db.Collection(loanNoticesCollection),
+	}
+}
+
+// Claim upserts on the loan, kind and due date, which the unique index in
+// init-db.js covers, so concurrent claims insert at most one document.
+func (r *mongoLoanNoticeRepository) Claim(ctx context.Context, notice *entity.LoanNotice) (bool, error) {
+	doc := toLoanNoticeDocument(notice)
+	filter := bson.M{"loanid": doc.LoanID, "kind": doc.Kind, "duedate": doc.DueDate}
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{"$setOnInsert": doc}, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return result.UpsertedCount > 0, nil
+}
+
+func (r *mongoLoanNoticeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"id": id})
+	return err
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresLoanNoticeRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresLoanNoticeRepository(db *sql.DB) repository.LoanNoticeRepository {
+	return &postgresLoanNoticeRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresLoanNoticeRepository) Claim(ctx context.Context, notice *entity.LoanNotice) (bool, error) {
+	claimed, err := r.q(ctx).ClaimLoanNotice(ctx, sqlc.ClaimLoanNoticeParams{
+		ID:      notice.ID,
+		LoanID:  notice.LoanID,
+		Kind:    string(notice.Kind),
+		DueDate: notice.DueDate,
+		SentAt:  notice.SentAt,
+	})
+	if err != nil {
+		return false, err
+	}
+	return claimed > 0, nil
+}
+
+func (r *postgresLoanNoticeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.q(ctx).DeleteLoanNotice(ctx, id)
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresLoanNoticeRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
@@ -0,0 +1,54 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresLoanNoticeRepository_Claim(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	userRepo := repository.NewPostgresUserRepository(PostgresTestDB)
+	bookRepo := repository.NewPostgresBookRepository(PostgresTestDB)
+	loanRepo := repository.NewPostgresLoanRepository(PostgresTestDB)
+	repo := repository.NewPostgresLoanNoticeRepository(PostgresTestDB)
+
+	user := CreateTestUser("Notice User PG", "noticepg@example.com")
+	book := CreateTestBook("Notice Book PG", "Author", "1234567805")
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, bookRepo.Create(ctx, book))
+
+	loan := CreateTestLoan(user.ID, book.ID)
+	require.NoError(t, loanRepo.Create(ctx, loan))
+	loan, err := loanRepo.GetByID(ctx, loan.ID)
+	require.NoError(t, err)
+
+	reminder := entity.NewLoanNotice(loan, entity.NoticeDueReminder)
+	claimed, err := repo.Claim(ctx, reminder)
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+
+	// The same kind for the same due date is claimed only once
+	claimed, err = repo.Claim(ctx, entity.NewLoanNotice(loan, entity.NoticeDueReminder))
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+
+	claimed, err = repo.Claim(ctx, entity.NewLoanNotice(loan, entity.NoticeOverdue))
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+
+	// A released claim can be claimed again
+	require.NoError(t, repo.Delete(ctx, reminder.ID))
+	claimed, err = repo.Claim(ctx, entity.NewLoanNotice(loan, entity.NoticeDueReminder))
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+}
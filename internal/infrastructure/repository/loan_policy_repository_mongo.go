@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const loanPoliciesCollection = "loan_policies"
+
+type mongoLoanPolicyRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoLoanPolicyRepository(db *mongo.Database) repository.LoanPolicyRepository {
+	return &mongoLoanPolicyRepository{
+		collection: db.Collection(loanPoliciesCollection),
+	}
+}
+
+func (r *mongoLoanPolicyRepository) Create(ctx context.Context, policy *entity.LoanPolicy) error {
+	doc := toLoanPolicyDocument(policy)
+	_, err := r.collection.InsertOne(ctx, doc)
+	return err
+}
+
+func (r *mongoLoanPolicyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.LoanPolicy, error) {
+	return r.findOne(ctx, bson.M{"id": id})
+}
+
+func (r *mongoLoanPolicyRepository) GetByCategories(ctx context.Context, patronCategory, itemCategory string) (*entity.LoanPolicy, error) {
+	return r.findOne(ctx, bson.M{"patroncategory": patronCategory, "itemcategory": itemCategory})
+}
+
+func (r *mongoLoanPolicyRepository) List(ctx context.Context) ([]*entity.LoanPolicy, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "patroncategory", Value: 1}, {Key: "itemcategory", Value: 1}})
+	return r.find(ctx, bson.M{}, opts)
+}
+
+func (r *mongoLoanPolicyRepository) ListMatching(ctx context.Context, patronCategory, itemCategory string) ([]*entity.LoanPolicy, error) {
+	filter := bson.M{
+		"patroncategory": bson.M{"$in": []string{patronCategory, entity.AnyCategory}},
+		"itemcategory":   bson.M{"$in": []string{itemCategory, entity.AnyCategory}},
+	}
+	return r.find(ctx, filter, options.Find())
+}
+
+func (r *mongoLoanPolicyRepository) Update(ctx context.Context, policy *entity.LoanPolicy) error {
+	filter := bson.M{"id": policy.ID}
+	update := bson.M{
+		"$set": bson.M{
+			"maxloans":    policy.MaxLoans,
+			"loandays":    policy.LoanDays,
+			"maxrenewals": policy.MaxRenewals,
+			"updatedat":   policy.UpdatedAt,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (r *mongoLoanPolicyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"id": id})
+	return err
+}
+
+func (r *mongoLoanPolicyRepository) findOne(ctx context.Context, filter bson.M) (*entity.LoanPolicy, error) {
+	var doc loanPolicyDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
+
+func (r *mongoLoanPolicyRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.LoanPolicy, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []loanPolicyDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	policies := make([]*entity.LoanPolicy, len(docs))
+	for i, doc := range docs {
+		policies[i] = doc.toEntity()
+	}
+	return policies, nil
+}
@@ -0,0 +1,94 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMongoLoanPolicyRepository_CRUD(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	repo := repository.NewMongoLoanPolicyRepository(MongoTestDB)
+
+	policy, err := entity.NewLoanPolicy("student", entity.AnyCategory, 3, 7, 1)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, policy))
+
+	retrieved, err := repo.GetByID(ctx, policy.ID)
+	assert.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, "student", retrieved.PatronCategory)
+	assert.Equal(t, entity.AnyCategory, retrieved.ItemCategory)
+	assert.Equal(t, 7, retrieved.LoanDays)
+
+	byCategories, err := repo.GetByCategories(ctx, "student", entity.AnyCategory)
+	assert.NoError(t, err)
+	require.NotNil(t, byCategories)
+	assert.Equal(t, policy.ID, byCategories.ID)
+
+	require.NoError(t, retrieved.Update(4, 10, 2))
+	require.NoError(t, repo.Update(ctx, retrieved))
+
+	retrieved, err = repo.GetByID(ctx, policy.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, retrieved.MaxLoans)
+	assert.Equal(t, 10, retrieved.LoanDays)
+	assert.Equal(t, 2, retrieved.MaxRenewals)
+
+	require.NoError(t, repo.Delete(ctx, policy.ID))
+
+	retrieved, err = repo.GetByID(ctx, policy.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestMongoLoanPolicyRepository_GetByIDNotFound(t *testing.T) {
+	CleanupMongo(t)
+
+	repo := repository.NewMongoLoanPolicyRepository(MongoTestDB)
+
+	retrieved, err := repo.GetByID(context.Background(), uuid.New())
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestMongoLoanPolicyRepository_ListMatching(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	repo := repository.NewMongoLoanPolicyRepository(MongoTestDB)
+
+	for _, categories := range [][2]string{
+		{entity.AnyCategory, entity.AnyCategory},
+		{"student", entity.AnyCategory},
+		{"student", "reference"},
+		{"faculty", entity.AnyCategory},
+		{entity.AnyCategory, "periodical"},
+	} {
+		policy, err := entity.NewLoanPolicy(categories[0], categories[1], 3, 7, 1)
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, policy))
+	}
+
+	all, err := repo.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, all, 5)
+
+	matching, err := repo.ListMatching(ctx, "student", "reference")
+	assert.NoError(t, err)
+	assert.Len(t, matching, 3)
+
+	selected := entity.SelectLoanPolicy(matching, "student", "reference")
+	require.NotNil(t, selected)
+	assert.Equal(t, "reference", selected.ItemCategory)
+}
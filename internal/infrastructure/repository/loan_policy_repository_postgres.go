@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresLoanPolicyRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresLoanPolicyRepository(db *sql.DB) repository.LoanPolicyRepository {
+	return &postgresLoanPolicyRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresLoanPolicyRepository) Create(ctx context.Context, policy *entity.LoanPolicy) error {
+	_, err := r.q(ctx).CreateLoanPolicy(ctx, sqlc.CreateLoanPolicyParams{
+		ID:             policy.ID,
+		PatronCategory: policy.PatronCategory,
+		ItemCategory:   policy.ItemCategory,
+		MaxLoans:       int32(policy.MaxLoans),
+		LoanDays:       int32(policy.LoanDays),
+		MaxRenewals:    int32(policy.MaxRenewals),
+		CreatedAt:      policy.CreatedAt,
+		UpdatedAt:      policy.UpdatedAt,
+	})
+	return err
+}
+
+func (r *postgresLoanPolicyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.LoanPolicy, error) {
+	row, err := r.q(ctx).GetLoanPolicyByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresLoanPolicyRepository) GetByCategories(ctx context.Context, patronCategory, itemCategory string) (*entity.LoanPolicy, error) {
+	row, err := r.q(ctx).GetLoanPolicyByCategories(ctx, sqlc.GetLoanPolicyByCategoriesParams{
+		PatronCategory: patronCategory,
+		ItemCategory:   itemCategory,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresLoanPolicyRepository) List(ctx context.Context) ([]*entity.LoanPolicy, error) {
+	rows, err := r.q(ctx).ListLoanPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntities(rows), nil
+}
+
+func (r *postgresLoanPolicyRepository) ListMatching(ctx context.Context, patronCategory, itemCategory string) ([]*entity.LoanPolicy, error) {
+	rows, err := r.q(ctx).ListMatchingLoanPolicies(ctx, sqlc.ListMatchingLoanPoliciesParams{
+		PatronCategory: patronCategory,
+		ItemCategory:   itemCategory,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntities(rows), nil
+}
+
+func (r *postgresLoanPolicyRepository) Update(ctx context.Context, policy *entity.LoanPolicy) error {
+	_, err := r.q(ctx).UpdateLoanPolicy(ctx, sqlc.UpdateLoanPolicyParams{
+		ID:          policy.ID,
+		MaxLoans:    int32(policy.MaxLoans),
+		LoanDays:    int32(policy.LoanDays),
+		MaxRenewals: int32(policy.MaxRenewals),
+		UpdatedAt:   policy.UpdatedAt,
+	})
+	return err
+}
+
+func (r *postgresLoanPolicyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.q(ctx).DeleteLoanPolicy(ctx, id)
+	return err
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresLoanPolicyRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresLoanPolicyRepository) toEntities(rows []sqlc.LoanPolicy) []*entity.LoanPolicy {
+	policies := make([]*entity.LoanPolicy, len(rows))
+	for i, row := range rows {
+		policies[i] = r.toEntity(row)
+	}
+	return policies
+}
+
+func (r *postgresLoanPolicyRepository) toEntity(row sqlc.LoanPolicy) *entity.LoanPolicy {
+	return &entity.LoanPolicy{
+		ID:             row.ID,
+		PatronCategory: row.PatronCategory,
+		ItemCategory:   row.ItemCategory,
+		MaxLoans:       int(row.MaxLoans),
+		LoanDays:       int(row.LoanDays),
+		MaxRenewals:    int(row.MaxRenewals),
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
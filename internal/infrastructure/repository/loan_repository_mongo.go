@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"bookhub/internal/domain/entity"
 	"bookhub/internal/domain/repository"
@@ -15,10 +16,18 @@ import (
 
 const loansCollection = "loans"
 
+// loanSortKeys maps the repository.LoanSortFields to document keys.
+var loanSortKeys = map[string]string{
+	"borrowed_at": "borrowedat",
+	"due_date":    "duedate",
+	"returned_at": "returnedat",
+}
+
 type mongoLoanRepository struct {
 	loansCollection *mongo.Collection
 	usersCollection *mongo.Collection
 	booksCollection *mongo.Collection
+	copies          *mongo.Collection
 }
 
 func NewMongoLoanRepository(db *mongo.Database) repository.LoanRepositoryWithDetails {
@@ -26,6 +35,7 @@ func NewMongoLoanRepository(db *mongo.Database) repository.LoanRepositoryWithDet
 		loansCollection: db.Collection(loansCollection),
 		usersCollection: db.Collection(usersCollection),
 		booksCollection: db.Collection(booksCollection),
+		copies:          db.Collection(bookCopiesCollection),
 	}
 }
 
@@ -77,7 +87,7 @@ func (r *mongoLoanRepository) GetActiveByUserAndBook(ctx context.Context, userID
 	filter := bson.M{
 		"userid": userID,
 		"bookid": bookID,
-		"status": entity.LoanStatusActive,
+		"status": bson.M{"$in": []string{entity.LoanStatusActive, entity.LoanStatusOverdue}},
 	}
 
 	var doc loanDocument
@@ -91,18 +101,73 @@ func (r *mongoLoanRepository) GetActiveByUserAndBook(ctx context.Context, userID
 	return doc.toEntity(), nil
 }
 
-func (r *mongoLoanRepository) List(ctx context.Context, page, limit int, userID *uuid.UUID, status *string) ([]*entity.Loan, int, error) {
+func (r *mongoLoanRepository) GetActiveByCopy(ctx context.Context, copyID uuid.UUID) (*entity.Loan, error) {
+	filter := bson.M{
+		"copyid": copyID,
+		"status": bson.M{"$in": []string{entity.LoanStatusActive, entity.LoanStatusOverdue}},
+	}
+
+	var doc loanDocument
+	err := r.loansCollection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
+
+func (r *mongoLoanRepository) CountActiveByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	filter := bson.M{
+		"userid": userID,
+		"status": bson.M{"$in": []string{entity.LoanStatusActive, entity.LoanStatusOverdue}},
+	}
+
+	count, err := r.loansCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *mongoLoanRepository) CountActiveByBook(ctx context.Context, bookID uuid.UUID) (int, error) {
+	filter := bson.M{
+		"bookid": bookID,
+		"status": bson.M{"$in": []string{entity.LoanStatusActive, entity.LoanStatusOverdue}},
+	}
+
+	count, err := r.loansCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *mongoLoanRepository) CountByBook(ctx context.Context, bookID uuid.UUID) (int, error) {
+	count, err := r.loansCollection.CountDocuments(ctx, bson.M{"bookid": bookID})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *mongoLoanRepository) List(ctx context.Context, page, limit int, filter repository.LoanFilter) ([]*entity.Loan, int, error) {
 	skip := int64((page - 1) * limit)
 	limitInt64 := int64(limit)
 
-	filter := r.buildFilter(userID, status)
+	query := r.buildFilter(filter)
+	sort, err := sortDocument(filter.Sort, loanDefaultSort, loanSortKeys)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	opts := options.Find().
 		SetSkip(skip).
 		SetLimit(limitInt64).
-		SetSort(bson.D{{Key: "borrowedat", Value: -1}})
+		SetSort(sort)
 
-	cursor, err := r.loansCollection.Find(ctx, filter, opts)
+	cursor, err := r.loansCollection.Find(ctx, query, opts)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -118,7 +183,7 @@ func (r *mongoLoanRepository) List(ctx context.Context, page, limit int, userID
 		loans[i] = doc.toEntity()
 	}
 
-	count, err := r.loansCollection.CountDocuments(ctx, filter)
+	count, err := r.loansCollection.CountDocuments(ctx, query)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -126,8 +191,8 @@ func (r *mongoLoanRepository) List(ctx context.Context, page, limit int, userID
 	return loans, int(count), nil
 }
 
-func (r *mongoLoanRepository) ListWithDetails(ctx context.Context, page, limit int, userID *uuid.UUID, status *string) ([]*repository.LoanWithDetails, int, error) {
-	loans, count, err := r.List(ctx, page, limit, userID, status)
+func (r *mongoLoanRepository) ListWithDetails(ctx context.Context, page, limit int, filter repository.LoanFilter) ([]*repository.LoanWithDetails, int, error) {
+	loans, count, err := r.List(ctx, page, limit, filter)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -154,12 +219,51 @@ func (r *mongoLoanRepository) ListWithDetails(ctx context.Context, page, limit i
 	return loansWithDetails, count, nil
 }
 
+func (r *mongoLoanRepository) ListActiveDue(ctx context.Context, filter repository.DueLoanFilter) ([]*entity.Loan, error) {
+	query := bson.M{
+		"status":  bson.M{"$in": []string{entity.LoanStatusActive, entity.LoanStatusOverdue}},
+		"duedate": bson.M{"$gte": filter.DueFrom, "$lt": filter.DueBefore},
+	}
+	if filter.BookID != nil {
+		query["bookid"] = *filter.BookID
+	}
+	if filter.BranchID != nil {
+		// Loans are kept at a branch through their copies.
+		copyIDs, err := r.copies.Distinct(ctx, "id", bson.M{"branchid": *filter.BranchID})
+		if err != nil {
+			return nil, err
+		}
+		query["copyid"] = bson.M{"$in": copyIDs}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "duedate", Value: 1}})
+
+	cursor, err := r.loansCollection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []loanDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	loans := make([]*entity.Loan, len(docs))
+	for i, doc := range docs {
+		loans[i] = doc.toEntity()
+	}
+	return loans, nil
+}
+
 func (r *mongoLoanRepository) Update(ctx context.Context, loan *entity.Loan) error {
 	filter := bson.M{"id": loan.ID}
 	update := bson.M{
 		"$set": bson.M{
-			"returnedat": loan.ReturnedAt,
-			"status":     loan.Status,
+			"returnedat":   loan.ReturnedAt,
+			"status":       loan.Status,
+			"duedate":      loan.DueDate,
+			"renewalcount": loan.RenewalCount,
 		},
 	}
 
@@ -167,17 +271,86 @@ func (r *mongoLoanRepository) Update(ctx context.Context, loan *entity.Loan) err
 	return err
 }
 
-func (r *mongoLoanRepository) buildFilter(userID *uuid.UUID, status *string) bson.M {
-	filter := bson.M{}
+// MarkOverdue reads the loans before updating them, so it should run in a
+// transaction: a loan changed in between is then a write conflict rather
+// than a loan reported without being marked.
+func (r *mongoLoanRepository) MarkOverdue(ctx context.Context, now time.Time) ([]*entity.Loan, error) {
+	filter := bson.M{
+		"status":  entity.LoanStatusActive,
+		"duedate": bson.M{"$lt": now},
+	}
 
-	if userID != nil {
-		filter["userid"] = *userID
+	cursor, err := r.loansCollection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
 	}
-	if status != nil {
-		filter["status"] = *status
+	defer cursor.Close(ctx)
+
+	var docs []loanDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
 	}
+	if len(docs) == 0 {
+		return []*entity.Loan{}, nil
+	}
+
+	loans := make([]*entity.Loan, len(docs))
+	ids := make([]uuid.UUID, len(docs))
+	for i, doc := range docs {
+		loans[i] = doc.toEntity()
+		loans[i].Status = entity.LoanStatusOverdue
+		ids[i] = doc.ID
+	}
+
+	_, err = r.loansCollection.UpdateMany(ctx,
+		bson.M{"id": bson.M{"$in": ids}, "status": entity.LoanStatusActive},
+		bson.M{"$set": bson.M{"status": entity.LoanStatusOverdue}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return loans, nil
+}
+
+func (r *mongoLoanRepository) buildFilter(filter repository.LoanFilter) bson.M {
+	query := bson.M{}
 
-	return filter
+	if filter.UserID != nil {
+		query["userid"] = *filter.UserID
+	}
+	if filter.BookID != nil {
+		query["bookid"] = *filter.BookID
+	}
+	if filter.Status != nil {
+		query["status"] = *filter.Status
+	}
+	if borrowed := timeRange(filter.BorrowedFrom, filter.BorrowedTo); borrowed != nil {
+		query["borrowedat"] = borrowed
+	}
+	if returned := timeRange(filter.ReturnedFrom, filter.ReturnedTo); returned != nil {
+		query["returnedat"] = returned
+	}
+	if filter.DueBefore != nil {
+		query["duedate"] = bson.M{"$lt": *filter.DueBefore}
+	}
+
+	return query
+}
+
+// timeRange matches times from from to to, both inclusive and optional; it
+// is nil when both are.
+func timeRange(from, to *time.Time) bson.M {
+	if from == nil && to == nil {
+		return nil
+	}
+	r := bson.M{}
+	if from != nil {
+		r["$gte"] = *from
+	}
+	if to != nil {
+		r["$lte"] = *to
+	}
+	return r
 }
 
 func (r *mongoLoanRepository) getUserName(ctx context.Context, userID uuid.UUID) (string, error) {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
 	"bookhub/internal/infrastructure/repository"
 
 	"github.com/google/uuid"
@@ -135,6 +136,45 @@ func TestMongoLoanRepository_GetActiveByUserAndBook(t *testing.T) {
 	assert.Nil(t, nonExistent)
 }
 
+func TestMongoLoanRepository_GetActiveByCopy(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	userRepo := repository.NewMongoUserRepository(MongoTestDB)
+	bookRepo := repository.NewMongoBookRepository(MongoTestDB)
+	copyRepo := repository.NewMongoBookCopyRepository(MongoTestDB)
+	repo := repository.NewMongoLoanRepository(MongoTestDB)
+
+	user := CreateTestUser("Copy Loan User", "copyloan@example.com")
+	book := CreateTestBook("Copy Loan Book", "Author", "1234567898")
+
+	err := userRepo.Create(ctx, user)
+	require.NoError(t, err)
+	err = bookRepo.Create(ctx, book)
+	require.NoError(t, err)
+	branch := CreateTestBranch("TEST", "Test Branch")
+	require.NoError(t, repository.NewMongoBranchRepository(MongoTestDB).Create(ctx, branch))
+
+	bookCopy, err := entity.NewBookCopy(book.ID, branch.ID, "1234567898-001", "", "")
+	require.NoError(t, err)
+	err = copyRepo.Create(ctx, bookCopy)
+	require.NoError(t, err)
+
+	loan := CreateTestLoan(user.ID, book.ID)
+	loan.CopyID = &bookCopy.ID
+	err = repo.Create(ctx, loan)
+	require.NoError(t, err)
+
+	active, err := repo.GetActiveByCopy(ctx, bookCopy.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, active)
+	assert.Equal(t, loan.ID, active.ID)
+
+	nonExistent, err := repo.GetActiveByCopy(ctx, uuid.New())
+	assert.NoError(t, err)
+	assert.Nil(t, nonExistent)
+}
+
 func TestMongoLoanRepository_List(t *testing.T) {
 	CleanupMongo(t)
 
@@ -166,23 +206,23 @@ func TestMongoLoanRepository_List(t *testing.T) {
 	require.NoError(t, repo.Create(ctx, loan2))
 	require.NoError(t, repo.Create(ctx, loan3))
 
-	result, count, err := repo.List(ctx, 1, 10, nil, nil)
+	result, count, err := repo.List(ctx, 1, 10, domainrepo.LoanFilter{})
 	assert.NoError(t, err)
 	assert.Equal(t, 3, count)
 	assert.Len(t, result, 3)
 
-	result, count, err = repo.List(ctx, 1, 10, &user1.ID, nil)
+	result, count, err = repo.List(ctx, 1, 10, domainrepo.LoanFilter{UserID: &user1.ID})
 	assert.NoError(t, err)
 	assert.Equal(t, 2, count)
 	assert.Len(t, result, 2)
 
 	activeStatus := entity.LoanStatusActive
-	result, count, err = repo.List(ctx, 1, 10, nil, &activeStatus)
+	result, count, err = repo.List(ctx, 1, 10, domainrepo.LoanFilter{Status: &activeStatus})
 	assert.NoError(t, err)
 	assert.Equal(t, 2, count)
 
 	returnedStatus := entity.LoanStatusReturned
-	result, count, err = repo.List(ctx, 1, 10, nil, &returnedStatus)
+	result, count, err = repo.List(ctx, 1, 10, domainrepo.LoanFilter{Status: &returnedStatus})
 	assert.NoError(t, err)
 	assert.Equal(t, 1, count)
 }
@@ -209,7 +249,7 @@ func TestMongoLoanRepository_ListWithDetails(t *testing.T) {
 	require.NoError(t, repo.Create(ctx, loan1))
 	require.NoError(t, repo.Create(ctx, loan2))
 
-	details, count, err := repo.ListWithDetails(ctx, 1, 10, nil, nil)
+	details, count, err := repo.ListWithDetails(ctx, 1, 10, domainrepo.LoanFilter{})
 	assert.NoError(t, err)
 	assert.Equal(t, 2, count)
 	assert.Len(t, details, 2)
@@ -249,3 +289,182 @@ func TestMongoLoanRepository_Update(t *testing.T) {
 	assert.Equal(t, entity.LoanStatusReturned, retrieved.Status)
 	assert.NotNil(t, retrieved.ReturnedAt)
 }
+
+func TestMongoLoanRepository_UpdateRenewal(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	userRepo := repository.NewMongoUserRepository(MongoTestDB)
+	bookRepo := repository.NewMongoBookRepository(MongoTestDB)
+	repo := repository.NewMongoLoanRepository(MongoTestDB)
+
+	user := CreateTestUser("Renew Loan User", "renewloan@example.com")
+	book := CreateTestBook("Renew Loan Book", "Author", "1234567896")
+
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, bookRepo.Create(ctx, book))
+
+	loan := CreateTestLoan(user.ID, book.ID)
+	require.NoError(t, repo.Create(ctx, loan))
+
+	require.NoError(t, loan.Renew(entity.DefaultLoanDays, 2, 0))
+
+	err := repo.Update(ctx, loan)
+	assert.NoError(t, err)
+
+	retrieved, err := repo.GetByID(ctx, loan.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, retrieved.RenewalCount)
+	assert.WithinDuration(t, loan.DueDate, retrieved.DueDate, time.Second)
+}
+
+func TestMongoLoanRepository_MarkOverdue(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	userRepo := repository.NewMongoUserRepository(MongoTestDB)
+	bookRepo := repository.NewMongoBookRepository(MongoTestDB)
+	repo := repository.NewMongoLoanRepository(MongoTestDB)
+
+	user := CreateTestUser("Overdue Loan User Mongo", "overdueloanmongo@example.com")
+	lateBook := CreateTestBook("Late Book Mongo", "Author", "1234567801")
+	onTimeBook := CreateTestBook("On Time Book Mongo", "Author", "1234567802")
+
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, bookRepo.Create(ctx, lateBook))
+	require.NoError(t, bookRepo.Create(ctx, onTimeBook))
+
+	late := CreateTestLoan(user.ID, lateBook.ID)
+	late.DueDate = time.Now().Add(-time.Hour)
+	require.NoError(t, repo.Create(ctx, late))
+
+	onTime := CreateTestLoan(user.ID, onTimeBook.ID)
+	require.NoError(t, repo.Create(ctx, onTime))
+
+	marked, err := repo.MarkOverdue(ctx, time.Now())
+	assert.NoError(t, err)
+	require.Len(t, marked, 1)
+	assert.Equal(t, late.ID, marked[0].ID)
+	assert.Equal(t, entity.LoanStatusOverdue, marked[0].Status)
+
+	retrieved, err := repo.GetByID(ctx, late.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.LoanStatusOverdue, retrieved.Status)
+
+	retrieved, err = repo.GetByID(ctx, onTime.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.LoanStatusActive, retrieved.Status)
+
+	// An overdue loan still counts as the user's open loan for the book
+	active, err := repo.GetActiveByUserAndBook(ctx, user.ID, lateBook.ID)
+	assert.NoError(t, err)
+	require.NotNil(t, active)
+	assert.Equal(t, late.ID, active.ID)
+
+	count, err := repo.CountActiveByUser(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestMongoLoanRepository_ListActiveDue(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	userRepo := repository.NewMongoUserRepository(MongoTestDB)
+	bookRepo := repository.NewMongoBookRepository(MongoTestDB)
+	branchRepo := repository.NewMongoBranchRepository(MongoTestDB)
+	copyRepo := repository.NewMongoBookCopyRepository(MongoTestDB)
+	repo := repository.NewMongoLoanRepository(MongoTestDB)
+
+	user := CreateTestUser("Due Loan User Mongo", "dueloanmongo@example.com")
+	book := CreateTestBook("Due Loan Book Mongo", "Author", "1234567803")
+	otherBook := CreateTestBook("Other Due Loan Book Mongo", "Author", "1234567804")
+	north := CreateTestBranch("NORTH", "North Branch")
+	south := CreateTestBranch("SOUTH", "South Branch")
+
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, bookRepo.Create(ctx, book))
+	require.NoError(t, bookRepo.Create(ctx, otherBook))
+	require.NoError(t, branchRepo.Create(ctx, north))
+	require.NoError(t, branchRepo.Create(ctx, south))
+
+	northCopy, err := entity.NewBookCopy(book.ID, north.ID, "1234567803-001", "", "")
+	require.NoError(t, err)
+	require.NoError(t, copyRepo.Create(ctx, northCopy))
+	southCopy, err := entity.NewBookCopy(otherBook.ID, south.ID, "1234567804-001", "", "")
+	require.NoError(t, err)
+	require.NoError(t, copyRepo.Create(ctx, southCopy))
+
+	window := time.Date(2099, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	inWindow := CreateTestLoan(user.ID, book.ID)
+	inWindow.CopyID = &northCopy.ID
+	inWindow.DueDate = window.Add(30 * time.Hour)
+	require.NoError(t, repo.Create(ctx, inWindow))
+
+	otherBranch := CreateTestLoan(user.ID, otherBook.ID)
+	otherBranch.CopyID = &southCopy.ID
+	otherBranch.DueDate = window.Add(10 * time.Hour)
+	require.NoError(t, repo.Create(ctx, otherBranch))
+
+	later := CreateTestLoan(user.ID, book.ID)
+	later.DueDate = window.AddDate(0, 0, 10)
+	require.NoError(t, repo.Create(ctx, later))
+
+	returned := CreateTestLoan(user.ID, book.ID)
+	returned.DueDate = window.Add(12 * time.Hour)
+	require.NoError(t, returned.Return())
+	require.NoError(t, repo.Create(ctx, returned))
+
+	filter := domainrepo.DueLoanFilter{DueFrom: window, DueBefore: window.AddDate(0, 0, 5)}
+
+	loans, err := repo.ListActiveDue(ctx, filter)
+	assert.NoError(t, err)
+	require.Len(t, loans, 2)
+	assert.Equal(t, otherBranch.ID, loans[0].ID)
+	assert.Equal(t, inWindow.ID, loans[1].ID)
+
+	filter.BranchID = &north.ID
+	loans, err = repo.ListActiveDue(ctx, filter)
+	assert.NoError(t, err)
+	require.Len(t, loans, 1)
+	assert.Equal(t, inWindow.ID, loans[0].ID)
+
+	filter.BranchID = nil
+	filter.BookID = &otherBook.ID
+	loans, err = repo.ListActiveDue(ctx, filter)
+	assert.NoError(t, err)
+	require.Len(t, loans, 1)
+	assert.Equal(t, otherBranch.ID, loans[0].ID)
+}
+
+func TestMongoLoanRepository_CountByBook(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	userRepo := repository.NewMongoUserRepository(MongoTestDB)
+	bookRepo := repository.NewMongoBookRepository(MongoTestDB)
+	repo := repository.NewMongoLoanRepository(MongoTestDB)
+
+	user := CreateTestUser("Count Loans User", "countbook@example.com")
+	book := CreateTestBook("Count Loans Book", "Author", "1234567898")
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, bookRepo.Create(ctx, book))
+
+	returned := CreateTestLoan(user.ID, book.ID)
+	require.NoError(t, returned.Return())
+	require.NoError(t, repo.Create(ctx, returned))
+	require.NoError(t, repo.Create(ctx, CreateTestLoan(user.ID, book.ID)))
+
+	active, err := repo.CountActiveByBook(ctx, book.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, active)
+
+	total, err := repo.CountByBook(ctx, book.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	total, err = repo.CountByBook(ctx, uuid.New())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, total)
+}
@@ -12,31 +12,45 @@ import (
 	"github.com/google/uuid"
 )
 
+// loanColumns are the columns of loans l in the order sqlc.Loan is scanned.
+const loanColumns = "l.id, l.user_id, l.book_id, l.borrowed_at, l.due_date, l.returned_at, l.status, l.renewal_count, l.copy_id"
+
+// loanSortColumns maps the repository.LoanSortFields to columns.
+var loanSortColumns = map[string]sortColumn{
+	"borrowed_at": {name: "l.borrowed_at"},
+	"due_date":    {name: "l.due_date"},
+	"returned_at": {name: "l.returned_at", nullable: true},
+}
+
 type postgresLoanRepository struct {
+	db      *sql.DB
 	queries *sqlc.Queries
 }
 
 func NewPostgresLoanRepository(db *sql.DB) repository.LoanRepositoryWithDetails {
 	return &postgresLoanRepository{
+		db:      db,
 		queries: sqlc.New(db),
 	}
 }
 
 func (r *postgresLoanRepository) Create(ctx context.Context, loan *entity.Loan) error {
-	_, err := r.queries.CreateLoan(ctx, sqlc.CreateLoanParams{
-		ID:         loan.ID,
-		UserID:     loan.UserID,
-		BookID:     loan.BookID,
-		BorrowedAt: loan.BorrowedAt,
-		DueDate:    loan.DueDate,
-		ReturnedAt: r.toNullTime(loan.ReturnedAt),
-		Status:     loan.Status,
+	_, err := r.q(ctx).CreateLoan(ctx, sqlc.CreateLoanParams{
+		ID:           loan.ID,
+		UserID:       loan.UserID,
+		BookID:       loan.BookID,
+		BorrowedAt:   loan.BorrowedAt,
+		DueDate:      loan.DueDate,
+		ReturnedAt:   r.toNullTime(loan.ReturnedAt),
+		Status:       loan.Status,
+		RenewalCount: int32(loan.RenewalCount),
+		CopyID:       r.toNullUUID(loan.CopyID),
 	})
 	return err
 }
 
 func (r *postgresLoanRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Loan, error) {
-	row, err := r.queries.GetLoanByID(ctx, id)
+	row, err := r.q(ctx).GetLoanByID(ctx, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -47,7 +61,7 @@ func (r *postgresLoanRepository) GetByID(ctx context.Context, id uuid.UUID) (*en
 }
 
 func (r *postgresLoanRepository) GetByIDWithDetails(ctx context.Context, id uuid.UUID) (*repository.LoanWithDetails, error) {
-	row, err := r.queries.GetLoanByIDWithDetails(ctx, id)
+	row, err := r.q(ctx).GetLoanByIDWithDetails(ctx, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -58,7 +72,7 @@ func (r *postgresLoanRepository) GetByIDWithDetails(ctx context.Context, id uuid
 }
 
 func (r *postgresLoanRepository) GetActiveByUserAndBook(ctx context.Context, userID, bookID uuid.UUID) (*entity.Loan, error) {
-	row, err := r.queries.GetActiveByUserAndBook(ctx, sqlc.GetActiveByUserAndBookParams{
+	row, err := r.q(ctx).GetActiveByUserAndBook(ctx, sqlc.GetActiveByUserAndBookParams{
 		UserID: userID,
 		BookID: bookID,
 	})
@@ -71,188 +85,249 @@ func (r *postgresLoanRepository) GetActiveByUserAndBook(ctx context.Context, use
 	return r.toEntity(row), nil
 }
 
-func (r *postgresLoanRepository) List(ctx context.Context, page, limit int, userID *uuid.UUID, status *string) ([]*entity.Loan, int, error) {
+func (r *postgresLoanRepository) GetActiveByCopy(ctx context.Context, copyID uuid.UUID) (*entity.Loan, error) {
+	row, err := r.q(ctx).GetActiveLoanByCopy(ctx, r.toNullUUID(&copyID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresLoanRepository) CountActiveByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	count, err := r.q(ctx).CountActiveLoansByUser(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *postgresLoanRepository) CountActiveByBook(ctx context.Context, bookID uuid.UUID) (int, error) {
+	count, err := r.q(ctx).CountActiveLoansByBook(ctx, bookID)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *postgresLoanRepository) CountByBook(ctx context.Context, bookID uuid.UUID) (int, error) {
+	count, err := r.q(ctx).CountLoansByBook(ctx, bookID)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *postgresLoanRepository) List(ctx context.Context, page, limit int, filter repository.LoanFilter) ([]*entity.Loan, int, error) {
 	offset := (page - 1) * limit
 
-	var rows []sqlc.Loan
-	var count int64
-	var err error
-
-	switch {
-	case userID != nil && status != nil:
-		rows, err = r.queries.ListLoansByUserAndStatus(ctx, sqlc.ListLoansByUserAndStatusParams{
-			UserID: *userID,
-			Status: *status,
-			Limit:  int32(limit),
-			Offset: int32(offset),
-		})
-		if err != nil {
-			return nil, 0, err
-		}
-		count, err = r.queries.CountLoansByUserAndStatus(ctx, sqlc.CountLoansByUserAndStatusParams{
-			UserID: *userID,
-			Status: *status,
-		})
-	case userID != nil:
-		rows, err = r.queries.ListLoansByUser(ctx, sqlc.ListLoansByUserParams{
-			UserID: *userID,
-			Limit:  int32(limit),
-			Offset: int32(offset),
-		})
-		if err != nil {
-			return nil, 0, err
-		}
-		count, err = r.queries.CountLoansByUser(ctx, *userID)
-	case status != nil:
-		rows, err = r.queries.ListLoansByStatus(ctx, sqlc.ListLoansByStatusParams{
-			Status: *status,
-			Limit:  int32(limit),
-			Offset: int32(offset),
-		})
-		if err != nil {
-			return nil, 0, err
-		}
-		count, err = r.queries.CountLoansByStatus(ctx, *status)
-	default:
-		rows, err = r.queries.ListLoans(ctx, sqlc.ListLoansParams{
-			Limit:  int32(limit),
-			Offset: int32(offset),
-		})
-		if err != nil {
-			return nil, 0, err
-		}
-		count, err = r.queries.CountLoans(ctx)
+	orderBy, err := orderByClause(filter.Sort, loanDefaultSort, loanSortColumns, "l.id")
+	if err != nil {
+		return nil, 0, err
 	}
 
+	db := dbFromContext(ctx, r.db)
+	q := r.listQuery("loans l", filter)
+	query, args := q.selectPage(loanColumns, orderBy, limit, offset)
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, 0, err
 	}
+	defer rows.Close()
 
-	loans := make([]*entity.Loan, len(rows))
-	for i, row := range rows {
-		loans[i] = r.toEntity(row)
+	var loans []*entity.Loan
+	for rows.Next() {
+		var row sqlc.Loan
+		if err := rows.Scan(
+			&row.ID,
+			&row.UserID,
+			&row.BookID,
+			&row.BorrowedAt,
+			&row.DueDate,
+			&row.ReturnedAt,
+			&row.Status,
+			&row.RenewalCount,
+			&row.CopyID,
+		); err != nil {
+			return nil, 0, err
+		}
+		loans = append(loans, r.toEntity(row))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
 	}
 
-	return loans, int(count), nil
+	count, err := r.count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return loans, count, nil
 }
 
-func (r *postgresLoanRepository) ListWithDetails(ctx context.Context, page, limit int, userID *uuid.UUID, status *string) ([]*repository.LoanWithDetails, int, error) {
+func (r *postgresLoanRepository) ListWithDetails(ctx context.Context, page, limit int, filter repository.LoanFilter) ([]*repository.LoanWithDetails, int, error) {
 	offset := (page - 1) * limit
 
-	var rows []sqlc.ListLoansWithDetailsRow
-	var count int64
-	var err error
-
-	switch {
-	case userID != nil && status != nil:
-		detailRows, e := r.queries.ListLoansByUserAndStatusWithDetails(ctx, sqlc.ListLoansByUserAndStatusWithDetailsParams{
-			UserID: *userID,
-			Status: *status,
-			Limit:  int32(limit),
-			Offset: int32(offset),
-		})
-		if e != nil {
-			return nil, 0, e
-		}
-		for _, dr := range detailRows {
-			rows = append(rows, sqlc.ListLoansWithDetailsRow(dr))
-		}
-		count, err = r.queries.CountLoansByUserAndStatus(ctx, sqlc.CountLoansByUserAndStatusParams{
-			UserID: *userID,
-			Status: *status,
-		})
-	case userID != nil:
-		detailRows, e := r.queries.ListLoansByUserWithDetails(ctx, sqlc.ListLoansByUserWithDetailsParams{
-			UserID: *userID,
-			Limit:  int32(limit),
-			Offset: int32(offset),
-		})
-		if e != nil {
-			return nil, 0, e
-		}
-		for _, dr := range detailRows {
-			rows = append(rows, sqlc.ListLoansWithDetailsRow(dr))
-		}
-		count, err = r.queries.CountLoansByUser(ctx, *userID)
-	case status != nil:
-		detailRows, e := r.queries.ListLoansByStatusWithDetails(ctx, sqlc.ListLoansByStatusWithDetailsParams{
-			Status: *status,
-			Limit:  int32(limit),
-			Offset: int32(offset),
-		})
-		if e != nil {
-			return nil, 0, e
-		}
-		for _, dr := range detailRows {
-			rows = append(rows, sqlc.ListLoansWithDetailsRow(dr))
-		}
-		count, err = r.queries.CountLoansByStatus(ctx, *status)
-	default:
-		rows, err = r.queries.ListLoansWithDetails(ctx, sqlc.ListLoansWithDetailsParams{
-			Limit:  int32(limit),
-			Offset: int32(offset),
-		})
-		if err != nil {
+	orderBy, err := orderByClause(filter.Sort, loanDefaultSort, loanSortColumns, "l.id")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	db := dbFromContext(ctx, r.db)
+	q := r.listQuery("loans l JOIN users u ON l.user_id = u.id JOIN books b ON l.book_id = b.id", filter)
+	query, args := q.selectPage(loanColumns+", u.name, b.title", orderBy, limit, offset)
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var loans []*repository.LoanWithDetails
+	for rows.Next() {
+		var row sqlc.GetLoanByIDWithDetailsRow
+		if err := rows.Scan(
+			&row.ID,
+			&row.UserID,
+			&row.BookID,
+			&row.BorrowedAt,
+			&row.DueDate,
+			&row.ReturnedAt,
+			&row.Status,
+			&row.RenewalCount,
+			&row.CopyID,
+			&row.UserName,
+			&row.BookTitle,
+		); err != nil {
 			return nil, 0, err
 		}
-		count, err = r.queries.CountLoans(ctx)
+		loans = append(loans, r.toEntityWithDetails(row))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
 	}
 
+	count, err := r.count(ctx, filter)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	loans := make([]*repository.LoanWithDetails, len(rows))
-	for i, row := range rows {
-		loans[i] = &repository.LoanWithDetails{
-			Loan: &entity.Loan{
-				ID:         row.ID,
-				UserID:     row.UserID,
-				BookID:     row.BookID,
-				BorrowedAt: row.BorrowedAt,
-				DueDate:    row.DueDate,
-				ReturnedAt: r.fromNullTime(row.ReturnedAt),
-				Status:     row.Status,
-			},
-			UserName:  row.UserName,
-			BookTitle: row.BookTitle,
-		}
+	return loans, count, nil
+}
+
+// listQuery selects the loans l in from that match filter.
+func (r *postgresLoanRepository) listQuery(from string, filter repository.LoanFilter) *listQuery {
+	q := newListQuery(from)
+	if filter.UserID != nil {
+		q.where("l.user_id = ?", *filter.UserID)
 	}
+	if filter.BookID != nil {
+		q.where("l.book_id = ?", *filter.BookID)
+	}
+	if filter.Status != nil {
+		q.where("l.status = ?", *filter.Status)
+	}
+	if filter.BorrowedFrom != nil {
+		q.where("l.borrowed_at >= ?", *filter.BorrowedFrom)
+	}
+	if filter.BorrowedTo != nil {
+		q.where("l.borrowed_at <= ?", *filter.BorrowedTo)
+	}
+	if filter.ReturnedFrom != nil {
+		q.where("l.returned_at >= ?", *filter.ReturnedFrom)
+	}
+	if filter.ReturnedTo != nil {
+		q.where("l.returned_at <= ?", *filter.ReturnedTo)
+	}
+	if filter.DueBefore != nil {
+		q.where("l.due_date < ?", *filter.DueBefore)
+	}
+	return q
+}
 
-	return loans, int(count), nil
+// count counts the loans that match filter over loans alone; the joins of the
+// details list never drop a loan, so both lists share this total.
+func (r *postgresLoanRepository) count(ctx context.Context, filter repository.LoanFilter) (int, error) {
+	var count int
+	query, args := r.listQuery("loans l", filter).count()
+	err := dbFromContext(ctx, r.db).QueryRowContext(ctx, query, args...).Scan(&count)
+	return count, err
+}
+
+func (r *postgresLoanRepository) ListActiveDue(ctx context.Context, filter repository.DueLoanFilter) ([]*entity.Loan, error) {
+	rows, err := r.q(ctx).ListActiveLoansDue(ctx, sqlc.ListActiveLoansDueParams{
+		DueFrom:   filter.DueFrom,
+		DueBefore: filter.DueBefore,
+		BookID:    r.toNullUUID(filter.BookID),
+		BranchID:  r.toNullUUID(filter.BranchID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	loans := make([]*entity.Loan, len(rows))
+	for i, row := range rows {
+		loans[i] = r.toEntity(row)
+	}
+	return loans, nil
 }
 
 func (r *postgresLoanRepository) Update(ctx context.Context, loan *entity.Loan) error {
-	_, err := r.queries.UpdateLoan(ctx, sqlc.UpdateLoanParams{
-		ID:         loan.ID,
-		ReturnedAt: r.toNullTime(loan.ReturnedAt),
-		Status:     loan.Status,
+	_, err := r.q(ctx).UpdateLoan(ctx, sqlc.UpdateLoanParams{
+		ID:           loan.ID,
+		ReturnedAt:   r.toNullTime(loan.ReturnedAt),
+		Status:       loan.Status,
+		DueDate:      loan.DueDate,
+		RenewalCount: int32(loan.RenewalCount),
 	})
 	return err
 }
 
+func (r *postgresLoanRepository) MarkOverdue(ctx context.Context, now time.Time) ([]*entity.Loan, error) {
+	rows, err := r.q(ctx).MarkOverdueLoans(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	loans := make([]*entity.Loan, len(rows))
+	for i, row := range rows {
+		loans[i] = r.toEntity(row)
+	}
+	return loans, nil
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresLoanRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
 func (r *postgresLoanRepository) toEntity(row sqlc.Loan) *entity.Loan {
 	return &entity.Loan{
-		ID:         row.ID,
-		UserID:     row.UserID,
-		BookID:     row.BookID,
-		BorrowedAt: row.BorrowedAt,
-		DueDate:    row.DueDate,
-		ReturnedAt: r.fromNullTime(row.ReturnedAt),
-		Status:     row.Status,
+		ID:           row.ID,
+		UserID:       row.UserID,
+		BookID:       row.BookID,
+		BorrowedAt:   row.BorrowedAt,
+		DueDate:      row.DueDate,
+		ReturnedAt:   r.fromNullTime(row.ReturnedAt),
+		Status:       row.Status,
+		RenewalCount: int(row.RenewalCount),
+		CopyID:       r.fromNullUUID(row.CopyID),
 	}
 }
 
 func (r *postgresLoanRepository) toEntityWithDetails(row sqlc.GetLoanByIDWithDetailsRow) *repository.LoanWithDetails {
 	return &repository.LoanWithDetails{
 		Loan: &entity.Loan{
-			ID:         row.ID,
-			UserID:     row.UserID,
-			BookID:     row.BookID,
-			BorrowedAt: row.BorrowedAt,
-			DueDate:    row.DueDate,
-			ReturnedAt: r.fromNullTime(row.ReturnedAt),
-			Status:     row.Status,
+			ID:           row.ID,
+			UserID:       row.UserID,
+			BookID:       row.BookID,
+			BorrowedAt:   row.BorrowedAt,
+			DueDate:      row.DueDate,
+			ReturnedAt:   r.fromNullTime(row.ReturnedAt),
+			Status:       row.Status,
+			RenewalCount: int(row.RenewalCount),
+			CopyID:       r.fromNullUUID(row.CopyID),
 		},
 		UserName:  row.UserName,
 		BookTitle: row.BookTitle,
@@ -272,3 +347,17 @@ func (r *postgresLoanRepository) fromNullTime(nt sql.NullTime) *time.Time {
 	}
 	return &nt.Time
 }
+
+func (r *postgresLoanRepository) toNullUUID(id *uuid.UUID) uuid.NullUUID {
+	if id == nil {
+		return uuid.NullUUID{Valid: false}
+	}
+	return uuid.NullUUID{UUID: *id, Valid: true}
+}
+
+func (r *postgresLoanRepository) fromNullUUID(nu uuid.NullUUID) *uuid.UUID {
+	if !nu.Valid {
+		return nil
+	}
+	return &nu.UUID
+}
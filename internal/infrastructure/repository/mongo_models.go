@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"encoding/json"
 	"time"
 
 	"bookhub/internal/domain/entity"
@@ -15,7 +16,11 @@ type userDocument struct {
 	Name         string    `bson:"name"`
 	Email        string    `bson:"email"`
 	PasswordHash string    `bson:"passwordhash"`
+	Role         string    `bson:"role"`
+	Category     string    `bson:"category"`
+	CardNumber   string    `bson:"cardnumber"`
 	Active       bool      `bson:"active"`
+	Blocked      bool      `bson:"blocked"`
 	CreatedAt    time.Time `bson:"createdat"`
 	UpdatedAt    time.Time `bson:"updatedat"`
 }
@@ -26,34 +31,56 @@ func toUserDocument(u *entity.User) *userDocument {
 		Name:         u.Name,
 		Email:        u.Email,
 		PasswordHash: u.PasswordHash,
+		Role:         u.Role,
+		Category:     u.Category,
+		CardNumber:   u.CardNumber,
 		Active:       u.Active,
+		Blocked:      u.Blocked,
 		CreatedAt:    u.CreatedAt,
 		UpdatedAt:    u.UpdatedAt,
 	}
 }
 
 func (d *userDocument) toEntity() *entity.User {
+	// Users created before roles existed have no role field
+	role := d.Role
+	if role == "" {
+		role = entity.RoleMember
+	}
+	// Likewise for users created before patron categories
+	category := d.Category
+	if category == "" {
+		category = entity.DefaultPatronCategory
+	}
+
 	return &entity.User{
 		ID:           d.ID,
 		Name:         d.Name,
 		Email:        d.Email,
 		PasswordHash: d.PasswordHash,
+		Role:         role,
+		Category:     category,
+		CardNumber:   d.CardNumber,
 		Active:       d.Active,
+		Blocked:      d.Blocked,
 		CreatedAt:    d.CreatedAt,
 		UpdatedAt:    d.UpdatedAt,
 	}
 }
 
 type bookDocument struct {
-	ID              uuid.UUID `bson:"id"`
-	Title           string    `bson:"title"`
-	Author          string    `bson:"author"`
-	ISBN            string    `bson:"isbn"`
-	PublishedYear   int       `bson:"publishedyear"`
-	TotalCopies     int       `bson:"totalcopies"`
-	AvailableCopies int       `bson:"availablecopies"`
-	CreatedAt       time.Time `bson:"createdat"`
-	UpdatedAt       time.Time `bson:"updatedat"`
+	ID              uuid.UUID  `bson:"id"`
+	Title           string     `bson:"title"`
+	Author          string     `bson:"author"`
+	ISBN            string     `bson:"isbn"`
+	PublishedYear   int        `bson:"publishedyear"`
+	Category        string     `bson:"category"`
+	TotalCopies     int        `bson:"totalcopies"`
+	AvailableCopies int        `bson:"availablecopies"`
+	CreatedAt       time.Time  `bson:"createdat"`
+	UpdatedAt       time.Time  `bson:"updatedat"`
+	Version         int        `bson:"version"`
+	WithdrawnAt     *time.Time `bson:"withdrawnat,omitempty"`
 }
 
 func toBookDocument(b *entity.Book) *bookDocument {
@@ -63,57 +90,644 @@ func toBookDocument(b *entity.Book) *bookDocument {
 		Author:          b.Author,
 		ISBN:            b.ISBN,
 		PublishedYear:   b.PublishedYear,
+		Category:        b.Category,
 		TotalCopies:     b.TotalCopies,
 		AvailableCopies: b.AvailableCopies,
 		CreatedAt:       b.CreatedAt,
 		UpdatedAt:       b.UpdatedAt,
+		Version:         b.Version,
+		WithdrawnAt:     b.WithdrawnAt,
 	}
 }
 
 func (d *bookDocument) toEntity() *entity.Book {
+	// Books created before item categories have no category field
+	category := d.Category
+	if category == "" {
+		category = entity.DefaultItemCategory
+	}
+
 	return &entity.Book{
 		ID:              d.ID,
 		Title:           d.Title,
 		Author:          d.Author,
 		ISBN:            d.ISBN,
 		PublishedYear:   d.PublishedYear,
+		Category:        category,
 		TotalCopies:     d.TotalCopies,
 		AvailableCopies: d.AvailableCopies,
 		CreatedAt:       d.CreatedAt,
 		UpdatedAt:       d.UpdatedAt,
+		Version:         d.Version,
+		WithdrawnAt:     d.WithdrawnAt,
+	}
+}
+
+type bookCopyDocument struct {
+	ID        uuid.UUID `bson:"id"`
+	BookID    uuid.UUID `bson:"bookid"`
+	BranchID  uuid.UUID `bson:"branchid"`
+	Barcode   string    `bson:"barcode"`
+	Location  string    `bson:"location"`
+	Condition string    `bson:"condition"`
+	Status    string    `bson:"status"`
+	CreatedAt time.Time `bson:"createdat"`
+	UpdatedAt time.Time `bson:"updatedat"`
+}
+
+func toBookCopyDocument(c *entity.BookCopy) *bookCopyDocument {
+	return &bookCopyDocument{
+		ID:        c.ID,
+		BookID:    c.BookID,
+		BranchID:  c.BranchID,
+		Barcode:   c.Barcode,
+		Location:  c.Location,
+		Condition: c.Condition,
+		Status:    c.Status,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func (d *bookCopyDocument) toEntity() *entity.BookCopy {
+	return &entity.BookCopy{
+		ID:        d.ID,
+		BookID:    d.BookID,
+		BranchID:  d.BranchID,
+		Barcode:   d.Barcode,
+		Location:  d.Location,
+		Condition: d.Condition,
+		Status:    d.Status,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
 	}
 }
 
 type loanDocument struct {
-	ID         uuid.UUID  `bson:"id"`
-	UserID     uuid.UUID  `bson:"userid"`
-	BookID     uuid.UUID  `bson:"bookid"`
-	BorrowedAt time.Time  `bson:"borrowedat"`
-	DueDate    time.Time  `bson:"duedate"`
-	ReturnedAt *time.Time `bson:"returnedat"`
-	Status     string     `bson:"status"`
+	ID           uuid.UUID  `bson:"id"`
+	UserID       uuid.UUID  `bson:"userid"`
+	BookID       uuid.UUID  `bson:"bookid"`
+	CopyID       *uuid.UUID `bson:"copyid"`
+	BorrowedAt   time.Time  `bson:"borrowedat"`
+	DueDate      time.Time  `bson:"duedate"`
+	ReturnedAt   *time.Time `bson:"returnedat"`
+	Status       string     `bson:"status"`
+	RenewalCount int        `bson:"renewalcount"`
 }
 
 func toLoanDocument(l *entity.Loan) *loanDocument {
 	return &loanDocument{
-		ID:         l.ID,
-		UserID:     l.UserID,
-		BookID:     l.BookID,
-		BorrowedAt: l.BorrowedAt,
-		DueDate:    l.DueDate,
-		ReturnedAt: l.ReturnedAt,
-		Status:     l.Status,
+		ID:           l.ID,
+		UserID:       l.UserID,
+		BookID:       l.BookID,
+		CopyID:       l.CopyID,
+		BorrowedAt:   l.BorrowedAt,
+		DueDate:      l.DueDate,
+		ReturnedAt:   l.ReturnedAt,
+		Status:       l.Status,
+		RenewalCount: l.RenewalCount,
 	}
 }
 
 func (d *loanDocument) toEntity() *entity.Loan {
 	return &entity.Loan{
-		ID:         d.ID,
-		UserID:     d.UserID,
-		BookID:     d.BookID,
-		BorrowedAt: d.BorrowedAt,
-		DueDate:    d.DueDate,
-		ReturnedAt: d.ReturnedAt,
-		Status:     d.Status,
+		ID:           d.ID,
+		UserID:       d.UserID,
+		BookID:       d.BookID,
+		CopyID:       d.CopyID,
+		BorrowedAt:   d.BorrowedAt,
+		DueDate:      d.DueDate,
+		ReturnedAt:   d.ReturnedAt,
+		Status:       d.Status,
+		RenewalCount: d.RenewalCount,
+	}
+}
+
+type holdDocument struct {
+	ID             uuid.UUID  `bson:"id"`
+	UserID         uuid.UUID  `bson:"userid"`
+	BookID         uuid.UUID  `bson:"bookid"`
+	Status         string     `bson:"status"`
+	CreatedAt      time.Time  `bson:"createdat"`
+	ReadyAt        *time.Time `bson:"readyat"`
+	PickupDeadline *time.Time `bson:"pickupdeadline"`
+	UpdatedAt      time.Time  `bson:"updatedat"`
+	CopyID         *uuid.UUID `bson:"copyid"`
+}
+
+func toHoldDocument(h *entity.Hold) *holdDocument {
+	return &holdDocument{
+		ID:             h.ID,
+		UserID:         h.UserID,
+		BookID:         h.BookID,
+		Status:         h.Status,
+		CreatedAt:      h.CreatedAt,
+		ReadyAt:        h.ReadyAt,
+		PickupDeadline: h.PickupDeadline,
+		UpdatedAt:      h.UpdatedAt,
+		CopyID:         h.CopyID,
+	}
+}
+
+func (d *holdDocument) toEntity() *entity.Hold {
+	return &entity.Hold{
+		ID:             d.ID,
+		UserID:         d.UserID,
+		BookID:         d.BookID,
+		Status:         d.Status,
+		CreatedAt:      d.CreatedAt,
+		ReadyAt:        d.ReadyAt,
+		PickupDeadline: d.PickupDeadline,
+		UpdatedAt:      d.UpdatedAt,
+		CopyID:         d.CopyID,
+	}
+}
+
+type fineDocument struct {
+	ID          uuid.UUID `bson:"id"`
+	UserID      uuid.UUID `bson:"userid"`
+	LoanID      uuid.UUID `bson:"loanid"`
+	Reason      string    `bson:"reason"`
+	AmountCents int64     `bson:"amountcents"`
+	PaidCents   int64     `bson:"paidcents"`
+	Status      string    `bson:"status"`
+	CreatedAt   time.Time `bson:"createdat"`
+	UpdatedAt   time.Time `bson:"updatedat"`
+}
+
+func toFineDocument(f *entity.Fine) *fineDocument {
+	return &fineDocument{
+		ID:          f.ID,
+		UserID:      f.UserID,
+		LoanID:      f.LoanID,
+		Reason:      f.Reason,
+		AmountCents: f.AmountCents,
+		PaidCents:   f.PaidCents,
+		Status:      f.Status,
+		CreatedAt:   f.CreatedAt,
+		UpdatedAt:   f.UpdatedAt,
+	}
+}
+
+func (d *fineDocument) toEntity() *entity.Fine {
+	return &entity.Fine{
+		ID:          d.ID,
+		UserID:      d.UserID,
+		LoanID:      d.LoanID,
+		Reason:      d.Reason,
+		AmountCents: d.AmountCents,
+		PaidCents:   d.PaidCents,
+		Status:      d.Status,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+type loanPolicyDocument struct {
+	ID             uuid.UUID `bson:"id"`
+	PatronCategory string    `bson:"patroncategory"`
+	ItemCategory   string    `bson:"itemcategory"`
+	MaxLoans       int       `bson:"maxloans"`
+	LoanDays       int       `bson:"loandays"`
+	MaxRenewals    int       `bson:"maxrenewals"`
+	CreatedAt      time.Time `bson:"createdat"`
+	UpdatedAt      time.Time `bson:"updatedat"`
+}
+
+func toLoanPolicyDocument(p *entity.LoanPolicy) *loanPolicyDocument {
+	return &loanPolicyDocument{
+		ID:             p.ID,
+		PatronCategory: p.PatronCategory,
+		ItemCategory:   p.ItemCategory,
+		MaxLoans:       p.MaxLoans,
+		LoanDays:       p.LoanDays,
+		MaxRenewals:    p.MaxRenewals,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}
+}
+
+func (d *loanPolicyDocument) toEntity() *entity.LoanPolicy {
+	return &entity.LoanPolicy{
+		ID:             d.ID,
+		PatronCategory: d.PatronCategory,
+		ItemCategory:   d.ItemCategory,
+		MaxLoans:       d.MaxLoans,
+		LoanDays:       d.LoanDays,
+		MaxRenewals:    d.MaxRenewals,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
+
+type branchDocument struct {
+	ID        uuid.UUID `bson:"id"`
+	Code      string    `bson:"code"`
+	Name      string    `bson:"name"`
+	Address   string    `bson:"address"`
+	CreatedAt time.Time `bson:"createdat"`
+	UpdatedAt time.Time `bson:"updatedat"`
+}
+
+func toBranchDocument(b *entity.Branch) *branchDocument {
+	return &branchDocument{
+		ID:        b.ID,
+		Code:      b.Code,
+		Name:      b.Name,
+		Address:   b.Address,
+		CreatedAt: b.CreatedAt,
+		UpdatedAt: b.UpdatedAt,
+	}
+}
+
+func (d *branchDocument) toEntity() *entity.Branch {
+	return &entity.Branch{
+		ID:        d.ID,
+		Code:      d.Code,
+		Name:      d.Name,
+		Address:   d.Address,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+type transferDocument struct {
+	ID           uuid.UUID  `bson:"id"`
+	CopyID       uuid.UUID  `bson:"copyid"`
+	BookID       uuid.UUID  `bson:"bookid"`
+	FromBranchID uuid.UUID  `bson:"frombranchid"`
+	ToBranchID   uuid.UUID  `bson:"tobranchid"`
+	Status       string     `bson:"status"`
+	RequestedAt  time.Time  `bson:"requestedat"`
+	ShippedAt    *time.Time `bson:"shippedat"`
+	ReceivedAt   *time.Time `bson:"receivedat"`
+	UpdatedAt    time.Time  `bson:"updatedat"`
+}
+
+func toTransferDocument(t *entity.Transfer) *transferDocument {
+	return &transferDocument{
+		ID:           t.ID,
+		CopyID:       t.CopyID,
+		BookID:       t.BookID,
+		FromBranchID: t.FromBranchID,
+		ToBranchID:   t.ToBranchID,
+		Status:       t.Status,
+		RequestedAt:  t.RequestedAt,
+		ShippedAt:    t.ShippedAt,
+		ReceivedAt:   t.ReceivedAt,
+		UpdatedAt:    t.UpdatedAt,
+	}
+}
+
+func (d *transferDocument) toEntity() *entity.Transfer {
+	return &entity.Transfer{
+		ID:           d.ID,
+		CopyID:       d.CopyID,
+		BookID:       d.BookID,
+		FromBranchID: d.FromBranchID,
+		ToBranchID:   d.ToBranchID,
+		Status:       d.Status,
+		RequestedAt:  d.RequestedAt,
+		ShippedAt:    d.ShippedAt,
+		ReceivedAt:   d.ReceivedAt,
+		UpdatedAt:    d.UpdatedAt,
+	}
+}
+
+type openingHoursDocument struct {
+	BranchID uuid.UUID `bson:"branchid"`
+	Weekday  int       `bson:"weekday"`
+	OpensAt  int       `bson:"opensat"`
+	ClosesAt int       `bson:"closesat"`
+}
+
+func toOpeningHoursDocument(h *entity.OpeningHours) *openingHoursDocument {
+	return &openingHoursDocument{
+		BranchID: h.BranchID,
+		Weekday:  int(h.Weekday),
+		OpensAt:  h.OpensAt,
+		ClosesAt: h.ClosesAt,
+	}
+}
+
+func (d *openingHoursDocument) toEntity() *entity.OpeningHours {
+	return &entity.OpeningHours{
+		BranchID: d.BranchID,
+		Weekday:  time.Weekday(d.Weekday),
+		OpensAt:  d.OpensAt,
+		ClosesAt: d.ClosesAt,
+	}
+}
+
+type closedDateDocument struct {
+	ID        uuid.UUID `bson:"id"`
+	BranchID  uuid.UUID `bson:"branchid"`
+	Date      time.Time `bson:"date"`
+	Reason    string    `bson:"reason"`
+	CreatedAt time.Time `bson:"createdat"`
+}
+
+func toClosedDateDocument(c *entity.ClosedDate) *closedDateDocument {
+	return &closedDateDocument{
+		ID:        c.ID,
+		BranchID:  c.BranchID,
+		Date:      c.Date,
+		Reason:    c.Reason,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func (d *closedDateDocument) toEntity() *entity.ClosedDate {
+	return &entity.ClosedDate{
+		ID:        d.ID,
+		BranchID:  d.BranchID,
+		Date:      entity.CalendarDay(d.Date.UTC()),
+		Reason:    d.Reason,
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+type dueDateAdjustmentDocument struct {
+	ID            uuid.UUID  `bson:"id"`
+	DueFrom       time.Time  `bson:"duefrom"`
+	DueTo         time.Time  `bson:"dueto"`
+	BranchID      *uuid.UUID `bson:"branchid"`
+	BookID        *uuid.UUID `bson:"bookid"`
+	ShiftDays     int        `bson:"shiftdays"`
+	NewDueDate    *time.Time `bson:"newduedate"`
+	Reason        string     `bson:"reason"`
+	LoansAdjusted int        `bson:"loansadjusted"`
+	AdjustedBy    uuid.UUID  `bson:"adjustedby"`
+	CreatedAt     time.Time  `bson:"createdat"`
+}
+
+func toDueDateAdjustmentDocument(a *entity.DueDateAdjustment) *dueDateAdjustmentDocument {
+	return &dueDateAdjustmentDocument{
+		ID:            a.ID,
+		DueFrom:       a.DueFrom,
+		DueTo:         a.DueTo,
+		BranchID:      a.BranchID,
+		BookID:        a.BookID,
+		ShiftDays:     a.ShiftDays,
+		NewDueDate:    a.NewDueDate,
+		Reason:        a.Reason,
+		LoansAdjusted: a.LoansAdjusted,
+		AdjustedBy:    a.AdjustedBy,
+		CreatedAt:     a.CreatedAt,
+	}
+}
+
+type loanNoticeDocument struct {
+	ID      uuid.UUID `bson:"id"`
+	LoanID  uuid.UUID `bson:"loanid"`
+	Kind    string    `bson:"kind"`
+	DueDate time.Time `bson:"duedate"`
+	SentAt  time.Time `bson:"sentat"`
+}
+
+func toLoanNoticeDocument(n *entity.LoanNotice) *loanNoticeDocument {
+	return &loanNoticeDocument{
+		ID:      n.ID,
+		LoanID:  n.LoanID,
+		Kind:    string(n.Kind),
+		DueDate: n.DueDate,
+		SentAt:  n.SentAt,
+	}
+}
+
+type loanEscalationDocument struct {
+	ID          uuid.UUID `bson:"id"`
+	LoanID      uuid.UUID `bson:"loanid"`
+	UserID      uuid.UUID `bson:"userid"`
+	Level       int       `bson:"level"`
+	Action      string    `bson:"action"`
+	DaysOverdue int       `bson:"daysoverdue"`
+	CreatedAt   time.Time `bson:"createdat"`
+}
+
+func toLoanEscalationDocument(e *entity.LoanEscalation) *loanEscalationDocument {
+	return &loanEscalationDocument{
+		ID:          e.ID,
+		LoanID:      e.LoanID,
+		UserID:      e.UserID,
+		Level:       e.Level,
+		Action:      string(e.Action),
+		DaysOverdue: e.DaysOverdue,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+func (d *loanEscalationDocument) toEntity() *entity.LoanEscalation {
+	return &entity.LoanEscalation{
+		ID:          d.ID,
+		LoanID:      d.LoanID,
+		UserID:      d.UserID,
+		Level:       d.Level,
+		Action:      entity.EscalationAction(d.Action),
+		DaysOverdue: d.DaysOverdue,
+		CreatedAt:   d.CreatedAt,
+	}
+}
+
+type webhookSubscriptionDocument struct {
+	ID        uuid.UUID `bson:"id"`
+	URL       string    `bson:"url"`
+	Events    []string  `bson:"events"`
+	Secret    string    `bson:"secret"`
+	Active    bool      `bson:"active"`
+	CreatedAt time.Time `bson:"createdat"`
+	UpdatedAt time.Time `bson:"updatedat"`
+}
+
+func toWebhookSubscriptionDocument(s *entity.WebhookSubscription) *webhookSubscriptionDocument {
+	events := make([]string, len(s.Events))
+	for i, eventType := range s.Events {
+		events[i] = string(eventType)
+	}
+	return &webhookSubscriptionDocument{
+		ID:        s.ID,
+		URL:       s.URL,
+		Events:    events,
+		Secret:    s.Secret,
+		Active:    s.Active,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
 	}
 }
+
+func (d *webhookSubscriptionDocument) toEntity() *entity.WebhookSubscription {
+	events := make([]entity.EventType, len(d.Events))
+	for i, eventType := range d.Events {
+		events[i] = entity.EventType(eventType)
+	}
+	return &entity.WebhookSubscription{
+		ID:        d.ID,
+		URL:       d.URL,
+		Events:    events,
+		Secret:    d.Secret,
+		Active:    d.Active,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+// webhookDeliveryDocument keeps the payload as JSON text so the delivery
+// log stays readable in the shell.
+type webhookDeliveryDocument struct {
+	ID             uuid.UUID  `bson:"id"`
+	SubscriptionID uuid.UUID  `bson:"subscriptionid"`
+	EventID        uuid.UUID  `bson:"eventid"`
+	EventType      string     `bson:"eventtype"`
+	Payload        string     `bson:"payload"`
+	Status         string     `bson:"status"`
+	Attempts       int        `bson:"attempts"`
+	NextAttemptAt  *time.Time `bson:"nextattemptat"`
+	ResponseStatus *int       `bson:"responsestatus"`
+	LastError      string     `bson:"lasterror"`
+	CreatedAt      time.Time  `bson:"createdat"`
+	UpdatedAt      time.Time  `bson:"updatedat"`
+	DeliveredAt    *time.Time `bson:"deliveredat"`
+}
+
+func toWebhookDeliveryDocument(d *entity.WebhookDelivery) *webhookDeliveryDocument {
+	return &webhookDeliveryDocument{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      string(d.EventType),
+		Payload:        string(d.Payload),
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
+func (d *webhookDeliveryDocument) toEntity() *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      entity.EventType(d.EventType),
+		Payload:        []byte(d.Payload),
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
+// outboxMessageDocument keeps the payload as JSON text, like the webhook
+// deliveries.
+type outboxMessageDocument struct {
+	ID          uuid.UUID  `bson:"id"`
+	Position    int64      `bson:"position"`
+	EventType   string     `bson:"eventtype"`
+	AggregateID uuid.UUID  `bson:"aggregateid"`
+	Payload     string     `bson:"payload"`
+	OccurredAt  time.Time  `bson:"occurredat"`
+	Attempts    int        `bson:"attempts"`
+	LastError   string     `bson:"lasterror"`
+	LockedUntil *time.Time `bson:"lockeduntil"`
+	PublishedAt *time.Time `bson:"publishedat"`
+}
+
+func toOutboxMessageDocument(m *entity.OutboxMessage) *outboxMessageDocument {
+	return &outboxMessageDocument{
+		ID:          m.ID,
+		Position:    m.Position,
+		EventType:   string(m.EventType),
+		AggregateID: m.AggregateID,
+		Payload:     string(m.Payload),
+		OccurredAt:  m.OccurredAt,
+		Attempts:    m.Attempts,
+		LastError:   m.LastError,
+		LockedUntil: m.LockedUntil,
+		PublishedAt: m.PublishedAt,
+	}
+}
+
+func (d *outboxMessageDocument) toEntity() *entity.OutboxMessage {
+	return &entity.OutboxMessage{
+		ID:          d.ID,
+		Position:    d.Position,
+		EventType:   entity.EventType(d.EventType),
+		AggregateID: d.AggregateID,
+		Payload:     []byte(d.Payload),
+		OccurredAt:  d.OccurredAt,
+		Attempts:    d.Attempts,
+		LastError:   d.LastError,
+		LockedUntil: d.LockedUntil,
+		PublishedAt: d.PublishedAt,
+	}
+}
+
+// auditEntryDocument keeps the changes as JSON text, so they hash the same
+// as when the entry was appended.
+type auditEntryDocument struct {
+	ID         uuid.UUID  `bson:"id"`
+	Sequence   int64      `bson:"sequence"`
+	ActorID    *uuid.UUID `bson:"actorid"`
+	Action     string     `bson:"action"`
+	EntityType string     `bson:"entitytype"`
+	EntityID   uuid.UUID  `bson:"entityid"`
+	Changes    string     `bson:"changes"`
+	RequestID  string     `bson:"requestid"`
+	CreatedAt  time.Time  `bson:"createdat"`
+	PrevHash   string     `bson:"prevhash"`
+	Hash       string     `bson:"hash"`
+}
+
+func toAuditEntryDocument(e *entity.AuditEntry) (*auditEntryDocument, error) {
+	changes, err := json.Marshal(e.Changes)
+	if err != nil {
+		return nil, err
+	}
+	return &auditEntryDocument{
+		ID:         e.ID,
+		Sequence:   e.Sequence,
+		ActorID:    e.ActorID,
+		Action:     e.Action,
+		EntityType: e.EntityType,
+		EntityID:   e.EntityID,
+		Changes:    string(changes),
+		RequestID:  e.RequestID,
+		CreatedAt:  e.CreatedAt,
+		PrevHash:   e.PrevHash,
+		Hash:       e.Hash,
+	}, nil
+}
+
+func (d *auditEntryDocument) toEntity() (*entity.AuditEntry, error) {
+	var changes map[string]entity.AuditChange
+	if err := json.Unmarshal([]byte(d.Changes), &changes); err != nil {
+		return nil, err
+	}
+	return &entity.AuditEntry{
+		ID:         d.ID,
+		Sequence:   d.Sequence,
+		ActorID:    d.ActorID,
+		Action:     d.Action,
+		EntityType: d.EntityType,
+		EntityID:   d.EntityID,
+		Changes:    changes,
+		RequestID:  d.RequestID,
+		CreatedAt:  d.CreatedAt.UTC(),
+		PrevHash:   d.PrevHash,
+		Hash:       d.Hash,
+	}, nil
+}
+
+// auditChainHeadDocument is the last entry of the audit chain.
+type auditChainHeadDocument struct {
+	Sequence int64  `bson:"sequence"`
+	Hash     string `bson:"hash"`
+}
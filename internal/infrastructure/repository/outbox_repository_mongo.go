@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const outboxMessagesCollection = "outbox_messages"
+
+type mongoOutboxRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoOutboxRepository(db *mongo.Database) repository.OutboxRepository {
+	return &mongoOutboxRepository{
+		collection: db.Collection(outboxMessagesCollection),
+	}
+}
+
+// Append numbers the message after the last one of its aggregate. Two
+// transactions appending to the same aggregate collide on the unique
+// (aggregateid, position) index and the later one is retried.
+func (r *mongoOutboxRepository) Append(ctx context.Context, message *entity.OutboxMessage) error {
+	opts := options.FindOne().
+		SetSort(bson.D{{Key: "position", Value: -1}}).
+		SetProjection(bson.M{"position": 1})
+
+	var last outboxMessageDocument
+	err := r.collection.FindOne(ctx, bson.M{"aggregateid": message.AggregateID}, opts).Decode(&last)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+
+	message.Position = last.Position + 1
+	_, err = r.collection.InsertOne(ctx, toOutboxMessageDocument(message))
+	return err
+}
+
+// ClaimPending finds the oldest unpublished message of each aggregate and
+// leases the aggregate by moving that message's lease with
+// FindOneAndUpdate, which is atomic per document; a relay that loses the
+// race for a head skips its aggregate.
+func (r *mongoOutboxRepository) ClaimPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.OutboxMessage, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"publishedat": nil}}},
+		{{Key: "$sort", Value: bson.D{{Key: "aggregateid", Value: 1}, {Key: "position", Value: 1}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$aggregateid", "head": bson.M{"$first": "$$ROOT"}}}},
+		{{Key: "$replaceWith", Value: "$head"}},
+		{{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{"lockeduntil": nil},
+			bson.M{"lockeduntil": bson.M{"$lte": now}},
+		}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "occurredat", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var heads []outboxMessageDocument
+	if err := cursor.All(ctx, &heads); err != nil {
+		return nil, err
+	}
+
+	messages := make([]*entity.OutboxMessage, 0, len(heads))
+	for _, head := range heads {
+		claimed, err := r.claimAggregate(ctx, head, now, leaseUntil)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, claimed...)
+	}
+	return messages, nil
+}
+
+func (r *mongoOutboxRepository) Update(ctx context.Context, message *entity.OutboxMessage) error {
+	filter := bson.M{"id": message.ID}
+	update := bson.M{
+		"$set": bson.M{
+			"attempts":    message.Attempts,
+			"lasterror":   message.LastError,
+			"lockeduntil": message.LockedUntil,
+			"publishedat": message.PublishedAt,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (r *mongoOutboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.collection.DeleteMany(ctx, bson.M{"publishedat": bson.M{"$ne": nil, "$lt": before}})
+	if err != nil {
+		return 0, err
+	}
+	return int(result.DeletedCount), nil
+}
+
+// claimAggregate leases head and then the messages queued behind it. It
+// returns nothing if another relay claimed the head first.
+func (r *mongoOutboxRepository) claimAggregate(ctx context.Context, head outboxMessageDocument, now, leaseUntil time.Time) ([]*entity.OutboxMessage, error) {
+	filter := bson.M{
+		"id":          head.ID,
+		"publishedat": nil,
+		"$or": bson.A{
+			bson.M{"lockeduntil": nil},
+			bson.M{"lockeduntil": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"lockeduntil": leaseUntil}}
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update).Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	pending := bson.M{"aggregateid": head.AggregateID, "publishedat": nil}
+	if _, err := r.collection.UpdateMany(ctx, pending, update); err != nil {
+		return nil, err
+	}
+
+	cursor, err := r.collection.Find(ctx, pending, options.Find().SetSort(bson.D{{Key: "position", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []outboxMessageDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	messages := make([]*entity.OutboxMessage, len(docs))
+	for i, doc := range docs {
+		messages[i] = doc.toEntity()
+	}
+	return messages, nil
+}
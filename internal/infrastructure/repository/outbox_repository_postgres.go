@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+)
+
+type postgresOutboxRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresOutboxRepository(db *sql.DB) repository.OutboxRepository {
+	return &postgresOutboxRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresOutboxRepository) Append(ctx context.Context, message *entity.OutboxMessage) error {
+	position, err := r.q(ctx).AppendOutboxMessage(ctx, sqlc.AppendOutboxMessageParams{
+		ID:          message.ID,
+		EventType:   string(message.EventType),
+		AggregateID: message.AggregateID,
+		Payload:     message.Payload,
+		OccurredAt:  message.OccurredAt,
+	})
+	if err != nil {
+		return err
+	}
+	message.Position = position
+	return nil
+}
+
+// ClaimPending leases in a single statement. FOR UPDATE SKIP LOCKED lets
+// relays on other replicas pass over the heads being claimed here instead
+// of waiting for them.
+func (r *postgresOutboxRepository) ClaimPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.OutboxMessage, error) {
+	rows, err := r.q(ctx).ClaimOutboxMessages(ctx, sqlc.ClaimOutboxMessagesParams{
+		LeaseUntil: sql.NullTime{Time: leaseUntil, Valid: true},
+		Now:        sql.NullTime{Time: now, Valid: true},
+		BatchSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// UPDATE ... RETURNING has no order of its own.
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].AggregateID != rows[j].AggregateID {
+			return rows[i].AggregateID.String() < rows[j].AggregateID.String()
+		}
+		return rows[i].Position < rows[j].Position
+	})
+
+	messages := make([]*entity.OutboxMessage, len(rows))
+	for i, row := range rows {
+		messages[i] = r.toMessage(row)
+	}
+	return messages, nil
+}
+
+func (r *postgresOutboxRepository) Update(ctx context.Context, message *entity.OutboxMessage) error {
+	return r.q(ctx).UpdateOutboxMessage(ctx, sqlc.UpdateOutboxMessageParams{
+		ID:          message.ID,
+		Attempts:    int32(message.Attempts),
+		LastError:   message.LastError,
+		LockedUntil: r.toNullTime(message.LockedUntil),
+		PublishedAt: r.toNullTime(message.PublishedAt),
+	})
+}
+
+func (r *postgresOutboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int, error) {
+	count, err := r.q(ctx).DeletePublishedOutboxMessages(ctx, sql.NullTime{Time: before, Valid: true})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresOutboxRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresOutboxRepository) toMessage(row sqlc.OutboxMessage) *entity.OutboxMessage {
+	return &entity.OutboxMessage{
+		ID:          row.ID,
+		Position:    row.Position,
+		EventType:   entity.EventType(row.EventType),
+		AggregateID: row.AggregateID,
+		Payload:     row.Payload,
+		OccurredAt:  row.OccurredAt,
+		Attempts:    int(row.Attempts),
+		LastError:   row.LastError,
+		LockedUntil: r.fromNullTime(row.LockedUntil),
+		PublishedAt: r.fromNullTime(row.PublishedAt),
+	}
+}
+
+func (r *postgresOutboxRepository) toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{Valid: false}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func (r *postgresOutboxRepository) fromNullTime(nt sql.NullTime) *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+	return &nt.Time
+}
@@ -0,0 +1,133 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOutboxMessage(t *testing.T, eventType entity.EventType, aggregateID uuid.UUID) *entity.OutboxMessage {
+	t.Helper()
+	message, err := entity.NewOutboxMessage(entity.NewEvent(eventType, aggregateID, map[string]string{"id": aggregateID.String()}))
+	require.NoError(t, err)
+	return message
+}
+
+func TestPostgresOutboxRepository_ClaimPending(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresOutboxRepository(PostgresTestDB)
+
+	loanID, bookID := uuid.New(), uuid.New()
+	borrowed := newTestOutboxMessage(t, entity.EventLoanBorrowed, loanID)
+	created := newTestOutboxMessage(t, entity.EventBookCreated, bookID)
+	returned := newTestOutboxMessage(t, entity.EventLoanReturned, loanID)
+	require.NoError(t, repo.Append(ctx, borrowed))
+	require.NoError(t, repo.Append(ctx, created))
+	require.NoError(t, repo.Append(ctx, returned))
+	assert.Less(t, borrowed.Position, returned.Position)
+
+	now := time.Now()
+	claimed, err := repo.ClaimPending(ctx, now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 3)
+
+	// Each aggregate's messages come together, in position order
+	var loanMessages []uuid.UUID
+	for _, message := range claimed {
+		if message.AggregateID == loanID {
+			loanMessages = append(loanMessages, message.ID)
+		}
+		if message.ID == borrowed.ID {
+			assert.JSONEq(t, `{"id":"`+loanID.String()+`"}`, string(message.Payload))
+		}
+	}
+	assert.Equal(t, []uuid.UUID{borrowed.ID, returned.ID}, loanMessages)
+
+	// The lease keeps other relays off the claimed aggregates
+	claimed, err = repo.ClaimPending(ctx, now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	// A failed head holds back the rest of its aggregate until it is retried
+	borrowed.Fail("broker unavailable", now.Add(time.Hour))
+	require.NoError(t, repo.Update(ctx, borrowed))
+	created.Publish(now)
+	require.NoError(t, repo.Update(ctx, created))
+
+	later := now.Add(2 * time.Minute)
+	claimed, err = repo.ClaimPending(ctx, later, later.Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	retry := now.Add(2 * time.Hour)
+	claimed, err = repo.ClaimPending(ctx, retry, retry.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 2)
+	assert.Equal(t, borrowed.ID, claimed[0].ID)
+	assert.Equal(t, 1, claimed[0].Attempts)
+	assert.Equal(t, "broker unavailable", claimed[0].LastError)
+}
+
+func TestPostgresOutboxRepository_AppendJoinsTransaction(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresOutboxRepository(PostgresTestDB)
+	txManager := repository.NewPostgresTxManager(PostgresTestDB)
+
+	errAbort := errors.New("abort")
+	err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := repo.Append(ctx, newTestOutboxMessage(t, entity.EventUserDisabled, uuid.New())); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	require.ErrorIs(t, err, errAbort)
+
+	now := time.Now()
+	claimed, err := repo.ClaimPending(ctx, now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+}
+
+func TestPostgresOutboxRepository_DeletePublishedBefore(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresOutboxRepository(PostgresTestDB)
+
+	old := newTestOutboxMessage(t, entity.EventBookCreated, uuid.New())
+	recent := newTestOutboxMessage(t, entity.EventBookCreated, uuid.New())
+	pending := newTestOutboxMessage(t, entity.EventBookCreated, uuid.New())
+	require.NoError(t, repo.Append(ctx, old))
+	require.NoError(t, repo.Append(ctx, recent))
+	require.NoError(t, repo.Append(ctx, pending))
+
+	now := time.Now()
+	old.Publish(now.Add(-48 * time.Hour))
+	require.NoError(t, repo.Update(ctx, old))
+	recent.Publish(now)
+	require.NoError(t, repo.Update(ctx, recent))
+
+	deleted, err := repo.DeletePublishedBefore(ctx, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	// The pending message is still there to be published
+	claimed, err := repo.ClaimPending(ctx, now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, pending.ID, claimed[0].ID)
+}
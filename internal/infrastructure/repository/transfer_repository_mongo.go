@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const transfersCollection = "transfers"
+
+type mongoTransferRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoTransferRepository(db *mongo.Database) repository.TransferRepository {
+	return &mongoTransferRepository{
+		collection: db.Collection(transfersCollection),
+	}
+}
+
+func (r *mongoTransferRepository) Create(ctx context.Context, transfer *entity.Transfer) error {
+	doc := toTransferDocument(transfer)
+	_, err := r.collection.InsertOne(ctx, doc)
+	return err
+}
+
+func (r *mongoTransferRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	return r.findOne(ctx, bson.M{"id": id})
+}
+
+func (r *mongoTransferRepository) GetOpenByCopy(ctx context.Context, copyID uuid.UUID) (*entity.Transfer, error) {
+	return r.findOne(ctx, bson.M{
+		"copyid": copyID,
+		"status": bson.M{"$in": []string{entity.TransferStatusRequested, entity.TransferStatusInTransit}},
+	})
+}
+
+func (r *mongoTransferRepository) List(ctx context.Context, page, limit int, filter repository.TransferFilter) ([]*entity.Transfer, int, error) {
+	skip := int64((page - 1) * limit)
+	limitInt64 := int64(limit)
+
+	query := bson.M{}
+	if filter.BranchID != nil {
+		query["$or"] = bson.A{
+			bson.M{"frombranchid": *filter.BranchID},
+			bson.M{"tobranchid": *filter.BranchID},
+		}
+	}
+	if filter.Status != nil {
+		query["status"] = *filter.Status
+	}
+
+	opts := options.Find().
+		SetSkip(skip).
+		SetLimit(limitInt64).
+		SetSort(bson.D{{Key: "requestedat", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []transferDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, 0, err
+	}
+
+	transfers := make([]*entity.Transfer, len(docs))
+	for i, doc := range docs {
+		transfers[i] = doc.toEntity()
+	}
+
+	count, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return transfers, int(count), nil
+}
+
+func (r *mongoTransferRepository) Update(ctx context.Context, transfer *entity.Transfer) error {
+	filter := bson.M{"id": transfer.ID}
+	update := bson.M{
+		"$set": bson.M{
+			"status":     transfer.Status,
+			"shippedat":  transfer.ShippedAt,
+			"receivedat": transfer.ReceivedAt,
+			"updatedat":  transfer.UpdatedAt,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (r *mongoTransferRepository) findOne(ctx context.Context, filter bson.M) (*entity.Transfer, error) {
+	var doc transferDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresTransferRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresTransferRepository(db *sql.DB) repository.TransferRepository {
+	return &postgresTransferRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresTransferRepository) Create(ctx context.Context, transfer *entity.Transfer) error {
+	_, err := r.q(ctx).CreateTransfer(ctx, sqlc.CreateTransferParams{
+		ID:           transfer.ID,
+		CopyID:       transfer.CopyID,
+		BookID:       transfer.BookID,
+		FromBranchID: transfer.FromBranchID,
+		ToBranchID:   transfer.ToBranchID,
+		Status:       transfer.Status,
+		RequestedAt:  transfer.RequestedAt,
+		ShippedAt:    r.toNullTime(transfer.ShippedAt),
+		ReceivedAt:   r.toNullTime(transfer.ReceivedAt),
+		UpdatedAt:    transfer.UpdatedAt,
+	})
+	return err
+}
+
+func (r *postgresTransferRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	row, err := r.q(ctx).GetTransferByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresTransferRepository) GetOpenByCopy(ctx context.Context, copyID uuid.UUID) (*entity.Transfer, error) {
+	row, err := r.q(ctx).GetOpenTransferByCopy(ctx, copyID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresTransferRepository) List(ctx context.Context, page, limit int, filter repository.TransferFilter) ([]*entity.Transfer, int, error) {
+	offset := (page - 1) * limit
+
+	branchID := r.toNullUUID(filter.BranchID)
+	var status sql.NullString
+	if filter.Status != nil {
+		status = sql.NullString{String: *filter.Status, Valid: true}
+	}
+
+	rows, err := r.q(ctx).ListTransfers(ctx, sqlc.ListTransfersParams{
+		Limit:    int32(limit),
+		Offset:   int32(offset),
+		BranchID: branchID,
+		Status:   status,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := r.q(ctx).CountTransfers(ctx, sqlc.CountTransfersParams{
+		BranchID: branchID,
+		Status:   status,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	transfers := make([]*entity.Transfer, len(rows))
+	for i, row := range rows {
+		transfers[i] = r.toEntity(row)
+	}
+
+	return transfers, int(count), nil
+}
+
+func (r *postgresTransferRepository) Update(ctx context.Context, transfer *entity.Transfer) error {
+	_, err := r.q(ctx).UpdateTransfer(ctx, sqlc.UpdateTransferParams{
+		ID:         transfer.ID,
+		Status:     transfer.Status,
+		ShippedAt:  r.toNullTime(transfer.ShippedAt),
+		ReceivedAt: r.toNullTime(transfer.ReceivedAt),
+		UpdatedAt:  transfer.UpdatedAt,
+	})
+	return err
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresTransferRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresTransferRepository) toEntity(row sqlc.Transfer) *entity.Transfer {
+	return &entity.Transfer{
+		ID:           row.ID,
+		CopyID:       row.CopyID,
+		BookID:       row.BookID,
+		FromBranchID: row.FromBranchID,
+		ToBranchID:   row.ToBranchID,
+		Status:       row.Status,
+		RequestedAt:  row.RequestedAt,
+		ShippedAt:    r.fromNullTime(row.ShippedAt),
+		ReceivedAt:   r.fromNullTime(row.ReceivedAt),
+		UpdatedAt:    row.UpdatedAt,
+	}
+}
+
+func (r *postgresTransferRepository) toNullUUID(id *uuid.UUID) uuid.NullUUID {
+	if id == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *id, Valid: true}
+}
+
+func (r *postgresTransferRepository) toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{Valid: false}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func (r *postgresTransferRepository) fromNullTime(nt sql.NullTime) *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+	return &nt.Time
+}
@@ -0,0 +1,121 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPostgresTransferCopy stores a copy shelved at a new branch and a second
+// branch it can be sent to.
+func setupPostgresTransferCopy(t *testing.T) (*entity.BookCopy, *entity.Branch, *entity.Branch) {
+	ctx := context.Background()
+	branchRepo := repository.NewPostgresBranchRepository(PostgresTestDB)
+
+	book := CreateTestBook("Test Book", "Author", "1234567890")
+	require.NoError(t, repository.NewPostgresBookRepository(PostgresTestDB).Create(ctx, book))
+	north := CreateTestBranch("NORTH", "North Branch")
+	south := CreateTestBranch("SOUTH", "South Branch")
+	require.NoError(t, branchRepo.Create(ctx, north))
+	require.NoError(t, branchRepo.Create(ctx, south))
+
+	bookCopy, err := entity.NewBookCopy(book.ID, north.ID, "BC-001", "", "")
+	require.NoError(t, err)
+	require.NoError(t, repository.NewPostgresBookCopyRepository(PostgresTestDB).Create(ctx, bookCopy))
+
+	return bookCopy, north, south
+}
+
+func TestPostgresTransferRepository_Lifecycle(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresTransferRepository(PostgresTestDB)
+	bookCopy, north, south := setupPostgresTransferCopy(t)
+
+	transfer, err := entity.NewTransfer(bookCopy, south.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, transfer))
+
+	retrieved, err := repo.GetByID(ctx, transfer.ID)
+	assert.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, bookCopy.BookID, retrieved.BookID)
+	assert.Equal(t, north.ID, retrieved.FromBranchID)
+	assert.Equal(t, south.ID, retrieved.ToBranchID)
+	assert.Equal(t, entity.TransferStatusRequested, retrieved.Status)
+	assert.Nil(t, retrieved.ShippedAt)
+
+	open, err := repo.GetOpenByCopy(ctx, bookCopy.ID)
+	assert.NoError(t, err)
+	require.NotNil(t, open)
+	assert.Equal(t, transfer.ID, open.ID)
+
+	require.NoError(t, retrieved.Ship())
+	require.NoError(t, repo.Update(ctx, retrieved))
+	require.NoError(t, retrieved.Receive())
+	require.NoError(t, repo.Update(ctx, retrieved))
+
+	retrieved, err = repo.GetByID(ctx, transfer.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TransferStatusReceived, retrieved.Status)
+	assert.NotNil(t, retrieved.ShippedAt)
+	assert.NotNil(t, retrieved.ReceivedAt)
+
+	open, err = repo.GetOpenByCopy(ctx, bookCopy.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, open)
+}
+
+func TestPostgresTransferRepository_GetByIDNotFound(t *testing.T) {
+	CleanupPostgres(t)
+
+	repo := repository.NewPostgresTransferRepository(PostgresTestDB)
+
+	retrieved, err := repo.GetByID(context.Background(), uuid.New())
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestPostgresTransferRepository_List(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresTransferRepository(PostgresTestDB)
+	bookCopy, north, south := setupPostgresTransferCopy(t)
+
+	cancelled, err := entity.NewTransfer(bookCopy, south.ID)
+	require.NoError(t, err)
+	require.NoError(t, cancelled.Cancel())
+	require.NoError(t, repo.Create(ctx, cancelled))
+
+	requested, err := entity.NewTransfer(bookCopy, south.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, requested))
+
+	result, count, err := repo.List(ctx, 1, 10, domainrepo.TransferFilter{BranchID: &north.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Len(t, result, 2)
+
+	status := entity.TransferStatusRequested
+	result, count, err = repo.List(ctx, 1, 10, domainrepo.TransferFilter{BranchID: &south.ID, Status: &status})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, result, 1)
+	assert.Equal(t, requested.ID, result[0].ID)
+
+	other := uuid.New()
+	_, count, err = repo.List(ctx, 1, 10, domainrepo.TransferFilter{BranchID: &other})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
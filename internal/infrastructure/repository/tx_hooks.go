@@ -0,0 +1,34 @@
+package repository
+
+import "context"
+
+type txHooksKey struct{}
+
+// txHooks collects writes that must be the last ones of a transaction. The
+// transaction managers run them after the unit of work and just before
+// commit, so any lock they take is held only for the commit itself and is
+// always taken after the locks of the rest of the transaction.
+type txHooks struct {
+	fns []func(ctx context.Context) error
+}
+
+// run calls the hooks in the order they were added, including any added by
+// the hooks themselves.
+func (h *txHooks) run(ctx context.Context) error {
+	for i := 0; i < len(h.fns); i++ {
+		if err := h.fns[i](ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// beforeCommit runs fn at the end of the transaction carried by ctx, or
+// right away when there is none.
+func beforeCommit(ctx context.Context, fn func(ctx context.Context) error) error {
+	if hooks, ok := ctx.Value(txHooksKey{}).(*txHooks); ok {
+		hooks.fns = append(hooks.fns, fn)
+		return nil
+	}
+	return fn(ctx)
+}
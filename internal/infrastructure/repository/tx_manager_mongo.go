@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"bookhub/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// mongoTxManager runs units of work inside a session transaction. The mongo
+// driver picks the session up from the context, so the Mongo repositories need
+// no changes to take part in it. Transactions require a replica set.
+type mongoTxManager struct {
+	client *mongo.Client
+}
+
+func NewMongoTxManager(db *mongo.Database) repository.TxManager {
+	return &mongoTxManager{
+		client: db.Client(),
+	}
+}
+
+func (m *mongoTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Nested calls join the transaction that is already in progress
+	if mongo.SessionFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	session, err := m.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		// Each attempt starts with no hooks, as the driver may retry it
+		hooks := &txHooks{}
+		txCtx := context.WithValue(sc, txHooksKey{}, hooks)
+		if err := fn(txCtx); err != nil {
+			return nil, err
+		}
+		return nil, hooks.run(txCtx)
+	})
+	return err
+}
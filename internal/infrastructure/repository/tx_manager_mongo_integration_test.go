@@ -0,0 +1,114 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestMongoTxManager_Commit(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	userRepo := repository.NewMongoUserRepository(MongoTestDB)
+	bookRepo := repository.NewMongoBookRepository(MongoTestDB)
+	loanRepo := repository.NewMongoLoanRepository(MongoTestDB)
+	txManager := repository.NewMongoTxManager(MongoTestDB)
+
+	user := CreateTestUser("Tx Commit User", "txcommitmongo@example.com")
+	book := CreateTestBook("Tx Commit Book", "Author", "1515151515")
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, bookRepo.Create(ctx, book))
+
+	loan := CreateTestLoan(user.ID, book.ID)
+	err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		book.AvailableCopies--
+		if err := bookRepo.Update(ctx, book); err != nil {
+			return err
+		}
+		return loanRepo.Create(ctx, loan)
+	})
+	assert.NoError(t, err)
+
+	retrievedBook, err := bookRepo.GetByID(ctx, book.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, retrievedBook.AvailableCopies)
+
+	retrievedLoan, err := loanRepo.GetByID(ctx, loan.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, retrievedLoan)
+}
+
+func TestMongoTxManager_RollbackWhenSecondWriteFails(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	userRepo := repository.NewMongoUserRepository(MongoTestDB)
+	bookRepo := repository.NewMongoBookRepository(MongoTestDB)
+	loanRepo := repository.NewMongoLoanRepository(MongoTestDB)
+	txManager := repository.NewMongoTxManager(MongoTestDB)
+
+	user := CreateTestUser("Tx Rollback User", "txrollbackmongo@example.com")
+	book := CreateTestBook("Tx Rollback Book", "Author", "1616161616")
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, bookRepo.Create(ctx, book))
+
+	_, err := MongoTestDB.Collection("loans").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	require.NoError(t, err)
+
+	existing := CreateTestLoan(user.ID, book.ID)
+	require.NoError(t, loanRepo.Create(ctx, existing))
+
+	err = txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		book.AvailableCopies--
+		if err := bookRepo.Update(ctx, book); err != nil {
+			return err
+		}
+
+		// Reusing the unique loan id makes the insert fail after the book was updated
+		duplicate := CreateTestLoan(user.ID, book.ID)
+		duplicate.ID = existing.ID
+		return loanRepo.Create(ctx, duplicate)
+	})
+	assert.Error(t, err)
+
+	retrieved, err := bookRepo.GetByID(ctx, book.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, retrieved.AvailableCopies)
+}
+
+func TestMongoTxManager_RollbackOnError(t *testing.T) {
+	CleanupMongo(t)
+
+	ctx := context.Background()
+	bookRepo := repository.NewMongoBookRepository(MongoTestDB)
+	txManager := repository.NewMongoTxManager(MongoTestDB)
+
+	book := CreateTestBook("Tx Error Book", "Author", "1717171717")
+	wantErr := errors.New("abort")
+
+	err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := bookRepo.Create(ctx, book); err != nil {
+			return err
+		}
+		return wantErr
+	})
+	assert.ErrorIs(t, err, wantErr)
+
+	retrieved, err := bookRepo.GetByID(ctx, book.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
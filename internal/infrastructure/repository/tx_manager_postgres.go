@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+)
+
+type postgresTxKey struct{}
+
+type postgresTxManager struct {
+	db *sql.DB
+}
+
+func NewPostgresTxManager(db *sql.DB) repository.TxManager {
+	return &postgresTxManager{
+		db: db,
+	}
+}
+
+func (m *postgresTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Nested calls join the transaction that is already in progress
+	if _, ok := ctx.Value(postgresTxKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	hooks := &txHooks{}
+	txCtx := context.WithValue(context.WithValue(ctx, postgresTxKey{}, tx), txHooksKey{}, hooks)
+	err = fn(txCtx)
+	if err == nil {
+		err = hooks.run(txCtx)
+	}
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// queriesFromContext binds q to the transaction carried by ctx, if there is one.
+func queriesFromContext(ctx context.Context, q *sqlc.Queries) *sqlc.Queries {
+	if tx, ok := ctx.Value(postgresTxKey{}).(*sql.Tx); ok {
+		return q.WithTx(tx)
+	}
+	return q
+}
+
+// dbFromContext returns the transaction carried by ctx, or db when there is
+// none, for queries built at run time.
+func dbFromContext(ctx context.Context, db *sql.DB) sqlc.DBTX {
+	if tx, ok := ctx.Value(postgresTxKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
@@ -0,0 +1,105 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresTxManager_Commit(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	userRepo := repository.NewPostgresUserRepository(PostgresTestDB)
+	bookRepo := repository.NewPostgresBookRepository(PostgresTestDB)
+	loanRepo := repository.NewPostgresLoanRepository(PostgresTestDB)
+	txManager := repository.NewPostgresTxManager(PostgresTestDB)
+
+	user := CreateTestUser("Tx Commit User PG", "txcommitpg@example.com")
+	book := CreateTestBook("Tx Commit Book PG", "Author", "1212121212")
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, bookRepo.Create(ctx, book))
+
+	loan := CreateTestLoan(user.ID, book.ID)
+	err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		book.AvailableCopies--
+		if err := bookRepo.Update(ctx, book); err != nil {
+			return err
+		}
+		return loanRepo.Create(ctx, loan)
+	})
+	assert.NoError(t, err)
+
+	retrievedBook, err := bookRepo.GetByID(ctx, book.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, retrievedBook.AvailableCopies)
+
+	retrievedLoan, err := loanRepo.GetByID(ctx, loan.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, retrievedLoan)
+}
+
+func TestPostgresTxManager_RollbackWhenSecondWriteFails(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	userRepo := repository.NewPostgresUserRepository(PostgresTestDB)
+	bookRepo := repository.NewPostgresBookRepository(PostgresTestDB)
+	loanRepo := repository.NewPostgresLoanRepository(PostgresTestDB)
+	txManager := repository.NewPostgresTxManager(PostgresTestDB)
+
+	user := CreateTestUser("Tx Rollback User PG", "txrollbackpg@example.com")
+	book := CreateTestBook("Tx Rollback Book PG", "Author", "1313131313")
+	require.NoError(t, userRepo.Create(ctx, user))
+	require.NoError(t, bookRepo.Create(ctx, book))
+
+	existing := CreateTestLoan(user.ID, book.ID)
+	require.NoError(t, loanRepo.Create(ctx, existing))
+
+	err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		book.AvailableCopies--
+		if err := bookRepo.Update(ctx, book); err != nil {
+			return err
+		}
+
+		// Reusing the primary key makes the insert fail after the book was updated
+		duplicate := CreateTestLoan(user.ID, book.ID)
+		duplicate.ID = existing.ID
+		return loanRepo.Create(ctx, duplicate)
+	})
+	assert.Error(t, err)
+
+	retrieved, err := bookRepo.GetByID(ctx, book.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, retrieved.AvailableCopies)
+}
+
+func TestPostgresTxManager_RollbackOnError(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	bookRepo := repository.NewPostgresBookRepository(PostgresTestDB)
+	txManager := repository.NewPostgresTxManager(PostgresTestDB)
+
+	book := CreateTestBook("Tx Error Book PG", "Author", "1414141414")
+	wantErr := errors.New("abort")
+
+	err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := bookRepo.Create(ctx, book); err != nil {
+			return err
+		}
+		return wantErr
+	})
+	assert.ErrorIs(t, err, wantErr)
+
+	retrieved, err := bookRepo.GetByID(ctx, book.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
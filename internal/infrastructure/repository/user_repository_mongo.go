@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"regexp"
 
 	"bookhub/internal/domain/entity"
 	"bookhub/internal/domain/repository"
@@ -15,6 +16,13 @@ import (
 
 const usersCollection = "users"
 
+// userSortKeys maps the repository.UserSortFields to document keys.
+var userSortKeys = map[string]string{
+	"name":       "name",
+	"email":      "email",
+	"created_at": "createdat",
+}
+
 type mongoUserRepository struct {
 	collection *mongo.Collection
 }
@@ -55,18 +63,41 @@ func (r *mongoUserRepository) GetByEmail(ctx context.Context, email string) (*en
 	return doc.toEntity(), nil
 }
 
-func (r *mongoUserRepository) List(ctx context.Context, page, limit int) ([]*entity.User, int, error) {
+func (r *mongoUserRepository) GetByCardNumber(ctx context.Context, cardNumber string) (*entity.User, error) {
+	var doc userDocument
+	err := r.collection.FindOne(ctx, bson.M{"cardnumber": cardNumber}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
+
+func (r *mongoUserRepository) List(ctx context.Context, page, limit int, filter repository.UserFilter) ([]*entity.User, int, error) {
 	skip := int64((page - 1) * limit)
 	limitInt64 := int64(limit)
 
-	filter := bson.M{}
+	query := bson.M{}
+	if filter.Active != nil {
+		query["active"] = *filter.Active
+	}
+	if filter.Query != "" {
+		contains := bson.M{"$regex": regexp.QuoteMeta(filter.Query), "$options": "i"}
+		query["$or"] = bson.A{bson.M{"name": contains}, bson.M{"email": contains}}
+	}
+	sort, err := sortDocument(filter.Sort, userDefaultSort, userSortKeys)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	opts := options.Find().
 		SetSkip(skip).
 		SetLimit(limitInt64).
-		SetSort(bson.D{{Key: "createdat", Value: -1}})
+		SetSort(sort)
 
-	cursor, err := r.collection.Find(ctx, filter, opts)
+	cursor, err := r.collection.Find(ctx, query, opts)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -82,7 +113,7 @@ func (r *mongoUserRepository) List(ctx context.Context, page, limit int) ([]*ent
 		users[i] = doc.toEntity()
 	}
 
-	count, err := r.collection.CountDocuments(ctx, filter)
+	count, err := r.collection.CountDocuments(ctx, query)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -94,10 +125,15 @@ func (r *mongoUserRepository) Update(ctx context.Context, user *entity.User) err
 	filter := bson.M{"id": user.ID}
 	update := bson.M{
 		"$set": bson.M{
-			"name":      user.Name,
-			"email":     user.Email,
-			"active":    user.Active,
-			"updatedat": user.UpdatedAt,
+			"name":         user.Name,
+			"email":        user.Email,
+			"passwordhash": user.PasswordHash,
+			"role":         user.Role,
+			"category":     user.Category,
+			"cardnumber":   user.CardNumber,
+			"active":       user.Active,
+			"blocked":      user.Blocked,
+			"updatedat":    user.UpdatedAt,
 		},
 	}
 
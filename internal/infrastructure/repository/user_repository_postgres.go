@@ -11,18 +11,30 @@ import (
 	"github.com/google/uuid"
 )
 
+// userColumns are the users columns in the order sqlc.User is scanned.
+const userColumns = "id, name, email, password_hash, active, created_at, updated_at, role, category, card_number, blocked"
+
+// userSortColumns maps the repository.UserSortFields to columns.
+var userSortColumns = map[string]sortColumn{
+	"name":       {name: "name"},
+	"email":      {name: "email"},
+	"created_at": {name: "created_at"},
+}
+
 type postgresUserRepository struct {
+	db      *sql.DB
 	queries *sqlc.Queries
 }
 
 func NewPostgresUserRepository(db *sql.DB) repository.UserRepository {
 	return &postgresUserRepository{
+		db:      db,
 		queries: sqlc.New(db),
 	}
 }
 
 func (r *postgresUserRepository) Create(ctx context.Context, user *entity.User) error {
-	_, err := r.queries.CreateUser(ctx, sqlc.CreateUserParams{
+	_, err := r.q(ctx).CreateUser(ctx, sqlc.CreateUserParams{
 		ID:           user.ID,
 		Name:         user.Name,
 		Email:        user.Email,
@@ -30,12 +42,16 @@ func (r *postgresUserRepository) Create(ctx context.Context, user *entity.User)
 		Active:       user.Active,
 		CreatedAt:    user.CreatedAt,
 		UpdatedAt:    user.UpdatedAt,
+		Role:         user.Role,
+		Category:     user.Category,
+		CardNumber:   user.CardNumber,
+		Blocked:      user.Blocked,
 	})
 	return err
 }
 
 func (r *postgresUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
-	row, err := r.queries.GetUserByID(ctx, id)
+	row, err := r.q(ctx).GetUserByID(ctx, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -46,7 +62,18 @@ func (r *postgresUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*en
 }
 
 func (r *postgresUserRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
-	row, err := r.queries.GetUserByEmail(ctx, email)
+	row, err := r.q(ctx).GetUserByEmail(ctx, email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(row), nil
+}
+
+func (r *postgresUserRepository) GetByCardNumber(ctx context.Context, cardNumber string) (*entity.User, error) {
+	row, err := r.q(ctx).GetUserByCardNumber(ctx, cardNumber)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -56,43 +83,86 @@ func (r *postgresUserRepository) GetByEmail(ctx context.Context, email string) (
 	return r.toEntity(row), nil
 }
 
-func (r *postgresUserRepository) List(ctx context.Context, page, limit int) ([]*entity.User, int, error) {
+func (r *postgresUserRepository) List(ctx context.Context, page, limit int, filter repository.UserFilter) ([]*entity.User, int, error) {
 	offset := (page - 1) * limit
 
-	rows, err := r.queries.ListUsers(ctx, sqlc.ListUsersParams{
-		Limit:  int32(limit),
-		Offset: int32(offset),
-	})
+	orderBy, err := orderByClause(filter.Sort, userDefaultSort, userSortColumns, "id")
 	if err != nil {
 		return nil, 0, err
 	}
 
-	count, err := r.queries.CountUsers(ctx)
+	q := newListQuery("users")
+	if filter.Active != nil {
+		q.where("active = ?", *filter.Active)
+	}
+	if filter.Query != "" {
+		q.where("(name ILIKE '%' || ?::text || '%' OR email ILIKE '%' || ?::text || '%')", escapeLike(filter.Query), escapeLike(filter.Query))
+	}
+
+	db := dbFromContext(ctx, r.db)
+	query, args := q.selectPage(userColumns, orderBy, limit, offset)
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, 0, err
 	}
+	defer rows.Close()
+
+	var users []*entity.User
+	for rows.Next() {
+		var row sqlc.User
+		if err := rows.Scan(
+			&row.ID,
+			&row.Name,
+			&row.Email,
+			&row.PasswordHash,
+			&row.Active,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+			&row.Role,
+			&row.Category,
+			&row.CardNumber,
+			&row.Blocked,
+		); err != nil {
+			return nil, 0, err
+		}
+		users = append(users, r.toEntity(row))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
 
-	users := make([]*entity.User, len(rows))
-	for i, row := range rows {
-		users[i] = r.toEntity(row)
+	var count int
+	query, args = q.count()
+	if err := db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return nil, 0, err
 	}
 
-	return users, int(count), nil
+	return users, count, nil
 }
 
 func (r *postgresUserRepository) Update(ctx context.Context, user *entity.User) error {
-	_, err := r.queries.UpdateUser(ctx, sqlc.UpdateUserParams{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		Active:    user.Active,
-		UpdatedAt: user.UpdatedAt,
+	_, err := r.q(ctx).UpdateUser(ctx, sqlc.UpdateUserParams{
+		ID:           user.ID,
+		Name:         user.Name,
+		Email:        user.Email,
+		Active:       user.Active,
+		UpdatedAt:    user.UpdatedAt,
+		Role:         user.Role,
+		PasswordHash: user.PasswordHash,
+		Category:     user.Category,
+		CardNumber:   user.CardNumber,
+		Blocked:      user.Blocked,
 	})
 	return err
 }
 
 func (r *postgresUserRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.queries.DeleteUser(ctx, id)
+	return r.q(ctx).DeleteUser(ctx, id)
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresUserRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
 }
 
 func (r *postgresUserRepository) toEntity(row sqlc.User) *entity.User {
@@ -101,7 +171,11 @@ func (r *postgresUserRepository) toEntity(row sqlc.User) *entity.User {
 		Name:         row.Name,
 		Email:        row.Email,
 		PasswordHash: row.PasswordHash,
+		Role:         row.Role,
+		Category:     row.Category,
+		CardNumber:   row.CardNumber,
 		Active:       row.Active,
+		Blocked:      row.Blocked,
 		CreatedAt:    row.CreatedAt,
 		UpdatedAt:    row.UpdatedAt,
 	}
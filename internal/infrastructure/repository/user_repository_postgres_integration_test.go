@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"bookhub/internal/domain/entity"
+	domainrepo "bookhub/internal/domain/repository"
 	"bookhub/internal/infrastructure/repository"
 
 	"github.com/google/uuid"
@@ -73,6 +74,26 @@ func TestPostgresUserRepository_GetByEmail(t *testing.T) {
 	assert.Nil(t, nonExistent)
 }
 
+func TestPostgresUserRepository_GetByCardNumber(t *testing.T) {
+	CleanupPostgres(t)
+
+	repo := repository.NewPostgresUserRepository(PostgresTestDB)
+	ctx := context.Background()
+
+	user := CreateTestUser("GetByCardNumber User PG", "getbycardpg@example.com")
+	err := repo.Create(ctx, user)
+	require.NoError(t, err)
+
+	retrieved, err := repo.GetByCardNumber(ctx, user.CardNumber)
+	assert.NoError(t, err)
+	assert.NotNil(t, retrieved)
+	assert.Equal(t, user.ID, retrieved.ID)
+
+	nonExistent, err := repo.GetByCardNumber(ctx, "NO-SUCH-CARD")
+	assert.NoError(t, err)
+	assert.Nil(t, nonExistent)
+}
+
 func TestPostgresUserRepository_List(t *testing.T) {
 	CleanupPostgres(t)
 
@@ -90,12 +111,12 @@ func TestPostgresUserRepository_List(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	result, count, err := repo.List(ctx, 1, 10)
+	result, count, err := repo.List(ctx, 1, 10, domainrepo.UserFilter{})
 	assert.NoError(t, err)
 	assert.Equal(t, 3, count)
 	assert.Len(t, result, 3)
 
-	result, count, err = repo.List(ctx, 1, 2)
+	result, count, err = repo.List(ctx, 1, 2, domainrepo.UserFilter{})
 	assert.NoError(t, err)
 	assert.Equal(t, 3, count)
 	assert.Len(t, result, 2)
@@ -114,6 +135,8 @@ func TestPostgresUserRepository_Update(t *testing.T) {
 	user.Name = "Updated Name PG"
 	user.Email = "updatedpg@example.com"
 	user.Active = false
+	user.Role = entity.RoleLibrarian
+	user.PasswordHash = "newhashedpassword"
 	user.UpdatedAt = time.Now()
 
 	err = repo.Update(ctx, user)
@@ -124,6 +147,8 @@ func TestPostgresUserRepository_Update(t *testing.T) {
 	assert.Equal(t, "Updated Name PG", retrieved.Name)
 	assert.Equal(t, "updatedpg@example.com", retrieved.Email)
 	assert.False(t, retrieved.Active)
+	assert.Equal(t, entity.RoleLibrarian, retrieved.Role)
+	assert.Equal(t, "newhashedpassword", retrieved.PasswordHash)
 }
 
 func TestPostgresUserRepository_Delete(t *testing.T) {
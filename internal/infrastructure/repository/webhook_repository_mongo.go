@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	webhookSubscriptionsCollection = "webhook_subscriptions"
+	webhookDeliveriesCollection    = "webhook_deliveries"
+)
+
+type mongoWebhookRepository struct {
+	subscriptions *mongo.Collection
+	deliveries    *mongo.Collection
+}
+
+func NewMongoWebhookRepository(db *mongo.Database) repository.WebhookRepository {
+	return &mongoWebhookRepository{
+		subscriptions: db.Collection(webhookSubscriptionsCollection),
+		deliveries:    db.Collection(webhookDeliveriesCollection),
+	}
+}
+
+func (r *mongoWebhookRepository) CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	_, err := r.subscriptions.InsertOne(ctx, toWebhookSubscriptionDocument(subscription))
+	return err
+}
+
+func (r *mongoWebhookRepository) GetSubscription(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	var doc webhookSubscriptionDocument
+	err := r.subscriptions.FindOne(ctx, bson.M{"id": id}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
+
+func (r *mongoWebhookRepository) ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	return r.findSubscriptions(ctx, bson.M{})
+}
+
+func (r *mongoWebhookRepository) ListSubscriptionsForEvent(ctx context.Context, eventType entity.EventType) ([]*entity.WebhookSubscription, error) {
+	return r.findSubscriptions(ctx, bson.M{"active": true, "events": string(eventType)})
+}
+
+func (r *mongoWebhookRepository) UpdateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	doc := toWebhookSubscriptionDocument(subscription)
+	filter := bson.M{"id": doc.ID}
+	update := bson.M{
+		"$set": bson.M{
+			"url":       doc.URL,
+			"events":    doc.Events,
+			"secret":    doc.Secret,
+			"active":    doc.Active,
+			"updatedat": doc.UpdatedAt,
+		},
+	}
+
+	_, err := r.subscriptions.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// DeleteSubscription removes the deliveries first; without a foreign key
+// nothing else would.
+func (r *mongoWebhookRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.deliveries.DeleteMany(ctx, bson.M{"subscriptionid": id}); err != nil {
+		return err
+	}
+	_, err := r.subscriptions.DeleteOne(ctx, bson.M{"id": id})
+	return err
+}
+
+func (r *mongoWebhookRepository) CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	_, err := r.deliveries.InsertOne(ctx, toWebhookDeliveryDocument(delivery))
+	return err
+}
+
+func (r *mongoWebhookRepository) GetDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	var doc webhookDeliveryDocument
+	err := r.deliveries.FindOne(ctx, bson.M{"id": id}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
+
+func (r *mongoWebhookRepository) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, page, limit int) ([]*entity.WebhookDelivery, int, error) {
+	skip := int64((page - 1) * limit)
+	limitInt64 := int64(limit)
+
+	query := bson.M{"subscriptionid": subscriptionID}
+	opts := options.Find().
+		SetSkip(skip).
+		SetLimit(limitInt64).
+		SetSort(bson.D{{Key: "createdat", Value: -1}, {Key: "id", Value: 1}})
+
+	cursor, err := r.deliveries.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []webhookDeliveryDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, 0, err
+	}
+
+	deliveries := make([]*entity.WebhookDelivery, len(docs))
+	for i, doc := range docs {
+		deliveries[i] = doc.toEntity()
+	}
+
+	count, err := r.deliveries.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, int(count), nil
+}
+
+// ClaimDueDeliveries moves the lease forward one delivery at a time.
+// FindOneAndUpdate is atomic per document, so concurrent runs never claim
+// the same delivery.
+func (r *mongoWebhookRepository) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	filter := bson.M{
+		"status":        entity.WebhookDeliveryPending,
+		"nextattemptat": bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{"nextattemptat": leaseUntil}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "nextattemptat", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	deliveries := make([]*entity.WebhookDelivery, 0, limit)
+	for len(deliveries) < limit {
+		var doc webhookDeliveryDocument
+		err := r.deliveries.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				break
+			}
+			return nil, err
+		}
+		deliveries = append(deliveries, doc.toEntity())
+	}
+	return deliveries, nil
+}
+
+func (r *mongoWebhookRepository) UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	filter := bson.M{"id": delivery.ID}
+	update := bson.M{
+		"$set": bson.M{
+			"status":         delivery.Status,
+			"attempts":       delivery.Attempts,
+			"nextattemptat":  delivery.NextAttemptAt,
+			"responsestatus": delivery.ResponseStatus,
+			"lasterror":      delivery.LastError,
+			"updatedat":      delivery.UpdatedAt,
+			"deliveredat":    delivery.DeliveredAt,
+		},
+	}
+
+	_, err := r.deliveries.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (r *mongoWebhookRepository) findSubscriptions(ctx context.Context, filter bson.M) ([]*entity.WebhookSubscription, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdat", Value: 1}, {Key: "id", Value: 1}})
+
+	cursor, err := r.subscriptions.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []webhookSubscriptionDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]*entity.WebhookSubscription, len(docs))
+	for i, doc := range docs {
+		subscriptions[i] = doc.toEntity()
+	}
+	return subscriptions, nil
+}
@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+	"bookhub/internal/infrastructure/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type postgresWebhookRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewPostgresWebhookRepository(db *sql.DB) repository.WebhookRepository {
+	return &postgresWebhookRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *postgresWebhookRepository) CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	_, err := r.q(ctx).CreateWebhookSubscription(ctx, sqlc.CreateWebhookSubscriptionParams{
+		ID:        subscription.ID,
+		Url:       subscription.URL,
+		Events:    r.fromEventTypes(subscription.Events),
+		Secret:    subscription.Secret,
+		Active:    subscription.Active,
+		CreatedAt: subscription.CreatedAt,
+		UpdatedAt: subscription.UpdatedAt,
+	})
+	return err
+}
+
+func (r *postgresWebhookRepository) GetSubscription(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	row, err := r.q(ctx).GetWebhookSubscriptionByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toSubscription(row), nil
+}
+
+func (r *postgresWebhookRepository) ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	rows, err := r.q(ctx).ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toSubscriptions(rows), nil
+}
+
+func (r *postgresWebhookRepository) ListSubscriptionsForEvent(ctx context.Context, eventType entity.EventType) ([]*entity.WebhookSubscription, error) {
+	rows, err := r.q(ctx).ListWebhookSubscriptionsForEvent(ctx, string(eventType))
+	if err != nil {
+		return nil, err
+	}
+	return r.toSubscriptions(rows), nil
+}
+
+func (r *postgresWebhookRepository) UpdateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	_, err := r.q(ctx).UpdateWebhookSubscription(ctx, sqlc.UpdateWebhookSubscriptionParams{
+		ID:        subscription.ID,
+		Url:       subscription.URL,
+		Events:    r.fromEventTypes(subscription.Events),
+		Secret:    subscription.Secret,
+		Active:    subscription.Active,
+		UpdatedAt: subscription.UpdatedAt,
+	})
+	return err
+}
+
+func (r *postgresWebhookRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	return r.q(ctx).DeleteWebhookSubscription(ctx, id)
+}
+
+func (r *postgresWebhookRepository) CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	_, err := r.q(ctx).CreateWebhookDelivery(ctx, sqlc.CreateWebhookDeliveryParams{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      string(delivery.EventType),
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       int32(delivery.Attempts),
+		NextAttemptAt:  r.toNullTime(delivery.NextAttemptAt),
+		ResponseStatus: r.toNullInt32(delivery.ResponseStatus),
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		DeliveredAt:    r.toNullTime(delivery.DeliveredAt),
+	})
+	return err
+}
+
+func (r *postgresWebhookRepository) GetDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	row, err := r.q(ctx).GetWebhookDeliveryByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toDelivery(row), nil
+}
+
+func (r *postgresWebhookRepository) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, page, limit int) ([]*entity.WebhookDelivery, int, error) {
+	offset := (page - 1) * limit
+
+	rows, err := r.q(ctx).ListWebhookDeliveries(ctx, sqlc.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := r.q(ctx).CountWebhookDeliveries(ctx, subscriptionID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return r.toDeliveries(rows), int(count), nil
+}
+
+func (r *postgresWebhookRepository) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	rows, err := r.q(ctx).ClaimDueWebhookDeliveries(ctx, sqlc.ClaimDueWebhookDeliveriesParams{
+		LeaseUntil: sql.NullTime{Time: leaseUntil, Valid: true},
+		Now:        sql.NullTime{Time: now, Valid: true},
+		BatchSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.toDeliveries(rows), nil
+}
+
+func (r *postgresWebhookRepository) UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	return r.q(ctx).UpdateWebhookDelivery(ctx, sqlc.UpdateWebhookDeliveryParams{
+		ID:             delivery.ID,
+		Status:         delivery.Status,
+		Attempts:       int32(delivery.Attempts),
+		NextAttemptAt:  r.toNullTime(delivery.NextAttemptAt),
+		ResponseStatus: r.toNullInt32(delivery.ResponseStatus),
+		LastError:      delivery.LastError,
+		UpdatedAt:      delivery.UpdatedAt,
+		DeliveredAt:    r.toNullTime(delivery.DeliveredAt),
+	})
+}
+
+// q returns the queries bound to the transaction in ctx, if any.
+func (r *postgresWebhookRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+func (r *postgresWebhookRepository) toSubscriptions(rows []sqlc.WebhookSubscription) []*entity.WebhookSubscription {
+	subscriptions := make([]*entity.WebhookSubscription, len(rows))
+	for i, row := range rows {
+		subscriptions[i] = r.toSubscription(row)
+	}
+	return subscriptions
+}
+
+func (r *postgresWebhookRepository) toSubscription(row sqlc.WebhookSubscription) *entity.WebhookSubscription {
+	events := make([]entity.EventType, len(row.Events))
+	for i, eventType := range row.Events {
+		events[i] = entity.EventType(eventType)
+	}
+	return &entity.WebhookSubscription{
+		ID:        row.ID,
+		URL:       row.Url,
+		Events:    events,
+		Secret:    row.Secret,
+		Active:    row.Active,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+func (r *postgresWebhookRepository) toDeliveries(rows []sqlc.WebhookDelivery) []*entity.WebhookDelivery {
+	deliveries := make([]*entity.WebhookDelivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = r.toDelivery(row)
+	}
+	return deliveries
+}
+
+func (r *postgresWebhookRepository) toDelivery(row sqlc.WebhookDelivery) *entity.WebhookDelivery {
+	var responseStatus *int
+	if row.ResponseStatus.Valid {
+		status := int(row.ResponseStatus.Int32)
+		responseStatus = &status
+	}
+	return &entity.WebhookDelivery{
+		ID:             row.ID,
+		SubscriptionID: row.SubscriptionID,
+		EventID:        row.EventID,
+		EventType:      entity.EventType(row.EventType),
+		Payload:        row.Payload,
+		Status:         row.Status,
+		Attempts:       int(row.Attempts),
+		NextAttemptAt:  r.fromNullTime(row.NextAttemptAt),
+		ResponseStatus: responseStatus,
+		LastError:      row.LastError,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+		DeliveredAt:    r.fromNullTime(row.DeliveredAt),
+	}
+}
+
+func (r *postgresWebhookRepository) fromEventTypes(events []entity.EventType) []string {
+	types := make([]string, len(events))
+	for i, eventType := range events {
+		types[i] = string(eventType)
+	}
+	return types
+}
+
+func (r *postgresWebhookRepository) toNullInt32(n *int) sql.NullInt32 {
+	if n == nil {
+		return sql.NullInt32{Valid: false}
+	}
+	return sql.NullInt32{Int32: int32(*n), Valid: true}
+}
+
+func (r *postgresWebhookRepository) toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{Valid: false}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func (r *postgresWebhookRepository) fromNullTime(nt sql.NullTime) *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+	return &nt.Time
+}
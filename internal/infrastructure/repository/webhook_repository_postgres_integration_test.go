@@ -0,0 +1,128 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/infrastructure/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresWebhookRepository_Subscriptions(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresWebhookRepository(PostgresTestDB)
+
+	borrows, err := entity.NewWebhookSubscription("https://example.com/borrows", []entity.EventType{entity.EventLoanBorrowed}, "0123456789abcdef")
+	require.NoError(t, err)
+	both, err := entity.NewWebhookSubscription("https://example.com/loans", []entity.EventType{entity.EventLoanBorrowed, entity.EventLoanReturned}, "0123456789abcdef")
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateSubscription(ctx, borrows))
+	require.NoError(t, repo.CreateSubscription(ctx, both))
+
+	subscriptions, err := repo.ListSubscriptionsForEvent(ctx, entity.EventLoanReturned)
+	require.NoError(t, err)
+	require.Len(t, subscriptions, 1)
+	assert.Equal(t, both.ID, subscriptions[0].ID)
+	assert.Equal(t, both.Events, subscriptions[0].Events)
+
+	// Inactive subscriptions receive no new events
+	require.NoError(t, borrows.Update(borrows.URL, borrows.Events, borrows.Secret, false))
+	require.NoError(t, repo.UpdateSubscription(ctx, borrows))
+	subscriptions, err = repo.ListSubscriptionsForEvent(ctx, entity.EventLoanBorrowed)
+	require.NoError(t, err)
+	require.Len(t, subscriptions, 1)
+	assert.Equal(t, both.ID, subscriptions[0].ID)
+
+	found, err := repo.GetSubscription(ctx, borrows.ID)
+	require.NoError(t, err)
+	assert.False(t, found.Active)
+	assert.Equal(t, "0123456789abcdef", found.Secret)
+
+	all, err := repo.ListSubscriptions(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+}
+
+func TestPostgresWebhookRepository_Deliveries(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresWebhookRepository(PostgresTestDB)
+
+	subscription, err := entity.NewWebhookSubscription("https://example.com/hooks", []entity.EventType{entity.EventBookCreated}, "0123456789abcdef")
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateSubscription(ctx, subscription))
+
+	delivery := entity.NewWebhookDelivery(subscription.ID, uuid.New(), entity.EventBookCreated, []byte(`{"type":"book.created"}`))
+	require.NoError(t, repo.CreateDelivery(ctx, delivery))
+
+	now := time.Now()
+	claimed, err := repo.ClaimDueDeliveries(ctx, now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.JSONEq(t, `{"type":"book.created"}`, string(claimed[0].Payload))
+
+	// The lease keeps other runs off the claimed delivery
+	claimed, err = repo.ClaimDueDeliveries(ctx, now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	delivery.Fail(503, "receiver responded with status 503", now, entity.WebhookRetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute})
+	require.NoError(t, repo.UpdateDelivery(ctx, delivery))
+
+	found, err := repo.GetDelivery(ctx, delivery.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.WebhookDeliveryPending, found.Status)
+	assert.Equal(t, 1, found.Attempts)
+	require.NotNil(t, found.ResponseStatus)
+	assert.Equal(t, 503, *found.ResponseStatus)
+
+	again := delivery.Redeliver()
+	require.NoError(t, repo.CreateDelivery(ctx, again))
+	deliveries, total, err := repo.ListDeliveries(ctx, subscription.ID, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, deliveries, 2)
+
+	// Deleting the subscription removes its delivery log
+	require.NoError(t, repo.DeleteSubscription(ctx, subscription.ID))
+	found, err = repo.GetDelivery(ctx, delivery.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
+
+func TestPostgresWebhookRepository_DeliveriesJoinTransaction(t *testing.T) {
+	CleanupPostgres(t)
+
+	ctx := context.Background()
+	repo := repository.NewPostgresWebhookRepository(PostgresTestDB)
+	txManager := repository.NewPostgresTxManager(PostgresTestDB)
+
+	subscription, err := entity.NewWebhookSubscription("https://example.com/hooks", []entity.EventType{entity.EventUserDisabled}, "0123456789abcdef")
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateSubscription(ctx, subscription))
+
+	errRollback := errors.New("rollback")
+	err = txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		delivery := entity.NewWebhookDelivery(subscription.ID, uuid.New(), entity.EventUserDisabled, []byte(`{}`))
+		if err := repo.CreateDelivery(ctx, delivery); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	assert.ErrorIs(t, err, errRollback)
+
+	_, total, err := repo.ListDeliveries(ctx, subscription.ID, 1, 10)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"bookhub/internal/domain/entity"
+)
+
+const (
+	// HeaderSignature carries "sha256=" and the hex HMAC-SHA256 of the body,
+	// keyed with the subscription's secret.
+	HeaderSignature = "X-BookHub-Signature-256"
+	HeaderEvent     = "X-BookHub-Event"
+	// HeaderDelivery identifies the delivery; redeliveries of an event get
+	// a new one. Receivers deduplicate on the "id" of the body instead.
+	HeaderDelivery = "X-BookHub-Delivery"
+)
+
+const signaturePrefix = "sha256="
+
+// Sender posts webhook deliveries to their subscription's URL.
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender posts with client, or with http.DefaultClient when client is
+// nil. Attempts are bounded by the context they are sent with.
+func NewSender(client *http.Client) *Sender {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Sender{
+		client: client,
+	}
+}
+
+func (s *Sender) Send(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "BookHub-Webhook")
+	req.Header.Set(HeaderEvent, string(delivery.EventType))
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a little of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the HeaderSignature value of body for secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the HeaderSignature value of body for
+// secret. Receivers written in Go can use it as is.
+func Verify(secret string, body []byte, signature string) bool {
+	digest, ok := strings.CutPrefix(signature, signaturePrefix)
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
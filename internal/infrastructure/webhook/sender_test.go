@@ -0,0 +1,274 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+const testSecret = "0123456789abcdef"
+
+// receiver is an httptest webhook endpoint that checks signatures and
+// answers with the statuses queued in replies, then with 200.
+type receiver struct {
+	mu       sync.Mutex
+	replies  []int
+	received []receivedEvent
+}
+
+type receivedEvent struct {
+	delivery string
+	event    string
+	id       uuid.UUID
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	if !Verify(testSecret, body, r.Header.Get(HeaderSignature)) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var payload struct {
+		ID uuid.UUID `json:"id"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	status := http.StatusOK
+	if len(rc.replies) > 0 {
+		status, rc.replies = rc.replies[0], rc.replies[1:]
+	}
+	if status == http.StatusOK {
+		rc.received = append(rc.received, receivedEvent{
+			delivery: r.Header.Get(HeaderDelivery),
+			event:    r.Header.Get(HeaderEvent),
+			id:       payload.ID,
+		})
+	}
+	w.WriteHeader(status)
+}
+
+func newTestDelivery(t *testing.T, url string) (*entity.WebhookSubscription, *entity.WebhookDelivery) {
+	t.Helper()
+	subscription, err := entity.NewWebhookSubscription(url, []entity.EventType{entity.EventLoanBorrowed}, testSecret)
+	if err != nil {
+		t.Fatalf("entity.NewWebhookSubscription() unexpected error = %v", err)
+	}
+	eventID := uuid.New()
+	payload := []byte(`{"id":"` + eventID.String() + `","type":"loan.borrowed","data":{}}`)
+	return subscription, entity.NewWebhookDelivery(subscription.ID, eventID, entity.EventLoanBorrowed, payload)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	signature := Sign(testSecret, body)
+
+	if !Verify(testSecret, body, signature) {
+		t.Error("Verify() = false for the signature Sign() returned")
+	}
+	if Verify("another-secret-value", body, signature) {
+		t.Error("Verify() = true with another secret")
+	}
+	if Verify(testSecret, []byte(`{"id":"2"}`), signature) {
+		t.Error("Verify() = true for another body")
+	}
+	if Verify(testSecret, body, signature[len(signaturePrefix):]) {
+		t.Error("Verify() = true without the sha256= prefix")
+	}
+}
+
+func TestSender_Send(t *testing.T) {
+	rc := &receiver{}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	subscription, delivery := newTestDelivery(t, server.URL)
+	status, err := NewSender(server.Client()).Send(context.Background(), subscription, delivery)
+	if err != nil || status != http.StatusOK {
+		t.Fatalf("Sender.Send() = %v, %v, want 200, nil", status, err)
+	}
+
+	if len(rc.received) != 1 {
+		t.Fatalf("Sender.Send() received = %v, want 1", len(rc.received))
+	}
+	got := rc.received[0]
+	if got.delivery != delivery.ID.String() || got.event != "loan.borrowed" || got.id != delivery.EventID {
+		t.Errorf("Sender.Send() received %+v, want delivery %v of event %v", got, delivery.ID, delivery.EventID)
+	}
+}
+
+func TestSender_SendRejected(t *testing.T) {
+	rc := &receiver{}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	subscription, delivery := newTestDelivery(t, server.URL)
+	subscription.Secret = "not-the-receivers-secret"
+
+	status, err := NewSender(server.Client()).Send(context.Background(), subscription, delivery)
+	if err == nil || status != http.StatusUnauthorized {
+		t.Errorf("Sender.Send() = %v, %v, want 401 and an error", status, err)
+	}
+}
+
+// TestSender_RetriedUntilDelivered runs the webhook use case against a
+// receiver that is down for the first two attempts.
+func TestSender_RetriedUntilDelivered(t *testing.T) {
+	ctx := context.Background()
+	rc := &receiver{replies: []int{http.StatusServiceUnavailable, http.StatusInternalServerError}}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	repo := newMemoryWebhookRepository()
+	uc := usecase.NewWebhookUseCase(repo, directTx{}, discardAuditor{}, NewSender(server.Client()), usecase.WebhookRules{
+		Retry:     entity.WebhookRetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond},
+		Timeout:   time.Second,
+		BatchSize: 10,
+	})
+
+	subscription, err := uc.CreateSubscription(ctx, usecase.CreateWebhookInput{
+		URL:    server.URL,
+		Events: []entity.EventType{entity.EventLoanBorrowed},
+		Secret: testSecret,
+	})
+	if err != nil {
+		t.Fatalf("WebhookUseCase.CreateSubscription() unexpected error = %v", err)
+	}
+	event := entity.NewEvent(entity.EventLoanBorrowed, uuid.New(), map[string]string{"status": "active"})
+	if err := uc.Publish(ctx, event); err != nil {
+		t.Fatalf("WebhookUseCase.Publish() unexpected error = %v", err)
+	}
+
+	for range 3 {
+		if _, err := uc.DeliverPending(ctx); err != nil {
+			t.Fatalf("WebhookUseCase.DeliverPending() unexpected error = %v", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	deliveries, _, _ := uc.ListDeliveries(ctx, subscription.ID, 1, 10)
+	if len(deliveries) != 1 {
+		t.Fatalf("WebhookUseCase.ListDeliveries() count = %v, want 1", len(deliveries))
+	}
+	delivery := deliveries[0]
+	if delivery.Status != entity.WebhookDeliveryDelivered || delivery.Attempts != 3 {
+		t.Errorf("delivery status = %v after %d attempts, want %v after 3", delivery.Status, delivery.Attempts, entity.WebhookDeliveryDelivered)
+	}
+	if len(rc.received) != 1 || rc.received[0].id != event.ID {
+		t.Errorf("receiver got %+v, want event %v once", rc.received, event.ID)
+	}
+}
+
+// memoryWebhookRepository keeps subscriptions and deliveries in maps.
+type memoryWebhookRepository struct {
+	subscriptions map[uuid.UUID]*entity.WebhookSubscription
+	deliveries    map[uuid.UUID]*entity.WebhookDelivery
+}
+
+func newMemoryWebhookRepository() *memoryWebhookRepository {
+	return &memoryWebhookRepository{
+		subscriptions: make(map[uuid.UUID]*entity.WebhookSubscription),
+		deliveries:    make(map[uuid.UUID]*entity.WebhookDelivery),
+	}
+}
+
+func (m *memoryWebhookRepository) CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	m.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (m *memoryWebhookRepository) GetSubscription(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	return m.subscriptions[id], nil
+}
+
+func (m *memoryWebhookRepository) ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	subscriptions := make([]*entity.WebhookSubscription, 0, len(m.subscriptions))
+	for _, subscription := range m.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+func (m *memoryWebhookRepository) ListSubscriptionsForEvent(ctx context.Context, eventType entity.EventType) ([]*entity.WebhookSubscription, error) {
+	subscriptions := make([]*entity.WebhookSubscription, 0)
+	for _, subscription := range m.subscriptions {
+		if subscription.Subscribes(eventType) {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	return subscriptions, nil
+}
+
+func (m *memoryWebhookRepository) UpdateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	m.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (m *memoryWebhookRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	delete(m.subscriptions, id)
+	return nil
+}
+
+func (m *memoryWebhookRepository) CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.deliveries[delivery.ID] = delivery
+	return nil
+}
+
+func (m *memoryWebhookRepository) GetDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	return m.deliveries[id], nil
+}
+
+func (m *memoryWebhookRepository) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, page, limit int) ([]*entity.WebhookDelivery, int, error) {
+	deliveries := make([]*entity.WebhookDelivery, 0)
+	for _, delivery := range m.deliveries {
+		if delivery.SubscriptionID == subscriptionID {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	return deliveries, len(deliveries), nil
+}
+
+func (m *memoryWebhookRepository) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	deliveries := make([]*entity.WebhookDelivery, 0)
+	for _, delivery := range m.deliveries {
+		if len(deliveries) < limit && delivery.Status == entity.WebhookDeliveryPending && !delivery.NextAttemptAt.After(now) {
+			delivery.NextAttemptAt = &leaseUntil
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	return deliveries, nil
+}
+
+func (m *memoryWebhookRepository) UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.deliveries[delivery.ID] = delivery
+	return nil
+}
+
+// directTx runs the function without a transaction.
+type directTx struct{}
+
+func (directTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// discardAuditor drops audit records.
+type discardAuditor struct{}
+
+func (discardAuditor) Record(ctx context.Context, record usecase.AuditRecord) error {
+	return nil
+}
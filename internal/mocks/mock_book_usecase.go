@@ -11,6 +11,7 @@ package mocks
 
 import (
 	entity "bookhub/internal/domain/entity"
+	repository "bookhub/internal/domain/repository"
 	usecase "bookhub/internal/usecase"
 	context "context"
 	reflect "reflect"
@@ -58,6 +59,20 @@ func (mr *MockBookUseCaseMockRecorder) Create(ctx, input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookUseCase)(nil).Create), ctx, input)
 }
 
+// Delete mocks base method.
+func (m *MockBookUseCase) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBookUseCaseMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBookUseCase)(nil).Delete), ctx, id)
+}
+
 // GetByID mocks base method.
 func (m *MockBookUseCase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Book, error) {
 	m.ctrl.T.Helper()
@@ -74,9 +89,9 @@ func (mr *MockBookUseCaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockBookUseCase) List(ctx context.Context, page, limit int, availableOnly *bool) ([]*entity.Book, int, error) {
+func (m *MockBookUseCase) List(ctx context.Context, page, limit int, filter repository.BookFilter) ([]*entity.Book, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, page, limit, availableOnly)
+	ret := m.ctrl.Call(m, "List", ctx, page, limit, filter)
 	ret0, _ := ret[0].([]*entity.Book)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -84,7 +99,68 @@ func (m *MockBookUseCase) List(ctx context.Context, page, limit int, availableOn
 }
 
 // List indicates an expected call of List.
-func (mr *MockBookUseCaseMockRecorder) List(ctx, page, limit, availableOnly any) *gomock.Call {
+func (mr *MockBookUseCaseMockRecorder) List(ctx, page, limit, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBookUseCase)(nil).List), ctx, page, limit, filter)
+}
+
+// Search mocks base method.
+func (m *MockBookUseCase) Search(ctx context.Context, page, limit int, search repository.BookSearch) ([]*repository.BookMatch, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, page, limit, search)
+	ret0, _ := ret[0].([]*repository.BookMatch)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockBookUseCaseMockRecorder) Search(ctx, page, limit, search any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockBookUseCase)(nil).Search), ctx, page, limit, search)
+}
+
+// Suggest mocks base method.
+func (m *MockBookUseCase) Suggest(ctx context.Context, prefix string, limit int) (*usecase.BookSuggestions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, prefix, limit)
+	ret0, _ := ret[0].(*usecase.BookSuggestions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockBookUseCaseMockRecorder) Suggest(ctx, prefix, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockBookUseCase)(nil).Suggest), ctx, prefix, limit)
+}
+
+// Update mocks base method.
+func (m *MockBookUseCase) Update(ctx context.Context, id uuid.UUID, input usecase.UpdateBookInput) (*entity.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, input)
+	ret0, _ := ret[0].(*entity.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBookUseCaseMockRecorder) Update(ctx, id, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBookUseCase)(nil).Update), ctx, id, input)
+}
+
+// Withdraw mocks base method.
+func (m *MockBookUseCase) Withdraw(ctx context.Context, id uuid.UUID) (*entity.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Withdraw", ctx, id)
+	ret0, _ := ret[0].(*entity.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Withdraw indicates an expected call of Withdraw.
+func (mr *MockBookUseCaseMockRecorder) Withdraw(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBookUseCase)(nil).List), ctx, page, limit, availableOnly)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockBookUseCase)(nil).Withdraw), ctx, id)
 }
@@ -43,9 +43,9 @@ func (m *MockJWTService) EXPECT() *MockJWTServiceMockRecorder {
 }
 
 // GenerateToken mocks base method.
-func (m *MockJWTService) GenerateToken(userID uuid.UUID, email string) (string, time.Time, error) {
+func (m *MockJWTService) GenerateToken(userID uuid.UUID, email, role string) (string, time.Time, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateToken", userID, email)
+	ret := m.ctrl.Call(m, "GenerateToken", userID, email, role)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(time.Time)
 	ret2, _ := ret[2].(error)
@@ -53,9 +53,9 @@ func (m *MockJWTService) GenerateToken(userID uuid.UUID, email string) (string,
 }
 
 // GenerateToken indicates an expected call of GenerateToken.
-func (mr *MockJWTServiceMockRecorder) GenerateToken(userID, email any) *gomock.Call {
+func (mr *MockJWTServiceMockRecorder) GenerateToken(userID, email, role any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockJWTService)(nil).GenerateToken), userID, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockJWTService)(nil).GenerateToken), userID, email, role)
 }
 
 // ValidateToken mocks base method.
@@ -58,6 +58,66 @@ func (mr *MockLoanUseCaseMockRecorder) BorrowBook(ctx, input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BorrowBook", reflect.TypeOf((*MockLoanUseCase)(nil).BorrowBook), ctx, input)
 }
 
+// BorrowForCaller mocks base method.
+func (m *MockLoanUseCase) BorrowForCaller(ctx context.Context, input usecase.BorrowForCallerInput) (*repository.LoanWithDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BorrowForCaller", ctx, input)
+	ret0, _ := ret[0].(*repository.LoanWithDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BorrowForCaller indicates an expected call of BorrowForCaller.
+func (mr *MockLoanUseCaseMockRecorder) BorrowForCaller(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BorrowForCaller", reflect.TypeOf((*MockLoanUseCase)(nil).BorrowForCaller), ctx, input)
+}
+
+// CheckIn mocks base method.
+func (m *MockLoanUseCase) CheckIn(ctx context.Context, input usecase.CheckInInput) (*repository.LoanWithDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, input)
+	ret0, _ := ret[0].(*repository.LoanWithDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockLoanUseCaseMockRecorder) CheckIn(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockLoanUseCase)(nil).CheckIn), ctx, input)
+}
+
+// CheckOut mocks base method.
+func (m *MockLoanUseCase) CheckOut(ctx context.Context, input usecase.CheckOutInput) (*repository.LoanWithDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOut", ctx, input)
+	ret0, _ := ret[0].(*repository.LoanWithDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckOut indicates an expected call of CheckOut.
+func (mr *MockLoanUseCaseMockRecorder) CheckOut(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOut", reflect.TypeOf((*MockLoanUseCase)(nil).CheckOut), ctx, input)
+}
+
+// DeclareLost mocks base method.
+func (m *MockLoanUseCase) DeclareLost(ctx context.Context, input usecase.DeclareLostInput) (*repository.LoanWithDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclareLost", ctx, input)
+	ret0, _ := ret[0].(*repository.LoanWithDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclareLost indicates an expected call of DeclareLost.
+func (mr *MockLoanUseCaseMockRecorder) DeclareLost(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclareLost", reflect.TypeOf((*MockLoanUseCase)(nil).DeclareLost), ctx, input)
+}
+
 // GetByID mocks base method.
 func (m *MockLoanUseCase) GetByID(ctx context.Context, id uuid.UUID) (*repository.LoanWithDetails, error) {
 	m.ctrl.T.Helper()
@@ -74,9 +134,9 @@ func (mr *MockLoanUseCaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockLoanUseCase) List(ctx context.Context, page, limit int, userID *uuid.UUID, status *string) ([]*repository.LoanWithDetails, int, error) {
+func (m *MockLoanUseCase) List(ctx context.Context, page, limit int, filter repository.LoanFilter) ([]*repository.LoanWithDetails, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, page, limit, userID, status)
+	ret := m.ctrl.Call(m, "List", ctx, page, limit, filter)
 	ret0, _ := ret[0].([]*repository.LoanWithDetails)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -84,9 +144,55 @@ func (m *MockLoanUseCase) List(ctx context.Context, page, limit int, userID *uui
 }
 
 // List indicates an expected call of List.
-func (mr *MockLoanUseCaseMockRecorder) List(ctx, page, limit, userID, status any) *gomock.Call {
+func (mr *MockLoanUseCaseMockRecorder) List(ctx, page, limit, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoanUseCase)(nil).List), ctx, page, limit, userID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoanUseCase)(nil).List), ctx, page, limit, filter)
+}
+
+// ListCallerLoans mocks base method.
+func (m *MockLoanUseCase) ListCallerLoans(ctx context.Context, page, limit int, status *string) ([]*repository.LoanWithDetails, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCallerLoans", ctx, page, limit, status)
+	ret0, _ := ret[0].([]*repository.LoanWithDetails)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListCallerLoans indicates an expected call of ListCallerLoans.
+func (mr *MockLoanUseCaseMockRecorder) ListCallerLoans(ctx, page, limit, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCallerLoans", reflect.TypeOf((*MockLoanUseCase)(nil).ListCallerLoans), ctx, page, limit, status)
+}
+
+// MarkOverdueLoans mocks base method.
+func (m *MockLoanUseCase) MarkOverdueLoans(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOverdueLoans", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOverdueLoans indicates an expected call of MarkOverdueLoans.
+func (mr *MockLoanUseCaseMockRecorder) MarkOverdueLoans(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOverdueLoans", reflect.TypeOf((*MockLoanUseCase)(nil).MarkOverdueLoans), ctx)
+}
+
+// RenewLoan mocks base method.
+func (m *MockLoanUseCase) RenewLoan(ctx context.Context, loanID uuid.UUID) (*repository.LoanWithDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewLoan", ctx, loanID)
+	ret0, _ := ret[0].(*repository.LoanWithDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewLoan indicates an expected call of RenewLoan.
+func (mr *MockLoanUseCaseMockRecorder) RenewLoan(ctx, loanID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewLoan", reflect.TypeOf((*MockLoanUseCase)(nil).RenewLoan), ctx, loanID)
 }
 
 // ReturnBook mocks base method.
@@ -103,3 +209,18 @@ func (mr *MockLoanUseCaseMockRecorder) ReturnBook(ctx, loanID any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnBook", reflect.TypeOf((*MockLoanUseCase)(nil).ReturnBook), ctx, loanID)
 }
+
+// ReturnDamaged mocks base method.
+func (m *MockLoanUseCase) ReturnDamaged(ctx context.Context, loanID uuid.UUID, damage usecase.DamageReport) (*repository.LoanWithDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnDamaged", ctx, loanID, damage)
+	ret0, _ := ret[0].(*repository.LoanWithDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReturnDamaged indicates an expected call of ReturnDamaged.
+func (mr *MockLoanUseCaseMockRecorder) ReturnDamaged(ctx, loanID, damage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnDamaged", reflect.TypeOf((*MockLoanUseCase)(nil).ReturnDamaged), ctx, loanID, damage)
+}
@@ -11,6 +11,7 @@ package mocks
 
 import (
 	entity "bookhub/internal/domain/entity"
+	repository "bookhub/internal/domain/repository"
 	usecase "bookhub/internal/usecase"
 	context "context"
 	reflect "reflect"
@@ -43,6 +44,20 @@ func (m *MockUserUseCase) EXPECT() *MockUserUseCaseMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockUserUseCase) ChangePassword(ctx context.Context, input usecase.ChangePasswordInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUserUseCaseMockRecorder) ChangePassword(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserUseCase)(nil).ChangePassword), ctx, input)
+}
+
 // Create mocks base method.
 func (m *MockUserUseCase) Create(ctx context.Context, input usecase.CreateUserInput) (*entity.User, error) {
 	m.ctrl.T.Helper()
@@ -102,10 +117,25 @@ func (mr *MockUserUseCaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserUseCase)(nil).GetByID), ctx, id)
 }
 
+// GetProfile mocks base method.
+func (m *MockUserUseCase) GetProfile(ctx context.Context) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockUserUseCaseMockRecorder) GetProfile(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockUserUseCase)(nil).GetProfile), ctx)
+}
+
 // List mocks base method.
-func (m *MockUserUseCase) List(ctx context.Context, page, limit int) ([]*entity.User, int, error) {
+func (m *MockUserUseCase) List(ctx context.Context, page, limit int, filter repository.UserFilter) ([]*entity.User, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, page, limit)
+	ret := m.ctrl.Call(m, "List", ctx, page, limit, filter)
 	ret0, _ := ret[0].([]*entity.User)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -113,9 +143,24 @@ func (m *MockUserUseCase) List(ctx context.Context, page, limit int) ([]*entity.
 }
 
 // List indicates an expected call of List.
-func (mr *MockUserUseCaseMockRecorder) List(ctx, page, limit any) *gomock.Call {
+func (mr *MockUserUseCaseMockRecorder) List(ctx, page, limit, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserUseCase)(nil).List), ctx, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserUseCase)(nil).List), ctx, page, limit, filter)
+}
+
+// Unblock mocks base method.
+func (m *MockUserUseCase) Unblock(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unblock", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unblock indicates an expected call of Unblock.
+func (mr *MockUserUseCaseMockRecorder) Unblock(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unblock", reflect.TypeOf((*MockUserUseCase)(nil).Unblock), ctx, id)
 }
 
 // Update mocks base method.
@@ -133,6 +178,21 @@ func (mr *MockUserUseCaseMockRecorder) Update(ctx, id, input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserUseCase)(nil).Update), ctx, id, input)
 }
 
+// UpdateProfile mocks base method.
+func (m *MockUserUseCase) UpdateProfile(ctx context.Context, input usecase.UpdateProfileInput) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, input)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUserUseCaseMockRecorder) UpdateProfile(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUserUseCase)(nil).UpdateProfile), ctx, input)
+}
+
 // ValidateCredentials mocks base method.
 func (m *MockUserUseCase) ValidateCredentials(ctx context.Context, email, password string) (*entity.User, error) {
 	m.ctrl.T.Helper()
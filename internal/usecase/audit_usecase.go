@@ -0,0 +1,410 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// Auditor records changes in the audit log. Use cases record inside the
+// transaction that made the change, so the entry is kept only if the change
+// is committed.
+type Auditor interface {
+	Record(ctx context.Context, record AuditRecord) error
+}
+
+// AuditRecord describes one change to an entity. Before and After are
+// snapshots of it that encode as JSON objects; Before is nil when the
+// entity was created. Only the fields that differ are logged.
+type AuditRecord struct {
+	Action     string
+	EntityType string
+	EntityID   uuid.UUID
+	Before     any
+	After      any
+}
+
+type AuditUseCase interface {
+	Auditor
+
+	List(ctx context.Context, page, limit int, filter repository.AuditFilter) ([]*entity.AuditEntry, int, error)
+	// Verify walks the whole chain and reports the first entry that breaks
+	// it.
+	Verify(ctx context.Context) (*AuditVerification, error)
+}
+
+// AuditVerification is the outcome of checking the audit chain.
+type AuditVerification struct {
+	// Checked is how many entries were found intact before BrokenAt, or in
+	// all when the chain is intact.
+	Checked int
+	// BrokenAt is the sequence of the first entry that fails, and Reason
+	// why; both are empty when the chain is intact.
+	BrokenAt *int64
+	Reason   string
+}
+
+func (v *AuditVerification) Valid() bool {
+	return v.BrokenAt == nil
+}
+
+// auditVerifyBatch is how many entries Verify reads at a time.
+const auditVerifyBatch = 500
+
+type auditUseCase struct {
+	auditRepo repository.AuditRepository
+	txManager repository.TxManager
+}
+
+func NewAuditUseCase(auditRepo repository.AuditRepository, txManager repository.TxManager) AuditUseCase {
+	return &auditUseCase{
+		auditRepo: auditRepo,
+		txManager: txManager,
+	}
+}
+
+// Record logs the change on behalf of the caller in ctx, or of no one for
+// background jobs. It joins the transaction in ctx or starts its own, as
+// appending to the chain requires one.
+func (uc *auditUseCase) Record(ctx context.Context, record AuditRecord) error {
+	changes, err := entity.DiffAuditStates(record.Before, record.After)
+	if err != nil {
+		return err
+	}
+
+	var actorID *uuid.UUID
+	if caller, ok := CallerFromContext(ctx); ok {
+		actorID = &caller.UserID
+	}
+
+	entry := entity.NewAuditEntry(actorID, record.Action, record.EntityType, record.EntityID, changes, RequestIDFromContext(ctx))
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return uc.auditRepo.Append(ctx, entry)
+	})
+}
+
+func (uc *auditUseCase) List(ctx context.Context, page, limit int, filter repository.AuditFilter) ([]*entity.AuditEntry, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	return uc.auditRepo.List(ctx, page, limit, filter)
+}
+
+func (uc *auditUseCase) Verify(ctx context.Context) (*AuditVerification, error) {
+	result := &AuditVerification{}
+	var prev *entity.AuditEntry
+	for {
+		var after int64
+		if prev != nil {
+			after = prev.Sequence
+		}
+		entries, err := uc.auditRepo.ListChain(ctx, after, auditVerifyBatch)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if err := entry.VerifyAfter(prev); err != nil {
+				result.BrokenAt = &entry.Sequence
+				result.Reason = err.Error()
+				return result, nil
+			}
+			result.Checked++
+			prev = entry
+		}
+
+		if len(entries) < auditVerifyBatch {
+			return result, nil
+		}
+	}
+}
+
+// userAuditState is the user snapshot the audit log compares. The password
+// hash is left out; changing it is logged as its own action.
+type userAuditState struct {
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+	Role       string `json:"role"`
+	Category   string `json:"category"`
+	CardNumber string `json:"card_number"`
+	Active     bool   `json:"active"`
+	Blocked    bool   `json:"blocked"`
+}
+
+// bookAuditState is the book snapshot the audit log compares. Available
+// copies change with every loan and are left out.
+type bookAuditState struct {
+	Title         string     `json:"title"`
+	Author        string     `json:"author"`
+	ISBN          string     `json:"isbn"`
+	PublishedYear int        `json:"published_year"`
+	Category      string     `json:"category"`
+	TotalCopies   int        `json:"total_copies"`
+	WithdrawnAt   *time.Time `json:"withdrawn_at,omitempty"`
+}
+
+// loanAuditState is the loan snapshot the audit log compares.
+type loanAuditState struct {
+	UserID       uuid.UUID  `json:"user_id"`
+	BookID       uuid.UUID  `json:"book_id"`
+	CopyID       *uuid.UUID `json:"copy_id"`
+	Status       string     `json:"status"`
+	DueDate      time.Time  `json:"due_date"`
+	ReturnedAt   *time.Time `json:"returned_at"`
+	RenewalCount int        `json:"renewal_count"`
+}
+
+func userAudit(user *entity.User) *userAuditState {
+	return &userAuditState{
+		Name:       user.Name,
+		Email:      user.Email,
+		Role:       user.Role,
+		Category:   user.Category,
+		CardNumber: user.CardNumber,
+		Active:     user.Active,
+		Blocked:    user.Blocked,
+	}
+}
+
+func bookAudit(book *entity.Book) *bookAuditState {
+	return &bookAuditState{
+		Title:         book.Title,
+		Author:        book.Author,
+		ISBN:          book.ISBN,
+		PublishedYear: book.PublishedYear,
+		Category:      book.Category,
+		TotalCopies:   book.TotalCopies,
+		WithdrawnAt:   book.WithdrawnAt,
+	}
+}
+
+func loanAudit(loan *entity.Loan) *loanAuditState {
+	return &loanAuditState{
+		UserID:       loan.UserID,
+		BookID:       loan.BookID,
+		CopyID:       loan.CopyID,
+		Status:       loan.Status,
+		DueDate:      loan.DueDate,
+		ReturnedAt:   loan.ReturnedAt,
+		RenewalCount: loan.RenewalCount,
+	}
+}
+
+// copyAuditState is the book copy snapshot the audit log compares.
+type copyAuditState struct {
+	BookID    uuid.UUID `json:"book_id"`
+	BranchID  uuid.UUID `json:"branch_id"`
+	Barcode   string    `json:"barcode"`
+	Location  string    `json:"location"`
+	Condition string    `json:"condition"`
+	Status    string    `json:"status"`
+}
+
+// holdAuditState is the hold snapshot the audit log compares.
+type holdAuditState struct {
+	UserID         uuid.UUID  `json:"user_id"`
+	BookID         uuid.UUID  `json:"book_id"`
+	Status         string     `json:"status"`
+	ReadyAt        *time.Time `json:"ready_at"`
+	PickupDeadline *time.Time `json:"pickup_deadline"`
+	CopyID         *uuid.UUID `json:"copy_id"`
+}
+
+// fineAuditState is the fine snapshot the audit log compares.
+type fineAuditState struct {
+	UserID      uuid.UUID `json:"user_id"`
+	LoanID      uuid.UUID `json:"loan_id"`
+	Reason      string    `json:"reason"`
+	AmountCents int64     `json:"amount_cents"`
+	PaidCents   int64     `json:"paid_cents"`
+	Status      string    `json:"status"`
+}
+
+// loanPolicyAuditState is the loan policy snapshot the audit log compares.
+type loanPolicyAuditState struct {
+	PatronCategory string `json:"patron_category"`
+	ItemCategory   string `json:"item_category"`
+	MaxLoans       int    `json:"max_loans"`
+	LoanDays       int    `json:"loan_days"`
+	MaxRenewals    int    `json:"max_renewals"`
+}
+
+// branchAuditState is the branch snapshot the audit log compares.
+type branchAuditState struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// transferAuditState is the transfer snapshot the audit log compares.
+type transferAuditState struct {
+	CopyID       uuid.UUID  `json:"copy_id"`
+	BookID       uuid.UUID  `json:"book_id"`
+	FromBranchID uuid.UUID  `json:"from_branch_id"`
+	ToBranchID   uuid.UUID  `json:"to_branch_id"`
+	Status       string     `json:"status"`
+	ShippedAt    *time.Time `json:"shipped_at"`
+	ReceivedAt   *time.Time `json:"received_at"`
+}
+
+// webhookAuditState is the webhook subscription snapshot the audit log
+// compares. The signing secret is left out.
+type webhookAuditState struct {
+	URL    string             `json:"url"`
+	Events []entity.EventType `json:"events"`
+	Active bool               `json:"active"`
+}
+
+// webhookRedeliveryAuditState is logged against the subscription when a
+// past delivery is queued again.
+type webhookRedeliveryAuditState struct {
+	DeliveryID   uuid.UUID        `json:"delivery_id"`
+	RedeliveryOf uuid.UUID        `json:"redelivery_of"`
+	EventID      uuid.UUID        `json:"event_id"`
+	EventType    entity.EventType `json:"event_type"`
+}
+
+// openingHoursAuditState is a branch's weekly schedule, each open weekday
+// mapped to its HH:MM-HH:MM hours.
+type openingHoursAuditState struct {
+	Hours map[string]string `json:"hours"`
+}
+
+// closedDateAuditState is the closed date snapshot the audit log compares.
+type closedDateAuditState struct {
+	BranchID uuid.UUID `json:"branch_id"`
+	Date     time.Time `json:"date"`
+	Reason   string    `json:"reason"`
+}
+
+// dueDateAdjustmentAuditState is the due date adjustment snapshot the audit
+// log compares. Each loan it moved is logged on its own.
+type dueDateAdjustmentAuditState struct {
+	DueFrom       time.Time  `json:"due_from"`
+	DueTo         time.Time  `json:"due_to"`
+	BranchID      *uuid.UUID `json:"branch_id"`
+	BookID        *uuid.UUID `json:"book_id"`
+	ShiftDays     int        `json:"shift_days"`
+	NewDueDate    *time.Time `json:"new_due_date"`
+	Reason        string     `json:"reason"`
+	LoansAdjusted int        `json:"loans_adjusted"`
+}
+
+func copyAudit(bookCopy *entity.BookCopy) *copyAuditState {
+	return &copyAuditState{
+		BookID:    bookCopy.BookID,
+		BranchID:  bookCopy.BranchID,
+		Barcode:   bookCopy.Barcode,
+		Location:  bookCopy.Location,
+		Condition: bookCopy.Condition,
+		Status:    bookCopy.Status,
+	}
+}
+
+func holdAudit(hold *entity.Hold) *holdAuditState {
+	return &holdAuditState{
+		UserID:         hold.UserID,
+		BookID:         hold.BookID,
+		Status:         hold.Status,
+		ReadyAt:        hold.ReadyAt,
+		PickupDeadline: hold.PickupDeadline,
+		CopyID:         hold.CopyID,
+	}
+}
+
+func fineAudit(fine *entity.Fine) *fineAuditState {
+	return &fineAuditState{
+		UserID:      fine.UserID,
+		LoanID:      fine.LoanID,
+		Reason:      fine.Reason,
+		AmountCents: fine.AmountCents,
+		PaidCents:   fine.PaidCents,
+		Status:      fine.Status,
+	}
+}
+
+func loanPolicyAudit(policy *entity.LoanPolicy) *loanPolicyAuditState {
+	return &loanPolicyAuditState{
+		PatronCategory: policy.PatronCategory,
+		ItemCategory:   policy.ItemCategory,
+		MaxLoans:       policy.MaxLoans,
+		LoanDays:       policy.LoanDays,
+		MaxRenewals:    policy.MaxRenewals,
+	}
+}
+
+func branchAudit(branch *entity.Branch) *branchAuditState {
+	return &branchAuditState{
+		Code:    branch.Code,
+		Name:    branch.Name,
+		Address: branch.Address,
+	}
+}
+
+func transferAudit(transfer *entity.Transfer) *transferAuditState {
+	return &transferAuditState{
+		CopyID:       transfer.CopyID,
+		BookID:       transfer.BookID,
+		FromBranchID: transfer.FromBranchID,
+		ToBranchID:   transfer.ToBranchID,
+		Status:       transfer.Status,
+		ShippedAt:    transfer.ShippedAt,
+		ReceivedAt:   transfer.ReceivedAt,
+	}
+}
+
+func webhookAudit(subscription *entity.WebhookSubscription) *webhookAuditState {
+	return &webhookAuditState{
+		URL:    subscription.URL,
+		Events: subscription.Events,
+		Active: subscription.Active,
+	}
+}
+
+func webhookRedeliveryAudit(delivery, again *entity.WebhookDelivery) *webhookRedeliveryAuditState {
+	return &webhookRedeliveryAuditState{
+		DeliveryID:   again.ID,
+		RedeliveryOf: delivery.ID,
+		EventID:      again.EventID,
+		EventType:    again.EventType,
+	}
+}
+
+func openingHoursAudit(hours []*entity.OpeningHours) *openingHoursAuditState {
+	state := &openingHoursAuditState{Hours: make(map[string]string, len(hours))}
+	for _, h := range hours {
+		state.Hours[h.Weekday.String()] = entity.FormatClockTime(h.OpensAt) + "-" + entity.FormatClockTime(h.ClosesAt)
+	}
+	return state
+}
+
+func closedDateAudit(closedDate *entity.ClosedDate) *closedDateAuditState {
+	return &closedDateAuditState{
+		BranchID: closedDate.BranchID,
+		Date:     closedDate.Date,
+		Reason:   closedDate.Reason,
+	}
+}
+
+func dueDateAdjustmentAudit(adjustment *entity.DueDateAdjustment) *dueDateAdjustmentAuditState {
+	return &dueDateAdjustmentAuditState{
+		DueFrom:       adjustment.DueFrom,
+		DueTo:         adjustment.DueTo,
+		BranchID:      adjustment.BranchID,
+		BookID:        adjustment.BookID,
+		ShiftDays:     adjustment.ShiftDays,
+		NewDueDate:    adjustment.NewDueDate,
+		Reason:        adjustment.Reason,
+		LoansAdjusted: adjustment.LoansAdjusted,
+	}
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// mockAuditor keeps the records it was given.
+type mockAuditor struct {
+	records []AuditRecord
+}
+
+func newMockAuditor() *mockAuditor {
+	return &mockAuditor{}
+}
+
+func (m *mockAuditor) Record(ctx context.Context, record AuditRecord) error {
+	m.records = append(m.records, record)
+	return nil
+}
+
+func (m *mockAuditor) actions() []string {
+	actions := make([]string, len(m.records))
+	for i, record := range m.records {
+		actions[i] = record.Action
+	}
+	return actions
+}
+
+// mockAuditRepository keeps the chain in sequence order.
+type mockAuditRepository struct {
+	entries []*entity.AuditEntry
+}
+
+func newMockAuditRepository() *mockAuditRepository {
+	return &mockAuditRepository{}
+}
+
+func (m *mockAuditRepository) Append(ctx context.Context, entry *entity.AuditEntry) error {
+	if len(m.entries) == 0 {
+		entry.Chain(0, "")
+	} else {
+		last := m.entries[len(m.entries)-1]
+		entry.Chain(last.Sequence, last.Hash)
+	}
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func (m *mockAuditRepository) List(ctx context.Context, page, limit int, filter repository.AuditFilter) ([]*entity.AuditEntry, int, error) {
+	entries := make([]*entity.AuditEntry, 0)
+	for i := len(m.entries) - 1; i >= 0; i-- {
+		entry := m.entries[i]
+		if filter.EntityID != nil && entry.EntityID != *filter.EntityID {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, len(entries), nil
+}
+
+func (m *mockAuditRepository) ListChain(ctx context.Context, afterSequence int64, limit int) ([]*entity.AuditEntry, error) {
+	entries := make([]*entity.AuditEntry, 0)
+	for _, entry := range m.entries {
+		if entry.Sequence > afterSequence && len(entries) < limit {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func TestAuditUseCase_Record(t *testing.T) {
+	repo := newMockAuditRepository()
+	uc := NewAuditUseCase(repo, newMockTxManager())
+
+	actorID := uuid.New()
+	ctx := ContextWithCaller(context.Background(), Caller{UserID: actorID, Role: entity.RoleAdmin})
+	ctx = ContextWithRequestID(ctx, "req-42")
+
+	user, _ := entity.NewUser("John Doe", "john@example.com", "hashed_password")
+	before := userAudit(user)
+	_ = user.Disable()
+
+	err := uc.Record(ctx, AuditRecord{
+		Action:     entity.AuditUserDisabled,
+		EntityType: entity.AuditEntityUser,
+		EntityID:   user.ID,
+		Before:     before,
+		After:      userAudit(user),
+	})
+	if err != nil {
+		t.Fatalf("AuditUseCase.Record() unexpected error = %v", err)
+	}
+
+	if len(repo.entries) != 1 {
+		t.Fatalf("AuditUseCase.Record() appended %d entries, want 1", len(repo.entries))
+	}
+	entry := repo.entries[0]
+	if entry.ActorID == nil || *entry.ActorID != actorID {
+		t.Errorf("AuditUseCase.Record() actor = %v, want %v", entry.ActorID, actorID)
+	}
+	if entry.RequestID != "req-42" {
+		t.Errorf("AuditUseCase.Record() request ID = %q, want %q", entry.RequestID, "req-42")
+	}
+	want := map[string]entity.AuditChange{"active": {Before: true, After: false}}
+	if len(entry.Changes) != 1 || entry.Changes["active"] != want["active"] {
+		t.Errorf("AuditUseCase.Record() changes = %v, want %v", entry.Changes, want)
+	}
+}
+
+func TestAuditUseCase_Record_BackgroundJob(t *testing.T) {
+	repo := newMockAuditRepository()
+	uc := NewAuditUseCase(repo, newMockTxManager())
+
+	err := uc.Record(context.Background(), AuditRecord{
+		Action:     entity.AuditLoanLost,
+		EntityType: entity.AuditEntityLoan,
+		EntityID:   uuid.New(),
+	})
+	if err != nil {
+		t.Fatalf("AuditUseCase.Record() unexpected error = %v", err)
+	}
+	if entry := repo.entries[0]; entry.ActorID != nil || entry.RequestID != "" {
+		t.Errorf("AuditUseCase.Record() actor = %v, request ID = %q, want neither", entry.ActorID, entry.RequestID)
+	}
+}
+
+func TestAuditUseCase_Verify(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockAuditRepository()
+	uc := NewAuditUseCase(repo, newMockTxManager())
+
+	for i := 0; i < auditVerifyBatch+2; i++ {
+		_ = uc.Record(ctx, AuditRecord{
+			Action:     entity.AuditBookCreated,
+			EntityType: entity.AuditEntityBook,
+			EntityID:   uuid.New(),
+			After:      map[string]int{"total_copies": i},
+		})
+	}
+
+	t.Run("intact chain", func(t *testing.T) {
+		result, err := uc.Verify(ctx)
+		if err != nil {
+			t.Fatalf("AuditUseCase.Verify() unexpected error = %v", err)
+		}
+		if !result.Valid() || result.Checked != auditVerifyBatch+2 {
+			t.Errorf("AuditUseCase.Verify() = %+v, want %d entries intact", result, auditVerifyBatch+2)
+		}
+	})
+
+	t.Run("tampered entry", func(t *testing.T) {
+		tampered := repo.entries[auditVerifyBatch]
+		tampered.Changes["total_copies"] = entity.AuditChange{After: 99.0}
+
+		result, err := uc.Verify(ctx)
+		if err != nil {
+			t.Fatalf("AuditUseCase.Verify() unexpected error = %v", err)
+		}
+		if result.Valid() || *result.BrokenAt != tampered.Sequence {
+			t.Errorf("AuditUseCase.Verify() broken at = %v, want %v", result.BrokenAt, tampered.Sequence)
+		}
+		if result.Checked != auditVerifyBatch || result.Reason != entity.ErrAuditHashMismatch.Error() {
+			t.Errorf("AuditUseCase.Verify() = %+v, want %d checked and a hash mismatch", result, auditVerifyBatch)
+		}
+	})
+}
+
+func TestAuditUseCase_List(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockAuditRepository()
+	uc := NewAuditUseCase(repo, newMockTxManager())
+
+	entityID := uuid.New()
+	_ = uc.Record(ctx, AuditRecord{Action: entity.AuditBookCreated, EntityType: entity.AuditEntityBook, EntityID: entityID})
+	_ = uc.Record(ctx, AuditRecord{Action: entity.AuditBookCreated, EntityType: entity.AuditEntityBook, EntityID: uuid.New()})
+
+	entries, total, err := uc.List(ctx, 1, 10, repository.AuditFilter{EntityID: &entityID})
+	if err != nil {
+		t.Fatalf("AuditUseCase.List() unexpected error = %v", err)
+	}
+	if total != 1 || entries[0].EntityID != entityID {
+		t.Errorf("AuditUseCase.List() = %v entries of %d, want the one for %v", len(entries), total, entityID)
+	}
+}
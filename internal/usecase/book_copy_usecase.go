@@ -0,0 +1,276 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type BookCopyUseCase interface {
+	Create(ctx context.Context, bookID uuid.UUID, input CreateBookCopyInput) (*entity.BookCopy, error)
+	GetByID(ctx context.Context, bookID, copyID uuid.UUID) (*entity.BookCopy, error)
+	List(ctx context.Context, bookID uuid.UUID) ([]*entity.BookCopy, error)
+	Update(ctx context.Context, bookID, copyID uuid.UUID, input UpdateBookCopyInput) (*entity.BookCopy, error)
+	Delete(ctx context.Context, bookID, copyID uuid.UUID) error
+}
+
+type CreateBookCopyInput struct {
+	Barcode  string
+	Location string
+	// Condition defaults to entity.CopyConditionGood when empty
+	Condition string
+	// BranchID is where the copy is kept; the default branch when nil
+	BranchID *uuid.UUID
+}
+
+// UpdateBookCopyInput changes the fields left non-nil.
+type UpdateBookCopyInput struct {
+	Location  *string
+	Condition *string
+	Status    *string
+}
+
+type bookCopyUseCase struct {
+	copyRepo     repository.BookCopyRepository
+	bookRepo     repository.BookRepository
+	holdRepo     repository.HoldRepository
+	branchRepo   repository.BranchRepository
+	transferRepo repository.TransferRepository
+	txManager    repository.TxManager
+	events       EventEmitter
+	audit        Auditor
+	pickupWindow time.Duration
+}
+
+func NewBookCopyUseCase(
+	copyRepo repository.BookCopyRepository,
+	bookRepo repository.BookRepository,
+	holdRepo repository.HoldRepository,
+	branchRepo repository.BranchRepository,
+	transferRepo repository.TransferRepository,
+	txManager repository.TxManager,
+	events EventEmitter,
+	audit Auditor,
+	pickupWindow time.Duration,
+) BookCopyUseCase {
+	return &bookCopyUseCase{
+		copyRepo:     copyRepo,
+		bookRepo:     bookRepo,
+		holdRepo:     holdRepo,
+		branchRepo:   branchRepo,
+		transferRepo: transferRepo,
+		txManager:    txManager,
+		events:       events,
+		audit:        audit,
+		pickupWindow: pickupWindow,
+	}
+}
+
+func (uc *bookCopyUseCase) Create(ctx context.Context, bookID uuid.UUID, input CreateBookCopyInput) (*entity.BookCopy, error) {
+	branch, err := resolveBranch(ctx, uc.branchRepo, input.BranchID)
+	if err != nil {
+		return nil, err
+	}
+
+	var bookCopy *entity.BookCopy
+
+	err = withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		book, err := uc.getBook(ctx, bookID)
+		if err != nil {
+			return err
+		}
+		if book.IsWithdrawn() {
+			return entity.ErrBookWithdrawn
+		}
+
+		bookCopy, err = entity.NewBookCopy(book.ID, branch.ID, input.Barcode, input.Location, input.Condition)
+		if err != nil {
+			return err
+		}
+
+		existing, err := uc.copyRepo.GetByBarcode(ctx, bookCopy.Barcode)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return entity.ErrBarcodeAlreadyExists
+		}
+
+		if err := uc.copyRepo.Create(ctx, bookCopy); err != nil {
+			return err
+		}
+		if err := recordCopy(ctx, uc.audit, entity.AuditCopyCreated, nil, bookCopy); err != nil {
+			return err
+		}
+
+		// A new copy serves the hold queue before it reaches the shelf.
+		return releaseCopy(ctx, uc.holdRepo, uc.copyRepo, uc.bookRepo, uc.events, uc.audit, book, bookCopy, uc.pickupWindow)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bookCopy, nil
+}
+
+func (uc *bookCopyUseCase) GetByID(ctx context.Context, bookID, copyID uuid.UUID) (*entity.BookCopy, error) {
+	bookCopy, err := uc.copyRepo.GetByID(ctx, copyID)
+	if err != nil {
+		return nil, err
+	}
+	if bookCopy == nil || bookCopy.BookID != bookID {
+		return nil, entity.ErrBookCopyNotFound
+	}
+	return bookCopy, nil
+}
+
+func (uc *bookCopyUseCase) List(ctx context.Context, bookID uuid.UUID) ([]*entity.BookCopy, error) {
+	if _, err := uc.getBook(ctx, bookID); err != nil {
+		return nil, err
+	}
+	return uc.copyRepo.ListByBook(ctx, bookID)
+}
+
+func (uc *bookCopyUseCase) Update(ctx context.Context, bookID, copyID uuid.UUID, input UpdateBookCopyInput) (*entity.BookCopy, error) {
+	var bookCopy *entity.BookCopy
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		var err error
+		bookCopy, err = uc.GetByID(ctx, bookID, copyID)
+		if err != nil {
+			return err
+		}
+
+		location, condition, status := bookCopy.Location, bookCopy.Condition, bookCopy.Status
+		if input.Location != nil {
+			location = *input.Location
+		}
+		if input.Condition != nil {
+			condition = *input.Condition
+		}
+		if input.Status != nil {
+			status = *input.Status
+		}
+
+		before := copyAudit(bookCopy)
+		wasAvailable := bookCopy.Status == entity.CopyStatusAvailable
+		if err := bookCopy.Update(location, condition, status); err != nil {
+			return err
+		}
+
+		book, err := uc.getBook(ctx, bookID)
+		if err != nil {
+			return err
+		}
+		if book.IsWithdrawn() {
+			return entity.ErrBookWithdrawn
+		}
+
+		// A copy coming back from repair serves the hold queue first.
+		if bookCopy.Status == entity.CopyStatusAvailable && !wasAvailable {
+			if err := releaseCopy(ctx, uc.holdRepo, uc.copyRepo, uc.bookRepo, uc.events, uc.audit, book, bookCopy, uc.pickupWindow); err != nil {
+				return err
+			}
+		} else {
+			if err := uc.copyRepo.Update(ctx, bookCopy); err != nil {
+				return err
+			}
+			if err := syncCopyCounts(ctx, uc.copyRepo, uc.bookRepo, book); err != nil {
+				return err
+			}
+		}
+		return recordCopy(ctx, uc.audit, entity.AuditCopyUpdated, before, bookCopy)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bookCopy, nil
+}
+
+func (uc *bookCopyUseCase) Delete(ctx context.Context, bookID, copyID uuid.UUID) error {
+	return withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		bookCopy, err := uc.GetByID(ctx, bookID, copyID)
+		if err != nil {
+			return err
+		}
+		if bookCopy.IsInCirculation() {
+			return entity.ErrCopyInCirculation
+		}
+
+		transfer, err := uc.transferRepo.GetOpenByCopy(ctx, bookCopy.ID)
+		if err != nil {
+			return err
+		}
+		if transfer != nil {
+			return entity.ErrCopyHasOpenTransfer
+		}
+
+		book, err := uc.getBook(ctx, bookID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.copyRepo.Delete(ctx, bookCopy.ID); err != nil {
+			return err
+		}
+		if err := recordCopyDeleted(ctx, uc.audit, bookCopy); err != nil {
+			return err
+		}
+		return syncCopyCounts(ctx, uc.copyRepo, uc.bookRepo, book)
+	})
+}
+
+func (uc *bookCopyUseCase) getBook(ctx context.Context, bookID uuid.UUID) (*entity.Book, error) {
+	book, err := uc.bookRepo.GetByID(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	if book == nil {
+		return nil, entity.ErrBookNotFound
+	}
+	return book, nil
+}
+
+// syncCopyCounts derives the book's copy counts from its copies and saves
+// it. Every change to a copy's status goes through here, so the book version
+// check also serializes concurrent moves of its copies.
+func syncCopyCounts(
+	ctx context.Context,
+	copies repository.BookCopyRepository,
+	books repository.BookRepository,
+	book *entity.Book,
+) error {
+	all, err := copies.ListByBook(ctx, book.ID)
+	if err != nil {
+		return err
+	}
+	book.RefreshCopyCounts(all)
+	return books.Update(ctx, book)
+}
+
+// recordCopy records the change to bookCopy from before, or its creation
+// when before is nil, in the audit log.
+func recordCopy(ctx context.Context, audit Auditor, action string, before *copyAuditState, bookCopy *entity.BookCopy) error {
+	return audit.Record(ctx, AuditRecord{
+		Action:     action,
+		EntityType: entity.AuditEntityCopy,
+		EntityID:   bookCopy.ID,
+		Before:     before,
+		After:      copyAudit(bookCopy),
+	})
+}
+
+// recordCopyDeleted records the deletion of bookCopy in the audit log.
+func recordCopyDeleted(ctx context.Context, audit Auditor, bookCopy *entity.BookCopy) error {
+	return audit.Record(ctx, AuditRecord{
+		Action:     entity.AuditCopyDeleted,
+		EntityType: entity.AuditEntityCopy,
+		EntityID:   bookCopy.ID,
+		Before:     copyAudit(bookCopy),
+	})
+}
@@ -0,0 +1,356 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// mockBookCopyRepository keeps copies in insertion order so FindByStatus
+// picks them the same way on every run.
+type mockBookCopyRepository struct {
+	copies map[uuid.UUID]*entity.BookCopy
+	order  []uuid.UUID
+}
+
+func newMockBookCopyRepository() *mockBookCopyRepository {
+	return &mockBookCopyRepository{
+		copies: make(map[uuid.UUID]*entity.BookCopy),
+	}
+}
+
+func (m *mockBookCopyRepository) Create(ctx context.Context, bookCopy *entity.BookCopy) error {
+	m.copies[bookCopy.ID] = bookCopy
+	m.order = append(m.order, bookCopy.ID)
+	return nil
+}
+
+func (m *mockBookCopyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.BookCopy, error) {
+	if bookCopy, exists := m.copies[id]; exists {
+		return bookCopy, nil
+	}
+	return nil, nil
+}
+
+func (m *mockBookCopyRepository) GetByBarcode(ctx context.Context, barcode string) (*entity.BookCopy, error) {
+	for _, bookCopy := range m.copies {
+		if bookCopy.Barcode == barcode {
+			return bookCopy, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockBookCopyRepository) ListByBook(ctx context.Context, bookID uuid.UUID) ([]*entity.BookCopy, error) {
+	copies := make([]*entity.BookCopy, 0)
+	for _, id := range m.order {
+		if bookCopy, exists := m.copies[id]; exists && bookCopy.BookID == bookID {
+			copies = append(copies, bookCopy)
+		}
+	}
+	return copies, nil
+}
+
+func (m *mockBookCopyRepository) FindByStatus(ctx context.Context, bookID uuid.UUID, status string) (*entity.BookCopy, error) {
+	for _, id := range m.order {
+		if bookCopy, exists := m.copies[id]; exists && bookCopy.BookID == bookID && bookCopy.Status == status {
+			return bookCopy, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockBookCopyRepository) CountByBranch(ctx context.Context, branchID uuid.UUID) (int, error) {
+	count := 0
+	for _, bookCopy := range m.copies {
+		if bookCopy.BranchID == branchID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockBookCopyRepository) AvailabilityByBranch(ctx context.Context, bookIDs []uuid.UUID) ([]entity.BranchAvailability, error) {
+	availability := make([]entity.BranchAvailability, 0)
+	for _, bookID := range bookIDs {
+		for _, id := range m.order {
+			bookCopy, exists := m.copies[id]
+			if !exists || bookCopy.BookID != bookID || bookCopy.Status == entity.CopyStatusWithdrawn {
+				continue
+			}
+			i := slices.IndexFunc(availability, func(a entity.BranchAvailability) bool {
+				return a.BookID == bookID && a.BranchID == bookCopy.BranchID
+			})
+			if i < 0 {
+				availability = append(availability, entity.BranchAvailability{BookID: bookID, BranchID: bookCopy.BranchID})
+				i = len(availability) - 1
+			}
+			availability[i].TotalCopies++
+			if bookCopy.Status == entity.CopyStatusAvailable {
+				availability[i].AvailableCopies++
+			}
+		}
+	}
+	return availability, nil
+}
+
+func (m *mockBookCopyRepository) Update(ctx context.Context, bookCopy *entity.BookCopy) error {
+	m.copies[bookCopy.ID] = bookCopy
+	return nil
+}
+
+func (m *mockBookCopyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(m.copies, id)
+	return nil
+}
+
+// snapshot saves the state of every copy and returns a func that puts it
+// back, standing in for a transaction rollback.
+func (m *mockBookCopyRepository) snapshot() func() {
+	saved := make(map[uuid.UUID]entity.BookCopy, len(m.copies))
+	for id, bookCopy := range m.copies {
+		saved[id] = *bookCopy
+	}
+	return func() {
+		for id, bookCopy := range saved {
+			*m.copies[id] = bookCopy
+		}
+	}
+}
+
+type bookCopyTestData struct {
+	copyUC       BookCopyUseCase
+	loanUC       LoanUseCase
+	holdRepo     *mockHoldRepository
+	branchRepo   *mockBranchRepository
+	transferRepo *mockTransferRepository
+	book         *entity.Book
+	user         *entity.User
+}
+
+func newBookCopyTestData(t *testing.T, totalCopies int) *bookCopyTestData {
+	ctx := context.Background()
+
+	userRepo := newMockUserRepository()
+	bookRepo := newMockBookRepository()
+	copyRepo := newMockBookCopyRepository()
+	holdRepo := newMockHoldRepository()
+	branchRepo := newMockBranchRepository()
+	transferRepo := newMockTransferRepository()
+	txManager := newMockTxManager()
+
+	user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+	book, err := NewBookUseCase(bookRepo, copyRepo, branchRepo, newMockLoanRepository(), newMockHoldRepository(), txManager, newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+		Title:         "Clean Code",
+		Author:        "Robert C. Martin",
+		ISBN:          "9780132350884",
+		PublishedYear: 2008,
+		TotalCopies:   totalCopies,
+	})
+	if err != nil {
+		t.Fatalf("BookUseCase.Create() unexpected error = %v", err)
+	}
+
+	return &bookCopyTestData{
+		copyUC:       NewBookCopyUseCase(copyRepo, bookRepo, holdRepo, branchRepo, transferRepo, txManager, newMockEventEmitter(), newMockAuditor(), testLoanRules.HoldPickupWindow),
+		loanUC:       NewLoanUseCase(newMockLoanRepository(), bookRepo, copyRepo, userRepo, holdRepo, newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), txManager, newMockEventEmitter(), newMockAuditor(), testLoanRules),
+		holdRepo:     holdRepo,
+		branchRepo:   branchRepo,
+		transferRepo: transferRepo,
+		book:         book,
+		user:         user,
+	}
+}
+
+func TestBookUseCase_CreateAddsCopies(t *testing.T) {
+	ctx := context.Background()
+	data := newBookCopyTestData(t, 3)
+
+	copies, err := data.copyUC.List(ctx, data.book.ID)
+	if err != nil {
+		t.Fatalf("BookCopyUseCase.List() unexpected error = %v", err)
+	}
+
+	if len(copies) != 3 {
+		t.Fatalf("BookCopyUseCase.List() len = %v, want %v", len(copies), 3)
+	}
+	if copies[0].Barcode != "9780132350884-001" {
+		t.Errorf("BookCopyUseCase.List() barcode = %v, want %v", copies[0].Barcode, "9780132350884-001")
+	}
+}
+
+func TestBookCopyUseCase_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("new copy goes on the shelf", func(t *testing.T) {
+		data := newBookCopyTestData(t, 1)
+
+		bookCopy, err := data.copyUC.Create(ctx, data.book.ID, CreateBookCopyInput{Barcode: "BC-100", Location: "A-1"})
+		if err != nil {
+			t.Fatalf("BookCopyUseCase.Create() unexpected error = %v", err)
+		}
+
+		if bookCopy.Status != entity.CopyStatusAvailable {
+			t.Errorf("BookCopyUseCase.Create() status = %v, want %v", bookCopy.Status, entity.CopyStatusAvailable)
+		}
+		if data.book.TotalCopies != 2 || data.book.AvailableCopies != 2 {
+			t.Errorf("BookCopyUseCase.Create() copies = %v/%v, want %v/%v", data.book.AvailableCopies, data.book.TotalCopies, 2, 2)
+		}
+	})
+
+	t.Run("new copy serves the hold queue", func(t *testing.T) {
+		data := newBookCopyTestData(t, 1)
+		hold := entity.NewHold(data.user.ID, data.book.ID)
+		_ = data.holdRepo.Create(ctx, hold)
+
+		bookCopy, err := data.copyUC.Create(ctx, data.book.ID, CreateBookCopyInput{Barcode: "BC-100"})
+		if err != nil {
+			t.Fatalf("BookCopyUseCase.Create() unexpected error = %v", err)
+		}
+
+		if bookCopy.Status != entity.CopyStatusOnHold {
+			t.Errorf("BookCopyUseCase.Create() status = %v, want %v", bookCopy.Status, entity.CopyStatusOnHold)
+		}
+		if !hold.IsReady() {
+			t.Errorf("BookCopyUseCase.Create() hold status = %v, want %v", hold.Status, entity.HoldStatusReady)
+		}
+		if data.book.AvailableCopies != 1 {
+			t.Errorf("BookCopyUseCase.Create() available copies = %v, want %v", data.book.AvailableCopies, 1)
+		}
+	})
+
+	t.Run("new copy kept at the given branch", func(t *testing.T) {
+		data := newBookCopyTestData(t, 1)
+		branch, _ := entity.NewBranch("NORTH", "North Branch", "")
+		_ = data.branchRepo.Create(ctx, branch)
+
+		bookCopy, err := data.copyUC.Create(ctx, data.book.ID, CreateBookCopyInput{Barcode: "BC-100", BranchID: &branch.ID})
+		if err != nil {
+			t.Fatalf("BookCopyUseCase.Create() unexpected error = %v", err)
+		}
+
+		if bookCopy.BranchID != branch.ID {
+			t.Errorf("BookCopyUseCase.Create() branch = %v, want %v", bookCopy.BranchID, branch.ID)
+		}
+	})
+
+	t.Run("unknown branch", func(t *testing.T) {
+		data := newBookCopyTestData(t, 1)
+		branchID := uuid.New()
+
+		_, err := data.copyUC.Create(ctx, data.book.ID, CreateBookCopyInput{Barcode: "BC-100", BranchID: &branchID})
+		if err != entity.ErrBranchNotFound {
+			t.Errorf("BookCopyUseCase.Create() error = %v, want %v", err, entity.ErrBranchNotFound)
+		}
+	})
+
+	t.Run("duplicate barcode", func(t *testing.T) {
+		data := newBookCopyTestData(t, 1)
+
+		_, err := data.copyUC.Create(ctx, data.book.ID, CreateBookCopyInput{Barcode: "9780132350884-001"})
+		if err != entity.ErrBarcodeAlreadyExists {
+			t.Errorf("BookCopyUseCase.Create() error = %v, want %v", err, entity.ErrBarcodeAlreadyExists)
+		}
+	})
+
+	t.Run("book not found", func(t *testing.T) {
+		data := newBookCopyTestData(t, 1)
+
+		_, err := data.copyUC.Create(ctx, uuid.New(), CreateBookCopyInput{Barcode: "BC-100"})
+		if err != entity.ErrBookNotFound {
+			t.Errorf("BookCopyUseCase.Create() error = %v, want %v", err, entity.ErrBookNotFound)
+		}
+	})
+}
+
+func TestBookCopyUseCase_Update(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sending a copy to repair takes it off the shelf", func(t *testing.T) {
+		data := newBookCopyTestData(t, 2)
+		copies, _ := data.copyUC.List(ctx, data.book.ID)
+
+		status := entity.CopyStatusInRepair
+		_, err := data.copyUC.Update(ctx, data.book.ID, copies[0].ID, UpdateBookCopyInput{Status: &status})
+		if err != nil {
+			t.Fatalf("BookCopyUseCase.Update() unexpected error = %v", err)
+		}
+
+		if data.book.TotalCopies != 2 || data.book.AvailableCopies != 1 {
+			t.Errorf("BookCopyUseCase.Update() copies = %v/%v, want %v/%v", data.book.AvailableCopies, data.book.TotalCopies, 1, 2)
+		}
+	})
+
+	t.Run("copy on loan cannot change status", func(t *testing.T) {
+		data := newBookCopyTestData(t, 1)
+		loan, err := data.loanUC.BorrowBook(ctx, BorrowBookInput{UserID: data.user.ID, BookID: data.book.ID})
+		if err != nil {
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+
+		status := entity.CopyStatusWithdrawn
+		_, err = data.copyUC.Update(ctx, data.book.ID, *loan.Loan.CopyID, UpdateBookCopyInput{Status: &status})
+		if err != entity.ErrCopyInCirculation {
+			t.Errorf("BookCopyUseCase.Update() error = %v, want %v", err, entity.ErrCopyInCirculation)
+		}
+	})
+
+	t.Run("copy of another book", func(t *testing.T) {
+		data := newBookCopyTestData(t, 1)
+		copies, _ := data.copyUC.List(ctx, data.book.ID)
+
+		_, err := data.copyUC.Update(ctx, uuid.New(), copies[0].ID, UpdateBookCopyInput{})
+		if err != entity.ErrBookCopyNotFound {
+			t.Errorf("BookCopyUseCase.Update() error = %v, want %v", err, entity.ErrBookCopyNotFound)
+		}
+	})
+}
+
+func TestBookCopyUseCase_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("delete shelved copy", func(t *testing.T) {
+		data := newBookCopyTestData(t, 2)
+		copies, _ := data.copyUC.List(ctx, data.book.ID)
+
+		if err := data.copyUC.Delete(ctx, data.book.ID, copies[0].ID); err != nil {
+			t.Fatalf("BookCopyUseCase.Delete() unexpected error = %v", err)
+		}
+
+		if data.book.TotalCopies != 1 {
+			t.Errorf("BookCopyUseCase.Delete() total copies = %v, want %v", data.book.TotalCopies, 1)
+		}
+	})
+
+	t.Run("copy on loan cannot be deleted", func(t *testing.T) {
+		data := newBookCopyTestData(t, 1)
+		loan, _ := data.loanUC.BorrowBook(ctx, BorrowBookInput{UserID: data.user.ID, BookID: data.book.ID})
+
+		err := data.copyUC.Delete(ctx, data.book.ID, *loan.Loan.CopyID)
+		if err != entity.ErrCopyInCirculation {
+			t.Errorf("BookCopyUseCase.Delete() error = %v, want %v", err, entity.ErrCopyInCirculation)
+		}
+	})
+
+	t.Run("copy with an open transfer cannot be deleted", func(t *testing.T) {
+		data := newBookCopyTestData(t, 2)
+		copies, _ := data.copyUC.List(ctx, data.book.ID)
+		branch, _ := entity.NewBranch("NORTH", "North Branch", "")
+		transfer, _ := entity.NewTransfer(copies[0], branch.ID)
+		_ = data.transferRepo.Create(ctx, transfer)
+
+		err := data.copyUC.Delete(ctx, data.book.ID, copies[0].ID)
+		if err != entity.ErrCopyHasOpenTransfer {
+			t.Errorf("BookCopyUseCase.Delete() error = %v, want %v", err, entity.ErrCopyHasOpenTransfer)
+		}
+	})
+}
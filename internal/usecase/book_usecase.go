@@ -2,6 +2,10 @@ package usecase
 
 import (
 	"context"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"bookhub/internal/domain/entity"
 	"bookhub/internal/domain/repository"
@@ -12,7 +16,20 @@ import (
 type BookUseCase interface {
 	Create(ctx context.Context, input CreateBookInput) (*entity.Book, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Book, error)
-	List(ctx context.Context, page, limit int, availableOnly *bool) ([]*entity.Book, int, error)
+	List(ctx context.Context, page, limit int, filter repository.BookFilter) ([]*entity.Book, int, error)
+	// Search finds books by words of their title or author or by ISBN, best
+	// matches first.
+	Search(ctx context.Context, page, limit int, search repository.BookSearch) ([]*repository.BookMatch, int, error)
+	// Suggest completes prefix with the titles and authors of listed books,
+	// ignoring case and accents.
+	Suggest(ctx context.Context, prefix string, limit int) (*BookSuggestions, error)
+	Update(ctx context.Context, id uuid.UUID, input UpdateBookInput) (*entity.Book, error)
+	// Withdraw takes the book out of the catalog along with its copies and
+	// cancels its holds. Its loan history is kept.
+	Withdraw(ctx context.Context, id uuid.UUID) (*entity.Book, error)
+	// Delete removes a book that was never lent or held. Books with history
+	// are withdrawn instead.
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 type CreateBookInput struct {
@@ -21,15 +38,75 @@ type CreateBookInput struct {
 	ISBN          string
 	PublishedYear int
 	TotalCopies   int
+	// Category defaults to entity.DefaultItemCategory when empty
+	Category string
+	// BranchID is where the copies are kept; the default branch when nil
+	BranchID *uuid.UUID
 }
 
+// UpdateBookInput changes the fields left non-nil.
+type UpdateBookInput struct {
+	Title         *string
+	Author        *string
+	ISBN          *string
+	PublishedYear *int
+	Category      *string
+	// TotalCopies adds copies, or withdraws copies on the shelf or in
+	// repair, until the book holds that many.
+	TotalCopies *int
+	// BranchID is where added copies are kept; the default branch when nil
+	BranchID *uuid.UUID
+}
+
+// BookSuggestions are the completions of a prefix, in alphabetical order.
+type BookSuggestions struct {
+	Titles  []string
+	Authors []string
+}
+
+// Suggest completes prefixes of minSuggestPrefix to maxSuggestPrefix
+// characters; shorter ones match too much of the catalog to be useful.
+const (
+	minSuggestPrefix = 2
+	maxSuggestPrefix = 100
+)
+
+// holdCancelBatch is how many holds Withdraw cancels at a time.
+const holdCancelBatch = 100
+
 type bookUseCase struct {
-	bookRepo repository.BookRepository
+	bookRepo     repository.BookRepository
+	copyRepo     repository.BookCopyRepository
+	branchRepo   repository.BranchRepository
+	loanRepo     repository.LoanRepository
+	holdRepo     repository.HoldRepository
+	txManager    repository.TxManager
+	events       EventEmitter
+	audit        Auditor
+	pickupWindow time.Duration
 }
 
-func NewBookUseCase(bookRepo repository.BookRepository) BookUseCase {
+func NewBookUseCase(
+	bookRepo repository.BookRepository,
+	copyRepo repository.BookCopyRepository,
+	branchRepo repository.BranchRepository,
+	loanRepo repository.LoanRepository,
+	holdRepo repository.HoldRepository,
+	txManager repository.TxManager,
+	events EventEmitter,
+	audit Auditor,
+	pickupWindow time.Duration,
+) BookUseCase {
 	return &bookUseCase{
-		bookRepo: bookRepo,
+		bookRepo:     bookRepo,
+		copyRepo:     copyRepo,
+		branchRepo:   branchRepo,
+		loanRepo:     loanRepo,
+		holdRepo:     holdRepo,
+		txManager:    txManager,
+		events:       events,
+		audit:        audit,
+		pickupWindow: pickupWindow,
 	}
 }
 
@@ -44,10 +121,53 @@ func (uc *bookUseCase) Create(ctx context.Context, input CreateBookInput) (*enti
 		return nil, err
 	}
 
-	if err := uc.bookRepo.Create(ctx, book); err != nil {
+	if input.Category != "" {
+		book.Category = input.Category
+		if err := book.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	branch, err := resolveBranch(ctx, uc.branchRepo, input.BranchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// TotalCopies copies are created along with the book, numbered after
+	// its ISBN.
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.bookRepo.Create(ctx, book); err != nil {
+			return err
+		}
+		for n := 1; n <= book.TotalCopies; n++ {
+			bookCopy, err := entity.NewBookCopy(book.ID, branch.ID, entity.DefaultCopyBarcode(book.ISBN, n), "", "")
+			if err != nil {
+				return err
+			}
+			if err := uc.copyRepo.Create(ctx, bookCopy); err != nil {
+				return err
+			}
+			if err := recordCopy(ctx, uc.audit, entity.AuditCopyCreated, nil, bookCopy); err != nil {
+				return err
+			}
+		}
+		if err := uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditBookCreated,
+			EntityType: entity.AuditEntityBook,
+			EntityID:   book.ID,
+			After:      bookAudit(book),
+		}); err != nil {
+			return err
+		}
+		return uc.events.Emit(ctx, bookEvent(entity.EventBookCreated, book))
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	if err := uc.fillBranches(ctx, book); err != nil {
+		return nil, err
+	}
 	return book, nil
 }
 
@@ -59,10 +179,13 @@ func (uc *bookUseCase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Book,
 	if book == nil {
 		return nil, entity.ErrBookNotFound
 	}
+	if err := uc.fillBranches(ctx, book); err != nil {
+		return nil, err
+	}
 	return book, nil
 }
 
-func (uc *bookUseCase) List(ctx context.Context, page, limit int, availableOnly *bool) ([]*entity.Book, int, error) {
+func (uc *bookUseCase) List(ctx context.Context, page, limit int, filter repository.BookFilter) ([]*entity.Book, int, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -72,5 +195,452 @@ func (uc *bookUseCase) List(ctx context.Context, page, limit int, availableOnly
 	if limit > 100 {
 		limit = 100
 	}
-	return uc.bookRepo.List(ctx, page, limit, availableOnly)
+
+	filter.Author = strings.TrimSpace(filter.Author)
+	if filter.PublishedFrom != nil && filter.PublishedTo != nil && *filter.PublishedFrom > *filter.PublishedTo {
+		return nil, 0, entity.ErrInvalidYearRange
+	}
+	if filter.BranchID != nil {
+		if _, err := resolveBranch(ctx, uc.branchRepo, filter.BranchID); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	books, total, err := uc.bookRepo.List(ctx, page, limit, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := uc.fillBranches(ctx, books...); err != nil {
+		return nil, 0, err
+	}
+	return books, total, nil
+}
+
+func (uc *bookUseCase) Search(ctx context.Context, page, limit int, search repository.BookSearch) ([]*repository.BookMatch, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	search.Query = strings.TrimSpace(search.Query)
+	if strings.IndexFunc(search.Query, func(c rune) bool { return unicode.IsLetter(c) || unicode.IsDigit(c) }) < 0 {
+		return nil, 0, entity.ErrInvalidSearchQuery
+	}
+	search.Author = strings.TrimSpace(search.Author)
+	if search.PublishedFrom != nil && search.PublishedTo != nil && *search.PublishedFrom > *search.PublishedTo {
+		return nil, 0, entity.ErrInvalidYearRange
+	}
+	if search.BranchID != nil {
+		if _, err := resolveBranch(ctx, uc.branchRepo, search.BranchID); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	matches, total, err := uc.bookRepo.Search(ctx, page, limit, search)
+	if err != nil {
+		return nil, 0, err
+	}
+	books := make([]*entity.Book, len(matches))
+	for i, match := range matches {
+		books[i] = match.Book
+	}
+	if err := uc.fillBranches(ctx, books...); err != nil {
+		return nil, 0, err
+	}
+	return matches, total, nil
+}
+
+func (uc *bookUseCase) Suggest(ctx context.Context, prefix string, limit int) (*BookSuggestions, error) {
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 20 {
+		limit = 20
+	}
+
+	prefix = strings.TrimSpace(prefix)
+	if n := utf8.RuneCountInString(prefix); n < minSuggestPrefix || n > maxSuggestPrefix {
+		return nil, entity.ErrInvalidSuggestPrefix
+	}
+
+	titles, err := uc.bookRepo.SuggestTitles(ctx, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	authors, err := uc.bookRepo.SuggestAuthors(ctx, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &BookSuggestions{Titles: titles, Authors: authors}, nil
+}
+
+func (uc *bookUseCase) Update(ctx context.Context, id uuid.UUID, input UpdateBookInput) (*entity.Book, error) {
+	var book *entity.Book
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		var err error
+		book, err = uc.getBook(ctx, id)
+		if err != nil {
+			return err
+		}
+		if book.IsWithdrawn() {
+			return entity.ErrBookWithdrawn
+		}
+
+		before := bookAudit(book)
+
+		title, author, isbn := book.Title, book.Author, book.ISBN
+		publishedYear, category := book.PublishedYear, book.Category
+		if input.Title != nil {
+			title = *input.Title
+		}
+		if input.Author != nil {
+			author = *input.Author
+		}
+		if input.ISBN != nil {
+			isbn = *input.ISBN
+		}
+		if input.PublishedYear != nil {
+			publishedYear = *input.PublishedYear
+		}
+		if input.Category != nil {
+			category = *input.Category
+		}
+
+		if isbn != book.ISBN {
+			existing, err := uc.bookRepo.GetByISBN(ctx, isbn)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return entity.ErrInvalidBookISBN
+			}
+		}
+
+		if err := book.Update(title, author, isbn, publishedYear, category); err != nil {
+			return err
+		}
+
+		// Resizing saves the book along with the new counts.
+		if input.TotalCopies != nil && *input.TotalCopies != book.TotalCopies {
+			if err := uc.resizeCopies(ctx, book, *input.TotalCopies, input.BranchID); err != nil {
+				return err
+			}
+		} else if err := uc.bookRepo.Update(ctx, book); err != nil {
+			return err
+		}
+
+		if err := uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditBookUpdated,
+			EntityType: entity.AuditEntityBook,
+			EntityID:   book.ID,
+			Before:     before,
+			After:      bookAudit(book),
+		}); err != nil {
+			return err
+		}
+		return uc.events.Emit(ctx, bookEvent(entity.EventBookUpdated, book))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.fillBranches(ctx, book); err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+func (uc *bookUseCase) Withdraw(ctx context.Context, id uuid.UUID) (*entity.Book, error) {
+	var book *entity.Book
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		var err error
+		book, err = uc.getBook(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		before := bookAudit(book)
+		if err := book.Withdraw(); err != nil {
+			return err
+		}
+		if err := uc.ensureNoActiveLoans(ctx, book.ID); err != nil {
+			return err
+		}
+
+		copies, err := uc.copyRepo.ListByBook(ctx, book.ID)
+		if err != nil {
+			return err
+		}
+		for _, bookCopy := range copies {
+			if bookCopy.Status == entity.CopyStatusInTransit {
+				return entity.ErrCopyInCirculation
+			}
+		}
+
+		// Copies set aside for ready holds are withdrawn with the rest.
+		if err := uc.cancelHolds(ctx, book.ID); err != nil {
+			return err
+		}
+		for _, bookCopy := range copies {
+			if bookCopy.Status == entity.CopyStatusWithdrawn {
+				continue
+			}
+			before := copyAudit(bookCopy)
+			bookCopy.Withdraw()
+			if err := uc.copyRepo.Update(ctx, bookCopy); err != nil {
+				return err
+			}
+			if err := recordCopy(ctx, uc.audit, entity.AuditCopyUpdated, before, bookCopy); err != nil {
+				return err
+			}
+		}
+
+		book.RefreshCopyCounts(copies)
+		if err := uc.bookRepo.Update(ctx, book); err != nil {
+			return err
+		}
+
+		if err := uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditBookWithdrawn,
+			EntityType: entity.AuditEntityBook,
+			EntityID:   book.ID,
+			Before:     before,
+			After:      bookAudit(book),
+		}); err != nil {
+			return err
+		}
+		return uc.events.Emit(ctx, bookEvent(entity.EventBookWithdrawn, book))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.fillBranches(ctx, book); err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+func (uc *bookUseCase) Delete(ctx context.Context, id uuid.UUID) error {
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		book, err := uc.getBook(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.ensureNoActiveLoans(ctx, book.ID); err != nil {
+			return err
+		}
+
+		loans, err := uc.loanRepo.CountByBook(ctx, book.ID)
+		if err != nil {
+			return err
+		}
+		_, holds, err := uc.holdRepo.List(ctx, 1, 1, repository.HoldFilter{BookID: &book.ID})
+		if err != nil {
+			return err
+		}
+		if loans > 0 || holds > 0 {
+			return entity.ErrBookHasHistory
+		}
+
+		copies, err := uc.copyRepo.ListByBook(ctx, book.ID)
+		if err != nil {
+			return err
+		}
+		for _, bookCopy := range copies {
+			if bookCopy.IsInCirculation() {
+				return entity.ErrCopyInCirculation
+			}
+		}
+		for _, bookCopy := range copies {
+			if err := uc.copyRepo.Delete(ctx, bookCopy.ID); err != nil {
+				return err
+			}
+			if err := recordCopyDeleted(ctx, uc.audit, bookCopy); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.bookRepo.Delete(ctx, book.ID); err != nil {
+			return err
+		}
+		if err := uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditBookDeleted,
+			EntityType: entity.AuditEntityBook,
+			EntityID:   book.ID,
+			Before:     bookAudit(book),
+		}); err != nil {
+			return err
+		}
+		return uc.events.Emit(ctx, bookEvent(entity.EventBookDeleted, book))
+	})
+}
+
+func (uc *bookUseCase) getBook(ctx context.Context, id uuid.UUID) (*entity.Book, error) {
+	book, err := uc.bookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if book == nil {
+		return nil, entity.ErrBookNotFound
+	}
+	return book, nil
+}
+
+func (uc *bookUseCase) ensureNoActiveLoans(ctx context.Context, bookID uuid.UUID) error {
+	active, err := uc.loanRepo.CountActiveByBook(ctx, bookID)
+	if err != nil {
+		return err
+	}
+	if active > 0 {
+		return entity.ErrBookHasActiveLoans
+	}
+	return nil
+}
+
+// resizeCopies adds or withdraws copies until the book holds total of them,
+// then saves it with the new counts. Added copies serve the hold queue
+// before they reach the shelf. Only copies on the shelf or in repair are
+// withdrawn, shelf copies first, so no loan, hold or transfer loses its copy.
+func (uc *bookUseCase) resizeCopies(ctx context.Context, book *entity.Book, total int, branchID *uuid.UUID) error {
+	if total < 1 {
+		return entity.ErrInvalidTotalCopies
+	}
+
+	copies, err := uc.copyRepo.ListByBook(ctx, book.ID)
+	if err != nil {
+		return err
+	}
+	held, _ := entity.CountCopies(copies)
+
+	if total > held {
+		branch, err := resolveBranch(ctx, uc.branchRepo, branchID)
+		if err != nil {
+			return err
+		}
+
+		// Numbering continues after the copies the book ever had, skipping
+		// barcodes already given out.
+		n := len(copies)
+		for added := held; added < total; {
+			n++
+			barcode := entity.DefaultCopyBarcode(book.ISBN, n)
+			existing, err := uc.copyRepo.GetByBarcode(ctx, barcode)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				continue
+			}
+
+			bookCopy, err := entity.NewBookCopy(book.ID, branch.ID, barcode, "", "")
+			if err != nil {
+				return err
+			}
+			if err := uc.copyRepo.Create(ctx, bookCopy); err != nil {
+				return err
+			}
+			if err := recordCopy(ctx, uc.audit, entity.AuditCopyCreated, nil, bookCopy); err != nil {
+				return err
+			}
+			if err := releaseCopy(ctx, uc.holdRepo, uc.copyRepo, uc.bookRepo, uc.events, uc.audit, book, bookCopy, uc.pickupWindow); err != nil {
+				return err
+			}
+			added++
+		}
+		return nil
+	}
+
+	surplus := held - total
+	for _, status := range []string{entity.CopyStatusAvailable, entity.CopyStatusInRepair} {
+		for _, bookCopy := range copies {
+			if surplus == 0 {
+				break
+			}
+			if bookCopy.Status != status {
+				continue
+			}
+			before := copyAudit(bookCopy)
+			bookCopy.Withdraw()
+			if err := uc.copyRepo.Update(ctx, bookCopy); err != nil {
+				return err
+			}
+			if err := recordCopy(ctx, uc.audit, entity.AuditCopyUpdated, before, bookCopy); err != nil {
+				return err
+			}
+			surplus--
+		}
+	}
+	if surplus > 0 {
+		return entity.ErrCopiesInCirculation
+	}
+
+	return syncCopyCounts(ctx, uc.copyRepo, uc.bookRepo, book)
+}
+
+// cancelHolds cancels the waiting and ready holds for the book.
+func (uc *bookUseCase) cancelHolds(ctx context.Context, bookID uuid.UUID) error {
+	for _, status := range []string{entity.HoldStatusWaiting, entity.HoldStatusReady} {
+		filter := repository.HoldFilter{BookID: &bookID, Status: &status}
+		for {
+			// Cancelled holds drop out of the filter, so the first page
+			// always holds the next batch.
+			holds, _, err := uc.holdRepo.List(ctx, 1, holdCancelBatch, filter)
+			if err != nil {
+				return err
+			}
+			for _, hold := range holds {
+				before := holdAudit(hold)
+				if err := hold.Cancel(); err != nil {
+					return err
+				}
+				if err := uc.holdRepo.Update(ctx, hold); err != nil {
+					return err
+				}
+				if err := recordHold(ctx, uc.audit, entity.AuditHoldCancelled, before, hold); err != nil {
+					return err
+				}
+				if err := uc.events.Emit(ctx, holdEvent(entity.EventHoldCancelled, hold)); err != nil {
+					return err
+				}
+			}
+			if len(holds) < holdCancelBatch {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// fillBranches sets the per-branch breakdown of each book's copies.
+func (uc *bookUseCase) fillBranches(ctx context.Context, books ...*entity.Book) error {
+	if len(books) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entity.Book, len(books))
+	ids := make([]uuid.UUID, len(books))
+	for i, book := range books {
+		book.Branches = []entity.BranchAvailability{}
+		byID[book.ID] = book
+		ids[i] = book.ID
+	}
+
+	availability, err := uc.copyRepo.AvailabilityByBranch(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, a := range availability {
+		if book, ok := byID[a.BookID]; ok {
+			book.Branches = append(book.Branches, a)
+		}
+	}
+	return nil
 }
@@ -2,9 +2,15 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
 
 	"github.com/google/uuid"
 )
@@ -40,10 +46,10 @@ func (m *mockBookRepository) GetByISBN(ctx context.Context, isbn string) (*entit
 	return nil, nil
 }
 
-func (m *mockBookRepository) List(ctx context.Context, page, limit int, availableOnly *bool) ([]*entity.Book, int, error) {
+func (m *mockBookRepository) List(ctx context.Context, page, limit int, filter repository.BookFilter) ([]*entity.Book, int, error) {
 	books := make([]*entity.Book, 0)
 	for _, book := range m.books {
-		if availableOnly != nil && *availableOnly && !book.IsAvailable() {
+		if filter.AvailableOnly && !book.IsAvailable() {
 			continue
 		}
 		books = append(books, book)
@@ -51,6 +57,44 @@ func (m *mockBookRepository) List(ctx context.Context, page, limit int, availabl
 	return books, len(books), nil
 }
 
+func (m *mockBookRepository) Search(ctx context.Context, page, limit int, search repository.BookSearch) ([]*repository.BookMatch, int, error) {
+	query := strings.ToLower(search.Query)
+	matches := make([]*repository.BookMatch, 0)
+	for _, book := range m.books {
+		if !strings.Contains(strings.ToLower(book.Title), query) && !strings.Contains(strings.ToLower(book.Author), query) && book.ISBN != search.Query {
+			continue
+		}
+		matches = append(matches, &repository.BookMatch{Book: book, Score: 1, TitleHighlight: book.Title, AuthorHighlight: book.Author})
+	}
+	return matches, len(matches), nil
+}
+
+func (m *mockBookRepository) SuggestTitles(ctx context.Context, prefix string, limit int) ([]string, error) {
+	return m.suggest(prefix, limit, func(book *entity.Book) string { return book.Title }), nil
+}
+
+func (m *mockBookRepository) SuggestAuthors(ctx context.Context, prefix string, limit int) ([]string, error) {
+	return m.suggest(prefix, limit, func(book *entity.Book) string { return book.Author }), nil
+}
+
+func (m *mockBookRepository) suggest(prefix string, limit int, field func(*entity.Book) string) []string {
+	seen := make(map[string]bool)
+	values := make([]string, 0)
+	for _, book := range m.books {
+		value := field(book)
+		if seen[value] || !strings.HasPrefix(strings.ToLower(value), strings.ToLower(prefix)) {
+			continue
+		}
+		seen[value] = true
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	if len(values) > limit {
+		values = values[:limit]
+	}
+	return values
+}
+
 func (m *mockBookRepository) Update(ctx context.Context, book *entity.Book) error {
 	m.books[book.ID] = book
 	return nil
@@ -64,7 +108,8 @@ func (m *mockBookRepository) Delete(ctx context.Context, id uuid.UUID) error {
 func TestBookUseCase_Create(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockBookRepository()
-	uc := NewBookUseCase(repo)
+	events := newMockEventEmitter()
+	uc := NewBookUseCase(repo, newMockBookCopyRepository(), newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), events, newMockAuditor(), time.Hour)
 
 	t.Run("create valid book", func(t *testing.T) {
 		input := CreateBookInput{
@@ -87,6 +132,9 @@ func TestBookUseCase_Create(t *testing.T) {
 		if book.AvailableCopies != input.TotalCopies {
 			t.Errorf("BookUseCase.Create() available copies = %v, want %v", book.AvailableCopies, input.TotalCopies)
 		}
+		if got := events.types(); len(got) != 1 || got[0] != entity.EventBookCreated {
+			t.Errorf("BookUseCase.Create() events = %v, want [%v]", got, entity.EventBookCreated)
+		}
 	})
 
 	t.Run("create book with duplicate ISBN", func(t *testing.T) {
@@ -123,7 +171,7 @@ func TestBookUseCase_Create(t *testing.T) {
 func TestBookUseCase_GetByID(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockBookRepository()
-	uc := NewBookUseCase(repo)
+	uc := NewBookUseCase(repo, newMockBookCopyRepository(), newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour)
 
 	book, _ := uc.Create(ctx, CreateBookInput{
 		Title:         "Clean Code",
@@ -156,7 +204,7 @@ func TestBookUseCase_GetByID(t *testing.T) {
 func TestBookUseCase_List(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockBookRepository()
-	uc := NewBookUseCase(repo)
+	uc := NewBookUseCase(repo, newMockBookCopyRepository(), newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour)
 
 	_, _ = uc.Create(ctx, CreateBookInput{
 		Title:         "Book 1",
@@ -178,7 +226,7 @@ func TestBookUseCase_List(t *testing.T) {
 	repo.Update(ctx, book2)
 
 	t.Run("list all books", func(t *testing.T) {
-		books, total, err := uc.List(ctx, 1, 10, nil)
+		books, total, err := uc.List(ctx, 1, 10, repository.BookFilter{})
 		if err != nil {
 			t.Errorf("BookUseCase.List() unexpected error = %v", err)
 			return
@@ -193,8 +241,7 @@ func TestBookUseCase_List(t *testing.T) {
 	})
 
 	t.Run("list only available books", func(t *testing.T) {
-		availableOnly := true
-		books, total, err := uc.List(ctx, 1, 10, &availableOnly)
+		books, total, err := uc.List(ctx, 1, 10, repository.BookFilter{AvailableOnly: true})
 		if err != nil {
 			t.Errorf("BookUseCase.List() unexpected error = %v", err)
 			return
@@ -207,4 +254,390 @@ func TestBookUseCase_List(t *testing.T) {
 			t.Errorf("BookUseCase.List() len = %v, want %v", len(books), 1)
 		}
 	})
+
+	t.Run("rejects an inverted year range", func(t *testing.T) {
+		from, to := 2021, 2020
+		_, _, err := uc.List(ctx, 1, 10, repository.BookFilter{PublishedFrom: &from, PublishedTo: &to})
+		if err != entity.ErrInvalidYearRange {
+			t.Errorf("BookUseCase.List() error = %v, want %v", err, entity.ErrInvalidYearRange)
+		}
+	})
+}
+
+func TestBookUseCase_Search(t *testing.T) {
+	ctx := context.Background()
+	uc := NewBookUseCase(newMockBookRepository(), newMockBookCopyRepository(), newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour)
+
+	book, _ := uc.Create(ctx, CreateBookInput{
+		Title:         "Dom Casmurro",
+		Author:        "Machado de Assis",
+		ISBN:          "9788535910414",
+		PublishedYear: 1899,
+		TotalCopies:   2,
+	})
+
+	t.Run("finds matching books with their branches", func(t *testing.T) {
+		matches, total, err := uc.Search(ctx, 1, 10, repository.BookSearch{Query: "  casmurro "})
+		if err != nil {
+			t.Fatalf("BookUseCase.Search() unexpected error = %v", err)
+		}
+		if total != 1 || len(matches) != 1 {
+			t.Fatalf("BookUseCase.Search() total = %v, len = %v, want 1", total, len(matches))
+		}
+		if matches[0].Book.ID != book.ID {
+			t.Errorf("BookUseCase.Search() book = %v, want %v", matches[0].Book.ID, book.ID)
+		}
+		if len(matches[0].Book.Branches) != 1 {
+			t.Errorf("BookUseCase.Search() branches = %v, want 1", len(matches[0].Book.Branches))
+		}
+	})
+
+	t.Run("rejects a query without words", func(t *testing.T) {
+		_, _, err := uc.Search(ctx, 1, 10, repository.BookSearch{Query: " ?! "})
+		if err != entity.ErrInvalidSearchQuery {
+			t.Errorf("BookUseCase.Search() error = %v, want %v", err, entity.ErrInvalidSearchQuery)
+		}
+	})
+
+	t.Run("rejects an inverted year range", func(t *testing.T) {
+		from, to := 1900, 1800
+		_, _, err := uc.Search(ctx, 1, 10, repository.BookSearch{BookFilter: repository.BookFilter{PublishedFrom: &from, PublishedTo: &to}, Query: "casmurro"})
+		if err != entity.ErrInvalidYearRange {
+			t.Errorf("BookUseCase.Search() error = %v, want %v", err, entity.ErrInvalidYearRange)
+		}
+	})
+}
+
+func TestBookUseCase_Suggest(t *testing.T) {
+	ctx := context.Background()
+	uc := NewBookUseCase(newMockBookRepository(), newMockBookCopyRepository(), newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour)
+
+	for i, input := range []CreateBookInput{
+		{Title: "Memorial do Convento", Author: "José Saramago"},
+		{Title: "Memórias Póstumas de Brás Cubas", Author: "Machado de Assis"},
+		{Title: "Dom Casmurro", Author: "Machado de Assis"},
+	} {
+		input.ISBN = fmt.Sprintf("978853591041%d", i)
+		input.TotalCopies = 1
+		if _, err := uc.Create(ctx, input); err != nil {
+			t.Fatalf("BookUseCase.Create() unexpected error = %v", err)
+		}
+	}
+
+	t.Run("completes titles and authors", func(t *testing.T) {
+		suggestions, err := uc.Suggest(ctx, " ma ", 10)
+		if err != nil {
+			t.Fatalf("BookUseCase.Suggest() unexpected error = %v", err)
+		}
+		if len(suggestions.Titles) != 0 {
+			t.Errorf("BookUseCase.Suggest() titles = %v, want none", suggestions.Titles)
+		}
+		if len(suggestions.Authors) != 1 || suggestions.Authors[0] != "Machado de Assis" {
+			t.Errorf("BookUseCase.Suggest() authors = %v, want [Machado de Assis]", suggestions.Authors)
+		}
+	})
+
+	t.Run("limits suggestions", func(t *testing.T) {
+		suggestions, err := uc.Suggest(ctx, "mem", 1)
+		if err != nil {
+			t.Fatalf("BookUseCase.Suggest() unexpected error = %v", err)
+		}
+		if len(suggestions.Titles) != 1 || suggestions.Titles[0] != "Memorial do Convento" {
+			t.Errorf("BookUseCase.Suggest() titles = %v, want [Memorial do Convento]", suggestions.Titles)
+		}
+	})
+
+	t.Run("rejects a short prefix", func(t *testing.T) {
+		_, err := uc.Suggest(ctx, " m ", 10)
+		if err != entity.ErrInvalidSuggestPrefix {
+			t.Errorf("BookUseCase.Suggest() error = %v, want %v", err, entity.ErrInvalidSuggestPrefix)
+		}
+	})
+}
+
+type bookTestData struct {
+	uc       BookUseCase
+	bookRepo *mockBookRepository
+	copyRepo *mockBookCopyRepository
+	loanRepo *mockLoanRepository
+	holdRepo *mockHoldRepository
+	auditor  *mockAuditor
+	outbox   *mockOutboxRepository
+	book     *entity.Book
+}
+
+// newBookTestData creates a book with totalCopies copies on the shelf.
+func newBookTestData(t *testing.T, totalCopies int) *bookTestData {
+	data := &bookTestData{
+		bookRepo: newMockBookRepository(),
+		copyRepo: newMockBookCopyRepository(),
+		loanRepo: newMockLoanRepository(),
+		holdRepo: newMockHoldRepository(),
+		auditor:  newMockAuditor(),
+	}
+	var events OutboxUseCase
+	events, data.outbox = newTestOutbox()
+	data.uc = NewBookUseCase(data.bookRepo, data.copyRepo, newMockBranchRepository(), data.loanRepo, data.holdRepo, newMockTxManager(), events, data.auditor, time.Hour)
+
+	book, err := data.uc.Create(context.Background(), CreateBookInput{
+		Title:         "Clean Code",
+		Author:        "Robert C. Martin",
+		ISBN:          "9780132350884",
+		PublishedYear: 2008,
+		TotalCopies:   totalCopies,
+	})
+	if err != nil {
+		t.Fatalf("BookUseCase.Create() unexpected error = %v", err)
+	}
+	data.book = book
+	return data
+}
+
+// lend checks out the book's first shelf copy.
+func (d *bookTestData) lend(t *testing.T) *entity.Loan {
+	ctx := context.Background()
+	bookCopy, _ := d.copyRepo.FindByStatus(ctx, d.book.ID, entity.CopyStatusAvailable)
+	if bookCopy == nil {
+		t.Fatal("no copy on the shelf to lend")
+	}
+	if err := bookCopy.CheckOut(); err != nil {
+		t.Fatalf("BookCopy.CheckOut() unexpected error = %v", err)
+	}
+	loan, _ := entity.NewLoan(uuid.New(), d.book.ID, nil)
+	loan.CopyID = &bookCopy.ID
+	_ = d.loanRepo.Create(ctx, loan)
+	if err := syncCopyCounts(ctx, d.copyRepo, d.bookRepo, d.book); err != nil {
+		t.Fatalf("syncCopyCounts() unexpected error = %v", err)
+	}
+	return loan
+}
+
+func (d *bookTestData) copyStatuses() map[string]int {
+	copies, _ := d.copyRepo.ListByBook(context.Background(), d.book.ID)
+	statuses := make(map[string]int)
+	for _, bookCopy := range copies {
+		statuses[bookCopy.Status]++
+	}
+	return statuses
+}
+
+func TestBookUseCase_Update(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("update details", func(t *testing.T) {
+		data := newBookTestData(t, 2)
+		title := "Clean Code, 2nd Edition"
+		year := 2025
+
+		book, err := data.uc.Update(ctx, data.book.ID, UpdateBookInput{Title: &title, PublishedYear: &year})
+		if err != nil {
+			t.Fatalf("BookUseCase.Update() unexpected error = %v", err)
+		}
+		if book.Title != title || book.PublishedYear != year || book.Author != "Robert C. Martin" {
+			t.Errorf("BookUseCase.Update() = %+v, want only title and year changed", book)
+		}
+		if got := data.auditor.actions(); got[len(got)-1] != entity.AuditBookUpdated {
+			t.Errorf("BookUseCase.Update() audit actions = %v, want %v last", got, entity.AuditBookUpdated)
+		}
+		want := []entity.EventType{entity.EventBookCreated, entity.EventBookUpdated}
+		if got := data.outbox.types(); !slices.Equal(got, want) {
+			t.Errorf("BookUseCase.Update() outbox = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("ISBN of another book", func(t *testing.T) {
+		data := newBookTestData(t, 1)
+		other, _ := data.uc.Create(ctx, CreateBookInput{
+			Title: "Refactoring", Author: "Martin Fowler", ISBN: "9780134757599", TotalCopies: 1,
+		})
+
+		_, err := data.uc.Update(ctx, data.book.ID, UpdateBookInput{ISBN: &other.ISBN})
+		if err != entity.ErrInvalidBookISBN {
+			t.Errorf("BookUseCase.Update() error = %v, want %v", err, entity.ErrInvalidBookISBN)
+		}
+	})
+
+	t.Run("raise total copies", func(t *testing.T) {
+		data := newBookTestData(t, 2)
+		data.lend(t)
+		total := 4
+
+		book, err := data.uc.Update(ctx, data.book.ID, UpdateBookInput{TotalCopies: &total})
+		if err != nil {
+			t.Fatalf("BookUseCase.Update() unexpected error = %v", err)
+		}
+		if book.TotalCopies != 4 || book.AvailableCopies != 3 {
+			t.Errorf("BookUseCase.Update() copies = %d/%d, want 3/4 available", book.AvailableCopies, book.TotalCopies)
+		}
+		added, _ := data.copyRepo.GetByBarcode(ctx, entity.DefaultCopyBarcode(book.ISBN, 4))
+		if added == nil {
+			t.Error("BookUseCase.Update() added copies are not numbered after the existing ones")
+		}
+	})
+
+	t.Run("added copies serve the hold queue first", func(t *testing.T) {
+		data := newBookTestData(t, 1)
+		data.lend(t)
+		hold := entity.NewHold(uuid.New(), data.book.ID)
+		_ = data.holdRepo.Create(ctx, hold)
+		total := 2
+
+		book, err := data.uc.Update(ctx, data.book.ID, UpdateBookInput{TotalCopies: &total})
+		if err != nil {
+			t.Fatalf("BookUseCase.Update() unexpected error = %v", err)
+		}
+		if hold.Status != entity.HoldStatusReady {
+			t.Errorf("BookUseCase.Update() hold status = %v, want %v", hold.Status, entity.HoldStatusReady)
+		}
+		if book.TotalCopies != 2 || book.AvailableCopies != 0 {
+			t.Errorf("BookUseCase.Update() copies = %d/%d, want 0/2 available", book.AvailableCopies, book.TotalCopies)
+		}
+	})
+
+	t.Run("lower total copies withdraws shelf copies", func(t *testing.T) {
+		data := newBookTestData(t, 4)
+		data.lend(t)
+		total := 2
+
+		book, err := data.uc.Update(ctx, data.book.ID, UpdateBookInput{TotalCopies: &total})
+		if err != nil {
+			t.Fatalf("BookUseCase.Update() unexpected error = %v", err)
+		}
+		if book.TotalCopies != 2 || book.AvailableCopies != 1 {
+			t.Errorf("BookUseCase.Update() copies = %d/%d, want 1/2 available", book.AvailableCopies, book.TotalCopies)
+		}
+		statuses := data.copyStatuses()
+		if statuses[entity.CopyStatusOnLoan] != 1 || statuses[entity.CopyStatusWithdrawn] != 2 {
+			t.Errorf("BookUseCase.Update() copy statuses = %v, want the lent copy kept", statuses)
+		}
+	})
+
+	t.Run("lower total copies below copies on loan", func(t *testing.T) {
+		data := newBookTestData(t, 3)
+		data.lend(t)
+		data.lend(t)
+		total := 1
+
+		_, err := data.uc.Update(ctx, data.book.ID, UpdateBookInput{TotalCopies: &total})
+		if err != entity.ErrCopiesInCirculation {
+			t.Errorf("BookUseCase.Update() error = %v, want %v", err, entity.ErrCopiesInCirculation)
+		}
+	})
+
+	t.Run("withdrawn book", func(t *testing.T) {
+		data := newBookTestData(t, 1)
+		if _, err := data.uc.Withdraw(ctx, data.book.ID); err != nil {
+			t.Fatalf("BookUseCase.Withdraw() unexpected error = %v", err)
+		}
+		title := "Another Title"
+
+		_, err := data.uc.Update(ctx, data.book.ID, UpdateBookInput{Title: &title})
+		if err != entity.ErrBookWithdrawn {
+			t.Errorf("BookUseCase.Update() error = %v, want %v", err, entity.ErrBookWithdrawn)
+		}
+	})
+}
+
+func TestBookUseCase_Withdraw(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("book with history", func(t *testing.T) {
+		data := newBookTestData(t, 2)
+		loan := data.lend(t)
+		_ = loan.Return()
+		hold := entity.NewHold(uuid.New(), data.book.ID)
+		_ = data.holdRepo.Create(ctx, hold)
+
+		book, err := data.uc.Withdraw(ctx, data.book.ID)
+		if err != nil {
+			t.Fatalf("BookUseCase.Withdraw() unexpected error = %v", err)
+		}
+		if !book.IsWithdrawn() || book.TotalCopies != 0 || book.AvailableCopies != 0 {
+			t.Errorf("BookUseCase.Withdraw() = %+v, want a withdrawn book without copies", book)
+		}
+		if got := data.copyStatuses(); got[entity.CopyStatusWithdrawn] != 2 {
+			t.Errorf("BookUseCase.Withdraw() copy statuses = %v, want all withdrawn", got)
+		}
+		if hold.Status != entity.HoldStatusCancelled {
+			t.Errorf("BookUseCase.Withdraw() hold status = %v, want %v", hold.Status, entity.HoldStatusCancelled)
+		}
+		if stored, _ := data.loanRepo.GetByID(ctx, loan.ID); stored == nil {
+			t.Error("BookUseCase.Withdraw() loan history was not kept")
+		}
+		if got := data.auditor.actions(); got[len(got)-1] != entity.AuditBookWithdrawn {
+			t.Errorf("BookUseCase.Withdraw() audit actions = %v, want %v last", got, entity.AuditBookWithdrawn)
+		}
+		want := []entity.EventType{entity.EventBookCreated, entity.EventHoldCancelled, entity.EventBookWithdrawn}
+		if got := data.outbox.types(); !slices.Equal(got, want) {
+			t.Errorf("BookUseCase.Withdraw() outbox = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("book with copies on loan", func(t *testing.T) {
+		data := newBookTestData(t, 2)
+		data.lend(t)
+
+		_, err := data.uc.Withdraw(ctx, data.book.ID)
+		if err != entity.ErrBookHasActiveLoans {
+			t.Errorf("BookUseCase.Withdraw() error = %v, want %v", err, entity.ErrBookHasActiveLoans)
+		}
+	})
+}
+
+func TestBookUseCase_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("book never lent", func(t *testing.T) {
+		data := newBookTestData(t, 2)
+
+		if err := data.uc.Delete(ctx, data.book.ID); err != nil {
+			t.Fatalf("BookUseCase.Delete() unexpected error = %v", err)
+		}
+		if _, err := data.uc.GetByID(ctx, data.book.ID); err != entity.ErrBookNotFound {
+			t.Errorf("BookUseCase.GetByID() after delete error = %v, want %v", err, entity.ErrBookNotFound)
+		}
+		if copies, _ := data.copyRepo.ListByBook(ctx, data.book.ID); len(copies) != 0 {
+			t.Errorf("BookUseCase.Delete() left %d copies", len(copies))
+		}
+		if got := data.auditor.actions(); got[len(got)-1] != entity.AuditBookDeleted {
+			t.Errorf("BookUseCase.Delete() audit actions = %v, want %v last", got, entity.AuditBookDeleted)
+		}
+		want := []entity.EventType{entity.EventBookCreated, entity.EventBookDeleted}
+		if got := data.outbox.types(); !slices.Equal(got, want) {
+			t.Errorf("BookUseCase.Delete() outbox = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("book with copies on loan", func(t *testing.T) {
+		data := newBookTestData(t, 2)
+		data.lend(t)
+
+		if err := data.uc.Delete(ctx, data.book.ID); err != entity.ErrBookHasActiveLoans {
+			t.Errorf("BookUseCase.Delete() error = %v, want %v", err, entity.ErrBookHasActiveLoans)
+		}
+		if got := data.outbox.types(); len(got) != 1 {
+			t.Errorf("BookUseCase.Delete() refused outbox = %v, want only %v", got, entity.EventBookCreated)
+		}
+	})
+
+	t.Run("book with loan history", func(t *testing.T) {
+		data := newBookTestData(t, 2)
+		loan := data.lend(t)
+		_ = loan.Return()
+
+		if err := data.uc.Delete(ctx, data.book.ID); err != entity.ErrBookHasHistory {
+			t.Errorf("BookUseCase.Delete() error = %v, want %v", err, entity.ErrBookHasHistory)
+		}
+	})
+
+	t.Run("book with hold history", func(t *testing.T) {
+		data := newBookTestData(t, 1)
+		hold := entity.NewHold(uuid.New(), data.book.ID)
+		_ = hold.Cancel()
+		_ = data.holdRepo.Create(ctx, hold)
+
+		if err := data.uc.Delete(ctx, data.book.ID); err != entity.ErrBookHasHistory {
+			t.Errorf("BookUseCase.Delete() error = %v, want %v", err, entity.ErrBookHasHistory)
+		}
+	})
 }
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type BranchUseCase interface {
+	Create(ctx context.Context, input CreateBranchInput) (*entity.Branch, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Branch, error)
+	List(ctx context.Context) ([]*entity.Branch, error)
+	Update(ctx context.Context, id uuid.UUID, input UpdateBranchInput) (*entity.Branch, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type CreateBranchInput struct {
+	Code    string
+	Name    string
+	Address string
+}
+
+// UpdateBranchInput changes the fields left non-nil.
+type UpdateBranchInput struct {
+	Name    *string
+	Address *string
+}
+
+type branchUseCase struct {
+	branchRepo   repository.BranchRepository
+	copyRepo     repository.BookCopyRepository
+	transferRepo repository.TransferRepository
+	txManager    repository.TxManager
+	audit        Auditor
+}
+
+func NewBranchUseCase(
+	branchRepo repository.BranchRepository,
+	copyRepo repository.BookCopyRepository,
+	transferRepo repository.TransferRepository,
+	txManager repository.TxManager,
+	audit Auditor,
+) BranchUseCase {
+	return &branchUseCase{
+		branchRepo:   branchRepo,
+		copyRepo:     copyRepo,
+		transferRepo: transferRepo,
+		txManager:    txManager,
+		audit:        audit,
+	}
+}
+
+func (uc *branchUseCase) Create(ctx context.Context, input CreateBranchInput) (*entity.Branch, error) {
+	branch, err := entity.NewBranch(input.Code, input.Name, input.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		existing, err := uc.branchRepo.GetByCode(ctx, branch.Code)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return entity.ErrBranchCodeAlreadyExists
+		}
+
+		if err := uc.branchRepo.Create(ctx, branch); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditBranchCreated,
+			EntityType: entity.AuditEntityBranch,
+			EntityID:   branch.ID,
+			After:      branchAudit(branch),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return branch, nil
+}
+
+func (uc *branchUseCase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Branch, error) {
+	branch, err := uc.branchRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if branch == nil {
+		return nil, entity.ErrBranchNotFound
+	}
+	return branch, nil
+}
+
+func (uc *branchUseCase) List(ctx context.Context) ([]*entity.Branch, error) {
+	return uc.branchRepo.List(ctx)
+}
+
+func (uc *branchUseCase) Update(ctx context.Context, id uuid.UUID, input UpdateBranchInput) (*entity.Branch, error) {
+	var branch *entity.Branch
+
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		branch, err = uc.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		before := branchAudit(branch)
+
+		name, address := branch.Name, branch.Address
+		if input.Name != nil {
+			name = *input.Name
+		}
+		if input.Address != nil {
+			address = *input.Address
+		}
+
+		if err := branch.Update(name, address); err != nil {
+			return err
+		}
+
+		if err := uc.branchRepo.Update(ctx, branch); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditBranchUpdated,
+			EntityType: entity.AuditEntityBranch,
+			EntityID:   branch.ID,
+			Before:     before,
+			After:      branchAudit(branch),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return branch, nil
+}
+
+// Delete removes a branch that never held a copy, or whose copies have all
+// been deleted and that has no transfers on record.
+func (uc *branchUseCase) Delete(ctx context.Context, id uuid.UUID) error {
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		branch, err := uc.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if branch.IsDefault() {
+			return entity.ErrDefaultBranchRequired
+		}
+
+		copies, err := uc.copyRepo.CountByBranch(ctx, branch.ID)
+		if err != nil {
+			return err
+		}
+		_, transfers, err := uc.transferRepo.List(ctx, 1, 1, repository.TransferFilter{BranchID: &branch.ID})
+		if err != nil {
+			return err
+		}
+		if copies > 0 || transfers > 0 {
+			return entity.ErrBranchInUse
+		}
+
+		if err := uc.branchRepo.Delete(ctx, branch.ID); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditBranchDeleted,
+			EntityType: entity.AuditEntityBranch,
+			EntityID:   branch.ID,
+			Before:     branchAudit(branch),
+		})
+	})
+}
+
+// resolveBranch returns the branch with id, or the default branch when id
+// is nil.
+func resolveBranch(ctx context.Context, branches repository.BranchRepository, id *uuid.UUID) (*entity.Branch, error) {
+	var branch *entity.Branch
+	var err error
+	if id != nil {
+		branch, err = branches.GetByID(ctx, *id)
+	} else {
+		branch, err = branches.GetByCode(ctx, entity.DefaultBranchCode)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if branch == nil {
+		return nil, entity.ErrBranchNotFound
+	}
+	return branch, nil
+}
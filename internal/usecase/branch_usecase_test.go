@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type mockBranchRepository struct {
+	branches map[uuid.UUID]*entity.Branch
+}
+
+// newMockBranchRepository starts with the default branch in place, as the
+// migrations leave it.
+func newMockBranchRepository() *mockBranchRepository {
+	m := &mockBranchRepository{
+		branches: make(map[uuid.UUID]*entity.Branch),
+	}
+	main, _ := entity.NewBranch(entity.DefaultBranchCode, "Main Library", "")
+	m.branches[main.ID] = main
+	return m
+}
+
+func (m *mockBranchRepository) Create(ctx context.Context, branch *entity.Branch) error {
+	m.branches[branch.ID] = branch
+	return nil
+}
+
+func (m *mockBranchRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Branch, error) {
+	if branch, exists := m.branches[id]; exists {
+		return branch, nil
+	}
+	return nil, nil
+}
+
+func (m *mockBranchRepository) GetByCode(ctx context.Context, code string) (*entity.Branch, error) {
+	for _, branch := range m.branches {
+		if branch.Code == code {
+			return branch, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockBranchRepository) List(ctx context.Context) ([]*entity.Branch, error) {
+	branches := make([]*entity.Branch, 0, len(m.branches))
+	for _, branch := range m.branches {
+		branches = append(branches, branch)
+	}
+	slices.SortFunc(branches, func(a, b *entity.Branch) int {
+		return strings.Compare(a.Code, b.Code)
+	})
+	return branches, nil
+}
+
+func (m *mockBranchRepository) Update(ctx context.Context, branch *entity.Branch) error {
+	m.branches[branch.ID] = branch
+	return nil
+}
+
+func (m *mockBranchRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(m.branches, id)
+	return nil
+}
+
+func TestBranchUseCase_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("valid branch", func(t *testing.T) {
+		uc := NewBranchUseCase(newMockBranchRepository(), newMockBookCopyRepository(), newMockTransferRepository(), newMockTxManager(), newMockAuditor())
+
+		branch, err := uc.Create(ctx, CreateBranchInput{Code: "NORTH", Name: "North Branch", Address: "1 North St"})
+		if err != nil {
+			t.Fatalf("BranchUseCase.Create() unexpected error = %v", err)
+		}
+
+		branches, _ := uc.List(ctx)
+		if len(branches) != 2 {
+			t.Errorf("BranchUseCase.List() count = %v, want %v", len(branches), 2)
+		}
+		if branches[1].ID != branch.ID {
+			t.Errorf("BranchUseCase.List() second branch = %v, want %v", branches[1].Code, branch.Code)
+		}
+	})
+
+	t.Run("duplicate code", func(t *testing.T) {
+		uc := NewBranchUseCase(newMockBranchRepository(), newMockBookCopyRepository(), newMockTransferRepository(), newMockTxManager(), newMockAuditor())
+
+		_, err := uc.Create(ctx, CreateBranchInput{Code: entity.DefaultBranchCode, Name: "Another Main"})
+		if err != entity.ErrBranchCodeAlreadyExists {
+			t.Errorf("BranchUseCase.Create() error = %v, want %v", err, entity.ErrBranchCodeAlreadyExists)
+		}
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		uc := NewBranchUseCase(newMockBranchRepository(), newMockBookCopyRepository(), newMockTransferRepository(), newMockTxManager(), newMockAuditor())
+
+		_, err := uc.Create(ctx, CreateBranchInput{Code: "north", Name: "North Branch"})
+		if err != entity.ErrInvalidBranchCode {
+			t.Errorf("BranchUseCase.Create() error = %v, want %v", err, entity.ErrInvalidBranchCode)
+		}
+	})
+}
+
+func TestBranchUseCase_Update(t *testing.T) {
+	ctx := context.Background()
+	uc := NewBranchUseCase(newMockBranchRepository(), newMockBookCopyRepository(), newMockTransferRepository(), newMockTxManager(), newMockAuditor())
+	branch, _ := uc.Create(ctx, CreateBranchInput{Code: "NORTH", Name: "North Branch", Address: "1 North St"})
+
+	name := "North Side Branch"
+	updated, err := uc.Update(ctx, branch.ID, UpdateBranchInput{Name: &name})
+	if err != nil {
+		t.Fatalf("BranchUseCase.Update() unexpected error = %v", err)
+	}
+
+	if updated.Name != name {
+		t.Errorf("BranchUseCase.Update() name = %v, want %v", updated.Name, name)
+	}
+	if updated.Address != "1 North St" {
+		t.Errorf("BranchUseCase.Update() address = %v, want %v", updated.Address, "1 North St")
+	}
+}
+
+func TestBranchUseCase_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unused branch", func(t *testing.T) {
+		uc := NewBranchUseCase(newMockBranchRepository(), newMockBookCopyRepository(), newMockTransferRepository(), newMockTxManager(), newMockAuditor())
+		branch, _ := uc.Create(ctx, CreateBranchInput{Code: "NORTH", Name: "North Branch"})
+
+		if err := uc.Delete(ctx, branch.ID); err != nil {
+			t.Fatalf("BranchUseCase.Delete() unexpected error = %v", err)
+		}
+
+		if _, err := uc.GetByID(ctx, branch.ID); err != entity.ErrBranchNotFound {
+			t.Errorf("BranchUseCase.GetByID() error = %v, want %v", err, entity.ErrBranchNotFound)
+		}
+	})
+
+	t.Run("default branch", func(t *testing.T) {
+		branchRepo := newMockBranchRepository()
+		uc := NewBranchUseCase(branchRepo, newMockBookCopyRepository(), newMockTransferRepository(), newMockTxManager(), newMockAuditor())
+		main, _ := branchRepo.GetByCode(ctx, entity.DefaultBranchCode)
+
+		err := uc.Delete(ctx, main.ID)
+		if err != entity.ErrDefaultBranchRequired {
+			t.Errorf("BranchUseCase.Delete() error = %v, want %v", err, entity.ErrDefaultBranchRequired)
+		}
+	})
+
+	t.Run("branch holding copies", func(t *testing.T) {
+		copyRepo := newMockBookCopyRepository()
+		uc := NewBranchUseCase(newMockBranchRepository(), copyRepo, newMockTransferRepository(), newMockTxManager(), newMockAuditor())
+		branch, _ := uc.Create(ctx, CreateBranchInput{Code: "NORTH", Name: "North Branch"})
+		bookCopy, _ := entity.NewBookCopy(uuid.New(), branch.ID, "BC-001", "", "")
+		_ = copyRepo.Create(ctx, bookCopy)
+
+		err := uc.Delete(ctx, branch.ID)
+		if err != entity.ErrBranchInUse {
+			t.Errorf("BranchUseCase.Delete() error = %v, want %v", err, entity.ErrBranchInUse)
+		}
+	})
+
+	t.Run("branch with transfers on record", func(t *testing.T) {
+		transferRepo := newMockTransferRepository()
+		uc := NewBranchUseCase(newMockBranchRepository(), newMockBookCopyRepository(), transferRepo, newMockTxManager(), newMockAuditor())
+		branch, _ := uc.Create(ctx, CreateBranchInput{Code: "NORTH", Name: "North Branch"})
+		bookCopy, _ := entity.NewBookCopy(uuid.New(), uuid.New(), "BC-001", "", "")
+		transfer, _ := entity.NewTransfer(bookCopy, branch.ID)
+		_ = transfer.Cancel()
+		_ = transferRepo.Create(ctx, transfer)
+
+		err := uc.Delete(ctx, branch.ID)
+		if err != entity.ErrBranchInUse {
+			t.Errorf("BranchUseCase.Delete() error = %v, want %v", err, entity.ErrBranchInUse)
+		}
+	})
+}
@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type CalendarUseCase interface {
+	GetOpeningHours(ctx context.Context, branchID uuid.UUID) ([]*entity.OpeningHours, error)
+	// SetOpeningHours replaces the branch's weekly schedule. Weekdays left
+	// out are days the branch is closed.
+	SetOpeningHours(ctx context.Context, branchID uuid.UUID, input []OpeningHoursInput) ([]*entity.OpeningHours, error)
+	// ListClosedDates returns the branch's closed dates between from and
+	// to. from defaults to today in the library time zone and to to no
+	// limit.
+	ListClosedDates(ctx context.Context, branchID uuid.UUID, from, to *time.Time) ([]*entity.ClosedDate, error)
+	AddClosedDate(ctx context.Context, branchID uuid.UUID, input AddClosedDateInput) (*entity.ClosedDate, error)
+	RemoveClosedDate(ctx context.Context, branchID, id uuid.UUID) error
+}
+
+// OpeningHoursInput gives the HH:MM opening and closing times of one
+// weekday.
+type OpeningHoursInput struct {
+	Weekday  time.Weekday
+	OpensAt  string
+	ClosesAt string
+}
+
+type AddClosedDateInput struct {
+	Date   time.Time
+	Reason string
+}
+
+type calendarUseCase struct {
+	calendarRepo repository.CalendarRepository
+	branchRepo   repository.BranchRepository
+	txManager    repository.TxManager
+	audit        Auditor
+	location     *time.Location
+}
+
+func NewCalendarUseCase(
+	calendarRepo repository.CalendarRepository,
+	branchRepo repository.BranchRepository,
+	txManager repository.TxManager,
+	audit Auditor,
+	location *time.Location,
+) CalendarUseCase {
+	if location == nil {
+		location = time.UTC
+	}
+	return &calendarUseCase{
+		calendarRepo: calendarRepo,
+		branchRepo:   branchRepo,
+		txManager:    txManager,
+		audit:        audit,
+		location:     location,
+	}
+}
+
+func (uc *calendarUseCase) GetOpeningHours(ctx context.Context, branchID uuid.UUID) ([]*entity.OpeningHours, error) {
+	if _, err := resolveBranch(ctx, uc.branchRepo, &branchID); err != nil {
+		return nil, err
+	}
+	return uc.calendarRepo.ListOpeningHours(ctx, branchID)
+}
+
+func (uc *calendarUseCase) SetOpeningHours(ctx context.Context, branchID uuid.UUID, input []OpeningHoursInput) ([]*entity.OpeningHours, error) {
+	if _, err := resolveBranch(ctx, uc.branchRepo, &branchID); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[time.Weekday]bool, len(input))
+	hours := make([]*entity.OpeningHours, 0, len(input))
+	for _, in := range input {
+		if seen[in.Weekday] {
+			return nil, entity.ErrDuplicateWeekday
+		}
+		seen[in.Weekday] = true
+
+		h, err := entity.NewOpeningHours(branchID, in.Weekday, in.OpensAt, in.ClosesAt)
+		if err != nil {
+			return nil, err
+		}
+		hours = append(hours, h)
+	}
+
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		previous, err := uc.calendarRepo.ListOpeningHours(ctx, branchID)
+		if err != nil {
+			return err
+		}
+		if err := uc.calendarRepo.ReplaceOpeningHours(ctx, branchID, hours); err != nil {
+			return err
+		}
+		// The schedule has no id of its own and is logged against the
+		// branch.
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditOpeningHoursSet,
+			EntityType: entity.AuditEntityOpeningHours,
+			EntityID:   branchID,
+			Before:     openingHoursAudit(previous),
+			After:      openingHoursAudit(hours),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.calendarRepo.ListOpeningHours(ctx, branchID)
+}
+
+func (uc *calendarUseCase) ListClosedDates(ctx context.Context, branchID uuid.UUID, from, to *time.Time) ([]*entity.ClosedDate, error) {
+	if _, err := resolveBranch(ctx, uc.branchRepo, &branchID); err != nil {
+		return nil, err
+	}
+
+	start := entity.CalendarDay(time.Now().In(uc.location))
+	if from != nil {
+		start = *from
+	}
+	return uc.calendarRepo.ListClosedDates(ctx, branchID, start, to)
+}
+
+func (uc *calendarUseCase) AddClosedDate(ctx context.Context, branchID uuid.UUID, input AddClosedDateInput) (*entity.ClosedDate, error) {
+	if _, err := resolveBranch(ctx, uc.branchRepo, &branchID); err != nil {
+		return nil, err
+	}
+
+	closedDate, err := entity.NewClosedDate(branchID, input.Date, input.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		existing, err := uc.calendarRepo.GetClosedDateByDay(ctx, branchID, closedDate.Date)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return entity.ErrClosedDateAlreadyExists
+		}
+
+		if err := uc.calendarRepo.CreateClosedDate(ctx, closedDate); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditClosedDateAdded,
+			EntityType: entity.AuditEntityClosedDate,
+			EntityID:   closedDate.ID,
+			After:      closedDateAudit(closedDate),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closedDate, nil
+}
+
+func (uc *calendarUseCase) RemoveClosedDate(ctx context.Context, branchID, id uuid.UUID) error {
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		closedDate, err := uc.calendarRepo.GetClosedDate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if closedDate == nil || closedDate.BranchID != branchID {
+			return entity.ErrClosedDateNotFound
+		}
+		if err := uc.calendarRepo.DeleteClosedDate(ctx, id); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditClosedDateRemoved,
+			EntityType: entity.AuditEntityClosedDate,
+			EntityID:   closedDate.ID,
+			Before:     closedDateAudit(closedDate),
+		})
+	})
+}
+
+// dueAtOpenDay moves due to closing time on the first day, on or after the
+// day it falls on, that the branch is open. Branches without opening hours
+// on record keep due as it is.
+func dueAtOpenDay(
+	ctx context.Context,
+	calendarRepo repository.CalendarRepository,
+	location *time.Location,
+	branchID uuid.UUID,
+	due time.Time,
+) (time.Time, error) {
+	calendar, err := branchCalendar(ctx, calendarRepo, location, branchID, due)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return calendar.DueAt(due), nil
+}
+
+// branchCalendar loads the branch's opening hours and its closed dates from
+// the day from falls on.
+func branchCalendar(
+	ctx context.Context,
+	calendarRepo repository.CalendarRepository,
+	location *time.Location,
+	branchID uuid.UUID,
+	from time.Time,
+) (*entity.LibraryCalendar, error) {
+	hours, err := calendarRepo.ListOpeningHours(ctx, branchID)
+	if err != nil {
+		return nil, err
+	}
+	if len(hours) == 0 {
+		return entity.NewLibraryCalendar(location, nil, nil), nil
+	}
+
+	closed, err := calendarRepo.ListClosedDates(ctx, branchID, entity.CalendarDay(from.In(location)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewLibraryCalendar(location, hours, closed), nil
+}
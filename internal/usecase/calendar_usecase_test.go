@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type mockCalendarRepository struct {
+	hours  map[uuid.UUID][]*entity.OpeningHours
+	closed map[uuid.UUID]*entity.ClosedDate
+}
+
+func newMockCalendarRepository() *mockCalendarRepository {
+	return &mockCalendarRepository{
+		hours:  make(map[uuid.UUID][]*entity.OpeningHours),
+		closed: make(map[uuid.UUID]*entity.ClosedDate),
+	}
+}
+
+func (m *mockCalendarRepository) ListOpeningHours(ctx context.Context, branchID uuid.UUID) ([]*entity.OpeningHours, error) {
+	hours := slices.Clone(m.hours[branchID])
+	slices.SortFunc(hours, func(a, b *entity.OpeningHours) int {
+		return int(a.Weekday) - int(b.Weekday)
+	})
+	return hours, nil
+}
+
+func (m *mockCalendarRepository) ReplaceOpeningHours(ctx context.Context, branchID uuid.UUID, hours []*entity.OpeningHours) error {
+	m.hours[branchID] = hours
+	return nil
+}
+
+func (m *mockCalendarRepository) CreateClosedDate(ctx context.Context, closedDate *entity.ClosedDate) error {
+	m.closed[closedDate.ID] = closedDate
+	return nil
+}
+
+func (m *mockCalendarRepository) GetClosedDate(ctx context.Context, id uuid.UUID) (*entity.ClosedDate, error) {
+	if closedDate, exists := m.closed[id]; exists {
+		return closedDate, nil
+	}
+	return nil, nil
+}
+
+func (m *mockCalendarRepository) GetClosedDateByDay(ctx context.Context, branchID uuid.UUID, date time.Time) (*entity.ClosedDate, error) {
+	for _, closedDate := range m.closed {
+		if closedDate.BranchID == branchID && closedDate.Date.Equal(entity.CalendarDay(date)) {
+			return closedDate, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockCalendarRepository) ListClosedDates(ctx context.Context, branchID uuid.UUID, from time.Time, to *time.Time) ([]*entity.ClosedDate, error) {
+	closedDates := make([]*entity.ClosedDate, 0)
+	for _, closedDate := range m.closed {
+		if closedDate.BranchID != branchID || closedDate.Date.Before(entity.CalendarDay(from)) {
+			continue
+		}
+		if to != nil && closedDate.Date.After(entity.CalendarDay(*to)) {
+			continue
+		}
+		closedDates = append(closedDates, closedDate)
+	}
+	slices.SortFunc(closedDates, func(a, b *entity.ClosedDate) int {
+		return a.Date.Compare(b.Date)
+	})
+	return closedDates, nil
+}
+
+func (m *mockCalendarRepository) DeleteClosedDate(ctx context.Context, id uuid.UUID) error {
+	delete(m.closed, id)
+	return nil
+}
+
+func newCalendarTestUseCase() (CalendarUseCase, *entity.Branch) {
+	branchRepo := newMockBranchRepository()
+	main, _ := branchRepo.GetByCode(context.Background(), entity.DefaultBranchCode)
+	return NewCalendarUseCase(newMockCalendarRepository(), branchRepo, newMockTxManager(), newMockAuditor(), time.UTC), main
+}
+
+func TestCalendarUseCase_SetOpeningHours(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("replaces the weekly schedule", func(t *testing.T) {
+		uc, main := newCalendarTestUseCase()
+		_, _ = uc.SetOpeningHours(ctx, main.ID, []OpeningHoursInput{
+			{Weekday: time.Monday, OpensAt: "09:00", ClosesAt: "18:00"},
+		})
+
+		hours, err := uc.SetOpeningHours(ctx, main.ID, []OpeningHoursInput{
+			{Weekday: time.Saturday, OpensAt: "09:00", ClosesAt: "13:00"},
+			{Weekday: time.Tuesday, OpensAt: "10:00", ClosesAt: "19:30"},
+		})
+		if err != nil {
+			t.Fatalf("CalendarUseCase.SetOpeningHours() unexpected error = %v", err)
+		}
+
+		if len(hours) != 2 {
+			t.Fatalf("CalendarUseCase.SetOpeningHours() count = %v, want %v", len(hours), 2)
+		}
+		if hours[0].Weekday != time.Tuesday || hours[0].ClosesAt != 19*60+30 {
+			t.Errorf("CalendarUseCase.SetOpeningHours() first = %v until %v, want Tuesday until 19:30", hours[0].Weekday, entity.FormatClockTime(hours[0].ClosesAt))
+		}
+	})
+
+	t.Run("same weekday twice", func(t *testing.T) {
+		uc, main := newCalendarTestUseCase()
+
+		_, err := uc.SetOpeningHours(ctx, main.ID, []OpeningHoursInput{
+			{Weekday: time.Monday, OpensAt: "09:00", ClosesAt: "12:00"},
+			{Weekday: time.Monday, OpensAt: "14:00", ClosesAt: "18:00"},
+		})
+		if err != entity.ErrDuplicateWeekday {
+			t.Errorf("CalendarUseCase.SetOpeningHours() error = %v, want %v", err, entity.ErrDuplicateWeekday)
+		}
+	})
+
+	t.Run("unknown branch", func(t *testing.T) {
+		uc, _ := newCalendarTestUseCase()
+
+		_, err := uc.SetOpeningHours(ctx, uuid.New(), nil)
+		if err != entity.ErrBranchNotFound {
+			t.Errorf("CalendarUseCase.SetOpeningHours() error = %v, want %v", err, entity.ErrBranchNotFound)
+		}
+	})
+}
+
+func TestCalendarUseCase_ClosedDates(t *testing.T) {
+	ctx := context.Background()
+	christmas := time.Date(2099, 12, 25, 0, 0, 0, 0, time.UTC)
+
+	t.Run("add and list", func(t *testing.T) {
+		uc, main := newCalendarTestUseCase()
+		past, _ := uc.AddClosedDate(ctx, main.ID, AddClosedDateInput{Date: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+		closedDate, err := uc.AddClosedDate(ctx, main.ID, AddClosedDateInput{Date: christmas, Reason: "Christmas"})
+		if err != nil {
+			t.Fatalf("CalendarUseCase.AddClosedDate() unexpected error = %v", err)
+		}
+
+		upcoming, _ := uc.ListClosedDates(ctx, main.ID, nil, nil)
+		if len(upcoming) != 1 || upcoming[0].ID != closedDate.ID {
+			t.Errorf("CalendarUseCase.ListClosedDates() = %v, want only %v", upcoming, closedDate.Date)
+		}
+
+		from := past.Date
+		all, _ := uc.ListClosedDates(ctx, main.ID, &from, nil)
+		if len(all) != 2 {
+			t.Errorf("CalendarUseCase.ListClosedDates() count = %v, want %v", len(all), 2)
+		}
+	})
+
+	t.Run("date already closed", func(t *testing.T) {
+		uc, main := newCalendarTestUseCase()
+		_, _ = uc.AddClosedDate(ctx, main.ID, AddClosedDateInput{Date: christmas})
+
+		_, err := uc.AddClosedDate(ctx, main.ID, AddClosedDateInput{Date: christmas.Add(10 * time.Hour)})
+		if err != entity.ErrClosedDateAlreadyExists {
+			t.Errorf("CalendarUseCase.AddClosedDate() error = %v, want %v", err, entity.ErrClosedDateAlreadyExists)
+		}
+	})
+
+	t.Run("remove from another branch", func(t *testing.T) {
+		uc, main := newCalendarTestUseCase()
+		closedDate, _ := uc.AddClosedDate(ctx, main.ID, AddClosedDateInput{Date: christmas})
+
+		err := uc.RemoveClosedDate(ctx, uuid.New(), closedDate.ID)
+		if err != entity.ErrClosedDateNotFound {
+			t.Errorf("CalendarUseCase.RemoveClosedDate() error = %v, want %v", err, entity.ErrClosedDateNotFound)
+		}
+
+		if err := uc.RemoveClosedDate(ctx, main.ID, closedDate.ID); err != nil {
+			t.Errorf("CalendarUseCase.RemoveClosedDate() unexpected error = %v", err)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var ErrUnauthenticated = errors.New("no authenticated user in context")
+
+// Caller identifies the authenticated user a request is made on behalf of.
+type Caller struct {
+	UserID uuid.UUID
+	Role   string
+}
+
+type callerKey struct{}
+
+// ContextWithCaller returns a copy of ctx carrying caller. The HTTP layer sets
+// it once the token is validated so use cases can act on the caller's behalf.
+func ContextWithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+func CallerFromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+	return caller, ok
+}
+
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a copy of ctx carrying the ID of the HTTP
+// request being served. The audit log records it with each change.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID in ctx, or "" outside of a
+// request, e.g. in background jobs.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
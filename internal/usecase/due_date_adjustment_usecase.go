@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type DueDateAdjustmentUseCase interface {
+	// AdjustDueDates moves, in one transaction, the due dates of the checked
+	// out loans due between input.DueFrom and input.DueTo and records the
+	// adjustment on behalf of the caller. Each loan moved is audited and
+	// announced on its own.
+	AdjustDueDates(ctx context.Context, input AdjustDueDatesInput) (*entity.DueDateAdjustment, error)
+}
+
+// AdjustDueDatesInput selects loans by the calendar day, in the library time
+// zone, they are due on and, optionally, by branch and book. Exactly one of
+// ShiftDays and NewDueDate gives the change.
+type AdjustDueDatesInput struct {
+	DueFrom    time.Time
+	DueTo      time.Time
+	BranchID   *uuid.UUID
+	BookID     *uuid.UUID
+	ShiftDays  int
+	NewDueDate *time.Time
+	Reason     string
+}
+
+type dueDateAdjustmentUseCase struct {
+	adjustmentRepo repository.DueDateAdjustmentRepository
+	loanRepo       repository.LoanRepository
+	copyRepo       repository.BookCopyRepository
+	bookRepo       repository.BookRepository
+	branchRepo     repository.BranchRepository
+	calendarRepo   repository.CalendarRepository
+	txManager      repository.TxManager
+	events         EventEmitter
+	audit          Auditor
+	location       *time.Location
+}
+
+func NewDueDateAdjustmentUseCase(
+	adjustmentRepo repository.DueDateAdjustmentRepository,
+	loanRepo repository.LoanRepository,
+	copyRepo repository.BookCopyRepository,
+	bookRepo repository.BookRepository,
+	branchRepo repository.BranchRepository,
+	calendarRepo repository.CalendarRepository,
+	txManager repository.TxManager,
+	events EventEmitter,
+	audit Auditor,
+	location *time.Location,
+) DueDateAdjustmentUseCase {
+	if location == nil {
+		location = time.UTC
+	}
+	return &dueDateAdjustmentUseCase{
+		adjustmentRepo: adjustmentRepo,
+		loanRepo:       loanRepo,
+		copyRepo:       copyRepo,
+		bookRepo:       bookRepo,
+		branchRepo:     branchRepo,
+		calendarRepo:   calendarRepo,
+		txManager:      txManager,
+		events:         events,
+		audit:          audit,
+		location:       location,
+	}
+}
+
+func (uc *dueDateAdjustmentUseCase) AdjustDueDates(ctx context.Context, input AdjustDueDatesInput) (*entity.DueDateAdjustment, error) {
+	caller, ok := CallerFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+
+	adjustment, err := entity.NewDueDateAdjustment(caller.UserID, input.DueFrom, input.DueTo, input.ShiftDays, input.NewDueDate, input.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.BranchID != nil {
+		if _, err := resolveBranch(ctx, uc.branchRepo, input.BranchID); err != nil {
+			return nil, err
+		}
+		adjustment.BranchID = input.BranchID
+	}
+	if input.BookID != nil {
+		book, err := uc.bookRepo.GetByID(ctx, *input.BookID)
+		if err != nil {
+			return nil, err
+		}
+		if book == nil {
+			return nil, entity.ErrBookNotFound
+		}
+		adjustment.BookID = input.BookID
+	}
+
+	dueFrom, dueBefore := adjustment.DueWindow(uc.location)
+	filter := repository.DueLoanFilter{
+		DueFrom:   dueFrom,
+		DueBefore: dueBefore,
+		BookID:    adjustment.BookID,
+		BranchID:  adjustment.BranchID,
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		loans, err := uc.loanRepo.ListActiveDue(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		calendars := make(map[uuid.UUID]*entity.LibraryCalendar)
+		for _, loan := range loans {
+			due, err := uc.adjustedDue(ctx, loan, adjustment, calendars)
+			if err != nil {
+				return err
+			}
+			if due.Equal(loan.DueDate) {
+				continue
+			}
+
+			before := loanAudit(loan)
+			loan.RescheduleDue(due)
+			if err := uc.loanRepo.Update(ctx, loan); err != nil {
+				return err
+			}
+			if err := recordLoan(ctx, uc.audit, entity.AuditLoanDueDateChanged, before, loan); err != nil {
+				return err
+			}
+			if err := uc.events.Emit(ctx, loanEvent(entity.EventLoanDueDateChanged, loan)); err != nil {
+				return err
+			}
+			adjustment.LoansAdjusted++
+		}
+
+		if err := uc.adjustmentRepo.Create(ctx, adjustment); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditDueDateAdjustmentMade,
+			EntityType: entity.AuditEntityDueDateAdjustment,
+			EntityID:   adjustment.ID,
+			After:      dueDateAdjustmentAudit(adjustment),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return adjustment, nil
+}
+
+// adjustedDue applies adjustment to the loan's due date and rolls the result
+// to the next day the lending branch is open. calendars caches the branch
+// calendars already loaded.
+func (uc *dueDateAdjustmentUseCase) adjustedDue(
+	ctx context.Context,
+	loan *entity.Loan,
+	adjustment *entity.DueDateAdjustment,
+	calendars map[uuid.UUID]*entity.LibraryCalendar,
+) (time.Time, error) {
+	due := adjustment.Apply(loan.DueDate, uc.location)
+	if loan.CopyID == nil {
+		return due, nil
+	}
+
+	bookCopy, err := uc.copyRepo.GetByID(ctx, *loan.CopyID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if bookCopy == nil {
+		return due, nil
+	}
+
+	calendar, cached := calendars[bookCopy.BranchID]
+	if !cached {
+		// No adjusted due date falls before the adjusted start of the range.
+		earliest := adjustment.Apply(adjustment.DueFrom, uc.location)
+		calendar, err = branchCalendar(ctx, uc.calendarRepo, uc.location, bookCopy.BranchID, earliest)
+		if err != nil {
+			return time.Time{}, err
+		}
+		calendars[bookCopy.BranchID] = calendar
+	}
+	return calendar.DueAt(due), nil
+}
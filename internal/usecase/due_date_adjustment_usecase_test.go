@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type mockDueDateAdjustmentRepository struct {
+	adjustments []*entity.DueDateAdjustment
+}
+
+func newMockDueDateAdjustmentRepository() *mockDueDateAdjustmentRepository {
+	return &mockDueDateAdjustmentRepository{}
+}
+
+func (m *mockDueDateAdjustmentRepository) Create(ctx context.Context, adjustment *entity.DueDateAdjustment) error {
+	m.adjustments = append(m.adjustments, adjustment)
+	return nil
+}
+
+func TestDueDateAdjustmentUseCase_AdjustDueDates(t *testing.T) {
+	admin := Caller{UserID: uuid.New(), Role: entity.RoleAdmin}
+	ctx := ContextWithCaller(context.Background(), admin)
+	day := time.Date(2099, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	type testData struct {
+		uc             DueDateAdjustmentUseCase
+		loanRepo       *mockLoanRepository
+		copyRepo       *mockBookCopyRepository
+		adjustmentRepo *mockDueDateAdjustmentRepository
+		branchRepo     *mockBranchRepository
+		calendarRepo   *mockCalendarRepository
+		outbox         *mockOutboxRepository
+		auditor        *mockAuditor
+		book           *entity.Book
+	}
+	createTestData := func() testData {
+		loanRepo := newMockLoanRepository()
+		copyRepo := newMockBookCopyRepository()
+		loanRepo.copies = copyRepo
+		bookRepo := newMockBookRepository()
+		book, _ := entity.NewBook("Clean Code", "Robert C. Martin", "9780132350884", 2008, 2)
+		_ = bookRepo.Create(context.Background(), book)
+		events, outbox := newTestOutbox()
+
+		data := testData{
+			loanRepo:       loanRepo,
+			copyRepo:       copyRepo,
+			adjustmentRepo: newMockDueDateAdjustmentRepository(),
+			branchRepo:     newMockBranchRepository(),
+			calendarRepo:   newMockCalendarRepository(),
+			outbox:         outbox,
+			auditor:        newMockAuditor(),
+			book:           book,
+		}
+		data.uc = NewDueDateAdjustmentUseCase(data.adjustmentRepo, loanRepo, copyRepo, bookRepo, data.branchRepo, data.calendarRepo, newMockTxManager(), events, data.auditor, time.UTC)
+		return data
+	}
+	addLoan := func(data testData, copyID *uuid.UUID, due time.Time, status string) *entity.Loan {
+		loan := &entity.Loan{
+			ID:         uuid.New(),
+			UserID:     uuid.New(),
+			BookID:     data.book.ID,
+			CopyID:     copyID,
+			BorrowedAt: due.AddDate(0, 0, -14),
+			DueDate:    due,
+			Status:     status,
+		}
+		data.loanRepo.loans[loan.ID] = loan
+		return loan
+	}
+
+	t.Run("shift pushes out loans due in the range", func(t *testing.T) {
+		data := createTestData()
+		onFirstDay := addLoan(data, nil, day.Add(12*time.Hour), entity.LoanStatusActive)
+		overdue := addLoan(data, nil, day.AddDate(0, 0, 1).Add(18*time.Hour), entity.LoanStatusOverdue)
+		later := addLoan(data, nil, day.AddDate(0, 0, 5), entity.LoanStatusActive)
+		returned := addLoan(data, nil, day.Add(12*time.Hour), entity.LoanStatusReturned)
+
+		adjustment, err := data.uc.AdjustDueDates(ctx, AdjustDueDatesInput{
+			DueFrom:   day,
+			DueTo:     day.AddDate(0, 0, 1),
+			ShiftDays: 7,
+			Reason:    "Flooding",
+		})
+		if err != nil {
+			t.Fatalf("DueDateAdjustmentUseCase.AdjustDueDates() unexpected error = %v", err)
+		}
+
+		if adjustment.LoansAdjusted != 2 {
+			t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() LoansAdjusted = %v, want %v", adjustment.LoansAdjusted, 2)
+		}
+		if want := day.AddDate(0, 0, 7).Add(12 * time.Hour); !onFirstDay.DueDate.Equal(want) {
+			t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() DueDate = %v, want %v", onFirstDay.DueDate, want)
+		}
+		if overdue.Status != entity.LoanStatusActive {
+			t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() overdue loan status = %v, want %v", overdue.Status, entity.LoanStatusActive)
+		}
+		if !later.DueDate.Equal(day.AddDate(0, 0, 5)) || !returned.DueDate.Equal(day.Add(12*time.Hour)) {
+			t.Error("DueDateAdjustmentUseCase.AdjustDueDates() changed loans outside the adjustment")
+		}
+		if len(data.adjustmentRepo.adjustments) != 1 || data.adjustmentRepo.adjustments[0].AdjustedBy != admin.UserID {
+			t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() recorded = %v, want one adjustment by the caller", data.adjustmentRepo.adjustments)
+		}
+
+		// Each moved loan is audited on its own, then the adjustment.
+		want := []string{entity.AuditLoanDueDateChanged, entity.AuditLoanDueDateChanged, entity.AuditDueDateAdjustmentMade}
+		if got := data.auditor.actions(); !slices.Equal(got, want) {
+			t.Fatalf("DueDateAdjustmentUseCase.AdjustDueDates() audit = %v, want %v", got, want)
+		}
+		for _, record := range data.auditor.records[:2] {
+			if record.EntityID != onFirstDay.ID && record.EntityID != overdue.ID {
+				t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() audited loan %v, want only the adjusted loans", record.EntityID)
+			}
+			if record.Before.(*loanAuditState).DueDate.Equal(record.After.(*loanAuditState).DueDate) {
+				t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() audit of loan %v has no due date change", record.EntityID)
+			}
+		}
+		if record := data.auditor.records[2]; record.EntityID != adjustment.ID {
+			t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() audited adjustment %v, want %v", record.EntityID, adjustment.ID)
+		}
+
+		// Each moved loan is announced through the outbox too.
+		wantEvents := []entity.EventType{entity.EventLoanDueDateChanged, entity.EventLoanDueDateChanged}
+		if got := data.outbox.types(); !slices.Equal(got, wantEvents) {
+			t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() outbox = %v, want %v", got, wantEvents)
+		}
+	})
+
+	t.Run("branch filter and calendar", func(t *testing.T) {
+		data := createTestData()
+		north, _ := entity.NewBranch("NORTH", "North Branch", "")
+		_ = data.branchRepo.Create(context.Background(), north)
+		main, _ := data.branchRepo.GetByCode(context.Background(), entity.DefaultBranchCode)
+
+		northCopy, _ := entity.NewBookCopy(data.book.ID, north.ID, "9780132350884-001", "", "")
+		mainCopy, _ := entity.NewBookCopy(data.book.ID, main.ID, "9780132350884-002", "", "")
+		_ = data.copyRepo.Create(context.Background(), northCopy)
+		_ = data.copyRepo.Create(context.Background(), mainCopy)
+		atNorth := addLoan(data, &northCopy.ID, day.Add(17*time.Hour), entity.LoanStatusActive)
+		atMain := addLoan(data, &mainCopy.ID, day.Add(17*time.Hour), entity.LoanStatusActive)
+
+		// North opens only on the weekday of openDay, so the new due date falls
+		// on a day it is closed.
+		openDay := day.AddDate(0, 0, 10)
+		hours, _ := entity.NewOpeningHours(north.ID, openDay.Weekday(), "09:00", "17:00")
+		_ = data.calendarRepo.ReplaceOpeningHours(context.Background(), north.ID, []*entity.OpeningHours{hours})
+		newDueDate := openDay.AddDate(0, 0, -1)
+
+		adjustment, err := data.uc.AdjustDueDates(ctx, AdjustDueDatesInput{
+			DueFrom:    day,
+			DueTo:      day,
+			BranchID:   &north.ID,
+			NewDueDate: &newDueDate,
+		})
+		if err != nil {
+			t.Fatalf("DueDateAdjustmentUseCase.AdjustDueDates() unexpected error = %v", err)
+		}
+
+		if adjustment.LoansAdjusted != 1 {
+			t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() LoansAdjusted = %v, want %v", adjustment.LoansAdjusted, 1)
+		}
+		if want := openDay.Add(17 * time.Hour); !atNorth.DueDate.Equal(want) {
+			t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() DueDate = %v, want %v", atNorth.DueDate, want)
+		}
+		if !atMain.DueDate.Equal(day.Add(17 * time.Hour)) {
+			t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() changed a loan at another branch")
+		}
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		data := createTestData()
+		unknown := uuid.New()
+
+		tests := []struct {
+			name    string
+			ctx     context.Context
+			input   AdjustDueDatesInput
+			wantErr error
+		}{
+			{"no caller", context.Background(), AdjustDueDatesInput{DueFrom: day, DueTo: day, ShiftDays: 1}, ErrUnauthenticated},
+			{"no change", ctx, AdjustDueDatesInput{DueFrom: day, DueTo: day}, entity.ErrInvalidDueDateAdjustment},
+			{"unknown branch", ctx, AdjustDueDatesInput{DueFrom: day, DueTo: day, ShiftDays: 1, BranchID: &unknown}, entity.ErrBranchNotFound},
+			{"unknown book", ctx, AdjustDueDatesInput{DueFrom: day, DueTo: day, ShiftDays: 1, BookID: &unknown}, entity.ErrBookNotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := data.uc.AdjustDueDates(tt.ctx, tt.input)
+				if err != tt.wantErr {
+					t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() error = %v, want %v", err, tt.wantErr)
+				}
+			})
+		}
+		if len(data.adjustmentRepo.adjustments) != 0 {
+			t.Errorf("DueDateAdjustmentUseCase.AdjustDueDates() recorded %v adjustments, want none", len(data.adjustmentRepo.adjustments))
+		}
+	})
+}
@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type EscalationUseCase interface {
+	// EscalateOverdueLoans takes, for every overdue loan, the steps of the
+	// escalation ladder it has reached and not been through yet, and returns
+	// how many steps were taken.
+	EscalateOverdueLoans(ctx context.Context) (int, error)
+	// ListLoanEscalations returns the steps taken against the loan ordered
+	// by level.
+	ListLoanEscalations(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanEscalation, error)
+}
+
+// EscalationNotifier tells patrons about a step taken against their overdue
+// loan. fine is the replacement bill of a loan declared lost, nil otherwise.
+type EscalationNotifier interface {
+	NotifyEscalation(ctx context.Context, loan *entity.Loan, escalation *entity.LoanEscalation, fine *entity.Fine) error
+}
+
+type escalationUseCase struct {
+	escalationRepo repository.LoanEscalationRepository
+	loanRepo       repository.LoanRepository
+	userRepo       repository.UserRepository
+	bookRepo       repository.BookRepository
+	copyRepo       repository.BookCopyRepository
+	fineRepo       repository.FineRepository
+	txManager      repository.TxManager
+	events         EventEmitter
+	audit          Auditor
+	notifier       EscalationNotifier
+	ladder         entity.EscalationLadder
+	fines          FineRules
+}
+
+func NewEscalationUseCase(
+	escalationRepo repository.LoanEscalationRepository,
+	loanRepo repository.LoanRepository,
+	userRepo repository.UserRepository,
+	bookRepo repository.BookRepository,
+	copyRepo repository.BookCopyRepository,
+	fineRepo repository.FineRepository,
+	txManager repository.TxManager,
+	events EventEmitter,
+	audit Auditor,
+	notifier EscalationNotifier,
+	ladder entity.EscalationLadder,
+	fines FineRules,
+) EscalationUseCase {
+	return &escalationUseCase{
+		escalationRepo: escalationRepo,
+		loanRepo:       loanRepo,
+		userRepo:       userRepo,
+		bookRepo:       bookRepo,
+		copyRepo:       copyRepo,
+		fineRepo:       fineRepo,
+		txManager:      txManager,
+		events:         events,
+		audit:          audit,
+		notifier:       notifier,
+		ladder:         ladder,
+		fines:          fines,
+	}
+}
+
+func (uc *escalationUseCase) EscalateOverdueLoans(ctx context.Context) (int, error) {
+	if len(uc.ladder) == 0 {
+		return 0, nil
+	}
+
+	// Only loans past due for longer than the first step are listed.
+	now := time.Now()
+	loans, err := uc.loanRepo.ListActiveDue(ctx, repository.DueLoanFilter{
+		DueBefore: now.Add(-time.Duration(uc.ladder[0].DaysOverdue-1) * 24 * time.Hour),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	taken := 0
+	var errs []error
+	for _, loan := range loans {
+		n, err := uc.escalate(ctx, loan.ID, now)
+		taken += n
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return taken, errors.Join(errs...)
+}
+
+// escalate takes, in one transaction, the steps the loan has reached since
+// the last run. Steps missed while the job was not running are taken
+// together, and the patron is notified once, of the furthest one. A failed
+// notice does not undo the steps.
+func (uc *escalationUseCase) escalate(ctx context.Context, loanID uuid.UUID, now time.Time) (int, error) {
+	var loan *entity.Loan
+	var last *entity.LoanEscalation
+	var fine *entity.Fine
+	taken := 0
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		last, fine, taken = nil, nil, 0
+
+		var err error
+		loan, err = uc.loanRepo.GetByID(ctx, loanID)
+		if err != nil {
+			return err
+		}
+		if loan == nil || !loan.IsActive() {
+			return nil
+		}
+
+		done, err := uc.escalationRepo.ListByLoan(ctx, loan.ID)
+		if err != nil {
+			return err
+		}
+		level := 0
+		for _, escalation := range done {
+			level = max(level, escalation.Level)
+		}
+
+		daysOverdue := loan.DaysOverdue(now)
+		for level < uc.ladder.Reached(daysOverdue) {
+			level++
+			step := uc.ladder[level-1]
+
+			escalation := entity.NewLoanEscalation(loan, level, step.Action, daysOverdue)
+			claimed, err := uc.escalationRepo.Claim(ctx, escalation)
+			if err != nil {
+				return err
+			}
+			if !claimed {
+				// Another run is taking this step.
+				return nil
+			}
+
+			if fine, err = uc.take(ctx, loan, step.Action, now); err != nil {
+				return err
+			}
+			last = escalation
+			taken++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if last == nil {
+		return 0, nil
+	}
+
+	return taken, uc.notifier.NotifyEscalation(ctx, loan, last, fine)
+}
+
+// take carries out action against loan. It returns the replacement fine
+// when the loan is declared lost.
+func (uc *escalationUseCase) take(ctx context.Context, loan *entity.Loan, action entity.EscalationAction, now time.Time) (*entity.Fine, error) {
+	switch action {
+	case entity.EscalationBlock:
+		user, err := uc.userRepo.GetByID(ctx, loan.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, entity.ErrUserNotFound
+		}
+		if user.Blocked {
+			// Blocked by an earlier loan.
+			return nil, nil
+		}
+		before := userAudit(user)
+		user.Block()
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+		if err := uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditUserBlocked,
+			EntityType: entity.AuditEntityUser,
+			EntityID:   user.ID,
+			Before:     before,
+			After:      userAudit(user),
+		}); err != nil {
+			return nil, err
+		}
+		return nil, uc.events.Emit(ctx, userEvent(entity.EventUserBlocked, user))
+	case entity.EscalationLost:
+		return declareLost(ctx, uc.loanRepo, uc.copyRepo, uc.bookRepo, uc.fineRepo, uc.events, uc.audit, loan, now, uc.fines.ReplacementCostCents)
+	default:
+		return nil, nil
+	}
+}
+
+func (uc *escalationUseCase) ListLoanEscalations(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanEscalation, error) {
+	loan, err := uc.loanRepo.GetByID(ctx, loanID)
+	if err != nil {
+		return nil, err
+	}
+	if loan == nil {
+		return nil, entity.ErrLoanNotFound
+	}
+	return uc.escalationRepo.ListByLoan(ctx, loanID)
+}
+
+// declareLost closes loan as lost at, withdraws the copy the patron kept
+// and bills replacementCents for it, announcing both through events and
+// recording them in audit. It returns the fine, or nil when replacements are not billed.
+func declareLost(
+	ctx context.Context,
+	loanRepo repository.LoanRepository,
+	copyRepo repository.BookCopyRepository,
+	bookRepo repository.BookRepository,
+	fineRepo repository.FineRepository,
+	events EventEmitter,
+	audit Auditor,
+	loan *entity.Loan,
+	at time.Time,
+	replacementCents int64,
+) (*entity.Fine, error) {
+	before := loanAudit(loan)
+	if err := loan.MarkLost(at); err != nil {
+		return nil, err
+	}
+
+	book, err := bookRepo.GetByID(ctx, loan.BookID)
+	if err != nil {
+		return nil, err
+	}
+	if book == nil {
+		return nil, entity.ErrBookNotFound
+	}
+
+	bookCopy, err := loanCopy(ctx, copyRepo, loan)
+	if err != nil {
+		return nil, err
+	}
+	bookCopy.Withdraw()
+	if err := copyRepo.Update(ctx, bookCopy); err != nil {
+		return nil, err
+	}
+	if err := syncCopyCounts(ctx, copyRepo, bookRepo, book); err != nil {
+		return nil, err
+	}
+
+	if err := loanRepo.Update(ctx, loan); err != nil {
+		return nil, err
+	}
+	if err := recordLoan(ctx, audit, entity.AuditLoanLost, before, loan); err != nil {
+		return nil, err
+	}
+	if err := events.Emit(ctx, loanEvent(entity.EventLoanLost, loan)); err != nil {
+		return nil, err
+	}
+
+	if replacementCents <= 0 {
+		return nil, nil
+	}
+	fine := entity.NewFine(loan.UserID, loan.ID, entity.FineReasonLost, replacementCents)
+	if err := assessFine(ctx, fineRepo, events, audit, fine); err != nil {
+		return nil, err
+	}
+	return fine, nil
+}
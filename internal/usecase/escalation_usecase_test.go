@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type mockLoanEscalationRepository struct {
+	escalations []*entity.LoanEscalation
+}
+
+func newMockLoanEscalationRepository() *mockLoanEscalationRepository {
+	return &mockLoanEscalationRepository{}
+}
+
+func (m *mockLoanEscalationRepository) Claim(ctx context.Context, escalation *entity.LoanEscalation) (bool, error) {
+	for _, e := range m.escalations {
+		if e.LoanID == escalation.LoanID && e.Level == escalation.Level {
+			return false, nil
+		}
+	}
+	m.escalations = append(m.escalations, escalation)
+	return true, nil
+}
+
+func (m *mockLoanEscalationRepository) ListByLoan(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanEscalation, error) {
+	escalations := make([]*entity.LoanEscalation, 0)
+	for _, e := range m.escalations {
+		if e.LoanID == loanID {
+			escalations = append(escalations, e)
+		}
+	}
+	slices.SortFunc(escalations, func(a, b *entity.LoanEscalation) int {
+		return a.Level - b.Level
+	})
+	return escalations, nil
+}
+
+type escalationNotice struct {
+	escalation *entity.LoanEscalation
+	fine       *entity.Fine
+}
+
+type mockEscalationNotifier struct {
+	notices []escalationNotice
+}
+
+func (m *mockEscalationNotifier) NotifyEscalation(ctx context.Context, loan *entity.Loan, escalation *entity.LoanEscalation, fine *entity.Fine) error {
+	m.notices = append(m.notices, escalationNotice{escalation: escalation, fine: fine})
+	return nil
+}
+
+func TestEscalationUseCase_EscalateOverdueLoans(t *testing.T) {
+	ctx := context.Background()
+	ladder, _ := entity.ParseEscalationLadder("1:notice,7:notice,14:block,30:lost")
+	fines := FineRules{ReplacementCostCents: 4500}
+
+	type testData struct {
+		uc             EscalationUseCase
+		escalationRepo *mockLoanEscalationRepository
+		loanRepo       *mockLoanRepository
+		userRepo       *mockUserRepository
+		bookRepo       *mockBookRepository
+		copyRepo       *mockBookCopyRepository
+		fineRepo       *mockFineRepository
+		events         *mockEventEmitter
+		auditor        *mockAuditor
+		notifier       *mockEscalationNotifier
+		user           *entity.User
+		book           *entity.Book
+	}
+	createTestData := func() testData {
+		data := testData{
+			escalationRepo: newMockLoanEscalationRepository(),
+			loanRepo:       newMockLoanRepository(),
+			userRepo:       newMockUserRepository(),
+			bookRepo:       newMockBookRepository(),
+			copyRepo:       newMockBookCopyRepository(),
+			fineRepo:       newMockFineRepository(),
+			events:         newMockEventEmitter(),
+			auditor:        newMockAuditor(),
+			notifier:       &mockEscalationNotifier{},
+		}
+		data.user, _ = NewUserUseCase(data.userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+			Name:     "John Doe",
+			Email:    "john@example.com",
+			Password: "password123",
+		})
+		data.book, _ = NewBookUseCase(data.bookRepo, data.copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+			Title:         "Clean Code",
+			Author:        "Robert C. Martin",
+			ISBN:          "9780132350884",
+			PublishedYear: 2008,
+			TotalCopies:   2,
+		})
+		data.uc = NewEscalationUseCase(data.escalationRepo, data.loanRepo, data.userRepo, data.bookRepo, data.copyRepo, data.fineRepo, newMockTxManager(), data.events, data.auditor, data.notifier, ladder, fines)
+		return data
+	}
+	// addLoan lends a copy of the book for a loan daysOverdue days past due.
+	addLoan := func(data testData, daysOverdue int) *entity.Loan {
+		bookCopy, _ := data.copyRepo.FindByStatus(ctx, data.book.ID, entity.CopyStatusAvailable)
+		_ = bookCopy.CheckOut()
+		_ = syncCopyCounts(ctx, data.copyRepo, data.bookRepo, data.book)
+
+		due := time.Now().AddDate(0, 0, -daysOverdue).Add(time.Hour)
+		loan := &entity.Loan{
+			ID:         uuid.New(),
+			UserID:     data.user.ID,
+			BookID:     data.book.ID,
+			CopyID:     &bookCopy.ID,
+			BorrowedAt: due.AddDate(0, 0, -14),
+			DueDate:    due,
+			Status:     entity.LoanStatusOverdue,
+		}
+		data.loanRepo.loans[loan.ID] = loan
+		return loan
+	}
+
+	t.Run("takes each step once", func(t *testing.T) {
+		data := createTestData()
+		loan := addLoan(data, 7)
+		notDue := addLoan(data, 0)
+
+		taken, err := data.uc.EscalateOverdueLoans(ctx)
+		if err != nil {
+			t.Fatalf("EscalationUseCase.EscalateOverdueLoans() unexpected error = %v", err)
+		}
+		if taken != 2 {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() taken = %v, want %v", taken, 2)
+		}
+		if len(data.notifier.notices) != 1 || data.notifier.notices[0].escalation.Level != 2 {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() notices = %v, want one for level 2", data.notifier.notices)
+		}
+
+		taken, _ = data.uc.EscalateOverdueLoans(ctx)
+		if taken != 0 {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() second run taken = %v, want %v", taken, 0)
+		}
+
+		history, _ := data.uc.ListLoanEscalations(ctx, loan.ID)
+		if len(history) != 2 || history[0].Action != entity.EscalationNotice || history[1].Level != 2 {
+			t.Errorf("EscalationUseCase.ListLoanEscalations() = %v, want notice steps 1 and 2", history)
+		}
+		if none, _ := data.uc.ListLoanEscalations(ctx, notDue.ID); len(none) != 0 {
+			t.Errorf("EscalationUseCase.ListLoanEscalations() not overdue = %v, want none", none)
+		}
+	})
+
+	t.Run("block stops the patron from borrowing", func(t *testing.T) {
+		data := createTestData()
+		addLoan(data, 14)
+
+		if _, err := data.uc.EscalateOverdueLoans(ctx); err != nil {
+			t.Fatalf("EscalationUseCase.EscalateOverdueLoans() unexpected error = %v", err)
+		}
+
+		if !data.user.Blocked {
+			t.Fatal("EscalationUseCase.EscalateOverdueLoans() user should be blocked")
+		}
+		if got := data.events.types(); !slices.Equal(got, []entity.EventType{entity.EventUserBlocked}) {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() events = %v, want [%v]", got, entity.EventUserBlocked)
+		}
+		if got := data.auditor.actions(); !slices.Equal(got, []string{entity.AuditUserBlocked}) {
+			t.Fatalf("EscalationUseCase.EscalateOverdueLoans() audit = %v, want [%v]", got, entity.AuditUserBlocked)
+		}
+		record := data.auditor.records[0]
+		if record.EntityType != entity.AuditEntityUser || record.EntityID != data.user.ID {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() audit entity = %v %v, want user %v", record.EntityType, record.EntityID, data.user.ID)
+		}
+		if record.Before.(*userAuditState).Blocked || !record.After.(*userAuditState).Blocked {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() audit = %+v -> %+v, want the user blocked", record.Before, record.After)
+		}
+
+		loanUC := NewLoanUseCase(data.loanRepo, data.bookRepo, data.copyRepo, data.userRepo, newMockHoldRepository(), data.fineRepo, newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
+		other, _ := NewBookUseCase(data.bookRepo, data.copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+			Title:         "Refactoring",
+			Author:        "Martin Fowler",
+			ISBN:          "9780134757599",
+			PublishedYear: 2018,
+			TotalCopies:   1,
+		})
+		_, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: data.user.ID, BookID: other.ID})
+		if err != entity.ErrUserBlocked {
+			t.Errorf("LoanUseCase.BorrowBook() error = %v, want %v", err, entity.ErrUserBlocked)
+		}
+	})
+
+	t.Run("lost closes the loan and bills the replacement", func(t *testing.T) {
+		data := createTestData()
+		loan := addLoan(data, 30)
+
+		taken, err := data.uc.EscalateOverdueLoans(ctx)
+		if err != nil {
+			t.Fatalf("EscalationUseCase.EscalateOverdueLoans() unexpected error = %v", err)
+		}
+		if taken != 4 {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() taken = %v, want %v", taken, 4)
+		}
+
+		if loan.Status != entity.LoanStatusLost || loan.ReturnedAt == nil {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() loan status = %v, want %v", loan.Status, entity.LoanStatusLost)
+		}
+		if data.book.TotalCopies != 1 || data.book.AvailableCopies != 1 {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() copies = %v/%v, want 1/1", data.book.AvailableCopies, data.book.TotalCopies)
+		}
+		owed, _ := data.fineRepo.OutstandingCents(ctx, data.user.ID)
+		if owed != fines.ReplacementCostCents {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() owed = %v, want %v", owed, fines.ReplacementCostCents)
+		}
+
+		want := []entity.EventType{entity.EventUserBlocked, entity.EventLoanLost, entity.EventFineAssessed}
+		if got := data.events.types(); !slices.Equal(got, want) {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() events = %v, want %v", got, want)
+		}
+
+		wantAudit := []struct {
+			action   string
+			entityID uuid.UUID
+		}{
+			{entity.AuditUserBlocked, data.user.ID},
+			{entity.AuditLoanLost, loan.ID},
+			{entity.AuditFineAssessed, data.notifier.notices[0].fine.ID},
+		}
+		if len(data.auditor.records) != len(wantAudit) {
+			t.Fatalf("EscalationUseCase.EscalateOverdueLoans() audit = %v, want %d entries", data.auditor.actions(), len(wantAudit))
+		}
+		for i, record := range data.auditor.records {
+			if record.Action != wantAudit[i].action || record.EntityID != wantAudit[i].entityID {
+				t.Errorf("EscalationUseCase.EscalateOverdueLoans() audit[%d] = %v for %v, want %v for %v", i, record.Action, record.EntityID, wantAudit[i].action, wantAudit[i].entityID)
+			}
+		}
+		if lost := data.auditor.records[1]; lost.Before.(*loanAuditState).Status == entity.LoanStatusLost || lost.After.(*loanAuditState).Status != entity.LoanStatusLost {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() audit = %+v -> %+v, want the loan lost", lost.Before, lost.After)
+		}
+
+		notice := data.notifier.notices[0]
+		if notice.escalation.Action != entity.EscalationLost || notice.fine == nil || notice.fine.Reason != entity.FineReasonLost {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() notice = %+v, want lost with a replacement fine", notice)
+		}
+	})
+
+	t.Run("returned loans are left alone", func(t *testing.T) {
+		data := createTestData()
+		loan := addLoan(data, 30)
+		loan.Status = entity.LoanStatusReturned
+
+		taken, _ := data.uc.EscalateOverdueLoans(ctx)
+		if taken != 0 || len(data.escalationRepo.escalations) != 0 {
+			t.Errorf("EscalationUseCase.EscalateOverdueLoans() taken = %v, want %v", taken, 0)
+		}
+	})
+}
+
+func TestEscalationUseCase_ListLoanEscalations(t *testing.T) {
+	uc := NewEscalationUseCase(newMockLoanEscalationRepository(), newMockLoanRepository(), newMockUserRepository(), newMockBookRepository(), newMockBookCopyRepository(), newMockFineRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), &mockEscalationNotifier{}, nil, FineRules{})
+
+	_, err := uc.ListLoanEscalations(context.Background(), uuid.New())
+	if err != entity.ErrLoanNotFound {
+		t.Errorf("EscalationUseCase.ListLoanEscalations() error = %v, want %v", err, entity.ErrLoanNotFound)
+	}
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// EventEmitter hands domain events to the systems listening for them. Use
+// cases emit inside the transaction that made the change, so an emitter
+// that stores events through the repositories keeps them only when the
+// change is committed.
+type EventEmitter interface {
+	Emit(ctx context.Context, event *entity.Event) error
+}
+
+// loanEventData is the loan snapshot sent with loan events.
+type loanEventData struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	BookID     uuid.UUID  `json:"book_id"`
+	CopyID     *uuid.UUID `json:"copy_id,omitempty"`
+	Status     string     `json:"status"`
+	BorrowedAt time.Time  `json:"borrowed_at"`
+	DueDate    time.Time  `json:"due_date"`
+	ReturnedAt *time.Time `json:"returned_at,omitempty"`
+}
+
+// bookEventData is the book snapshot sent with book events.
+type bookEventData struct {
+	ID            uuid.UUID `json:"id"`
+	Title         string    `json:"title"`
+	Author        string    `json:"author"`
+	ISBN          string    `json:"isbn"`
+	PublishedYear int       `json:"published_year,omitempty"`
+	Category      string    `json:"category"`
+	TotalCopies   int       `json:"total_copies"`
+}
+
+// userEventData is the user snapshot sent with user events. Credentials are
+// left out.
+type userEventData struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Email   string    `json:"email"`
+	Role    string    `json:"role"`
+	Active  bool      `json:"active"`
+	Blocked bool      `json:"blocked"`
+}
+
+// holdEventData is the hold snapshot sent with hold events.
+type holdEventData struct {
+	ID             uuid.UUID  `json:"id"`
+	UserID         uuid.UUID  `json:"user_id"`
+	BookID         uuid.UUID  `json:"book_id"`
+	Status         string     `json:"status"`
+	CreatedAt      time.Time  `json:"created_at"`
+	PickupDeadline *time.Time `json:"pickup_deadline,omitempty"`
+}
+
+// fineEventData is the fine snapshot sent with fine events.
+type fineEventData struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	LoanID      uuid.UUID `json:"loan_id"`
+	Reason      string    `json:"reason"`
+	AmountCents int64     `json:"amount_cents"`
+	PaidCents   int64     `json:"paid_cents"`
+	Status      string    `json:"status"`
+}
+
+func loanEvent(eventType entity.EventType, loan *entity.Loan) *entity.Event {
+	return entity.NewEvent(eventType, loan.ID, loanEventData{
+		ID:         loan.ID,
+		UserID:     loan.UserID,
+		BookID:     loan.BookID,
+		CopyID:     loan.CopyID,
+		Status:     loan.Status,
+		BorrowedAt: loan.BorrowedAt,
+		DueDate:    loan.DueDate,
+		ReturnedAt: loan.ReturnedAt,
+	})
+}
+
+func bookEvent(eventType entity.EventType, book *entity.Book) *entity.Event {
+	return entity.NewEvent(eventType, book.ID, bookEventData{
+		ID:            book.ID,
+		Title:         book.Title,
+		Author:        book.Author,
+		ISBN:          book.ISBN,
+		PublishedYear: book.PublishedYear,
+		Category:      book.Category,
+		TotalCopies:   book.TotalCopies,
+	})
+}
+
+func userEvent(eventType entity.EventType, user *entity.User) *entity.Event {
+	return entity.NewEvent(eventType, user.ID, userEventData{
+		ID:      user.ID,
+		Name:    user.Name,
+		Email:   user.Email,
+		Role:    user.Role,
+		Active:  user.Active,
+		Blocked: user.Blocked,
+	})
+}
+
+func holdEvent(eventType entity.EventType, hold *entity.Hold) *entity.Event {
+	return entity.NewEvent(eventType, hold.ID, holdEventData{
+		ID:             hold.ID,
+		UserID:         hold.UserID,
+		BookID:         hold.BookID,
+		Status:         hold.Status,
+		CreatedAt:      hold.CreatedAt,
+		PickupDeadline: hold.PickupDeadline,
+	})
+}
+
+func fineEvent(eventType entity.EventType, fine *entity.Fine) *entity.Event {
+	return entity.NewEvent(eventType, fine.ID, fineEventData{
+		ID:          fine.ID,
+		UserID:      fine.UserID,
+		LoanID:      fine.LoanID,
+		Reason:      fine.Reason,
+		AmountCents: fine.AmountCents,
+		PaidCents:   fine.PaidCents,
+		Status:      fine.Status,
+	})
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type FineUseCase interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Fine, error)
+	List(ctx context.Context, page, limit int, filter repository.FineFilter) ([]*entity.Fine, int, error)
+	Pay(ctx context.Context, id uuid.UUID, amountCents int64) (*entity.Fine, error)
+	Waive(ctx context.Context, id uuid.UUID) (*entity.Fine, error)
+}
+
+// FineRules configures overdue fines. All amounts are in cents.
+type FineRules struct {
+	// DailyRateCents is charged per started day a loan is returned late, up
+	// to MaxAmountCents per loan (no cap when zero).
+	DailyRateCents int64
+	MaxAmountCents int64
+	// BlockThresholdCents is the largest unpaid balance a user may carry and
+	// still borrow books.
+	BlockThresholdCents int64
+	// ReplacementCostCents is billed for a book declared lost (nothing when
+	// zero).
+	ReplacementCostCents int64
+}
+
+type fineUseCase struct {
+	fineRepo  repository.FineRepository
+	txManager repository.TxManager
+	events    EventEmitter
+	audit     Auditor
+}
+
+func NewFineUseCase(fineRepo repository.FineRepository, txManager repository.TxManager, events EventEmitter, audit Auditor) FineUseCase {
+	return &fineUseCase{
+		fineRepo:  fineRepo,
+		txManager: txManager,
+		events:    events,
+		audit:     audit,
+	}
+}
+
+func (uc *fineUseCase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Fine, error) {
+	fine, err := uc.fineRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if fine == nil {
+		return nil, entity.ErrFineNotFound
+	}
+	return fine, nil
+}
+
+func (uc *fineUseCase) List(ctx context.Context, page, limit int, filter repository.FineFilter) ([]*entity.Fine, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	return uc.fineRepo.List(ctx, page, limit, filter)
+}
+
+func (uc *fineUseCase) Pay(ctx context.Context, id uuid.UUID, amountCents int64) (*entity.Fine, error) {
+	return uc.update(ctx, id, entity.AuditFinePaid, entity.EventFinePaid, func(fine *entity.Fine) error {
+		return fine.Pay(amountCents)
+	})
+}
+
+func (uc *fineUseCase) Waive(ctx context.Context, id uuid.UUID) (*entity.Fine, error) {
+	return uc.update(ctx, id, entity.AuditFineWaived, entity.EventFineWaived, func(fine *entity.Fine) error {
+		return fine.Waive()
+	})
+}
+
+func (uc *fineUseCase) update(ctx context.Context, id uuid.UUID, action string, eventType entity.EventType, apply func(fine *entity.Fine) error) (*entity.Fine, error) {
+	var fine *entity.Fine
+
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		fine, err = uc.fineRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if fine == nil {
+			return entity.ErrFineNotFound
+		}
+
+		before := fineAudit(fine)
+		if err := apply(fine); err != nil {
+			return err
+		}
+		if err := uc.fineRepo.Update(ctx, fine); err != nil {
+			return err
+		}
+		if err := recordFine(ctx, uc.audit, action, before, fine); err != nil {
+			return err
+		}
+		return uc.events.Emit(ctx, fineEvent(eventType, fine))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fine, nil
+}
+
+// assessFine charges fine, announcing it through events and recording it in
+// audit.
+func assessFine(ctx context.Context, fineRepo repository.FineRepository, events EventEmitter, audit Auditor, fine *entity.Fine) error {
+	if err := fineRepo.Create(ctx, fine); err != nil {
+		return err
+	}
+	if err := recordFine(ctx, audit, entity.AuditFineAssessed, nil, fine); err != nil {
+		return err
+	}
+	return events.Emit(ctx, fineEvent(entity.EventFineAssessed, fine))
+}
+
+// recordFine records the change to fine from before, or its creation when
+// before is nil, in the audit log.
+func recordFine(ctx context.Context, audit Auditor, action string, before *fineAuditState, fine *entity.Fine) error {
+	return audit.Record(ctx, AuditRecord{
+		Action:     action,
+		EntityType: entity.AuditEntityFine,
+		EntityID:   fine.ID,
+		Before:     before,
+		After:      fineAudit(fine),
+	})
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type mockFineRepository struct {
+	fines map[uuid.UUID]*entity.Fine
+}
+
+func newMockFineRepository() *mockFineRepository {
+	return &mockFineRepository{
+		fines: make(map[uuid.UUID]*entity.Fine),
+	}
+}
+
+func (m *mockFineRepository) Create(ctx context.Context, fine *entity.Fine) error {
+	m.fines[fine.ID] = fine
+	return nil
+}
+
+func (m *mockFineRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Fine, error) {
+	if fine, exists := m.fines[id]; exists {
+		return fine, nil
+	}
+	return nil, nil
+}
+
+func (m *mockFineRepository) List(ctx context.Context, page, limit int, filter repository.FineFilter) ([]*entity.Fine, int, error) {
+	fines := make([]*entity.Fine, 0)
+	for _, fine := range m.fines {
+		if filter.UserID != nil && fine.UserID != *filter.UserID {
+			continue
+		}
+		if filter.Status != nil && fine.Status != *filter.Status {
+			continue
+		}
+		fines = append(fines, fine)
+	}
+	return fines, len(fines), nil
+}
+
+func (m *mockFineRepository) OutstandingCents(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var total int64
+	for _, fine := range m.fines {
+		if fine.UserID == userID {
+			total += fine.BalanceCents()
+		}
+	}
+	return total, nil
+}
+
+func (m *mockFineRepository) Update(ctx context.Context, fine *entity.Fine) error {
+	m.fines[fine.ID] = fine
+	return nil
+}
+
+func TestFineUseCase_Pay(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("partial then full payment", func(t *testing.T) {
+		fineRepo := newMockFineRepository()
+		events, outbox := newTestOutbox()
+		auditor := newMockAuditor()
+		fineUC := NewFineUseCase(fineRepo, newMockTxManager(), events, auditor)
+
+		fine := entity.NewFine(uuid.New(), uuid.New(), entity.FineReasonOverdue, 300)
+		_ = fineRepo.Create(ctx, fine)
+
+		paid, err := fineUC.Pay(ctx, fine.ID, 100)
+		if err != nil {
+			t.Fatalf("FineUseCase.Pay() unexpected error = %v", err)
+		}
+		if paid.BalanceCents() != 200 {
+			t.Errorf("FineUseCase.Pay() balance = %v, want 200", paid.BalanceCents())
+		}
+
+		paid, err = fineUC.Pay(ctx, fine.ID, 200)
+		if err != nil {
+			t.Fatalf("FineUseCase.Pay() unexpected error = %v", err)
+		}
+		if paid.Status != entity.FineStatusPaid {
+			t.Errorf("FineUseCase.Pay() status = %v, want %v", paid.Status, entity.FineStatusPaid)
+		}
+
+		want := []entity.EventType{entity.EventFinePaid, entity.EventFinePaid}
+		if got := outbox.types(); !slices.Equal(got, want) {
+			t.Errorf("FineUseCase.Pay() outbox = %v, want %v", got, want)
+		}
+		if outbox.messages[1].AggregateID != fine.ID {
+			t.Errorf("FineUseCase.Pay() outbox aggregate = %v, want fine %v", outbox.messages[1].AggregateID, fine.ID)
+		}
+		if got := auditor.actions(); !slices.Equal(got, []string{entity.AuditFinePaid, entity.AuditFinePaid}) {
+			t.Errorf("FineUseCase.Pay() audit = %v, want two %v", got, entity.AuditFinePaid)
+		}
+	})
+
+	t.Run("fine not found", func(t *testing.T) {
+		fineUC := NewFineUseCase(newMockFineRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor())
+
+		_, err := fineUC.Pay(ctx, uuid.New(), 100)
+		if err != entity.ErrFineNotFound {
+			t.Errorf("FineUseCase.Pay() error = %v, want %v", err, entity.ErrFineNotFound)
+		}
+	})
+}
+
+func TestFineUseCase_Waive(t *testing.T) {
+	ctx := context.Background()
+
+	fineRepo := newMockFineRepository()
+	events, outbox := newTestOutbox()
+	auditor := newMockAuditor()
+	fineUC := NewFineUseCase(fineRepo, newMockTxManager(), events, auditor)
+
+	fine := entity.NewFine(uuid.New(), uuid.New(), entity.FineReasonOverdue, 300)
+	_ = fineRepo.Create(ctx, fine)
+
+	waived, err := fineUC.Waive(ctx, fine.ID)
+	if err != nil {
+		t.Fatalf("FineUseCase.Waive() unexpected error = %v", err)
+	}
+	if waived.Status != entity.FineStatusWaived {
+		t.Errorf("FineUseCase.Waive() status = %v, want %v", waived.Status, entity.FineStatusWaived)
+	}
+
+	_, err = fineUC.Waive(ctx, fine.ID)
+	if err != entity.ErrFineNotOpen {
+		t.Errorf("FineUseCase.Waive() error = %v, want %v", err, entity.ErrFineNotOpen)
+	}
+
+	// The refused second waiver writes nothing.
+	if got := outbox.types(); !slices.Equal(got, []entity.EventType{entity.EventFineWaived}) {
+		t.Errorf("FineUseCase.Waive() outbox = %v, want [%v]", got, entity.EventFineWaived)
+	}
+	if got := auditor.actions(); !slices.Equal(got, []string{entity.AuditFineWaived}) {
+		t.Errorf("FineUseCase.Waive() audit = %v, want [%v]", got, entity.AuditFineWaived)
+	}
+}
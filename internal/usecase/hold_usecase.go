@@ -0,0 +1,359 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type HoldUseCase interface {
+	PlaceHold(ctx context.Context, input PlaceHoldInput) (*repository.HoldWithPosition, error)
+	CancelHold(ctx context.Context, id uuid.UUID) (*repository.HoldWithPosition, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*repository.HoldWithPosition, error)
+	List(ctx context.Context, page, limit int, filter repository.HoldFilter) ([]*repository.HoldWithPosition, int, error)
+	// ExpirePickups closes ready holds past their pickup deadline and passes
+	// each copy on to the next hold in line. It returns how many expired.
+	ExpirePickups(ctx context.Context) (int, error)
+}
+
+type PlaceHoldInput struct {
+	UserID uuid.UUID
+	BookID uuid.UUID
+}
+
+type holdUseCase struct {
+	holdRepo     repository.HoldRepository
+	bookRepo     repository.BookRepository
+	copyRepo     repository.BookCopyRepository
+	userRepo     repository.UserRepository
+	loanRepo     repository.LoanRepository
+	txManager    repository.TxManager
+	events       EventEmitter
+	audit        Auditor
+	pickupWindow time.Duration
+}
+
+func NewHoldUseCase(
+	holdRepo repository.HoldRepository,
+	bookRepo repository.BookRepository,
+	copyRepo repository.BookCopyRepository,
+	userRepo repository.UserRepository,
+	loanRepo repository.LoanRepository,
+	txManager repository.TxManager,
+	events EventEmitter,
+	audit Auditor,
+	pickupWindow time.Duration,
+) HoldUseCase {
+	return &holdUseCase{
+		holdRepo:     holdRepo,
+		bookRepo:     bookRepo,
+		copyRepo:     copyRepo,
+		userRepo:     userRepo,
+		loanRepo:     loanRepo,
+		txManager:    txManager,
+		events:       events,
+		audit:        audit,
+		pickupWindow: pickupWindow,
+	}
+}
+
+func (uc *holdUseCase) PlaceHold(ctx context.Context, input PlaceHoldInput) (*repository.HoldWithPosition, error) {
+	var hold *entity.Hold
+
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		user, err := uc.userRepo.GetByID(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return entity.ErrUserNotFound
+		}
+		if !user.Active {
+			return entity.ErrUserDisabled
+		}
+
+		book, err := uc.bookRepo.GetByID(ctx, input.BookID)
+		if err != nil {
+			return err
+		}
+		if book == nil {
+			return entity.ErrBookNotFound
+		}
+		if book.IsWithdrawn() {
+			return entity.ErrBookWithdrawn
+		}
+		if book.IsAvailable() {
+			return entity.ErrBookAvailableForLoan
+		}
+
+		existingLoan, err := uc.loanRepo.GetActiveByUserAndBook(ctx, input.UserID, input.BookID)
+		if err != nil {
+			return err
+		}
+		if existingLoan != nil {
+			return entity.ErrUserHasActiveLoan
+		}
+
+		existingHold, err := uc.holdRepo.GetActiveByUserAndBook(ctx, input.UserID, input.BookID)
+		if err != nil {
+			return err
+		}
+		if existingHold != nil {
+			return entity.ErrUserHasActiveHold
+		}
+
+		hold = entity.NewHold(input.UserID, input.BookID)
+		if err := uc.holdRepo.Create(ctx, hold); err != nil {
+			return err
+		}
+		if err := recordHold(ctx, uc.audit, entity.AuditHoldPlaced, nil, hold); err != nil {
+			return err
+		}
+		return uc.events.Emit(ctx, holdEvent(entity.EventHoldPlaced, hold))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.withPosition(ctx, hold)
+}
+
+func (uc *holdUseCase) CancelHold(ctx context.Context, id uuid.UUID) (*repository.HoldWithPosition, error) {
+	var hold *entity.Hold
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		var err error
+		hold, err = uc.holdRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if hold == nil {
+			return entity.ErrHoldNotFound
+		}
+
+		before := holdAudit(hold)
+		wasReady := hold.IsReady()
+		if err := hold.Cancel(); err != nil {
+			return err
+		}
+		if err := uc.holdRepo.Update(ctx, hold); err != nil {
+			return err
+		}
+		if err := recordHold(ctx, uc.audit, entity.AuditHoldCancelled, before, hold); err != nil {
+			return err
+		}
+		if err := uc.events.Emit(ctx, holdEvent(entity.EventHoldCancelled, hold)); err != nil {
+			return err
+		}
+
+		if !wasReady {
+			return nil
+		}
+		return uc.releaseCopy(ctx, hold)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.withPosition(ctx, hold)
+}
+
+func (uc *holdUseCase) GetByID(ctx context.Context, id uuid.UUID) (*repository.HoldWithPosition, error) {
+	hold, err := uc.holdRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if hold == nil {
+		return nil, entity.ErrHoldNotFound
+	}
+	return uc.withPosition(ctx, hold)
+}
+
+func (uc *holdUseCase) List(ctx context.Context, page, limit int, filter repository.HoldFilter) ([]*repository.HoldWithPosition, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	holds, total, err := uc.holdRepo.List(ctx, page, limit, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*repository.HoldWithPosition, len(holds))
+	for i, hold := range holds {
+		result[i], err = uc.withPosition(ctx, hold)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return result, total, nil
+}
+
+func (uc *holdUseCase) ExpirePickups(ctx context.Context) (int, error) {
+	expired, err := uc.holdRepo.ListExpiredPickups(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, candidate := range expired {
+		// Set by the attempt that commits, so retried or failed attempts
+		// are not counted.
+		var didExpire bool
+		err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+			didExpire = false
+
+			// Re-read inside the transaction: the patron may have picked the
+			// copy up since the hold was listed.
+			hold, err := uc.holdRepo.GetByID(ctx, candidate.ID)
+			if err != nil {
+				return err
+			}
+			if hold == nil || !hold.IsPickupExpired() {
+				return nil
+			}
+
+			before := holdAudit(hold)
+			if err := hold.Expire(); err != nil {
+				return err
+			}
+			if err := uc.holdRepo.Update(ctx, hold); err != nil {
+				return err
+			}
+			if err := recordHold(ctx, uc.audit, entity.AuditHoldExpired, before, hold); err != nil {
+				return err
+			}
+			if err := uc.events.Emit(ctx, holdEvent(entity.EventHoldExpired, hold)); err != nil {
+				return err
+			}
+			if err := uc.releaseCopy(ctx, hold); err != nil {
+				return err
+			}
+
+			didExpire = true
+			return nil
+		})
+		if err != nil {
+			return count, err
+		}
+		if didExpire {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// releaseCopy passes on the copy set aside for a hold that closed without
+// being picked up.
+func (uc *holdUseCase) releaseCopy(ctx context.Context, hold *entity.Hold) error {
+	book, err := uc.bookRepo.GetByID(ctx, hold.BookID)
+	if err != nil {
+		return err
+	}
+	if book == nil {
+		return entity.ErrBookNotFound
+	}
+
+	bookCopy, err := heldCopy(ctx, uc.copyRepo, hold)
+	if err != nil {
+		return err
+	}
+	if bookCopy == nil {
+		return entity.ErrBookCopyNotFound
+	}
+
+	return releaseCopy(ctx, uc.holdRepo, uc.copyRepo, uc.bookRepo, uc.events, uc.audit, book, bookCopy, uc.pickupWindow)
+}
+
+func (uc *holdUseCase) withPosition(ctx context.Context, hold *entity.Hold) (*repository.HoldWithPosition, error) {
+	result := &repository.HoldWithPosition{Hold: hold}
+	if hold.Status != entity.HoldStatusWaiting {
+		return result, nil
+	}
+
+	ahead, err := uc.holdRepo.CountAhead(ctx, hold)
+	if err != nil {
+		return nil, err
+	}
+	result.Position = ahead + 1
+	return result, nil
+}
+
+// releaseCopy sets a copy of book that just became free aside for the next
+// waiting hold, or puts it back on the shelf when nobody is waiting. The book
+// counts are refreshed and saved either way so its version check serializes
+// concurrent releases and no two holds are given the same copy.
+func releaseCopy(
+	ctx context.Context,
+	holds repository.HoldRepository,
+	copies repository.BookCopyRepository,
+	books repository.BookRepository,
+	events EventEmitter,
+	audit Auditor,
+	book *entity.Book,
+	bookCopy *entity.BookCopy,
+	pickupWindow time.Duration,
+) error {
+	next, err := holds.GetNextWaiting(ctx, book.ID)
+	if err != nil {
+		return err
+	}
+
+	if next != nil {
+		before := holdAudit(next)
+		if err := next.MarkReady(bookCopy.ID, time.Now().Add(pickupWindow)); err != nil {
+			return err
+		}
+		if err := holds.Update(ctx, next); err != nil {
+			return err
+		}
+		if err := recordHold(ctx, audit, entity.AuditHoldReady, before, next); err != nil {
+			return err
+		}
+		if err := events.Emit(ctx, holdEvent(entity.EventHoldReady, next)); err != nil {
+			return err
+		}
+		bookCopy.SetAside()
+	} else {
+		bookCopy.Shelve()
+	}
+
+	if err := copies.Update(ctx, bookCopy); err != nil {
+		return err
+	}
+	return syncCopyCounts(ctx, copies, books, book)
+}
+
+// heldCopy returns the copy set aside for a ready hold. Holds made ready
+// before the copy was recorded on them fall back to any copy of the book on
+// the hold shelf.
+func heldCopy(ctx context.Context, copies repository.BookCopyRepository, hold *entity.Hold) (*entity.BookCopy, error) {
+	if hold.CopyID == nil {
+		return copies.FindByStatus(ctx, hold.BookID, entity.CopyStatusOnHold)
+	}
+	return copies.GetByID(ctx, *hold.CopyID)
+}
+
+// recordHold records the change to hold from before, or its creation when
+// before is nil, in the audit log.
+func recordHold(ctx context.Context, audit Auditor, action string, before *holdAuditState, hold *entity.Hold) error {
+	return audit.Record(ctx, AuditRecord{
+		Action:     action,
+		EntityType: entity.AuditEntityHold,
+		EntityID:   hold.ID,
+		Before:     before,
+		After:      holdAudit(hold),
+	})
+}
@@ -0,0 +1,505 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// mockHoldRepository keeps holds in insertion order, which stands in for the
+// created_at ordering of the real queue.
+type mockHoldRepository struct {
+	holds map[uuid.UUID]*entity.Hold
+	order []uuid.UUID
+}
+
+func newMockHoldRepository() *mockHoldRepository {
+	return &mockHoldRepository{
+		holds: make(map[uuid.UUID]*entity.Hold),
+	}
+}
+
+func (m *mockHoldRepository) Create(ctx context.Context, hold *entity.Hold) error {
+	m.holds[hold.ID] = hold
+	m.order = append(m.order, hold.ID)
+	return nil
+}
+
+func (m *mockHoldRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Hold, error) {
+	if hold, exists := m.holds[id]; exists {
+		return hold, nil
+	}
+	return nil, nil
+}
+
+func (m *mockHoldRepository) GetActiveByUserAndBook(ctx context.Context, userID, bookID uuid.UUID) (*entity.Hold, error) {
+	for _, id := range m.order {
+		hold := m.holds[id]
+		if hold.UserID == userID && hold.BookID == bookID && hold.IsActive() {
+			return hold, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockHoldRepository) GetNextWaiting(ctx context.Context, bookID uuid.UUID) (*entity.Hold, error) {
+	for _, id := range m.order {
+		hold := m.holds[id]
+		if hold.BookID == bookID && hold.Status == entity.HoldStatusWaiting {
+			return hold, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockHoldRepository) HasPendingHolds(ctx context.Context, bookID, excludeUserID uuid.UUID) (bool, error) {
+	for _, hold := range m.holds {
+		if hold.BookID == bookID && hold.UserID != excludeUserID && hold.IsActive() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockHoldRepository) CountAhead(ctx context.Context, hold *entity.Hold) (int, error) {
+	count := 0
+	for _, id := range m.order {
+		if id == hold.ID {
+			break
+		}
+		other := m.holds[id]
+		if other.BookID == hold.BookID && other.Status == entity.HoldStatusWaiting {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockHoldRepository) List(ctx context.Context, page, limit int, filter repository.HoldFilter) ([]*entity.Hold, int, error) {
+	holds := make([]*entity.Hold, 0)
+	for _, id := range m.order {
+		hold := m.holds[id]
+		if filter.UserID != nil && hold.UserID != *filter.UserID {
+			continue
+		}
+		if filter.BookID != nil && hold.BookID != *filter.BookID {
+			continue
+		}
+		if filter.Status != nil && hold.Status != *filter.Status {
+			continue
+		}
+		holds = append(holds, hold)
+	}
+	return holds, len(holds), nil
+}
+
+func (m *mockHoldRepository) ListExpiredPickups(ctx context.Context, now time.Time) ([]*entity.Hold, error) {
+	holds := make([]*entity.Hold, 0)
+	for _, id := range m.order {
+		hold := m.holds[id]
+		if hold.IsReady() && hold.PickupDeadline.Before(now) {
+			holds = append(holds, hold)
+		}
+	}
+	return holds, nil
+}
+
+func (m *mockHoldRepository) Update(ctx context.Context, hold *entity.Hold) error {
+	m.holds[hold.ID] = hold
+	return nil
+}
+
+// snapshot saves the state of every hold and returns a func that puts it
+// back, standing in for a transaction rollback.
+func (m *mockHoldRepository) snapshot() func() {
+	saved := make(map[uuid.UUID]entity.Hold, len(m.holds))
+	for id, hold := range m.holds {
+		saved[id] = *hold
+	}
+	return func() {
+		for id, hold := range saved {
+			*m.holds[id] = hold
+		}
+	}
+}
+
+type holdTestData struct {
+	holdUC   HoldUseCase
+	loanUC   LoanUseCase
+	holdRepo *mockHoldRepository
+	bookRepo *mockBookRepository
+	copyRepo *mockBookCopyRepository
+	outbox   *mockOutboxRepository
+	auditor  *mockAuditor
+	book     *entity.Book
+	users    []*entity.User
+	loan     *repository.LoanWithDetails
+}
+
+// newHoldTestData creates a single-copy book that users[0] has borrowed, so
+// the other users can queue for it.
+func newHoldTestData(t *testing.T) *holdTestData {
+	ctx := context.Background()
+
+	userRepo := newMockUserRepository()
+	bookRepo := newMockBookRepository()
+	copyRepo := newMockBookCopyRepository()
+	loanRepo := newMockLoanRepository()
+	holdRepo := newMockHoldRepository()
+	txManager := newMockTxManager()
+	events, outbox := newTestOutbox()
+	auditor := newMockAuditor()
+
+	users := make([]*entity.User, 3)
+	for i, email := range []string{"john@example.com", "jane@example.com", "mary@example.com"} {
+		users[i], _ = NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+			Name:     "Patron",
+			Email:    email,
+			Password: "password123",
+		})
+	}
+
+	book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+		Title:         "Clean Code",
+		Author:        "Robert C. Martin",
+		ISBN:          "9780132350884",
+		PublishedYear: 2008,
+		TotalCopies:   1,
+	})
+
+	loanUC := NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, holdRepo, newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), txManager, events, auditor, testLoanRules)
+	loan, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: users[0].ID, BookID: book.ID})
+	if err != nil {
+		t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+	}
+
+	return &holdTestData{
+		holdUC:   NewHoldUseCase(holdRepo, bookRepo, copyRepo, userRepo, loanRepo, txManager, events, auditor, testLoanRules.HoldPickupWindow),
+		loanUC:   loanUC,
+		holdRepo: holdRepo,
+		bookRepo: bookRepo,
+		copyRepo: copyRepo,
+		outbox:   outbox,
+		auditor:  auditor,
+		book:     book,
+		users:    users,
+		loan:     loan,
+	}
+}
+
+func TestHoldUseCase_PlaceHold(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("queue positions follow placement order", func(t *testing.T) {
+		data := newHoldTestData(t)
+
+		first, err := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+		if err != nil {
+			t.Fatalf("HoldUseCase.PlaceHold() unexpected error = %v", err)
+		}
+		second, err := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[2].ID, BookID: data.book.ID})
+		if err != nil {
+			t.Fatalf("HoldUseCase.PlaceHold() unexpected error = %v", err)
+		}
+
+		if first.Position != 1 {
+			t.Errorf("HoldUseCase.PlaceHold() position = %v, want %v", first.Position, 1)
+		}
+		if second.Position != 2 {
+			t.Errorf("HoldUseCase.PlaceHold() position = %v, want %v", second.Position, 2)
+		}
+	})
+
+	t.Run("duplicate hold", func(t *testing.T) {
+		data := newHoldTestData(t)
+		input := PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID}
+
+		_, _ = data.holdUC.PlaceHold(ctx, input)
+		_, err := data.holdUC.PlaceHold(ctx, input)
+		if err != entity.ErrUserHasActiveHold {
+			t.Errorf("HoldUseCase.PlaceHold() error = %v, want %v", err, entity.ErrUserHasActiveHold)
+		}
+	})
+
+	t.Run("borrower cannot hold own loan", func(t *testing.T) {
+		data := newHoldTestData(t)
+
+		_, err := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[0].ID, BookID: data.book.ID})
+		if err != entity.ErrUserHasActiveLoan {
+			t.Errorf("HoldUseCase.PlaceHold() error = %v, want %v", err, entity.ErrUserHasActiveLoan)
+		}
+	})
+
+	t.Run("book still available", func(t *testing.T) {
+		data := newHoldTestData(t)
+		data.book.AvailableCopies = 1
+
+		_, err := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+		if err != entity.ErrBookAvailableForLoan {
+			t.Errorf("HoldUseCase.PlaceHold() error = %v, want %v", err, entity.ErrBookAvailableForLoan)
+		}
+	})
+}
+
+func TestHoldUseCase_ReturnReservesCopy(t *testing.T) {
+	ctx := context.Background()
+	data := newHoldTestData(t)
+
+	first, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+	_, _ = data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[2].ID, BookID: data.book.ID})
+
+	if _, err := data.loanUC.ReturnBook(ctx, data.loan.Loan.ID); err != nil {
+		t.Fatalf("LoanUseCase.ReturnBook() unexpected error = %v", err)
+	}
+
+	if data.book.AvailableCopies != 0 {
+		t.Errorf("LoanUseCase.ReturnBook() availableCopies = %v, want %v", data.book.AvailableCopies, 0)
+	}
+	if !first.Hold.IsReady() {
+		t.Errorf("LoanUseCase.ReturnBook() first hold status = %v, want %v", first.Hold.Status, entity.HoldStatusReady)
+	}
+
+	t.Run("others cannot take the reserved copy", func(t *testing.T) {
+		_, err := data.loanUC.BorrowBook(ctx, BorrowBookInput{UserID: data.users[2].ID, BookID: data.book.ID})
+		if err != entity.ErrBookNotAvailable {
+			t.Errorf("LoanUseCase.BorrowBook() error = %v, want %v", err, entity.ErrBookNotAvailable)
+		}
+	})
+
+	t.Run("hold owner picks up the copy", func(t *testing.T) {
+		_, err := data.loanUC.BorrowBook(ctx, BorrowBookInput{UserID: data.users[1].ID, BookID: data.book.ID})
+		if err != nil {
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+
+		if first.Hold.Status != entity.HoldStatusFulfilled {
+			t.Errorf("LoanUseCase.BorrowBook() hold status = %v, want %v", first.Hold.Status, entity.HoldStatusFulfilled)
+		}
+		got := data.outbox.types()
+		if want := []entity.EventType{entity.EventHoldFulfilled, entity.EventLoanBorrowed}; !slices.Equal(got[len(got)-2:], want) {
+			t.Errorf("LoanUseCase.BorrowBook() outbox = %v, want %v last", got, want)
+		}
+		if data.book.AvailableCopies != 0 {
+			t.Errorf("LoanUseCase.BorrowBook() availableCopies = %v, want %v", data.book.AvailableCopies, 0)
+		}
+	})
+}
+
+func TestHoldUseCase_ExpirePickups(t *testing.T) {
+	ctx := context.Background()
+	data := newHoldTestData(t)
+
+	first, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+	second, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[2].ID, BookID: data.book.ID})
+	_, _ = data.loanUC.ReturnBook(ctx, data.loan.Loan.ID)
+
+	t.Run("copy passes to the next hold", func(t *testing.T) {
+		past := time.Now().Add(-time.Minute)
+		first.Hold.PickupDeadline = &past
+
+		expired, err := data.holdUC.ExpirePickups(ctx)
+		if err != nil {
+			t.Fatalf("HoldUseCase.ExpirePickups() unexpected error = %v", err)
+		}
+
+		if expired != 1 {
+			t.Errorf("HoldUseCase.ExpirePickups() expired = %v, want %v", expired, 1)
+		}
+		if first.Hold.Status != entity.HoldStatusExpired {
+			t.Errorf("HoldUseCase.ExpirePickups() first status = %v, want %v", first.Hold.Status, entity.HoldStatusExpired)
+		}
+		if !second.Hold.IsReady() {
+			t.Errorf("HoldUseCase.ExpirePickups() second status = %v, want %v", second.Hold.Status, entity.HoldStatusReady)
+		}
+		if data.book.AvailableCopies != 0 {
+			t.Errorf("HoldUseCase.ExpirePickups() availableCopies = %v, want %v", data.book.AvailableCopies, 0)
+		}
+	})
+
+	t.Run("copy returns to the shelf when the queue is empty", func(t *testing.T) {
+		past := time.Now().Add(-time.Minute)
+		second.Hold.PickupDeadline = &past
+
+		if _, err := data.holdUC.ExpirePickups(ctx); err != nil {
+			t.Fatalf("HoldUseCase.ExpirePickups() unexpected error = %v", err)
+		}
+
+		if data.book.AvailableCopies != 1 {
+			t.Errorf("HoldUseCase.ExpirePickups() availableCopies = %v, want %v", data.book.AvailableCopies, 1)
+		}
+	})
+}
+
+func TestHoldUseCase_ExpirePickupsCountsCommittedExpirations(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T, conflicts int) (HoldUseCase, *repository.HoldWithPosition) {
+		data := newHoldTestData(t)
+		hold, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+		_, _ = data.loanUC.ReturnBook(ctx, data.loan.Loan.ID)
+		past := time.Now().Add(-time.Minute)
+		hold.Hold.PickupDeadline = &past
+
+		// The copy goes back to the shelf, so the book update can conflict
+		txManager := &mockTxManager{copies: data.copyRepo, holds: data.holdRepo}
+		books := &conflictingBookRepository{mockBookRepository: data.bookRepo, conflicts: conflicts}
+		holdUC := NewHoldUseCase(data.holdRepo, books, data.copyRepo, newMockUserRepository(), newMockLoanRepository(), txManager, newMockEventEmitter(), newMockAuditor(), testLoanRules.HoldPickupWindow)
+		return holdUC, hold
+	}
+
+	t.Run("a retried expiration counts once", func(t *testing.T) {
+		holdUC, hold := setup(t, 1)
+
+		expired, err := holdUC.ExpirePickups(ctx)
+		if err != nil {
+			t.Fatalf("HoldUseCase.ExpirePickups() unexpected error = %v", err)
+		}
+		if expired != 1 {
+			t.Errorf("HoldUseCase.ExpirePickups() expired = %v, want %v", expired, 1)
+		}
+		if hold.Hold.Status != entity.HoldStatusExpired {
+			t.Errorf("HoldUseCase.ExpirePickups() status = %v, want %v", hold.Hold.Status, entity.HoldStatusExpired)
+		}
+	})
+
+	t.Run("a failed expiration is not counted", func(t *testing.T) {
+		holdUC, hold := setup(t, maxUpdateAttempts)
+
+		expired, err := holdUC.ExpirePickups(ctx)
+		if err != entity.ErrConcurrentModification {
+			t.Errorf("HoldUseCase.ExpirePickups() error = %v, want %v", err, entity.ErrConcurrentModification)
+		}
+		if expired != 0 {
+			t.Errorf("HoldUseCase.ExpirePickups() expired = %v, want %v", expired, 0)
+		}
+		if hold.Hold.Status != entity.HoldStatusReady {
+			t.Errorf("HoldUseCase.ExpirePickups() status = %v, want %v", hold.Hold.Status, entity.HoldStatusReady)
+		}
+	})
+}
+
+func TestHoldUseCase_CancelHold(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("cancelling a waiting hold moves the queue up", func(t *testing.T) {
+		data := newHoldTestData(t)
+
+		first, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+		second, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[2].ID, BookID: data.book.ID})
+
+		if _, err := data.holdUC.CancelHold(ctx, first.Hold.ID); err != nil {
+			t.Fatalf("HoldUseCase.CancelHold() unexpected error = %v", err)
+		}
+
+		updated, _ := data.holdUC.GetByID(ctx, second.Hold.ID)
+		if updated.Position != 1 {
+			t.Errorf("HoldUseCase.GetByID() position = %v, want %v", updated.Position, 1)
+		}
+	})
+
+	t.Run("cancelling a ready hold passes the copy on", func(t *testing.T) {
+		data := newHoldTestData(t)
+
+		first, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+		second, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[2].ID, BookID: data.book.ID})
+		_, _ = data.loanUC.ReturnBook(ctx, data.loan.Loan.ID)
+
+		if _, err := data.holdUC.CancelHold(ctx, first.Hold.ID); err != nil {
+			t.Fatalf("HoldUseCase.CancelHold() unexpected error = %v", err)
+		}
+
+		if !second.Hold.IsReady() {
+			t.Errorf("HoldUseCase.CancelHold() next hold status = %v, want %v", second.Hold.Status, entity.HoldStatusReady)
+		}
+	})
+
+	t.Run("cancelling a ready hold shelves its own copy", func(t *testing.T) {
+		data := newCirculationTestData(t, 2)
+		_, _ = data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[0].CardNumber, Barcode: "9780132350884-001"})
+		_, _ = data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[1].CardNumber, Barcode: "9780132350884-002"})
+		hold, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[2].ID, BookID: data.book.ID})
+		_, _ = data.loanUC.CheckIn(ctx, CheckInInput{Barcode: "9780132350884-002"})
+		_, _ = data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+		_, _ = data.loanUC.CheckIn(ctx, CheckInInput{Barcode: "9780132350884-001"})
+
+		if _, err := data.holdUC.CancelHold(ctx, hold.Hold.ID); err != nil {
+			t.Fatalf("HoldUseCase.CancelHold() unexpected error = %v", err)
+		}
+
+		shelved, _ := data.copyRepo.GetByBarcode(ctx, "9780132350884-002")
+		if shelved.Status != entity.CopyStatusAvailable {
+			t.Errorf("HoldUseCase.CancelHold() cancelled hold's copy status = %v, want %v", shelved.Status, entity.CopyStatusAvailable)
+		}
+		other, _ := data.copyRepo.GetByBarcode(ctx, "9780132350884-001")
+		if other.Status != entity.CopyStatusOnHold {
+			t.Errorf("HoldUseCase.CancelHold() other set-aside copy status = %v, want %v", other.Status, entity.CopyStatusOnHold)
+		}
+	})
+
+	t.Run("hold not found", func(t *testing.T) {
+		data := newHoldTestData(t)
+
+		_, err := data.holdUC.CancelHold(ctx, uuid.New())
+		if err != entity.ErrHoldNotFound {
+			t.Errorf("HoldUseCase.CancelHold() error = %v, want %v", err, entity.ErrHoldNotFound)
+		}
+	})
+}
+
+func TestHoldUseCase_EmitsEvents(t *testing.T) {
+	ctx := context.Background()
+	data := newHoldTestData(t)
+
+	first, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+	second, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[2].ID, BookID: data.book.ID})
+	_, _ = data.loanUC.ReturnBook(ctx, data.loan.Loan.ID)
+	if _, err := data.holdUC.CancelHold(ctx, first.Hold.ID); err != nil {
+		t.Fatalf("HoldUseCase.CancelHold() unexpected error = %v", err)
+	}
+	past := time.Now().Add(-time.Minute)
+	second.Hold.PickupDeadline = &past
+	if _, err := data.holdUC.ExpirePickups(ctx); err != nil {
+		t.Fatalf("HoldUseCase.ExpirePickups() unexpected error = %v", err)
+	}
+
+	want := []struct {
+		eventType entity.EventType
+		aggregate uuid.UUID
+	}{
+		{entity.EventLoanBorrowed, data.loan.Loan.ID},
+		{entity.EventHoldPlaced, first.Hold.ID},
+		{entity.EventHoldPlaced, second.Hold.ID},
+		{entity.EventHoldReady, first.Hold.ID},
+		{entity.EventLoanReturned, data.loan.Loan.ID},
+		{entity.EventHoldCancelled, first.Hold.ID},
+		{entity.EventHoldReady, second.Hold.ID},
+		{entity.EventHoldExpired, second.Hold.ID},
+	}
+	if len(data.outbox.messages) != len(want) {
+		t.Fatalf("HoldUseCase outbox = %v, want %d messages", data.outbox.types(), len(want))
+	}
+	for i, message := range data.outbox.messages {
+		if message.EventType != want[i].eventType || message.AggregateID != want[i].aggregate {
+			t.Errorf("HoldUseCase outbox[%d] = %v for %v, want %v for %v", i, message.EventType, message.AggregateID, want[i].eventType, want[i].aggregate)
+		}
+	}
+	wantAudit := []string{
+		entity.AuditLoanBorrowed,
+		entity.AuditHoldPlaced,
+		entity.AuditHoldPlaced,
+		entity.AuditHoldReady,
+		entity.AuditLoanReturned,
+		entity.AuditHoldCancelled,
+		entity.AuditHoldReady,
+		entity.AuditHoldExpired,
+	}
+	if got := data.auditor.actions(); !slices.Equal(got, wantAudit) {
+		t.Errorf("HoldUseCase audit = %v, want %v", got, wantAudit)
+	}
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type LoanPolicyUseCase interface {
+	Create(ctx context.Context, input CreateLoanPolicyInput) (*entity.LoanPolicy, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.LoanPolicy, error)
+	List(ctx context.Context) ([]*entity.LoanPolicy, error)
+	Update(ctx context.Context, id uuid.UUID, input UpdateLoanPolicyInput) (*entity.LoanPolicy, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type CreateLoanPolicyInput struct {
+	PatronCategory string
+	ItemCategory   string
+	MaxLoans       int
+	LoanDays       int
+	MaxRenewals    int
+}
+
+// UpdateLoanPolicyInput changes the limits left non-nil.
+type UpdateLoanPolicyInput struct {
+	MaxLoans    *int
+	LoanDays    *int
+	MaxRenewals *int
+}
+
+type loanPolicyUseCase struct {
+	policyRepo repository.LoanPolicyRepository
+	txManager  repository.TxManager
+	audit      Auditor
+}
+
+func NewLoanPolicyUseCase(policyRepo repository.LoanPolicyRepository, txManager repository.TxManager, audit Auditor) LoanPolicyUseCase {
+	return &loanPolicyUseCase{
+		policyRepo: policyRepo,
+		txManager:  txManager,
+		audit:      audit,
+	}
+}
+
+func (uc *loanPolicyUseCase) Create(ctx context.Context, input CreateLoanPolicyInput) (*entity.LoanPolicy, error) {
+	policy, err := entity.NewLoanPolicy(input.PatronCategory, input.ItemCategory, input.MaxLoans, input.LoanDays, input.MaxRenewals)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		existing, err := uc.policyRepo.GetByCategories(ctx, policy.PatronCategory, policy.ItemCategory)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return entity.ErrLoanPolicyAlreadyExists
+		}
+
+		if err := uc.policyRepo.Create(ctx, policy); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditLoanPolicyCreated,
+			EntityType: entity.AuditEntityLoanPolicy,
+			EntityID:   policy.ID,
+			After:      loanPolicyAudit(policy),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (uc *loanPolicyUseCase) GetByID(ctx context.Context, id uuid.UUID) (*entity.LoanPolicy, error) {
+	policy, err := uc.policyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return nil, entity.ErrLoanPolicyNotFound
+	}
+	return policy, nil
+}
+
+func (uc *loanPolicyUseCase) List(ctx context.Context) ([]*entity.LoanPolicy, error) {
+	return uc.policyRepo.List(ctx)
+}
+
+func (uc *loanPolicyUseCase) Update(ctx context.Context, id uuid.UUID, input UpdateLoanPolicyInput) (*entity.LoanPolicy, error) {
+	var policy *entity.LoanPolicy
+
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		policy, err = uc.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		before := loanPolicyAudit(policy)
+
+		maxLoans, loanDays, maxRenewals := policy.MaxLoans, policy.LoanDays, policy.MaxRenewals
+		if input.MaxLoans != nil {
+			maxLoans = *input.MaxLoans
+		}
+		if input.LoanDays != nil {
+			loanDays = *input.LoanDays
+		}
+		if input.MaxRenewals != nil {
+			maxRenewals = *input.MaxRenewals
+		}
+
+		if err := policy.Update(maxLoans, loanDays, maxRenewals); err != nil {
+			return err
+		}
+
+		if err := uc.policyRepo.Update(ctx, policy); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditLoanPolicyUpdated,
+			EntityType: entity.AuditEntityLoanPolicy,
+			EntityID:   policy.ID,
+			Before:     before,
+			After:      loanPolicyAudit(policy),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (uc *loanPolicyUseCase) Delete(ctx context.Context, id uuid.UUID) error {
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		policy, err := uc.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.policyRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditLoanPolicyDeleted,
+			EntityType: entity.AuditEntityLoanPolicy,
+			EntityID:   policy.ID,
+			Before:     loanPolicyAudit(policy),
+		})
+	})
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type mockLoanPolicyRepository struct {
+	policies map[uuid.UUID]*entity.LoanPolicy
+}
+
+func newMockLoanPolicyRepository() *mockLoanPolicyRepository {
+	return &mockLoanPolicyRepository{
+		policies: make(map[uuid.UUID]*entity.LoanPolicy),
+	}
+}
+
+func (m *mockLoanPolicyRepository) Create(ctx context.Context, policy *entity.LoanPolicy) error {
+	m.policies[policy.ID] = policy
+	return nil
+}
+
+func (m *mockLoanPolicyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.LoanPolicy, error) {
+	if policy, exists := m.policies[id]; exists {
+		return policy, nil
+	}
+	return nil, nil
+}
+
+func (m *mockLoanPolicyRepository) GetByCategories(ctx context.Context, patronCategory, itemCategory string) (*entity.LoanPolicy, error) {
+	for _, policy := range m.policies {
+		if policy.PatronCategory == patronCategory && policy.ItemCategory == itemCategory {
+			return policy, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockLoanPolicyRepository) List(ctx context.Context) ([]*entity.LoanPolicy, error) {
+	policies := make([]*entity.LoanPolicy, 0, len(m.policies))
+	for _, policy := range m.policies {
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+func (m *mockLoanPolicyRepository) ListMatching(ctx context.Context, patronCategory, itemCategory string) ([]*entity.LoanPolicy, error) {
+	policies := make([]*entity.LoanPolicy, 0)
+	for _, policy := range m.policies {
+		if policy.Matches(patronCategory, itemCategory) {
+			policies = append(policies, policy)
+		}
+	}
+	return policies, nil
+}
+
+func (m *mockLoanPolicyRepository) Update(ctx context.Context, policy *entity.LoanPolicy) error {
+	m.policies[policy.ID] = policy
+	return nil
+}
+
+func (m *mockLoanPolicyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(m.policies, id)
+	return nil
+}
+
+func TestLoanPolicyUseCase_Create(t *testing.T) {
+	ctx := context.Background()
+	input := CreateLoanPolicyInput{
+		PatronCategory: "student",
+		ItemCategory:   entity.AnyCategory,
+		MaxLoans:       3,
+		LoanDays:       7,
+		MaxRenewals:    1,
+	}
+
+	t.Run("successful creation", func(t *testing.T) {
+		policyUC := NewLoanPolicyUseCase(newMockLoanPolicyRepository(), newMockTxManager(), newMockAuditor())
+
+		policy, err := policyUC.Create(ctx, input)
+		if err != nil {
+			t.Fatalf("LoanPolicyUseCase.Create() unexpected error = %v", err)
+		}
+		if policy.LoanDays != 7 {
+			t.Errorf("LoanPolicyUseCase.Create() LoanDays = %v, want 7", policy.LoanDays)
+		}
+	})
+
+	t.Run("duplicate categories", func(t *testing.T) {
+		policyUC := NewLoanPolicyUseCase(newMockLoanPolicyRepository(), newMockTxManager(), newMockAuditor())
+		_, _ = policyUC.Create(ctx, input)
+
+		_, err := policyUC.Create(ctx, input)
+		if err != entity.ErrLoanPolicyAlreadyExists {
+			t.Errorf("LoanPolicyUseCase.Create() error = %v, want %v", err, entity.ErrLoanPolicyAlreadyExists)
+		}
+	})
+
+	t.Run("invalid category", func(t *testing.T) {
+		policyUC := NewLoanPolicyUseCase(newMockLoanPolicyRepository(), newMockTxManager(), newMockAuditor())
+		invalid := input
+		invalid.PatronCategory = "Not Valid"
+
+		_, err := policyUC.Create(ctx, invalid)
+		if err != entity.ErrInvalidCategory {
+			t.Errorf("LoanPolicyUseCase.Create() error = %v, want %v", err, entity.ErrInvalidCategory)
+		}
+	})
+}
+
+func TestLoanPolicyUseCase_Update(t *testing.T) {
+	ctx := context.Background()
+	policyUC := NewLoanPolicyUseCase(newMockLoanPolicyRepository(), newMockTxManager(), newMockAuditor())
+	policy, _ := policyUC.Create(ctx, CreateLoanPolicyInput{
+		PatronCategory: "student",
+		ItemCategory:   "general",
+		MaxLoans:       3,
+		LoanDays:       7,
+		MaxRenewals:    1,
+	})
+
+	t.Run("partial update", func(t *testing.T) {
+		maxLoans := 5
+		updated, err := policyUC.Update(ctx, policy.ID, UpdateLoanPolicyInput{MaxLoans: &maxLoans})
+		if err != nil {
+			t.Fatalf("LoanPolicyUseCase.Update() unexpected error = %v", err)
+		}
+		if updated.MaxLoans != 5 || updated.LoanDays != 7 {
+			t.Errorf("LoanPolicyUseCase.Update() = %+v, want MaxLoans 5 and LoanDays 7", updated)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := policyUC.Update(ctx, uuid.New(), UpdateLoanPolicyInput{})
+		if err != entity.ErrLoanPolicyNotFound {
+			t.Errorf("LoanPolicyUseCase.Update() error = %v, want %v", err, entity.ErrLoanPolicyNotFound)
+		}
+	})
+}
+
+func TestLoanPolicyUseCase_Delete(t *testing.T) {
+	ctx := context.Background()
+	policyUC := NewLoanPolicyUseCase(newMockLoanPolicyRepository(), newMockTxManager(), newMockAuditor())
+
+	if err := policyUC.Delete(ctx, uuid.New()); err != entity.ErrLoanPolicyNotFound {
+		t.Errorf("LoanPolicyUseCase.Delete() error = %v, want %v", err, entity.ErrLoanPolicyNotFound)
+	}
+}
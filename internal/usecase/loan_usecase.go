@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"bookhub/internal/domain/entity"
@@ -10,11 +11,30 @@ import (
 	"github.com/google/uuid"
 )
 
+// maxUpdateAttempts bounds how many times an operation that moves copies is
+// retried when the book it touches was updated concurrently by another request.
+const maxUpdateAttempts = 5
+
 type LoanUseCase interface {
 	BorrowBook(ctx context.Context, input BorrowBookInput) (*repository.LoanWithDetails, error)
 	ReturnBook(ctx context.Context, loanID uuid.UUID) (*repository.LoanWithDetails, error)
+	// ReturnDamaged returns the loan of a book that came back damaged.
+	ReturnDamaged(ctx context.Context, loanID uuid.UUID, damage DamageReport) (*repository.LoanWithDetails, error)
+	// DeclareLost closes the loan of a book that will not come back.
+	DeclareLost(ctx context.Context, input DeclareLostInput) (*repository.LoanWithDetails, error)
+	// CheckOut lends the copy with the given barcode to the patron holding
+	// the given library card.
+	CheckOut(ctx context.Context, input CheckOutInput) (*repository.LoanWithDetails, error)
+	// CheckIn returns the loan of the copy with the given barcode.
+	CheckIn(ctx context.Context, input CheckInInput) (*repository.LoanWithDetails, error)
+	RenewLoan(ctx context.Context, loanID uuid.UUID) (*repository.LoanWithDetails, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*repository.LoanWithDetails, error)
-	List(ctx context.Context, page, limit int, userID *uuid.UUID, status *string) ([]*repository.LoanWithDetails, int, error)
+	List(ctx context.Context, page, limit int, filter repository.LoanFilter) ([]*repository.LoanWithDetails, int, error)
+	BorrowForCaller(ctx context.Context, input BorrowForCallerInput) (*repository.LoanWithDetails, error)
+	ListCallerLoans(ctx context.Context, page, limit int, status *string) ([]*repository.LoanWithDetails, int, error)
+	// MarkOverdueLoans moves active loans past their due date to overdue,
+	// auditing and announcing each one, and returns how many changed.
+	MarkOverdueLoans(ctx context.Context) (int, error)
 }
 
 type BorrowBookInput struct {
@@ -23,69 +43,303 @@ type BorrowBookInput struct {
 	DueDate *time.Time
 }
 
+// BorrowForCallerInput is a BorrowBookInput whose user is the caller.
+type BorrowForCallerInput struct {
+	BookID uuid.UUID
+}
+
+// CheckOutInput identifies the patron and the copy scanned at the
+// circulation desk.
+type CheckOutInput struct {
+	CardNumber string
+	Barcode    string
+	DueDate    *time.Time
+}
+
+// CheckInInput identifies the copy scanned at the circulation desk.
+// ReturnedAt backdates the return, e.g. for items left in the book drop
+// while the library was closed; it defaults to now.
+type CheckInInput struct {
+	Barcode    string
+	ReturnedAt *time.Time
+	// Damage is set when the copy came back damaged.
+	Damage *DamageReport
+}
+
+// DamageReport describes a book returned damaged: the loan is closed as
+// damaged and the copy goes to repair instead of back into circulation.
+// ChargeCents, when positive, is billed for the repair.
+type DamageReport struct {
+	ChargeCents int64
+}
+
+// DeclareLostInput identifies the loan of a book that will not come back.
+// ReplacementCents overrides the configured replacement cost; zero bills
+// nothing.
+type DeclareLostInput struct {
+	LoanID           uuid.UUID
+	ReplacementCents *int64
+}
+
+// LoanRules holds the configurable circulation limits.
+type LoanRules struct {
+	// MaxLoans, LoanDays and MaxRenewals apply when no loan policy matches
+	// the patron and item categories.
+	MaxLoans    int
+	LoanDays    int
+	MaxRenewals int
+	// RenewalGracePeriod is how long past the due date a loan may still be
+	// renewed.
+	RenewalGracePeriod time.Duration
+	// HoldPickupWindow is how long a returned copy stays set aside for the
+	// next hold in line.
+	HoldPickupWindow time.Duration
+	// Location is the library time zone, in which due dates are moved to
+	// closing time on a day the branch is open. It defaults to UTC.
+	Location *time.Location
+	Fines    FineRules
+}
+
 type loanUseCase struct {
-	loanRepo repository.LoanRepositoryWithDetails
-	bookRepo repository.BookRepository
-	userRepo repository.UserRepository
+	loanRepo     repository.LoanRepositoryWithDetails
+	bookRepo     repository.BookRepository
+	copyRepo     repository.BookCopyRepository
+	userRepo     repository.UserRepository
+	holdRepo     repository.HoldRepository
+	fineRepo     repository.FineRepository
+	policyRepo   repository.LoanPolicyRepository
+	calendarRepo repository.CalendarRepository
+	txManager    repository.TxManager
+	events       EventEmitter
+	audit        Auditor
+	rules        LoanRules
 }
 
 func NewLoanUseCase(
 	loanRepo repository.LoanRepositoryWithDetails,
 	bookRepo repository.BookRepository,
+	copyRepo repository.BookCopyRepository,
 	userRepo repository.UserRepository,
+	holdRepo repository.HoldRepository,
+	fineRepo repository.FineRepository,
+	policyRepo repository.LoanPolicyRepository,
+	calendarRepo repository.CalendarRepository,
+	txManager repository.TxManager,
+	events EventEmitter,
+	audit Auditor,
+	rules LoanRules,
 ) LoanUseCase {
+	if rules.Location == nil {
+		rules.Location = time.UTC
+	}
 	return &loanUseCase{
-		loanRepo: loanRepo,
-		bookRepo: bookRepo,
-		userRepo: userRepo,
+		loanRepo:     loanRepo,
+		bookRepo:     bookRepo,
+		copyRepo:     copyRepo,
+		userRepo:     userRepo,
+		holdRepo:     holdRepo,
+		fineRepo:     fineRepo,
+		policyRepo:   policyRepo,
+		calendarRepo: calendarRepo,
+		txManager:    txManager,
+		events:       events,
+		audit:        audit,
+		rules:        rules,
 	}
 }
 
 func (uc *loanUseCase) BorrowBook(ctx context.Context, input BorrowBookInput) (*repository.LoanWithDetails, error) {
-	user, err := uc.userRepo.GetByID(ctx, input.UserID)
+	var result *repository.LoanWithDetails
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		user, err := uc.userRepo.GetByID(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return entity.ErrUserNotFound
+		}
+
+		book, err := uc.bookRepo.GetByID(ctx, input.BookID)
+		if err != nil {
+			return err
+		}
+		if book == nil {
+			return entity.ErrBookNotFound
+		}
+
+		result, err = uc.lend(ctx, user, book, nil, input.DueDate)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	if user == nil {
-		return nil, entity.ErrUserNotFound
+
+	return result, nil
+}
+
+func (uc *loanUseCase) CheckOut(ctx context.Context, input CheckOutInput) (*repository.LoanWithDetails, error) {
+	var result *repository.LoanWithDetails
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		user, err := uc.userRepo.GetByCardNumber(ctx, input.CardNumber)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return entity.ErrUserNotFound
+		}
+
+		bookCopy, err := uc.copyRepo.GetByBarcode(ctx, input.Barcode)
+		if err != nil {
+			return err
+		}
+		if bookCopy == nil {
+			return entity.ErrBookCopyNotFound
+		}
+
+		book, err := uc.bookRepo.GetByID(ctx, bookCopy.BookID)
+		if err != nil {
+			return err
+		}
+		if book == nil {
+			return entity.ErrBookNotFound
+		}
+
+		result, err = uc.lend(ctx, user, book, bookCopy, input.DueDate)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
+
+	return result, nil
+}
+
+// lend checks the patron may borrow book and records the loan. When scanned
+// is nil a copy is picked for the patron; otherwise that copy is lent out.
+func (uc *loanUseCase) lend(
+	ctx context.Context,
+	user *entity.User,
+	book *entity.Book,
+	scanned *entity.BookCopy,
+	dueDate *time.Time,
+) (*repository.LoanWithDetails, error) {
 	if !user.Active {
 		return nil, entity.ErrUserDisabled
 	}
+	if user.Blocked {
+		return nil, entity.ErrUserBlocked
+	}
 
-	book, err := uc.bookRepo.GetByID(ctx, input.BookID)
+	owed, err := uc.fineRepo.OutstandingCents(ctx, user.ID)
 	if err != nil {
 		return nil, err
 	}
-	if book == nil {
-		return nil, entity.ErrBookNotFound
-	}
-
-	if !book.IsAvailable() {
-		return nil, entity.ErrBookNotAvailable
+	if owed > uc.rules.Fines.BlockThresholdCents {
+		return nil, entity.ErrOutstandingFines
 	}
 
-	existingLoan, _ := uc.loanRepo.GetActiveByUserAndBook(ctx, input.UserID, input.BookID)
+	existingLoan, _ := uc.loanRepo.GetActiveByUserAndBook(ctx, user.ID, book.ID)
 	if existingLoan != nil {
 		return nil, entity.ErrUserHasActiveLoan
 	}
 
-	loan, err := entity.NewLoan(input.UserID, input.BookID, input.DueDate)
+	policy, err := uc.policyFor(ctx, user, book)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := book.BorrowCopy(); err != nil {
+	activeLoans, err := uc.loanRepo.CountActiveByUser(ctx, user.ID)
+	if err != nil {
 		return nil, err
 	}
+	if activeLoans >= policy.MaxLoans {
+		return nil, entity.ErrLoanLimitReached
+	}
 
-	if err := uc.bookRepo.Update(ctx, book); err != nil {
+	hold, err := uc.holdRepo.GetActiveByUserAndBook(ctx, user.ID, book.ID)
+	if err != nil {
+		return nil, err
+	}
+	var holdBefore *holdAuditState
+	if hold != nil {
+		holdBefore = holdAudit(hold)
+	}
+
+	// Due dates given by staff and worked out from the policy alike move to
+	// a day the lending branch is open.
+	if dueDate == nil {
+		due := time.Now().AddDate(0, 0, policy.LoanDays)
+		dueDate = &due
+	}
+
+	loan, err := entity.NewLoan(user.ID, book.ID, dueDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var bookCopy, setAside *entity.BookCopy
+	if scanned != nil {
+		bookCopy = scanned
+		setAside, err = uc.applyHoldToScannedCopy(ctx, scanned, hold)
+	} else {
+		bookCopy, err = uc.pickCopy(ctx, book, hold)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	due, err := dueAtOpenDay(ctx, uc.calendarRepo, uc.rules.Location, bookCopy.BranchID, loan.DueDate)
+	if err != nil {
+		return nil, err
+	}
+	loan.RescheduleDue(due)
+
+	if err := bookCopy.CheckOut(); err != nil {
+		return nil, err
+	}
+	if err := uc.copyRepo.Update(ctx, bookCopy); err != nil {
+		return nil, err
+	}
+	loan.CopyID = &bookCopy.ID
+
+	if hold != nil {
+		if err := uc.holdRepo.Update(ctx, hold); err != nil {
+			return nil, err
+		}
+		holdAction, holdEventType := entity.AuditHoldFulfilled, entity.EventHoldFulfilled
+		if hold.Status == entity.HoldStatusCancelled {
+			holdAction, holdEventType = entity.AuditHoldCancelled, entity.EventHoldCancelled
+		}
+		if err := recordHold(ctx, uc.audit, holdAction, holdBefore, hold); err != nil {
+			return nil, err
+		}
+		if err := uc.events.Emit(ctx, holdEvent(holdEventType, hold)); err != nil {
+			return nil, err
+		}
+	}
+
+	// The patron took a shelf copy instead of the one set aside for them, so
+	// that copy goes to the next hold in line.
+	if setAside != nil {
+		err = releaseCopy(ctx, uc.holdRepo, uc.copyRepo, uc.bookRepo, uc.events, uc.audit, book, setAside, uc.rules.HoldPickupWindow)
+	} else {
+		err = syncCopyCounts(ctx, uc.copyRepo, uc.bookRepo, book)
+	}
+	if err != nil {
 		return nil, err
 	}
 
 	if err := uc.loanRepo.Create(ctx, loan); err != nil {
 		return nil, err
 	}
+	if err := recordLoan(ctx, uc.audit, entity.AuditLoanBorrowed, nil, loan); err != nil {
+		return nil, err
+	}
+	if err := uc.events.Emit(ctx, loanEvent(entity.EventLoanBorrowed, loan)); err != nil {
+		return nil, err
+	}
 
 	return &repository.LoanWithDetails{
 		Loan:      loan,
@@ -94,7 +348,149 @@ func (uc *loanUseCase) BorrowBook(ctx context.Context, input BorrowBookInput) (*
 	}, nil
 }
 
+// pickCopy chooses the copy to lend: the one set aside for the patron's ready
+// hold, or else one from the shelf.
+func (uc *loanUseCase) pickCopy(ctx context.Context, book *entity.Book, hold *entity.Hold) (*entity.BookCopy, error) {
+	var bookCopy *entity.BookCopy
+	var err error
+	switch {
+	case hold != nil && hold.IsReady():
+		// The copy set aside for the hold is not part of AvailableCopies.
+		bookCopy, err = heldCopy(ctx, uc.copyRepo, hold)
+		if err != nil {
+			return nil, err
+		}
+		if err := hold.Fulfill(); err != nil {
+			return nil, err
+		}
+	case !book.IsAvailable():
+		return nil, entity.ErrBookNotAvailable
+	default:
+		bookCopy, err = uc.copyRepo.FindByStatus(ctx, book.ID, entity.CopyStatusAvailable)
+		if err != nil {
+			return nil, err
+		}
+		// A copy came off the shelf, so the patron leaves the queue.
+		if hold != nil {
+			if err := hold.Cancel(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if bookCopy == nil {
+		return nil, entity.ErrBookNotAvailable
+	}
+	return bookCopy, nil
+}
+
+// applyHoldToScannedCopy settles the patron's hold against the copy handed
+// over at the desk. A copy on the hold shelf may only go to the patron whose
+// ready hold it was set aside for. When such a patron brings a shelf copy
+// instead, the copy set aside for them is returned so it can be passed on.
+func (uc *loanUseCase) applyHoldToScannedCopy(
+	ctx context.Context,
+	scanned *entity.BookCopy,
+	hold *entity.Hold,
+) (*entity.BookCopy, error) {
+	switch scanned.Status {
+	case entity.CopyStatusOnHold:
+		if hold == nil || !hold.IsReady() {
+			return nil, entity.ErrCopyNotAvailable
+		}
+		if hold.CopyID != nil && *hold.CopyID != scanned.ID {
+			return nil, entity.ErrCopyNotAvailable
+		}
+		return nil, hold.Fulfill()
+	case entity.CopyStatusAvailable:
+		if hold == nil {
+			return nil, nil
+		}
+		if !hold.IsReady() {
+			// A copy came off the shelf, so the patron leaves the queue.
+			return nil, hold.Cancel()
+		}
+		if err := hold.Fulfill(); err != nil {
+			return nil, err
+		}
+		return heldCopy(ctx, uc.copyRepo, hold)
+	default:
+		return nil, entity.ErrCopyNotAvailable
+	}
+}
+
 func (uc *loanUseCase) ReturnBook(ctx context.Context, loanID uuid.UUID) (*repository.LoanWithDetails, error) {
+	var result *repository.LoanWithDetails
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		var err error
+		result, err = uc.returnLoan(ctx, loanID, time.Now(), nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (uc *loanUseCase) ReturnDamaged(ctx context.Context, loanID uuid.UUID, damage DamageReport) (*repository.LoanWithDetails, error) {
+	var result *repository.LoanWithDetails
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		var err error
+		result, err = uc.returnLoan(ctx, loanID, time.Now(), &damage)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (uc *loanUseCase) CheckIn(ctx context.Context, input CheckInInput) (*repository.LoanWithDetails, error) {
+	returnedAt := time.Now()
+	if input.ReturnedAt != nil {
+		returnedAt = *input.ReturnedAt
+	}
+
+	var result *repository.LoanWithDetails
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		bookCopy, err := uc.copyRepo.GetByBarcode(ctx, input.Barcode)
+		if err != nil {
+			return err
+		}
+		if bookCopy == nil {
+			return entity.ErrBookCopyNotFound
+		}
+
+		loan, err := uc.loanRepo.GetActiveByCopy(ctx, bookCopy.ID)
+		if err != nil {
+			return err
+		}
+		if loan == nil {
+			return entity.ErrCopyNotOnLoan
+		}
+
+		result, err = uc.returnLoan(ctx, loan.ID, returnedAt, input.Damage)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// returnLoan closes the loan as of returnedAt and charges any overdue fine.
+// The copy goes to the next hold in line or back to the shelf, or to repair
+// when damage is set.
+func (uc *loanUseCase) returnLoan(ctx context.Context, loanID uuid.UUID, returnedAt time.Time, damage *DamageReport) (*repository.LoanWithDetails, error) {
+	if damage != nil && damage.ChargeCents < 0 {
+		return nil, entity.ErrInvalidChargeAmount
+	}
+
 	loanDetails, err := uc.loanRepo.GetByIDWithDetails(ctx, loanID)
 	if err != nil {
 		return nil, err
@@ -103,11 +499,26 @@ func (uc *loanUseCase) ReturnBook(ctx context.Context, loanID uuid.UUID) (*repos
 		return nil, entity.ErrLoanNotFound
 	}
 
-	if err := loanDetails.Loan.Return(); err != nil {
+	loan := loanDetails.Loan
+	before := loanAudit(loan)
+	if damage != nil {
+		err = loan.ReturnDamagedAt(returnedAt)
+	} else {
+		err = loan.ReturnAt(returnedAt)
+	}
+	if err != nil {
 		return nil, err
 	}
 
-	book, err := uc.bookRepo.GetByID(ctx, loanDetails.Loan.BookID)
+	amount := entity.OverdueFineCents(loan.DaysOverdue(*loan.ReturnedAt), uc.rules.Fines.DailyRateCents, uc.rules.Fines.MaxAmountCents)
+	if amount > 0 {
+		fine := entity.NewFine(loan.UserID, loan.ID, entity.FineReasonOverdue, amount)
+		if err := assessFine(ctx, uc.fineRepo, uc.events, uc.audit, fine); err != nil {
+			return nil, err
+		}
+	}
+
+	book, err := uc.bookRepo.GetByID(ctx, loan.BookID)
 	if err != nil {
 		return nil, err
 	}
@@ -115,21 +526,225 @@ func (uc *loanUseCase) ReturnBook(ctx context.Context, loanID uuid.UUID) (*repos
 		return nil, entity.ErrBookNotFound
 	}
 
-	if err := book.ReturnCopy(); err != nil {
+	bookCopy, err := loanCopy(ctx, uc.copyRepo, loan)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := uc.bookRepo.Update(ctx, book); err != nil {
+	if damage != nil {
+		bookCopy.SendToRepair()
+		if err := uc.copyRepo.Update(ctx, bookCopy); err != nil {
+			return nil, err
+		}
+		if err := syncCopyCounts(ctx, uc.copyRepo, uc.bookRepo, book); err != nil {
+			return nil, err
+		}
+		if damage.ChargeCents > 0 {
+			fine := entity.NewFine(loan.UserID, loan.ID, entity.FineReasonDamaged, damage.ChargeCents)
+			if err := assessFine(ctx, uc.fineRepo, uc.events, uc.audit, fine); err != nil {
+				return nil, err
+			}
+		}
+	} else if err := releaseCopy(ctx, uc.holdRepo, uc.copyRepo, uc.bookRepo, uc.events, uc.audit, book, bookCopy, uc.rules.HoldPickupWindow); err != nil {
 		return nil, err
 	}
 
-	if err := uc.loanRepo.Update(ctx, loanDetails.Loan); err != nil {
+	if err := uc.loanRepo.Update(ctx, loan); err != nil {
+		return nil, err
+	}
+	if err := recordLoan(ctx, uc.audit, entity.AuditLoanReturned, before, loan); err != nil {
+		return nil, err
+	}
+	eventType := entity.EventLoanReturned
+	if damage != nil {
+		eventType = entity.EventLoanDamaged
+	}
+	if err := uc.events.Emit(ctx, loanEvent(eventType, loan)); err != nil {
 		return nil, err
 	}
 
 	return loanDetails, nil
 }
 
+func (uc *loanUseCase) DeclareLost(ctx context.Context, input DeclareLostInput) (*repository.LoanWithDetails, error) {
+	replacementCents := uc.rules.Fines.ReplacementCostCents
+	if input.ReplacementCents != nil {
+		replacementCents = *input.ReplacementCents
+	}
+	if replacementCents < 0 {
+		return nil, entity.ErrInvalidChargeAmount
+	}
+
+	var result *repository.LoanWithDetails
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		loanDetails, err := uc.loanRepo.GetByIDWithDetails(ctx, input.LoanID)
+		if err != nil {
+			return err
+		}
+		if loanDetails == nil || loanDetails.Loan == nil {
+			return entity.ErrLoanNotFound
+		}
+
+		if _, err := declareLost(ctx, uc.loanRepo, uc.copyRepo, uc.bookRepo, uc.fineRepo, uc.events, uc.audit, loanDetails.Loan, time.Now(), replacementCents); err != nil {
+			return err
+		}
+
+		result = loanDetails
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (uc *loanUseCase) RenewLoan(ctx context.Context, loanID uuid.UUID) (*repository.LoanWithDetails, error) {
+	var result *repository.LoanWithDetails
+
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		loanDetails, err := uc.loanRepo.GetByIDWithDetails(ctx, loanID)
+		if err != nil {
+			return err
+		}
+		if loanDetails == nil || loanDetails.Loan == nil {
+			return entity.ErrLoanNotFound
+		}
+		loan := loanDetails.Loan
+
+		if loan.IsActive() {
+			pending, err := uc.holdRepo.HasPendingHolds(ctx, loan.BookID, loan.UserID)
+			if err != nil {
+				return err
+			}
+			if pending {
+				return entity.ErrBookHasPendingHolds
+			}
+		}
+
+		user, err := uc.userRepo.GetByID(ctx, loan.UserID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return entity.ErrUserNotFound
+		}
+
+		book, err := uc.bookRepo.GetByID(ctx, loan.BookID)
+		if err != nil {
+			return err
+		}
+		if book == nil {
+			return entity.ErrBookNotFound
+		}
+
+		policy, err := uc.policyFor(ctx, user, book)
+		if err != nil {
+			return err
+		}
+
+		before := loanAudit(loan)
+		if err := loan.Renew(policy.LoanDays, policy.MaxRenewals, uc.rules.RenewalGracePeriod); err != nil {
+			return err
+		}
+
+		bookCopy, err := loanCopy(ctx, uc.copyRepo, loan)
+		if err != nil {
+			return err
+		}
+		due, err := dueAtOpenDay(ctx, uc.calendarRepo, uc.rules.Location, bookCopy.BranchID, loan.DueDate)
+		if err != nil {
+			return err
+		}
+		loan.RescheduleDue(due)
+
+		if err := uc.loanRepo.Update(ctx, loan); err != nil {
+			return err
+		}
+		if err := recordLoan(ctx, uc.audit, entity.AuditLoanRenewed, before, loan); err != nil {
+			return err
+		}
+		if err := uc.events.Emit(ctx, loanEvent(entity.EventLoanRenewed, loan)); err != nil {
+			return err
+		}
+
+		result = loanDetails
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// recordLoan records the change to loan from before, or its creation when
+// before is nil, in the audit log.
+func recordLoan(ctx context.Context, audit Auditor, action string, before *loanAuditState, loan *entity.Loan) error {
+	return audit.Record(ctx, AuditRecord{
+		Action:     action,
+		EntityType: entity.AuditEntityLoan,
+		EntityID:   loan.ID,
+		Before:     before,
+		After:      loanAudit(loan),
+	})
+}
+
+// loanCopy returns the copy lent out by loan. Loans recorded before copies
+// were tracked take any copy of the book that is on loan.
+func loanCopy(ctx context.Context, copyRepo repository.BookCopyRepository, loan *entity.Loan) (*entity.BookCopy, error) {
+	var bookCopy *entity.BookCopy
+	var err error
+	if loan.CopyID != nil {
+		bookCopy, err = copyRepo.GetByID(ctx, *loan.CopyID)
+	} else {
+		bookCopy, err = copyRepo.FindByStatus(ctx, loan.BookID, entity.CopyStatusOnLoan)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if bookCopy == nil {
+		return nil, entity.ErrBookCopyNotFound
+	}
+	return bookCopy, nil
+}
+
+// policyFor picks the most specific loan policy for the user's patron
+// category and the book's item category, falling back to the configured
+// defaults when none matches.
+func (uc *loanUseCase) policyFor(ctx context.Context, user *entity.User, book *entity.Book) (*entity.LoanPolicy, error) {
+	policies, err := uc.policyRepo.ListMatching(ctx, user.Category, book.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy := entity.SelectLoanPolicy(policies, user.Category, book.Category); policy != nil {
+		return policy, nil
+	}
+
+	return &entity.LoanPolicy{
+		PatronCategory: entity.AnyCategory,
+		ItemCategory:   entity.AnyCategory,
+		MaxLoans:       uc.rules.MaxLoans,
+		LoanDays:       uc.rules.LoanDays,
+		MaxRenewals:    uc.rules.MaxRenewals,
+	}, nil
+}
+
+// withRetry runs fn in a transaction, starting over with fresh reads when the
+// book version check fails, up to maxUpdateAttempts times.
+func withRetry(ctx context.Context, txManager repository.TxManager, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		err = txManager.WithinTransaction(ctx, fn)
+		if !errors.Is(err, entity.ErrConcurrentModification) {
+			return err
+		}
+	}
+	return err
+}
+
 func (uc *loanUseCase) GetByID(ctx context.Context, id uuid.UUID) (*repository.LoanWithDetails, error) {
 	loanDetails, err := uc.loanRepo.GetByIDWithDetails(ctx, id)
 	if err != nil {
@@ -141,7 +756,7 @@ func (uc *loanUseCase) GetByID(ctx context.Context, id uuid.UUID) (*repository.L
 	return loanDetails, nil
 }
 
-func (uc *loanUseCase) List(ctx context.Context, page, limit int, userID *uuid.UUID, status *string) ([]*repository.LoanWithDetails, int, error) {
+func (uc *loanUseCase) List(ctx context.Context, page, limit int, filter repository.LoanFilter) ([]*repository.LoanWithDetails, int, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -151,5 +766,51 @@ func (uc *loanUseCase) List(ctx context.Context, page, limit int, userID *uuid.U
 	if limit > 100 {
 		limit = 100
 	}
-	return uc.loanRepo.ListWithDetails(ctx, page, limit, userID, status)
+	return uc.loanRepo.ListWithDetails(ctx, page, limit, filter)
+}
+
+func (uc *loanUseCase) BorrowForCaller(ctx context.Context, input BorrowForCallerInput) (*repository.LoanWithDetails, error) {
+	caller, ok := CallerFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return uc.BorrowBook(ctx, BorrowBookInput{
+		UserID: caller.UserID,
+		BookID: input.BookID,
+	})
+}
+
+func (uc *loanUseCase) ListCallerLoans(ctx context.Context, page, limit int, status *string) ([]*repository.LoanWithDetails, int, error) {
+	caller, ok := CallerFromContext(ctx)
+	if !ok {
+		return nil, 0, ErrUnauthenticated
+	}
+	return uc.List(ctx, page, limit, repository.LoanFilter{UserID: &caller.UserID, Status: status})
+}
+
+func (uc *loanUseCase) MarkOverdueLoans(ctx context.Context) (int, error) {
+	var marked int
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		loans, err := uc.loanRepo.MarkOverdue(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+		for _, loan := range loans {
+			// Only active loans are marked, so that is what each one was.
+			before := loanAudit(loan)
+			before.Status = entity.LoanStatusActive
+			if err := recordLoan(ctx, uc.audit, entity.AuditLoanOverdue, before, loan); err != nil {
+				return err
+			}
+			if err := uc.events.Emit(ctx, loanEvent(entity.EventLoanOverdue, loan)); err != nil {
+				return err
+			}
+		}
+		marked = len(loans)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return marked, nil
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
@@ -13,6 +14,8 @@ import (
 
 type mockLoanRepository struct {
 	loans map[uuid.UUID]*entity.Loan
+	// copies, when set, tells at which branch lent copies are kept.
+	copies *mockBookCopyRepository
 }
 
 func newMockLoanRepository() *mockLoanRepository {
@@ -46,20 +49,62 @@ func (m *mockLoanRepository) GetByIDWithDetails(ctx context.Context, id uuid.UUI
 
 func (m *mockLoanRepository) GetActiveByUserAndBook(ctx context.Context, userID, bookID uuid.UUID) (*entity.Loan, error) {
 	for _, loan := range m.loans {
-		if loan.UserID == userID && loan.BookID == bookID && loan.Status == entity.LoanStatusActive {
+		if loan.UserID == userID && loan.BookID == bookID && loan.IsActive() {
 			return loan, nil
 		}
 	}
 	return nil, nil
 }
 
-func (m *mockLoanRepository) List(ctx context.Context, page, limit int, userID *uuid.UUID, status *string) ([]*entity.Loan, int, error) {
+func (m *mockLoanRepository) GetActiveByCopy(ctx context.Context, copyID uuid.UUID) (*entity.Loan, error) {
+	for _, loan := range m.loans {
+		if loan.CopyID != nil && *loan.CopyID == copyID && loan.IsActive() {
+			return loan, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockLoanRepository) CountActiveByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	count := 0
+	for _, loan := range m.loans {
+		if loan.UserID == userID && loan.IsActive() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockLoanRepository) CountActiveByBook(ctx context.Context, bookID uuid.UUID) (int, error) {
+	count := 0
+	for _, loan := range m.loans {
+		if loan.BookID == bookID && loan.IsActive() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockLoanRepository) CountByBook(ctx context.Context, bookID uuid.UUID) (int, error) {
+	count := 0
+	for _, loan := range m.loans {
+		if loan.BookID == bookID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockLoanRepository) List(ctx context.Context, page, limit int, filter repository.LoanFilter) ([]*entity.Loan, int, error) {
 	loans := make([]*entity.Loan, 0)
 	for _, loan := range m.loans {
-		if userID != nil && loan.UserID != *userID {
+		if filter.UserID != nil && loan.UserID != *filter.UserID {
+			continue
+		}
+		if filter.BookID != nil && loan.BookID != *filter.BookID {
 			continue
 		}
-		if status != nil && loan.Status != *status {
+		if filter.Status != nil && loan.Status != *filter.Status {
 			continue
 		}
 		loans = append(loans, loan)
@@ -67,13 +112,16 @@ func (m *mockLoanRepository) List(ctx context.Context, page, limit int, userID *
 	return loans, len(loans), nil
 }
 
-func (m *mockLoanRepository) ListWithDetails(ctx context.Context, page, limit int, userID *uuid.UUID, status *string) ([]*repository.LoanWithDetails, int, error) {
+func (m *mockLoanRepository) ListWithDetails(ctx context.Context, page, limit int, filter repository.LoanFilter) ([]*repository.LoanWithDetails, int, error) {
 	loans := make([]*repository.LoanWithDetails, 0)
 	for _, loan := range m.loans {
-		if userID != nil && loan.UserID != *userID {
+		if filter.UserID != nil && loan.UserID != *filter.UserID {
 			continue
 		}
-		if status != nil && loan.Status != *status {
+		if filter.BookID != nil && loan.BookID != *filter.BookID {
+			continue
+		}
+		if filter.Status != nil && loan.Status != *filter.Status {
 			continue
 		}
 		loans = append(loans, &repository.LoanWithDetails{
@@ -85,21 +133,128 @@ func (m *mockLoanRepository) ListWithDetails(ctx context.Context, page, limit in
 	return loans, len(loans), nil
 }
 
+func (m *mockLoanRepository) ListActiveDue(ctx context.Context, filter repository.DueLoanFilter) ([]*entity.Loan, error) {
+	loans := make([]*entity.Loan, 0)
+	for _, loan := range m.loans {
+		if !loan.IsActive() || loan.DueDate.Before(filter.DueFrom) || !loan.DueDate.Before(filter.DueBefore) {
+			continue
+		}
+		if filter.BookID != nil && loan.BookID != *filter.BookID {
+			continue
+		}
+		if filter.BranchID != nil {
+			if loan.CopyID == nil || m.copies == nil {
+				continue
+			}
+			if bookCopy, exists := m.copies.copies[*loan.CopyID]; !exists || bookCopy.BranchID != *filter.BranchID {
+				continue
+			}
+		}
+		loans = append(loans, loan)
+	}
+	slices.SortFunc(loans, func(a, b *entity.Loan) int {
+		return a.DueDate.Compare(b.DueDate)
+	})
+	return loans, nil
+}
+
 func (m *mockLoanRepository) Update(ctx context.Context, loan *entity.Loan) error {
 	m.loans[loan.ID] = loan
 	return nil
 }
 
+func (m *mockLoanRepository) MarkOverdue(ctx context.Context, now time.Time) ([]*entity.Loan, error) {
+	marked := make([]*entity.Loan, 0)
+	for _, loan := range m.loans {
+		if loan.MarkOverdue(now) {
+			marked = append(marked, loan)
+		}
+	}
+	return marked, nil
+}
+
+var testLoanRules = LoanRules{
+	MaxLoans:           5,
+	LoanDays:           14,
+	MaxRenewals:        2,
+	RenewalGracePeriod: 24 * time.Hour,
+	HoldPickupWindow:   48 * time.Hour,
+	Fines: FineRules{
+		DailyRateCents:       100,
+		MaxAmountCents:       1000,
+		BlockThresholdCents:  500,
+		ReplacementCostCents: 2500,
+	},
+}
+
+type mockTxManager struct {
+	calls int
+	// copies and holds, when set, are rolled back if fn fails.
+	copies *mockBookCopyRepository
+	holds  *mockHoldRepository
+}
+
+func newMockTxManager() *mockTxManager {
+	return &mockTxManager{}
+}
+
+func (m *mockTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.calls++
+	var rollbacks []func()
+	if m.copies != nil {
+		rollbacks = append(rollbacks, m.copies.snapshot())
+	}
+	if m.holds != nil {
+		rollbacks = append(rollbacks, m.holds.snapshot())
+	}
+	err := fn(ctx)
+	if err != nil {
+		for _, rollback := range rollbacks {
+			rollback()
+		}
+	}
+	return err
+}
+
+// conflictingBookRepository fails the first conflicts updates with
+// ErrConcurrentModification, as if another request had won the race. Reads
+// return copies so a failed attempt leaves no trace in the stored book.
+type conflictingBookRepository struct {
+	*mockBookRepository
+	conflicts int
+	updates   int
+}
+
+func (m *conflictingBookRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Book, error) {
+	book, err := m.mockBookRepository.GetByID(ctx, id)
+	if book == nil || err != nil {
+		return book, err
+	}
+	clone := *book
+	return &clone, nil
+}
+
+func (m *conflictingBookRepository) Update(ctx context.Context, book *entity.Book) error {
+	m.updates++
+	if m.conflicts > 0 {
+		m.conflicts--
+		return entity.ErrConcurrentModification
+	}
+	clone := *book
+	return m.mockBookRepository.Update(ctx, &clone)
+}
+
 func TestLoanUseCase_BorrowBook(t *testing.T) {
 	ctx := context.Background()
 
 	createTestData := func() (*loanUseCase, *entity.User, *entity.Book) {
 		userRepo := newMockUserRepository()
 		bookRepo := newMockBookRepository()
+		copyRepo := newMockBookCopyRepository()
 		loanRepo := newMockLoanRepository()
 
-		userUC := NewUserUseCase(userRepo)
-		bookUC := NewBookUseCase(bookRepo)
+		userUC := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor())
+		bookUC := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour)
 
 		user, _ := userUC.Create(ctx, CreateUserInput{
 			Name:     "John Doe",
@@ -115,7 +270,7 @@ func TestLoanUseCase_BorrowBook(t *testing.T) {
 			TotalCopies:   3,
 		})
 
-		loanUC := NewLoanUseCase(loanRepo, bookRepo, userRepo).(*loanUseCase)
+		loanUC := NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules).(*loanUseCase)
 
 		return loanUC, user, book
 	}
@@ -189,10 +344,11 @@ func TestLoanUseCase_BorrowBook(t *testing.T) {
 	t.Run("borrow by disabled user", func(t *testing.T) {
 		userRepo := newMockUserRepository()
 		bookRepo := newMockBookRepository()
+		copyRepo := newMockBookCopyRepository()
 		loanRepo := newMockLoanRepository()
 
-		userUC := NewUserUseCase(userRepo)
-		bookUC := NewBookUseCase(bookRepo)
+		userUC := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor())
+		bookUC := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour)
 
 		user, _ := userUC.Create(ctx, CreateUserInput{
 			Name:     "John Doe",
@@ -209,7 +365,7 @@ func TestLoanUseCase_BorrowBook(t *testing.T) {
 			TotalCopies:   3,
 		})
 
-		loanUC := NewLoanUseCase(loanRepo, bookRepo, userRepo)
+		loanUC := NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
 
 		_, err := loanUC.BorrowBook(ctx, BorrowBookInput{
 			UserID: user.ID,
@@ -219,6 +375,117 @@ func TestLoanUseCase_BorrowBook(t *testing.T) {
 			t.Errorf("LoanUseCase.BorrowBook() error = %v, wantErr %v", err, entity.ErrUserDisabled)
 		}
 	})
+
+	t.Run("borrow by blocked user", func(t *testing.T) {
+		loanUC, user, book := createTestData()
+		user.Block()
+
+		_, err := loanUC.BorrowBook(ctx, BorrowBookInput{
+			UserID: user.ID,
+			BookID: book.ID,
+		})
+		if err != entity.ErrUserBlocked {
+			t.Errorf("LoanUseCase.BorrowBook() error = %v, wantErr %v", err, entity.ErrUserBlocked)
+		}
+	})
+}
+
+func TestLoanUseCase_RunsInsideTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	userRepo := newMockUserRepository()
+	bookRepo := newMockBookRepository()
+	copyRepo := newMockBookCopyRepository()
+	loanRepo := newMockLoanRepository()
+	txManager := newMockTxManager()
+
+	user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+	book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+		Title:         "Clean Code",
+		Author:        "Robert C. Martin",
+		ISBN:          "9780132350884",
+		PublishedYear: 2008,
+		TotalCopies:   3,
+	})
+
+	loanUC := NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), txManager, newMockEventEmitter(), newMockAuditor(), testLoanRules)
+
+	borrowed, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+	if err != nil {
+		t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+	}
+	if txManager.calls != 1 {
+		t.Errorf("LoanUseCase.BorrowBook() transactions = %v, want %v", txManager.calls, 1)
+	}
+
+	if _, err := loanUC.ReturnBook(ctx, borrowed.Loan.ID); err != nil {
+		t.Fatalf("LoanUseCase.ReturnBook() unexpected error = %v", err)
+	}
+	if txManager.calls != 2 {
+		t.Errorf("LoanUseCase.ReturnBook() transactions = %v, want %v", txManager.calls, 2)
+	}
+}
+
+func TestLoanUseCase_RetriesOnConcurrentModification(t *testing.T) {
+	ctx := context.Background()
+
+	createTestData := func(conflicts int) (LoanUseCase, *conflictingBookRepository, *mockTxManager, *entity.User, *entity.Book) {
+		userRepo := newMockUserRepository()
+		bookRepo := &conflictingBookRepository{mockBookRepository: newMockBookRepository()}
+		copyRepo := newMockBookCopyRepository()
+		loanRepo := newMockLoanRepository()
+		txManager := newMockTxManager()
+
+		user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+			Name:     "John Doe",
+			Email:    "john@example.com",
+			Password: "password123",
+		})
+		book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+			Title:         "Clean Code",
+			Author:        "Robert C. Martin",
+			ISBN:          "9780132350884",
+			PublishedYear: 2008,
+			TotalCopies:   3,
+		})
+		bookRepo.conflicts = conflicts
+		txManager.copies = copyRepo
+
+		return NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), txManager, newMockEventEmitter(), newMockAuditor(), testLoanRules), bookRepo, txManager, user, book
+	}
+
+	t.Run("borrow succeeds after transient conflicts", func(t *testing.T) {
+		loanUC, bookRepo, txManager, user, book := createTestData(2)
+
+		_, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		if err != nil {
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+		if txManager.calls != 3 {
+			t.Errorf("LoanUseCase.BorrowBook() transactions = %v, want %v", txManager.calls, 3)
+		}
+
+		stored, _ := bookRepo.mockBookRepository.GetByID(ctx, book.ID)
+		if stored.AvailableCopies != 2 {
+			t.Errorf("LoanUseCase.BorrowBook() available copies = %v, want %v", stored.AvailableCopies, 2)
+		}
+	})
+
+	t.Run("borrow gives up after max attempts", func(t *testing.T) {
+		loanUC, bookRepo, _, user, book := createTestData(maxUpdateAttempts)
+
+		_, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		if err != entity.ErrConcurrentModification {
+			t.Errorf("LoanUseCase.BorrowBook() error = %v, wantErr %v", err, entity.ErrConcurrentModification)
+		}
+		if bookRepo.updates != maxUpdateAttempts {
+			t.Errorf("LoanUseCase.BorrowBook() update attempts = %v, want %v", bookRepo.updates, maxUpdateAttempts)
+		}
+	})
 }
 
 func TestLoanUseCase_ReturnBook(t *testing.T) {
@@ -227,10 +494,11 @@ func TestLoanUseCase_ReturnBook(t *testing.T) {
 	t.Run("return borrowed book", func(t *testing.T) {
 		userRepo := newMockUserRepository()
 		bookRepo := newMockBookRepository()
+		copyRepo := newMockBookCopyRepository()
 		loanRepo := newMockLoanRepository()
 
-		userUC := NewUserUseCase(userRepo)
-		bookUC := NewBookUseCase(bookRepo)
+		userUC := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor())
+		bookUC := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour)
 
 		user, _ := userUC.Create(ctx, CreateUserInput{
 			Name:     "John Doe",
@@ -246,7 +514,7 @@ func TestLoanUseCase_ReturnBook(t *testing.T) {
 			TotalCopies:   3,
 		})
 
-		loanUC := NewLoanUseCase(loanRepo, bookRepo, userRepo)
+		loanUC := NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
 
 		borrowed, _ := loanUC.BorrowBook(ctx, BorrowBookInput{
 			UserID: user.ID,
@@ -267,12 +535,55 @@ func TestLoanUseCase_ReturnBook(t *testing.T) {
 		}
 	})
 
+	t.Run("return overdue loan", func(t *testing.T) {
+		userRepo := newMockUserRepository()
+		bookRepo := newMockBookRepository()
+		copyRepo := newMockBookCopyRepository()
+		loanRepo := newMockLoanRepository()
+
+		user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+			Name:     "John Doe",
+			Email:    "john@example.com",
+			Password: "password123",
+		})
+		book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+			Title:         "Clean Code",
+			Author:        "Robert C. Martin",
+			ISBN:          "9780132350884",
+			PublishedYear: 2008,
+			TotalCopies:   1,
+		})
+
+		loanUC := NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
+
+		borrowed, _ := loanUC.BorrowBook(ctx, BorrowBookInput{
+			UserID: user.ID,
+			BookID: book.ID,
+		})
+		borrowed.Loan.DueDate = time.Now().AddDate(0, 0, -1)
+		borrowed.Loan.Status = entity.LoanStatusOverdue
+
+		returned, err := loanUC.ReturnBook(ctx, borrowed.Loan.ID)
+		if err != nil {
+			t.Errorf("LoanUseCase.ReturnBook() unexpected error = %v", err)
+			return
+		}
+
+		if returned.Loan.Status != entity.LoanStatusReturned {
+			t.Errorf("LoanUseCase.ReturnBook() status = %v, want %v", returned.Loan.Status, entity.LoanStatusReturned)
+		}
+		if bookRepo.books[book.ID].AvailableCopies != 1 {
+			t.Errorf("LoanUseCase.ReturnBook() availableCopies = %v, want 1", bookRepo.books[book.ID].AvailableCopies)
+		}
+	})
+
 	t.Run("return non-existing loan", func(t *testing.T) {
 		loanRepo := newMockLoanRepository()
 		bookRepo := newMockBookRepository()
+		copyRepo := newMockBookCopyRepository()
 		userRepo := newMockUserRepository()
 
-		loanUC := NewLoanUseCase(loanRepo, bookRepo, userRepo)
+		loanUC := NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
 
 		_, err := loanUC.ReturnBook(ctx, uuid.New())
 		if err != entity.ErrLoanNotFound {
@@ -281,80 +592,1106 @@ func TestLoanUseCase_ReturnBook(t *testing.T) {
 	})
 }
 
-func TestLoanUseCase_List(t *testing.T) {
+func TestLoanUseCase_EmitsEvents(t *testing.T) {
 	ctx := context.Background()
-
 	userRepo := newMockUserRepository()
 	bookRepo := newMockBookRepository()
-	loanRepo := newMockLoanRepository()
+	copyRepo := newMockBookCopyRepository()
+	events := newMockEventEmitter()
 
-	userUC := NewUserUseCase(userRepo)
-	bookUC := NewBookUseCase(bookRepo)
-
-	user, _ := userUC.Create(ctx, CreateUserInput{
+	user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
 		Name:     "John Doe",
 		Email:    "john@example.com",
 		Password: "password123",
 	})
+	book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+		Title:         "Clean Code",
+		Author:        "Robert C. Martin",
+		ISBN:          "9780132350884",
+		PublishedYear: 2008,
+		TotalCopies:   1,
+	})
 
-	book, _ := bookUC.Create(ctx, CreateBookInput{
+	audit := newMockAuditor()
+	loanUC := NewLoanUseCase(newMockLoanRepository(), bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), events, audit, testLoanRules)
+
+	borrowed, _ := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+	if _, err := loanUC.ReturnBook(ctx, borrowed.Loan.ID); err != nil {
+		t.Fatalf("LoanUseCase.ReturnBook() unexpected error = %v", err)
+	}
+
+	want := []entity.EventType{entity.EventLoanBorrowed, entity.EventLoanReturned}
+	if got := events.types(); !slices.Equal(got, want) {
+		t.Fatalf("LoanUseCase events = %v, want %v", got, want)
+	}
+	for _, event := range events.events {
+		if event.AggregateID != borrowed.Loan.ID {
+			t.Errorf("LoanUseCase %v aggregate = %v, want loan %v", event.Type, event.AggregateID, borrowed.Loan.ID)
+		}
+	}
+
+	wantAudit := []string{entity.AuditLoanBorrowed, entity.AuditLoanReturned}
+	if got := audit.actions(); !slices.Equal(got, wantAudit) {
+		t.Errorf("LoanUseCase audit actions = %v, want %v", got, wantAudit)
+	}
+
+	// A refused borrow emits nothing.
+	_, _ = loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: uuid.New()})
+	if len(events.events) != len(want) {
+		t.Errorf("LoanUseCase events after refused borrow = %v, want %v", events.types(), want)
+	}
+	if len(audit.records) != len(wantAudit) {
+		t.Errorf("LoanUseCase audit actions after refused borrow = %v, want %v", audit.actions(), wantAudit)
+	}
+}
+
+func TestLoanUseCase_EmitsOutcomeEvents(t *testing.T) {
+	ctx := context.Background()
+	userRepo := newMockUserRepository()
+	bookRepo := newMockBookRepository()
+	copyRepo := newMockBookCopyRepository()
+
+	user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+	book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
 		Title:         "Clean Code",
 		Author:        "Robert C. Martin",
 		ISBN:          "9780132350884",
 		PublishedYear: 2008,
-		TotalCopies:   3,
+		TotalCopies:   2,
 	})
 
-	loanUC := NewLoanUseCase(loanRepo, bookRepo, userRepo)
+	events, outbox := newTestOutbox()
+	loanUC := NewLoanUseCase(newMockLoanRepository(), bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), events, newMockAuditor(), testLoanRules)
 
-	_, _ = loanUC.BorrowBook(ctx, BorrowBookInput{
-		UserID: user.ID,
-		BookID: book.ID,
+	t.Run("renewed and returned damaged", func(t *testing.T) {
+		outbox.messages = nil
+		borrowed, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		if err != nil {
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+		if _, err := loanUC.RenewLoan(ctx, borrowed.Loan.ID); err != nil {
+			t.Fatalf("LoanUseCase.RenewLoan() unexpected error = %v", err)
+		}
+		if _, err := loanUC.ReturnDamaged(ctx, borrowed.Loan.ID, DamageReport{ChargeCents: 400}); err != nil {
+			t.Fatalf("LoanUseCase.ReturnDamaged() unexpected error = %v", err)
+		}
+
+		want := []entity.EventType{entity.EventLoanBorrowed, entity.EventLoanRenewed, entity.EventFineAssessed, entity.EventLoanDamaged}
+		if got := outbox.types(); !slices.Equal(got, want) {
+			t.Errorf("LoanUseCase outbox = %v, want %v", got, want)
+		}
 	})
 
-	t.Run("list all loans", func(t *testing.T) {
-		loans, total, err := loanUC.List(ctx, 1, 10, nil, nil)
+	t.Run("declared lost", func(t *testing.T) {
+		outbox.messages = nil
+		borrowed, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
 		if err != nil {
-			t.Errorf("LoanUseCase.List() unexpected error = %v", err)
-			return
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+		if _, err := loanUC.DeclareLost(ctx, DeclareLostInput{LoanID: borrowed.Loan.ID}); err != nil {
+			t.Fatalf("LoanUseCase.DeclareLost() unexpected error = %v", err)
 		}
 
-		if total != 1 {
-			t.Errorf("LoanUseCase.List() total = %v, want %v", total, 1)
+		want := []entity.EventType{entity.EventLoanBorrowed, entity.EventLoanLost, entity.EventFineAssessed}
+		if got := outbox.types(); !slices.Equal(got, want) {
+			t.Fatalf("LoanUseCase outbox = %v, want %v", got, want)
 		}
-		if len(loans) != 1 {
-			t.Errorf("LoanUseCase.List() len = %v, want %v", len(loans), 1)
+		if outbox.messages[1].AggregateID != borrowed.Loan.ID {
+			t.Errorf("LoanUseCase.DeclareLost() outbox aggregate = %v, want loan %v", outbox.messages[1].AggregateID, borrowed.Loan.ID)
 		}
 	})
+}
 
-	t.Run("list loans by user", func(t *testing.T) {
-		loans, total, err := loanUC.List(ctx, 1, 10, &user.ID, nil)
+func TestLoanUseCase_ReturnDamaged(t *testing.T) {
+	ctx := context.Background()
+
+	createTestData := func() (LoanUseCase, *mockBookRepository, *mockBookCopyRepository, *mockFineRepository, *repository.LoanWithDetails) {
+		userRepo := newMockUserRepository()
+		bookRepo := newMockBookRepository()
+		copyRepo := newMockBookCopyRepository()
+		fineRepo := newMockFineRepository()
+
+		user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+			Name:     "John Doe",
+			Email:    "john@example.com",
+			Password: "password123",
+		})
+		book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+			Title:         "Clean Code",
+			Author:        "Robert C. Martin",
+			ISBN:          "9780132350884",
+			PublishedYear: 2008,
+			TotalCopies:   2,
+		})
+
+		loanUC := NewLoanUseCase(newMockLoanRepository(), bookRepo, copyRepo, userRepo, newMockHoldRepository(), fineRepo, newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
+		borrowed, _ := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		return loanUC, bookRepo, copyRepo, fineRepo, borrowed
+	}
+
+	t.Run("copy goes to repair", func(t *testing.T) {
+		loanUC, bookRepo, copyRepo, fineRepo, borrowed := createTestData()
+
+		returned, err := loanUC.ReturnDamaged(ctx, borrowed.Loan.ID, DamageReport{ChargeCents: 1500})
 		if err != nil {
-			t.Errorf("LoanUseCase.List() unexpected error = %v", err)
-			return
+			t.Fatalf("LoanUseCase.ReturnDamaged() unexpected error = %v", err)
 		}
 
-		if total != 1 {
-			t.Errorf("LoanUseCase.List() total = %v, want %v", total, 1)
+		if returned.Loan.Status != entity.LoanStatusDamaged {
+			t.Errorf("LoanUseCase.ReturnDamaged() status = %v, want %v", returned.Loan.Status, entity.LoanStatusDamaged)
 		}
-		if len(loans) != 1 {
-			t.Errorf("LoanUseCase.List() len = %v, want %v", len(loans), 1)
+		bookCopy := copyRepo.copies[*borrowed.Loan.CopyID]
+		if bookCopy.Status != entity.CopyStatusInRepair || bookCopy.Condition != entity.CopyConditionDamaged {
+			t.Errorf("LoanUseCase.ReturnDamaged() copy = %v/%v, want %v/%v", bookCopy.Status, bookCopy.Condition, entity.CopyStatusInRepair, entity.CopyConditionDamaged)
+		}
+		book := bookRepo.books[borrowed.Loan.BookID]
+		if book.AvailableCopies != 1 || book.TotalCopies != 2 {
+			t.Errorf("LoanUseCase.ReturnDamaged() copies = %v/%v, want %v/%v", book.AvailableCopies, book.TotalCopies, 1, 2)
+		}
+		if len(fineRepo.fines) != 1 {
+			t.Fatalf("LoanUseCase.ReturnDamaged() fines = %v, want 1", len(fineRepo.fines))
+		}
+		for _, fine := range fineRepo.fines {
+			if fine.Reason != entity.FineReasonDamaged || fine.AmountCents != 1500 {
+				t.Errorf("LoanUseCase.ReturnDamaged() fine = %v %v, want %v 1500", fine.Reason, fine.AmountCents, entity.FineReasonDamaged)
+			}
 		}
 	})
 
-	t.Run("list loans by status", func(t *testing.T) {
-		status := entity.LoanStatusActive
-		loans, total, err := loanUC.List(ctx, 1, 10, nil, &status)
-		if err != nil {
-			t.Errorf("LoanUseCase.List() unexpected error = %v", err)
-			return
+	t.Run("without charge", func(t *testing.T) {
+		loanUC, _, _, fineRepo, borrowed := createTestData()
+
+		if _, err := loanUC.ReturnDamaged(ctx, borrowed.Loan.ID, DamageReport{}); err != nil {
+			t.Fatalf("LoanUseCase.ReturnDamaged() unexpected error = %v", err)
 		}
+		if len(fineRepo.fines) != 0 {
+			t.Errorf("LoanUseCase.ReturnDamaged() fines = %v, want 0", len(fineRepo.fines))
+		}
+	})
 
-		if total != 1 {
-			t.Errorf("LoanUseCase.List() total = %v, want %v", total, 1)
+	t.Run("negative charge", func(t *testing.T) {
+		loanUC, _, _, _, borrowed := createTestData()
+
+		_, err := loanUC.ReturnDamaged(ctx, borrowed.Loan.ID, DamageReport{ChargeCents: -1})
+		if err != entity.ErrInvalidChargeAmount {
+			t.Errorf("LoanUseCase.ReturnDamaged() error = %v, wantErr %v", err, entity.ErrInvalidChargeAmount)
 		}
-		if len(loans) != 1 {
-			t.Errorf("LoanUseCase.List() len = %v, want %v", len(loans), 1)
+	})
+}
+
+func TestLoanUseCase_DeclareLost(t *testing.T) {
+	ctx := context.Background()
+
+	createTestData := func() (LoanUseCase, *mockBookRepository, *mockFineRepository, *repository.LoanWithDetails) {
+		userRepo := newMockUserRepository()
+		bookRepo := newMockBookRepository()
+		copyRepo := newMockBookCopyRepository()
+		fineRepo := newMockFineRepository()
+
+		user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+			Name:     "John Doe",
+			Email:    "john@example.com",
+			Password: "password123",
+		})
+		book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+			Title:         "Clean Code",
+			Author:        "Robert C. Martin",
+			ISBN:          "9780132350884",
+			PublishedYear: 2008,
+			TotalCopies:   2,
+		})
+
+		loanUC := NewLoanUseCase(newMockLoanRepository(), bookRepo, copyRepo, userRepo, newMockHoldRepository(), fineRepo, newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
+		borrowed, _ := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		return loanUC, bookRepo, fineRepo, borrowed
+	}
+
+	t.Run("bills the configured replacement cost", func(t *testing.T) {
+		loanUC, bookRepo, fineRepo, borrowed := createTestData()
+
+		lost, err := loanUC.DeclareLost(ctx, DeclareLostInput{LoanID: borrowed.Loan.ID})
+		if err != nil {
+			t.Fatalf("LoanUseCase.DeclareLost() unexpected error = %v", err)
+		}
+
+		if lost.Loan.Status != entity.LoanStatusLost {
+			t.Errorf("LoanUseCase.DeclareLost() status = %v, want %v", lost.Loan.Status, entity.LoanStatusLost)
+		}
+		book := bookRepo.books[borrowed.Loan.BookID]
+		if book.TotalCopies != 1 || book.AvailableCopies != 1 {
+			t.Errorf("LoanUseCase.DeclareLost() copies = %v/%v, want %v/%v", book.AvailableCopies, book.TotalCopies, 1, 1)
+		}
+		owed, _ := fineRepo.OutstandingCents(ctx, borrowed.Loan.UserID)
+		if owed != testLoanRules.Fines.ReplacementCostCents {
+			t.Errorf("LoanUseCase.DeclareLost() fine = %v, want %v", owed, testLoanRules.Fines.ReplacementCostCents)
+		}
+	})
+
+	t.Run("replacement cost waived", func(t *testing.T) {
+		loanUC, _, fineRepo, borrowed := createTestData()
+		none := int64(0)
+
+		if _, err := loanUC.DeclareLost(ctx, DeclareLostInput{LoanID: borrowed.Loan.ID, ReplacementCents: &none}); err != nil {
+			t.Fatalf("LoanUseCase.DeclareLost() unexpected error = %v", err)
+		}
+		if len(fineRepo.fines) != 0 {
+			t.Errorf("LoanUseCase.DeclareLost() fines = %v, want 0", len(fineRepo.fines))
+		}
+	})
+
+	t.Run("returned loan", func(t *testing.T) {
+		loanUC, _, _, borrowed := createTestData()
+		_, _ = loanUC.ReturnBook(ctx, borrowed.Loan.ID)
+
+		_, err := loanUC.DeclareLost(ctx, DeclareLostInput{LoanID: borrowed.Loan.ID})
+		if err != entity.ErrLoanAlreadyReturned {
+			t.Errorf("LoanUseCase.DeclareLost() error = %v, wantErr %v", err, entity.ErrLoanAlreadyReturned)
+		}
+	})
+
+	t.Run("non-existing loan", func(t *testing.T) {
+		loanUC, _, _, _ := createTestData()
+
+		_, err := loanUC.DeclareLost(ctx, DeclareLostInput{LoanID: uuid.New()})
+		if err != entity.ErrLoanNotFound {
+			t.Errorf("LoanUseCase.DeclareLost() error = %v, wantErr %v", err, entity.ErrLoanNotFound)
+		}
+	})
+}
+
+func TestLoanUseCase_List(t *testing.T) {
+	ctx := context.Background()
+
+	userRepo := newMockUserRepository()
+	bookRepo := newMockBookRepository()
+	copyRepo := newMockBookCopyRepository()
+	loanRepo := newMockLoanRepository()
+
+	userUC := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor())
+	bookUC := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour)
+
+	user, _ := userUC.Create(ctx, CreateUserInput{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+
+	book, _ := bookUC.Create(ctx, CreateBookInput{
+		Title:         "Clean Code",
+		Author:        "Robert C. Martin",
+		ISBN:          "9780132350884",
+		PublishedYear: 2008,
+		TotalCopies:   3,
+	})
+
+	loanUC := NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
+
+	_, _ = loanUC.BorrowBook(ctx, BorrowBookInput{
+		UserID: user.ID,
+		BookID: book.ID,
+	})
+
+	t.Run("list all loans", func(t *testing.T) {
+		loans, total, err := loanUC.List(ctx, 1, 10, repository.LoanFilter{})
+		if err != nil {
+			t.Errorf("LoanUseCase.List() unexpected error = %v", err)
+			return
+		}
+
+		if total != 1 {
+			t.Errorf("LoanUseCase.List() total = %v, want %v", total, 1)
+		}
+		if len(loans) != 1 {
+			t.Errorf("LoanUseCase.List() len = %v, want %v", len(loans), 1)
+		}
+	})
+
+	t.Run("list loans by user", func(t *testing.T) {
+		loans, total, err := loanUC.List(ctx, 1, 10, repository.LoanFilter{UserID: &user.ID})
+		if err != nil {
+			t.Errorf("LoanUseCase.List() unexpected error = %v", err)
+			return
+		}
+
+		if total != 1 {
+			t.Errorf("LoanUseCase.List() total = %v, want %v", total, 1)
+		}
+		if len(loans) != 1 {
+			t.Errorf("LoanUseCase.List() len = %v, want %v", len(loans), 1)
+		}
+	})
+
+	t.Run("list loans by status", func(t *testing.T) {
+		status := entity.LoanStatusActive
+		loans, total, err := loanUC.List(ctx, 1, 10, repository.LoanFilter{Status: &status})
+		if err != nil {
+			t.Errorf("LoanUseCase.List() unexpected error = %v", err)
+			return
+		}
+
+		if total != 1 {
+			t.Errorf("LoanUseCase.List() total = %v, want %v", total, 1)
+		}
+		if len(loans) != 1 {
+			t.Errorf("LoanUseCase.List() len = %v, want %v", len(loans), 1)
+		}
+	})
+}
+
+func TestLoanUseCase_CallerLoans(t *testing.T) {
+	ctx := context.Background()
+
+	userRepo := newMockUserRepository()
+	bookRepo := newMockBookRepository()
+	copyRepo := newMockBookCopyRepository()
+	loanRepo := newMockLoanRepository()
+
+	user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+	other, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+		Name:     "Jane Doe",
+		Email:    "jane@example.com",
+		Password: "password123",
+	})
+	book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+		Title:         "Clean Code",
+		Author:        "Robert C. Martin",
+		ISBN:          "9780132350884",
+		PublishedYear: 2008,
+		TotalCopies:   3,
+	})
+
+	loanUC := NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
+	callerCtx := ContextWithCaller(ctx, Caller{UserID: user.ID, Role: user.Role})
+
+	t.Run("borrow for caller", func(t *testing.T) {
+		loan, err := loanUC.BorrowForCaller(callerCtx, BorrowForCallerInput{BookID: book.ID})
+		if err != nil {
+			t.Errorf("LoanUseCase.BorrowForCaller() unexpected error = %v", err)
+			return
+		}
+
+		if loan.Loan.UserID != user.ID {
+			t.Errorf("LoanUseCase.BorrowForCaller() userID = %v, want %v", loan.Loan.UserID, user.ID)
+		}
+	})
+
+	t.Run("list only caller loans", func(t *testing.T) {
+		if _, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: other.ID, BookID: book.ID}); err != nil {
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+
+		loans, total, err := loanUC.ListCallerLoans(callerCtx, 1, 10, nil)
+		if err != nil {
+			t.Errorf("LoanUseCase.ListCallerLoans() unexpected error = %v", err)
+			return
+		}
+
+		if total != 1 {
+			t.Errorf("LoanUseCase.ListCallerLoans() total = %v, want %v", total, 1)
+		}
+		for _, loan := range loans {
+			if loan.Loan.UserID != user.ID {
+				t.Errorf("LoanUseCase.ListCallerLoans() userID = %v, want %v", loan.Loan.UserID, user.ID)
+			}
+		}
+	})
+
+	t.Run("without caller", func(t *testing.T) {
+		_, err := loanUC.BorrowForCaller(ctx, BorrowForCallerInput{BookID: book.ID})
+		if err != ErrUnauthenticated {
+			t.Errorf("LoanUseCase.BorrowForCaller() error = %v, wantErr %v", err, ErrUnauthenticated)
+		}
+
+		_, _, err = loanUC.ListCallerLoans(ctx, 1, 10, nil)
+		if err != ErrUnauthenticated {
+			t.Errorf("LoanUseCase.ListCallerLoans() error = %v, wantErr %v", err, ErrUnauthenticated)
+		}
+	})
+}
+
+func TestLoanUseCase_RenewLoan(t *testing.T) {
+	ctx := context.Background()
+
+	createTestData := func() (LoanUseCase, *mockHoldRepository, *entity.Loan) {
+		userRepo := newMockUserRepository()
+		bookRepo := newMockBookRepository()
+		copyRepo := newMockBookCopyRepository()
+		loanRepo := newMockLoanRepository()
+		holds := newMockHoldRepository()
+
+		user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+			Name:     "John Doe",
+			Email:    "john@example.com",
+			Password: "password123",
+		})
+		book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+			Title:         "Clean Code",
+			Author:        "Robert C. Martin",
+			ISBN:          "9780132350884",
+			PublishedYear: 2008,
+			TotalCopies:   3,
+		})
+
+		loanUC := NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, holds, newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
+		loan, _ := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		return loanUC, holds, loan.Loan
+	}
+
+	t.Run("successful renewal", func(t *testing.T) {
+		loanUC, _, loan := createTestData()
+		dueDate := loan.DueDate
+
+		renewed, err := loanUC.RenewLoan(ctx, loan.ID)
+		if err != nil {
+			t.Errorf("LoanUseCase.RenewLoan() unexpected error = %v", err)
+			return
+		}
+
+		if renewed.Loan.RenewalCount != 1 {
+			t.Errorf("LoanUseCase.RenewLoan() RenewalCount = %v, want %v", renewed.Loan.RenewalCount, 1)
+		}
+		if !renewed.Loan.DueDate.After(dueDate) {
+			t.Errorf("LoanUseCase.RenewLoan() DueDate = %v, want after %v", renewed.Loan.DueDate, dueDate)
+		}
+	})
+
+	t.Run("max renewals reached", func(t *testing.T) {
+		loanUC, _, loan := createTestData()
+
+		for i := 0; i < testLoanRules.MaxRenewals; i++ {
+			if _, err := loanUC.RenewLoan(ctx, loan.ID); err != nil {
+				t.Fatalf("LoanUseCase.RenewLoan() unexpected error = %v", err)
+			}
+		}
+
+		_, err := loanUC.RenewLoan(ctx, loan.ID)
+		if err != entity.ErrMaxRenewalsReached {
+			t.Errorf("LoanUseCase.RenewLoan() error = %v, want %v", err, entity.ErrMaxRenewalsReached)
+		}
+	})
+
+	t.Run("overdue beyond grace period", func(t *testing.T) {
+		loanUC, _, loan := createTestData()
+		loan.DueDate = time.Now().AddDate(0, 0, -2)
+
+		_, err := loanUC.RenewLoan(ctx, loan.ID)
+		if err != entity.ErrLoanTooOverdue {
+			t.Errorf("LoanUseCase.RenewLoan() error = %v, want %v", err, entity.ErrLoanTooOverdue)
+		}
+	})
+
+	t.Run("pending hold by another user", func(t *testing.T) {
+		loanUC, holds, loan := createTestData()
+		_ = holds.Create(ctx, entity.NewHold(uuid.New(), loan.BookID))
+
+		_, err := loanUC.RenewLoan(ctx, loan.ID)
+		if err != entity.ErrBookHasPendingHolds {
+			t.Errorf("LoanUseCase.RenewLoan() error = %v, want %v", err, entity.ErrBookHasPendingHolds)
+		}
+	})
+
+	t.Run("loan not found", func(t *testing.T) {
+		loanUC, _, _ := createTestData()
+
+		_, err := loanUC.RenewLoan(ctx, uuid.New())
+		if err != entity.ErrLoanNotFound {
+			t.Errorf("LoanUseCase.RenewLoan() error = %v, want %v", err, entity.ErrLoanNotFound)
+		}
+	})
+}
+
+func TestLoanUseCase_MarkOverdueLoans(t *testing.T) {
+	ctx := context.Background()
+
+	loanRepo := newMockLoanRepository()
+	events, outbox := newTestOutbox()
+	auditor := newMockAuditor()
+	loanUC := NewLoanUseCase(loanRepo, newMockBookRepository(), newMockBookCopyRepository(), newMockUserRepository(), newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), events, auditor, testLoanRules)
+
+	late, _ := entity.NewLoan(uuid.New(), uuid.New(), nil)
+	late.DueDate = time.Now().Add(-time.Hour)
+	onTime, _ := entity.NewLoan(uuid.New(), uuid.New(), nil)
+	returned, _ := entity.NewLoan(uuid.New(), uuid.New(), nil)
+	returned.DueDate = time.Now().Add(-time.Hour)
+	_ = returned.Return()
+
+	for _, loan := range []*entity.Loan{late, onTime, returned} {
+		_ = loanRepo.Create(ctx, loan)
+	}
+
+	marked, err := loanUC.MarkOverdueLoans(ctx)
+	if err != nil {
+		t.Fatalf("LoanUseCase.MarkOverdueLoans() unexpected error = %v", err)
+	}
+
+	if marked != 1 {
+		t.Errorf("LoanUseCase.MarkOverdueLoans() = %v, want 1", marked)
+	}
+	if late.Status != entity.LoanStatusOverdue {
+		t.Errorf("LoanUseCase.MarkOverdueLoans() late status = %v, want %v", late.Status, entity.LoanStatusOverdue)
+	}
+	if onTime.Status != entity.LoanStatusActive {
+		t.Errorf("LoanUseCase.MarkOverdueLoans() on-time status = %v, want %v", onTime.Status, entity.LoanStatusActive)
+	}
+	if returned.Status != entity.LoanStatusReturned {
+		t.Errorf("LoanUseCase.MarkOverdueLoans() returned status = %v, want %v", returned.Status, entity.LoanStatusReturned)
+	}
+	if got := auditor.actions(); !slices.Equal(got, []string{entity.AuditLoanOverdue}) {
+		t.Fatalf("LoanUseCase.MarkOverdueLoans() audit = %v, want %v", got, []string{entity.AuditLoanOverdue})
+	}
+	record := auditor.records[0]
+	if record.EntityID != late.ID {
+		t.Errorf("LoanUseCase.MarkOverdueLoans() audit entity = %v, want %v", record.EntityID, late.ID)
+	}
+	if before := record.Before.(*loanAuditState); before.Status != entity.LoanStatusActive {
+		t.Errorf("LoanUseCase.MarkOverdueLoans() audit before status = %v, want %v", before.Status, entity.LoanStatusActive)
+	}
+	if got := outbox.types(); !slices.Equal(got, []entity.EventType{entity.EventLoanOverdue}) {
+		t.Errorf("LoanUseCase.MarkOverdueLoans() outbox = %v, want %v", got, []entity.EventType{entity.EventLoanOverdue})
+	}
+}
+
+func TestLoanUseCase_Fines(t *testing.T) {
+	ctx := context.Background()
+
+	createTestData := func() (LoanUseCase, *mockFineRepository, *entity.User, *entity.Book) {
+		userRepo := newMockUserRepository()
+		bookRepo := newMockBookRepository()
+		copyRepo := newMockBookCopyRepository()
+		fineRepo := newMockFineRepository()
+
+		user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+			Name:     "John Doe",
+			Email:    "john@example.com",
+			Password: "password123",
+		})
+		book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+			Title:         "Clean Code",
+			Author:        "Robert C. Martin",
+			ISBN:          "9780132350884",
+			PublishedYear: 2008,
+			TotalCopies:   3,
+		})
+
+		loanUC := NewLoanUseCase(newMockLoanRepository(), bookRepo, copyRepo, userRepo, newMockHoldRepository(), fineRepo, newMockLoanPolicyRepository(), newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
+		return loanUC, fineRepo, user, book
+	}
+
+	t.Run("overdue return charges a fine per day", func(t *testing.T) {
+		loanUC, fineRepo, user, book := createTestData()
+
+		borrowed, _ := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		// Any started day counts, so this is 3 days late
+		borrowed.Loan.DueDate = time.Now().AddDate(0, 0, -3).Add(time.Hour)
+
+		if _, err := loanUC.ReturnBook(ctx, borrowed.Loan.ID); err != nil {
+			t.Fatalf("LoanUseCase.ReturnBook() unexpected error = %v", err)
+		}
+
+		fines, _, _ := fineRepo.List(ctx, 1, 10, repository.FineFilter{UserID: &user.ID})
+		if len(fines) != 1 {
+			t.Fatalf("LoanUseCase.ReturnBook() fines = %v, want 1", len(fines))
+		}
+		if fines[0].AmountCents != 300 {
+			t.Errorf("LoanUseCase.ReturnBook() fine = %v, want 300", fines[0].AmountCents)
+		}
+		if fines[0].LoanID != borrowed.Loan.ID {
+			t.Errorf("LoanUseCase.ReturnBook() fine loan = %v, want %v", fines[0].LoanID, borrowed.Loan.ID)
+		}
+	})
+
+	t.Run("fine is capped", func(t *testing.T) {
+		loanUC, fineRepo, user, book := createTestData()
+
+		borrowed, _ := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		borrowed.Loan.DueDate = time.Now().AddDate(0, 0, -60)
+
+		_, _ = loanUC.ReturnBook(ctx, borrowed.Loan.ID)
+
+		owed, _ := fineRepo.OutstandingCents(ctx, user.ID)
+		if owed != testLoanRules.Fines.MaxAmountCents {
+			t.Errorf("LoanUseCase.ReturnBook() fine = %v, want %v", owed, testLoanRules.Fines.MaxAmountCents)
+		}
+	})
+
+	t.Run("on-time return charges nothing", func(t *testing.T) {
+		loanUC, fineRepo, user, book := createTestData()
+
+		borrowed, _ := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		_, _ = loanUC.ReturnBook(ctx, borrowed.Loan.ID)
+
+		if len(fineRepo.fines) != 0 {
+			t.Errorf("LoanUseCase.ReturnBook() fines = %v, want 0", len(fineRepo.fines))
+		}
+	})
+
+	t.Run("balance above threshold blocks borrowing", func(t *testing.T) {
+		loanUC, fineRepo, user, book := createTestData()
+		_ = fineRepo.Create(ctx, entity.NewFine(user.ID, uuid.New(), entity.FineReasonOverdue, testLoanRules.Fines.BlockThresholdCents+1))
+
+		_, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		if err != entity.ErrOutstandingFines {
+			t.Errorf("LoanUseCase.BorrowBook() error = %v, want %v", err, entity.ErrOutstandingFines)
+		}
+	})
+
+	t.Run("balance at threshold still borrows", func(t *testing.T) {
+		loanUC, fineRepo, user, book := createTestData()
+		_ = fineRepo.Create(ctx, entity.NewFine(user.ID, uuid.New(), entity.FineReasonOverdue, testLoanRules.Fines.BlockThresholdCents))
+
+		_, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		if err != nil {
+			t.Errorf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+	})
+}
+
+func TestLoanUseCase_LoanPolicies(t *testing.T) {
+	ctx := context.Background()
+
+	createTestData := func() (LoanUseCase, *mockLoanPolicyRepository, *entity.User, BookUseCase) {
+		userRepo := newMockUserRepository()
+		bookRepo := newMockBookRepository()
+		copyRepo := newMockBookCopyRepository()
+		policyRepo := newMockLoanPolicyRepository()
+
+		user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+			Name:     "John Doe",
+			Email:    "john@example.com",
+			Password: "password123",
+			Category: "student",
+		})
+
+		loanUC := NewLoanUseCase(newMockLoanRepository(), bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), policyRepo, newMockCalendarRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
+		return loanUC, policyRepo, user, NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour)
+	}
+
+	createBook := func(bookUC BookUseCase, isbn, category string) *entity.Book {
+		book, _ := bookUC.Create(ctx, CreateBookInput{
+			Title:       "Clean Code",
+			Author:      "Robert C. Martin",
+			ISBN:        isbn,
+			TotalCopies: 3,
+			Category:    category,
+		})
+		return book
+	}
+
+	addPolicy := func(policyRepo *mockLoanPolicyRepository, patron, item string, maxLoans, loanDays, maxRenewals int) {
+		policy, _ := entity.NewLoanPolicy(patron, item, maxLoans, loanDays, maxRenewals)
+		_ = policyRepo.Create(ctx, policy)
+	}
+
+	t.Run("due date comes from the policy", func(t *testing.T) {
+		loanUC, policyRepo, user, bookUC := createTestData()
+		addPolicy(policyRepo, "student", entity.AnyCategory, 3, 7, 1)
+		book := createBook(bookUC, "9780132350884", "")
+
+		loan, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		if err != nil {
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+
+		want := time.Now().AddDate(0, 0, 7)
+		if loan.Loan.DueDate.Sub(want).Abs() > time.Minute {
+			t.Errorf("LoanUseCase.BorrowBook() DueDate = %v, want %v", loan.Loan.DueDate, want)
+		}
+	})
+
+	t.Run("defaults apply when no policy matches", func(t *testing.T) {
+		loanUC, policyRepo, user, bookUC := createTestData()
+		addPolicy(policyRepo, "faculty", entity.AnyCategory, 10, 60, 5)
+		book := createBook(bookUC, "9780132350884", "")
+
+		loan, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		if err != nil {
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+
+		want := time.Now().AddDate(0, 0, testLoanRules.LoanDays)
+		if loan.Loan.DueDate.Sub(want).Abs() > time.Minute {
+			t.Errorf("LoanUseCase.BorrowBook() DueDate = %v, want %v", loan.Loan.DueDate, want)
+		}
+	})
+
+	t.Run("limit reached", func(t *testing.T) {
+		loanUC, policyRepo, user, bookUC := createTestData()
+		addPolicy(policyRepo, "student", entity.AnyCategory, 1, 7, 1)
+		first := createBook(bookUC, "9780132350884", "")
+		second := createBook(bookUC, "9780201633610", "")
+
+		if _, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: first.ID}); err != nil {
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+
+		_, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: second.ID})
+		if err != entity.ErrLoanLimitReached {
+			t.Errorf("LoanUseCase.BorrowBook() error = %v, want %v", err, entity.ErrLoanLimitReached)
+		}
+	})
+
+	t.Run("item category policy wins over the patron wildcard", func(t *testing.T) {
+		loanUC, policyRepo, user, bookUC := createTestData()
+		addPolicy(policyRepo, "student", entity.AnyCategory, 3, 7, 1)
+		addPolicy(policyRepo, "student", "reference", 0, 1, 0)
+		book := createBook(bookUC, "9780132350884", "reference")
+
+		_, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		if err != entity.ErrLoanLimitReached {
+			t.Errorf("LoanUseCase.BorrowBook() error = %v, want %v", err, entity.ErrLoanLimitReached)
+		}
+	})
+
+	t.Run("renewals follow the policy", func(t *testing.T) {
+		loanUC, policyRepo, user, bookUC := createTestData()
+		addPolicy(policyRepo, "student", entity.AnyCategory, 3, 7, 1)
+		book := createBook(bookUC, "9780132350884", "")
+
+		loan, _ := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		dueDate := loan.Loan.DueDate
+
+		renewed, err := loanUC.RenewLoan(ctx, loan.Loan.ID)
+		if err != nil {
+			t.Fatalf("LoanUseCase.RenewLoan() unexpected error = %v", err)
+		}
+		if !renewed.Loan.DueDate.Equal(dueDate.AddDate(0, 0, 7)) {
+			t.Errorf("LoanUseCase.RenewLoan() DueDate = %v, want %v", renewed.Loan.DueDate, dueDate.AddDate(0, 0, 7))
+		}
+
+		_, err = loanUC.RenewLoan(ctx, loan.Loan.ID)
+		if err != entity.ErrMaxRenewalsReached {
+			t.Errorf("LoanUseCase.RenewLoan() error = %v, want %v", err, entity.ErrMaxRenewalsReached)
+		}
+	})
+}
+
+type circulationTestData struct {
+	loanUC   LoanUseCase
+	holdUC   HoldUseCase
+	copyRepo *mockBookCopyRepository
+	fineRepo *mockFineRepository
+	book     *entity.Book
+	users    []*entity.User
+}
+
+// newCirculationTestData creates a book with the given number of copies,
+// barcoded 9780132350884-001 onwards, and three patrons.
+func newCirculationTestData(t *testing.T, totalCopies int) *circulationTestData {
+	ctx := context.Background()
+
+	userRepo := newMockUserRepository()
+	bookRepo := newMockBookRepository()
+	copyRepo := newMockBookCopyRepository()
+	loanRepo := newMockLoanRepository()
+	holdRepo := newMockHoldRepository()
+	fineRepo := newMockFineRepository()
+	txManager := newMockTxManager()
+
+	users := make([]*entity.User, 3)
+	for i, email := range []string{"john@example.com", "jane@example.com", "mary@example.com"} {
+		users[i], _ = NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+			Name:     "Patron",
+			Email:    email,
+			Password: "password123",
+		})
+	}
+
+	book, err := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), txManager, newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+		Title:         "Clean Code",
+		Author:        "Robert C. Martin",
+		ISBN:          "9780132350884",
+		PublishedYear: 2008,
+		TotalCopies:   totalCopies,
+	})
+	if err != nil {
+		t.Fatalf("BookUseCase.Create() unexpected error = %v", err)
+	}
+
+	return &circulationTestData{
+		loanUC:   NewLoanUseCase(loanRepo, bookRepo, copyRepo, userRepo, holdRepo, fineRepo, newMockLoanPolicyRepository(), newMockCalendarRepository(), txManager, newMockEventEmitter(), newMockAuditor(), testLoanRules),
+		holdUC:   NewHoldUseCase(holdRepo, bookRepo, copyRepo, userRepo, loanRepo, txManager, newMockEventEmitter(), newMockAuditor(), testLoanRules.HoldPickupWindow),
+		copyRepo: copyRepo,
+		fineRepo: fineRepo,
+		book:     book,
+		users:    users,
+	}
+}
+
+func TestLoanUseCase_CheckOut(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lends the scanned copy", func(t *testing.T) {
+		data := newCirculationTestData(t, 2)
+
+		loan, err := data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[0].CardNumber, Barcode: "9780132350884-002"})
+		if err != nil {
+			t.Fatalf("LoanUseCase.CheckOut() unexpected error = %v", err)
+		}
+
+		scanned, _ := data.copyRepo.GetByBarcode(ctx, "9780132350884-002")
+		if loan.Loan.CopyID == nil || *loan.Loan.CopyID != scanned.ID {
+			t.Errorf("LoanUseCase.CheckOut() copy = %v, want %v", loan.Loan.CopyID, scanned.ID)
+		}
+		if scanned.Status != entity.CopyStatusOnLoan {
+			t.Errorf("LoanUseCase.CheckOut() copy status = %v, want %v", scanned.Status, entity.CopyStatusOnLoan)
+		}
+		if data.book.AvailableCopies != 1 {
+			t.Errorf("LoanUseCase.CheckOut() availableCopies = %v, want %v", data.book.AvailableCopies, 1)
+		}
+	})
+
+	t.Run("unknown card", func(t *testing.T) {
+		data := newCirculationTestData(t, 1)
+
+		_, err := data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: "NO-SUCH-CARD", Barcode: "9780132350884-001"})
+		if err != entity.ErrUserNotFound {
+			t.Errorf("LoanUseCase.CheckOut() error = %v, want %v", err, entity.ErrUserNotFound)
+		}
+	})
+
+	t.Run("unknown barcode", func(t *testing.T) {
+		data := newCirculationTestData(t, 1)
+
+		_, err := data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[0].CardNumber, Barcode: "UNKNOWN"})
+		if err != entity.ErrBookCopyNotFound {
+			t.Errorf("LoanUseCase.CheckOut() error = %v, want %v", err, entity.ErrBookCopyNotFound)
+		}
+	})
+
+	t.Run("copy already on loan", func(t *testing.T) {
+		data := newCirculationTestData(t, 2)
+		_, _ = data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[0].CardNumber, Barcode: "9780132350884-001"})
+
+		_, err := data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[1].CardNumber, Barcode: "9780132350884-001"})
+		if err != entity.ErrCopyNotAvailable {
+			t.Errorf("LoanUseCase.CheckOut() error = %v, want %v", err, entity.ErrCopyNotAvailable)
+		}
+	})
+
+	t.Run("copy set aside goes only to a ready hold", func(t *testing.T) {
+		data := newCirculationTestData(t, 1)
+		_, _ = data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[0].CardNumber, Barcode: "9780132350884-001"})
+		hold, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+		if _, err := data.loanUC.CheckIn(ctx, CheckInInput{Barcode: "9780132350884-001"}); err != nil {
+			t.Fatalf("LoanUseCase.CheckIn() unexpected error = %v", err)
+		}
+
+		_, err := data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[2].CardNumber, Barcode: "9780132350884-001"})
+		if err != entity.ErrCopyNotAvailable {
+			t.Errorf("LoanUseCase.CheckOut() error = %v, want %v", err, entity.ErrCopyNotAvailable)
+		}
+
+		if _, err := data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[1].CardNumber, Barcode: "9780132350884-001"}); err != nil {
+			t.Fatalf("LoanUseCase.CheckOut() unexpected error = %v", err)
+		}
+		if hold.Hold.Status != entity.HoldStatusFulfilled {
+			t.Errorf("LoanUseCase.CheckOut() hold status = %v, want %v", hold.Hold.Status, entity.HoldStatusFulfilled)
+		}
+	})
+
+	t.Run("each ready hold gets the copy set aside for it", func(t *testing.T) {
+		data := newCirculationTestData(t, 2)
+		_, _ = data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[0].CardNumber, Barcode: "9780132350884-001"})
+		_, _ = data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[1].CardNumber, Barcode: "9780132350884-002"})
+		_, _ = data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[2].ID, BookID: data.book.ID})
+		_, _ = data.loanUC.CheckIn(ctx, CheckInInput{Barcode: "9780132350884-002"})
+		_, _ = data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+		_, _ = data.loanUC.CheckIn(ctx, CheckInInput{Barcode: "9780132350884-001"})
+
+		_, err := data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[2].CardNumber, Barcode: "9780132350884-001"})
+		if err != entity.ErrCopyNotAvailable {
+			t.Errorf("LoanUseCase.CheckOut() error = %v, want %v", err, entity.ErrCopyNotAvailable)
+		}
+
+		loan, err := data.loanUC.BorrowBook(ctx, BorrowBookInput{UserID: data.users[2].ID, BookID: data.book.ID})
+		if err != nil {
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+		setAside, _ := data.copyRepo.GetByBarcode(ctx, "9780132350884-002")
+		if loan.Loan.CopyID == nil || *loan.Loan.CopyID != setAside.ID {
+			t.Errorf("LoanUseCase.BorrowBook() copy = %v, want %v", loan.Loan.CopyID, setAside.ID)
+		}
+		other, _ := data.copyRepo.GetByBarcode(ctx, "9780132350884-001")
+		if other.Status != entity.CopyStatusOnHold {
+			t.Errorf("LoanUseCase.BorrowBook() other set-aside copy status = %v, want %v", other.Status, entity.CopyStatusOnHold)
+		}
+	})
+
+	t.Run("ready hold patron taking a shelf copy frees the set-aside one", func(t *testing.T) {
+		data := newCirculationTestData(t, 1)
+		_, _ = data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[0].CardNumber, Barcode: "9780132350884-001"})
+		first, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[1].ID, BookID: data.book.ID})
+		second, _ := data.holdUC.PlaceHold(ctx, PlaceHoldInput{UserID: data.users[2].ID, BookID: data.book.ID})
+		_, _ = data.loanUC.CheckIn(ctx, CheckInInput{Barcode: "9780132350884-001"})
+
+		shelved, _ := entity.NewBookCopy(data.book.ID, uuid.New(), "9780132350884-002", "", "")
+		_ = data.copyRepo.Create(ctx, shelved)
+
+		if _, err := data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[1].CardNumber, Barcode: shelved.Barcode}); err != nil {
+			t.Fatalf("LoanUseCase.CheckOut() unexpected error = %v", err)
+		}
+
+		if first.Hold.Status != entity.HoldStatusFulfilled {
+			t.Errorf("LoanUseCase.CheckOut() first hold status = %v, want %v", first.Hold.Status, entity.HoldStatusFulfilled)
+		}
+		if !second.Hold.IsReady() {
+			t.Errorf("LoanUseCase.CheckOut() second hold status = %v, want %v", second.Hold.Status, entity.HoldStatusReady)
+		}
+		setAside, _ := data.copyRepo.GetByBarcode(ctx, "9780132350884-001")
+		if setAside.Status != entity.CopyStatusOnHold {
+			t.Errorf("LoanUseCase.CheckOut() set-aside copy status = %v, want %v", setAside.Status, entity.CopyStatusOnHold)
+		}
+	})
+}
+
+func TestLoanUseCase_CheckIn(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the loan of the scanned copy", func(t *testing.T) {
+		data := newCirculationTestData(t, 1)
+		_, _ = data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[0].CardNumber, Barcode: "9780132350884-001"})
+
+		returned, err := data.loanUC.CheckIn(ctx, CheckInInput{Barcode: "9780132350884-001"})
+		if err != nil {
+			t.Fatalf("LoanUseCase.CheckIn() unexpected error = %v", err)
+		}
+
+		if returned.Loan.Status != entity.LoanStatusReturned {
+			t.Errorf("LoanUseCase.CheckIn() status = %v, want %v", returned.Loan.Status, entity.LoanStatusReturned)
+		}
+		if data.book.AvailableCopies != 1 {
+			t.Errorf("LoanUseCase.CheckIn() availableCopies = %v, want %v", data.book.AvailableCopies, 1)
+		}
+	})
+
+	t.Run("backdated return is not fined", func(t *testing.T) {
+		data := newCirculationTestData(t, 1)
+		borrowed, _ := data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[0].CardNumber, Barcode: "9780132350884-001"})
+		borrowed.Loan.BorrowedAt = time.Now().AddDate(0, 0, -20)
+		borrowed.Loan.DueDate = time.Now().AddDate(0, 0, -3)
+		borrowed.Loan.Status = entity.LoanStatusOverdue
+
+		droppedAt := time.Now().AddDate(0, 0, -4)
+		returned, err := data.loanUC.CheckIn(ctx, CheckInInput{Barcode: "9780132350884-001", ReturnedAt: &droppedAt})
+		if err != nil {
+			t.Fatalf("LoanUseCase.CheckIn() unexpected error = %v", err)
+		}
+
+		if !returned.Loan.ReturnedAt.Equal(droppedAt) {
+			t.Errorf("LoanUseCase.CheckIn() returnedAt = %v, want %v", returned.Loan.ReturnedAt, droppedAt)
+		}
+		if len(data.fineRepo.fines) != 0 {
+			t.Errorf("LoanUseCase.CheckIn() fines = %v, want %v", len(data.fineRepo.fines), 0)
+		}
+	})
+
+	t.Run("return date in the future", func(t *testing.T) {
+		data := newCirculationTestData(t, 1)
+		_, _ = data.loanUC.CheckOut(ctx, CheckOutInput{CardNumber: data.users[0].CardNumber, Barcode: "9780132350884-001"})
+
+		tomorrow := time.Now().AddDate(0, 0, 1)
+		_, err := data.loanUC.CheckIn(ctx, CheckInInput{Barcode: "9780132350884-001", ReturnedAt: &tomorrow})
+		if err != entity.ErrInvalidReturnDate {
+			t.Errorf("LoanUseCase.CheckIn() error = %v, want %v", err, entity.ErrInvalidReturnDate)
+		}
+	})
+
+	t.Run("copy not on loan", func(t *testing.T) {
+		data := newCirculationTestData(t, 1)
+
+		_, err := data.loanUC.CheckIn(ctx, CheckInInput{Barcode: "9780132350884-001"})
+		if err != entity.ErrCopyNotOnLoan {
+			t.Errorf("LoanUseCase.CheckIn() error = %v, want %v", err, entity.ErrCopyNotOnLoan)
+		}
+	})
+}
+
+func TestLoanUseCase_DueDateFollowsCalendar(t *testing.T) {
+	ctx := context.Background()
+
+	// createTestData opens the book's branch only on the weekday of day,
+	// until 17:00.
+	createTestData := func(day time.Time) (LoanUseCase, *entity.User, *entity.Book) {
+		userRepo := newMockUserRepository()
+		bookRepo := newMockBookRepository()
+		copyRepo := newMockBookCopyRepository()
+		calendarRepo := newMockCalendarRepository()
+
+		user, _ := NewUserUseCase(userRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor()).Create(ctx, CreateUserInput{
+			Name:     "John Doe",
+			Email:    "john@example.com",
+			Password: "password123",
+		})
+		book, _ := NewBookUseCase(bookRepo, copyRepo, newMockBranchRepository(), newMockLoanRepository(), newMockHoldRepository(), newMockTxManager(), newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+			Title:         "Clean Code",
+			Author:        "Robert C. Martin",
+			ISBN:          "9780132350884",
+			PublishedYear: 2008,
+			TotalCopies:   1,
+		})
+		copies, _ := copyRepo.ListByBook(ctx, book.ID)
+		hours, _ := entity.NewOpeningHours(copies[0].BranchID, day.Weekday(), "09:00", "17:00")
+		_ = calendarRepo.ReplaceOpeningHours(ctx, copies[0].BranchID, []*entity.OpeningHours{hours})
+
+		loanUC := NewLoanUseCase(newMockLoanRepository(), bookRepo, copyRepo, userRepo, newMockHoldRepository(), newMockFineRepository(), newMockLoanPolicyRepository(), calendarRepo, newMockTxManager(), newMockEventEmitter(), newMockAuditor(), testLoanRules)
+		return loanUC, user, book
+	}
+	closingTime := func(day time.Time) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), 17, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("borrow rolls forward to the next open day", func(t *testing.T) {
+		openDay := time.Now().UTC().AddDate(0, 0, testLoanRules.LoanDays+1)
+		loanUC, user, book := createTestData(openDay)
+
+		loan, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+		if err != nil {
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+
+		if !loan.Loan.DueDate.Equal(closingTime(openDay)) {
+			t.Errorf("LoanUseCase.BorrowBook() DueDate = %v, want %v", loan.Loan.DueDate, closingTime(openDay))
+		}
+	})
+
+	t.Run("due date given by staff rolls forward to the next open day", func(t *testing.T) {
+		openDay := time.Now().UTC().AddDate(0, 0, 4)
+		loanUC, user, book := createTestData(openDay)
+		dueDate := time.Now().AddDate(0, 0, 3)
+
+		loan, err := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID, DueDate: &dueDate})
+		if err != nil {
+			t.Fatalf("LoanUseCase.BorrowBook() unexpected error = %v", err)
+		}
+
+		if !loan.Loan.DueDate.Equal(closingTime(openDay)) {
+			t.Errorf("LoanUseCase.BorrowBook() DueDate = %v, want %v", loan.Loan.DueDate, closingTime(openDay))
+		}
+	})
+
+	t.Run("renewal rolls forward to the next open day", func(t *testing.T) {
+		openDay := time.Now().UTC().AddDate(0, 0, testLoanRules.LoanDays)
+		loanUC, user, book := createTestData(openDay)
+		loan, _ := loanUC.BorrowBook(ctx, BorrowBookInput{UserID: user.ID, BookID: book.ID})
+
+		renewed, err := loanUC.RenewLoan(ctx, loan.Loan.ID)
+		if err != nil {
+			t.Fatalf("LoanUseCase.RenewLoan() unexpected error = %v", err)
+		}
+
+		// Another loan period of two weeks lands on the same weekday, which
+		// is open.
+		want := closingTime(openDay.AddDate(0, 0, 14))
+		if !renewed.Loan.DueDate.Equal(want) {
+			t.Errorf("LoanUseCase.RenewLoan() DueDate = %v, want %v", renewed.Loan.DueDate, want)
 		}
 	})
 }
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// EventPublisher hands domain events on to the systems outside BookHub.
+// The outbox relay calls it at least once per event, and in order for the
+// events of one aggregate; publishers must tolerate seeing an event twice.
+type EventPublisher interface {
+	Publish(ctx context.Context, event *entity.Event) error
+}
+
+type fanOutPublisher struct {
+	publishers []EventPublisher
+}
+
+// NewFanOutPublisher publishes each event to every one of publishers, e.g.
+// the webhooks and a message broker. A failure does not keep the event from
+// the others, but it is reported, and the relay then publishes the event to
+// all of them again.
+func NewFanOutPublisher(publishers ...EventPublisher) EventPublisher {
+	return &fanOutPublisher{
+		publishers: publishers,
+	}
+}
+
+func (p *fanOutPublisher) Publish(ctx context.Context, event *entity.Event) error {
+	var errs []error
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// OutboxUseCase is the EventEmitter the other use cases write their events
+// to. Emitted events wait in the outbox until Relay publishes them.
+type OutboxUseCase interface {
+	EventEmitter
+
+	// Relay publishes the pending events and returns how many were
+	// published.
+	Relay(ctx context.Context) (int, error)
+	// Purge removes the events published longer than the retention ago and
+	// returns how many were removed.
+	Purge(ctx context.Context) (int, error)
+}
+
+// OutboxRules holds the configurable relay limits.
+type OutboxRules struct {
+	// BatchSize is how many aggregates one Relay run publishes.
+	BatchSize int
+	// Lease is how long a run may take before another relay may publish
+	// the same aggregates again.
+	Lease time.Duration
+	// RetryDelay is how long an aggregate waits after the publisher refused
+	// one of its events.
+	RetryDelay time.Duration
+	// Retention is how long published events are kept.
+	Retention time.Duration
+}
+
+type outboxUseCase struct {
+	outboxRepo repository.OutboxRepository
+	publisher  EventPublisher
+	rules      OutboxRules
+}
+
+func NewOutboxUseCase(outboxRepo repository.OutboxRepository, publisher EventPublisher, rules OutboxRules) OutboxUseCase {
+	return &outboxUseCase{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		rules:      rules,
+	}
+}
+
+// Emit appends the event to the outbox through ctx, so it is kept only if
+// the caller's transaction commits.
+func (uc *outboxUseCase) Emit(ctx context.Context, event *entity.Event) error {
+	message, err := entity.NewOutboxMessage(event)
+	if err != nil {
+		return err
+	}
+	return uc.outboxRepo.Append(ctx, message)
+}
+
+func (uc *outboxUseCase) Relay(ctx context.Context) (int, error) {
+	now := time.Now()
+	messages, err := uc.outboxRepo.ClaimPending(ctx, now, now.Add(uc.rules.Lease), uc.rules.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	held := make(map[uuid.UUID]bool)
+	var errs []error
+	for _, message := range messages {
+		// A later event must not overtake one the publisher refused; the
+		// rest of the aggregate waits for the retry.
+		if held[message.AggregateID] {
+			continue
+		}
+
+		if err := uc.publisher.Publish(ctx, message.Event()); err != nil {
+			held[message.AggregateID] = true
+			message.Fail(err.Error(), time.Now().Add(uc.rules.RetryDelay))
+		} else {
+			message.Publish(time.Now())
+			published++
+		}
+
+		if err := uc.outboxRepo.Update(ctx, message); err != nil {
+			// Left unpublished, the event goes out again after the lease.
+			held[message.AggregateID] = true
+			errs = append(errs, err)
+		}
+	}
+
+	return published, errors.Join(errs...)
+}
+
+func (uc *outboxUseCase) Purge(ctx context.Context) (int, error) {
+	return uc.outboxRepo.DeletePublishedBefore(ctx, time.Now().Add(-uc.rules.Retention))
+}
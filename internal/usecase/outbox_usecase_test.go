@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type mockEventEmitter struct {
+	events []*entity.Event
+}
+
+func newMockEventEmitter() *mockEventEmitter {
+	return &mockEventEmitter{}
+}
+
+func (m *mockEventEmitter) Emit(ctx context.Context, event *entity.Event) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *mockEventEmitter) types() []entity.EventType {
+	types := make([]entity.EventType, len(m.events))
+	for i, event := range m.events {
+		types[i] = event.Type
+	}
+	return types
+}
+
+// mockOutboxRepository keeps the outbox in append order.
+type mockOutboxRepository struct {
+	messages []*entity.OutboxMessage
+}
+
+func newMockOutboxRepository() *mockOutboxRepository {
+	return &mockOutboxRepository{}
+}
+
+func (m *mockOutboxRepository) Append(ctx context.Context, message *entity.OutboxMessage) error {
+	message.Position = int64(len(m.messages) + 1)
+	m.messages = append(m.messages, message)
+	return nil
+}
+
+func (m *mockOutboxRepository) types() []entity.EventType {
+	types := make([]entity.EventType, len(m.messages))
+	for i, message := range m.messages {
+		types[i] = message.EventType
+	}
+	return types
+}
+
+func (m *mockOutboxRepository) ClaimPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.OutboxMessage, error) {
+	var aggregates []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, message := range m.messages {
+		if message.IsPublished() || seen[message.AggregateID] {
+			continue
+		}
+		seen[message.AggregateID] = true
+		if message.LockedUntil == nil || !message.LockedUntil.After(now) {
+			aggregates = append(aggregates, message.AggregateID)
+		}
+	}
+	if len(aggregates) > limit {
+		aggregates = aggregates[:limit]
+	}
+
+	claimed := make([]*entity.OutboxMessage, 0)
+	for _, aggregateID := range aggregates {
+		for _, message := range m.messages {
+			if message.AggregateID == aggregateID && !message.IsPublished() {
+				message.LockedUntil = &leaseUntil
+				claimed = append(claimed, message)
+			}
+		}
+	}
+	return claimed, nil
+}
+
+func (m *mockOutboxRepository) Update(ctx context.Context, message *entity.OutboxMessage) error {
+	return nil
+}
+
+func (m *mockOutboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int, error) {
+	kept := m.messages[:0]
+	for _, message := range m.messages {
+		if !message.IsPublished() || !message.PublishedAt.Before(before) {
+			kept = append(kept, message)
+		}
+	}
+	deleted := len(m.messages) - len(kept)
+	m.messages = kept
+	return deleted, nil
+}
+
+// mockEventPublisher records what it publishes and refuses the events in
+// refuse.
+type mockEventPublisher struct {
+	published []uuid.UUID
+	refuse    map[uuid.UUID]bool
+}
+
+func newMockEventPublisher() *mockEventPublisher {
+	return &mockEventPublisher{refuse: make(map[uuid.UUID]bool)}
+}
+
+func (m *mockEventPublisher) Publish(ctx context.Context, event *entity.Event) error {
+	if m.refuse[event.ID] {
+		return errors.New("broker unavailable")
+	}
+	m.published = append(m.published, event.ID)
+	return nil
+}
+
+var testOutboxRules = OutboxRules{
+	BatchSize:  10,
+	Lease:      time.Minute,
+	RetryDelay: time.Minute,
+	Retention:  24 * time.Hour,
+}
+
+// newTestOutbox returns an outbox to emit events through and the repository
+// it writes them to.
+func newTestOutbox() (OutboxUseCase, *mockOutboxRepository) {
+	repo := newMockOutboxRepository()
+	return NewOutboxUseCase(repo, newMockEventPublisher(), testOutboxRules), repo
+}
+
+func TestOutboxUseCase_Emit(t *testing.T) {
+	repo := newMockOutboxRepository()
+	uc := NewOutboxUseCase(repo, newMockEventPublisher(), testOutboxRules)
+
+	dueDate := time.Now().AddDate(0, 0, 14)
+	loan, _ := entity.NewLoan(uuid.New(), uuid.New(), &dueDate)
+	if err := uc.Emit(context.Background(), loanEvent(entity.EventLoanBorrowed, loan)); err != nil {
+		t.Fatalf("OutboxUseCase.Emit() unexpected error = %v", err)
+	}
+
+	if len(repo.messages) != 1 {
+		t.Fatalf("OutboxUseCase.Emit() messages = %v, want 1", len(repo.messages))
+	}
+	message := repo.messages[0]
+	if message.AggregateID != loan.ID || message.EventType != entity.EventLoanBorrowed || message.IsPublished() {
+		t.Errorf("OutboxUseCase.Emit() message = %+v, want an unpublished loan.borrowed for %v", message, loan.ID)
+	}
+}
+
+func TestOutboxUseCase_Relay(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockOutboxRepository()
+	publisher := newMockEventPublisher()
+	uc := NewOutboxUseCase(repo, publisher, testOutboxRules)
+
+	first, second := uuid.New(), uuid.New()
+	borrowed := entity.NewEvent(entity.EventLoanBorrowed, first, nil)
+	created := entity.NewEvent(entity.EventBookCreated, second, nil)
+	returned := entity.NewEvent(entity.EventLoanReturned, first, nil)
+	for _, event := range []*entity.Event{borrowed, created, returned} {
+		_ = uc.Emit(ctx, event)
+	}
+
+	published, err := uc.Relay(ctx)
+	if err != nil || published != 3 {
+		t.Fatalf("OutboxUseCase.Relay() = %v, %v, want 3, nil", published, err)
+	}
+	if !slices.Equal(publisher.published, []uuid.UUID{borrowed.ID, returned.ID, created.ID}) {
+		t.Errorf("OutboxUseCase.Relay() published %v, want each aggregate's events in order", publisher.published)
+	}
+
+	published, _ = uc.Relay(ctx)
+	if published != 0 {
+		t.Errorf("OutboxUseCase.Relay() again published %v, want 0", published)
+	}
+}
+
+func TestOutboxUseCase_RelayHoldsAggregateAfterFailure(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockOutboxRepository()
+	publisher := newMockEventPublisher()
+	uc := NewOutboxUseCase(repo, publisher, testOutboxRules)
+
+	loanID, bookID := uuid.New(), uuid.New()
+	borrowed := entity.NewEvent(entity.EventLoanBorrowed, loanID, nil)
+	returned := entity.NewEvent(entity.EventLoanReturned, loanID, nil)
+	created := entity.NewEvent(entity.EventBookCreated, bookID, nil)
+	for _, event := range []*entity.Event{borrowed, returned, created} {
+		_ = uc.Emit(ctx, event)
+	}
+	publisher.refuse[borrowed.ID] = true
+
+	published, err := uc.Relay(ctx)
+	if err != nil || published != 1 {
+		t.Fatalf("OutboxUseCase.Relay() = %v, %v, want 1, nil", published, err)
+	}
+	if !slices.Equal(publisher.published, []uuid.UUID{created.ID}) {
+		t.Errorf("OutboxUseCase.Relay() published %v, want only the other aggregate", publisher.published)
+	}
+	head := repo.messages[0]
+	if head.Attempts != 1 || head.LastError != "broker unavailable" {
+		t.Errorf("refused message = %+v, want one failed attempt", head)
+	}
+	if repo.messages[1].Attempts != 0 {
+		t.Errorf("message after the refused one was attempted %d times, want 0", repo.messages[1].Attempts)
+	}
+
+	// Once the retry is due both go out, still in order.
+	delete(publisher.refuse, borrowed.ID)
+	past := time.Now().Add(-time.Second)
+	head.LockedUntil = &past
+	published, _ = uc.Relay(ctx)
+	if published != 2 || !slices.Equal(publisher.published, []uuid.UUID{created.ID, borrowed.ID, returned.ID}) {
+		t.Errorf("OutboxUseCase.Relay() on retry published %v (%d), want the loan's events in order", publisher.published, published)
+	}
+}
+
+func TestOutboxUseCase_Purge(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockOutboxRepository()
+	uc := NewOutboxUseCase(repo, newMockEventPublisher(), testOutboxRules)
+
+	for range 3 {
+		_ = uc.Emit(ctx, entity.NewEvent(entity.EventBookCreated, uuid.New(), nil))
+	}
+	repo.messages[0].Publish(time.Now().Add(-48 * time.Hour))
+	repo.messages[1].Publish(time.Now())
+
+	purged, err := uc.Purge(ctx)
+	if err != nil || purged != 1 {
+		t.Fatalf("OutboxUseCase.Purge() = %v, %v, want 1, nil", purged, err)
+	}
+	if len(repo.messages) != 2 {
+		t.Errorf("OutboxUseCase.Purge() left %d messages, want 2", len(repo.messages))
+	}
+}
+
+func TestFanOutPublisher(t *testing.T) {
+	webhooks, broker := newMockEventPublisher(), newMockEventPublisher()
+	publisher := NewFanOutPublisher(webhooks, broker)
+
+	event := entity.NewEvent(entity.EventUserDisabled, uuid.New(), nil)
+	broker.refuse[event.ID] = true
+
+	if err := publisher.Publish(context.Background(), event); err == nil {
+		t.Fatal("fanOutPublisher.Publish() expected an error when a publisher refuses")
+	}
+	// The refusal does not keep the event from the other publishers
+	if len(webhooks.published) != 1 || webhooks.published[0] != event.ID {
+		t.Errorf("webhooks published %v, want [%v]", webhooks.published, event.ID)
+	}
+
+	delete(broker.refuse, event.ID)
+	if err := publisher.Publish(context.Background(), event); err != nil {
+		t.Fatalf("fanOutPublisher.Publish() unexpected error = %v", err)
+	}
+	if len(broker.published) != 1 {
+		t.Errorf("broker published %d events, want 1", len(broker.published))
+	}
+}
@@ -0,0 +1,276 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type TransferUseCase interface {
+	Request(ctx context.Context, input RequestTransferInput) (*entity.Transfer, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Transfer, error)
+	List(ctx context.Context, page, limit int, filter repository.TransferFilter) ([]*entity.Transfer, int, error)
+	Ship(ctx context.Context, id uuid.UUID) (*entity.Transfer, error)
+	Receive(ctx context.Context, id uuid.UUID) (*entity.Transfer, error)
+	Cancel(ctx context.Context, id uuid.UUID) (*entity.Transfer, error)
+}
+
+type RequestTransferInput struct {
+	CopyID     uuid.UUID
+	ToBranchID uuid.UUID
+}
+
+type transferUseCase struct {
+	transferRepo repository.TransferRepository
+	copyRepo     repository.BookCopyRepository
+	bookRepo     repository.BookRepository
+	branchRepo   repository.BranchRepository
+	holdRepo     repository.HoldRepository
+	txManager    repository.TxManager
+	events       EventEmitter
+	audit        Auditor
+	pickupWindow time.Duration
+}
+
+func NewTransferUseCase(
+	transferRepo repository.TransferRepository,
+	copyRepo repository.BookCopyRepository,
+	bookRepo repository.BookRepository,
+	branchRepo repository.BranchRepository,
+	holdRepo repository.HoldRepository,
+	txManager repository.TxManager,
+	events EventEmitter,
+	audit Auditor,
+	pickupWindow time.Duration,
+) TransferUseCase {
+	return &transferUseCase{
+		transferRepo: transferRepo,
+		copyRepo:     copyRepo,
+		bookRepo:     bookRepo,
+		branchRepo:   branchRepo,
+		holdRepo:     holdRepo,
+		txManager:    txManager,
+		events:       events,
+		audit:        audit,
+		pickupWindow: pickupWindow,
+	}
+}
+
+// Request asks for a copy to be sent to another branch. The copy stays on
+// the shelf where it is until the transfer is shipped.
+func (uc *transferUseCase) Request(ctx context.Context, input RequestTransferInput) (*entity.Transfer, error) {
+	var transfer *entity.Transfer
+
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		bookCopy, err := uc.getCopy(ctx, input.CopyID)
+		if err != nil {
+			return err
+		}
+		if bookCopy.Status == entity.CopyStatusWithdrawn {
+			return entity.ErrCopyNotAvailable
+		}
+
+		if _, err := resolveBranch(ctx, uc.branchRepo, &input.ToBranchID); err != nil {
+			return err
+		}
+
+		open, err := uc.transferRepo.GetOpenByCopy(ctx, bookCopy.ID)
+		if err != nil {
+			return err
+		}
+		if open != nil {
+			return entity.ErrCopyHasOpenTransfer
+		}
+
+		transfer, err = entity.NewTransfer(bookCopy, input.ToBranchID)
+		if err != nil {
+			return err
+		}
+		if err := uc.transferRepo.Create(ctx, transfer); err != nil {
+			return err
+		}
+		return uc.recordTransfer(ctx, entity.AuditTransferRequested, nil, transfer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+func (uc *transferUseCase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	transfer, err := uc.transferRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if transfer == nil {
+		return nil, entity.ErrTransferNotFound
+	}
+	return transfer, nil
+}
+
+func (uc *transferUseCase) List(ctx context.Context, page, limit int, filter repository.TransferFilter) ([]*entity.Transfer, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	return uc.transferRepo.List(ctx, page, limit, filter)
+}
+
+// Ship takes the copy off the shelf of the sending branch. Only a copy on
+// the shelf can leave, so a copy that went out on loan or to the hold shelf
+// since the request has to come back first.
+func (uc *transferUseCase) Ship(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	var transfer *entity.Transfer
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		var err error
+		transfer, err = uc.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		before := transferAudit(transfer)
+		if err := transfer.Ship(); err != nil {
+			return err
+		}
+
+		bookCopy, err := uc.getCopy(ctx, transfer.CopyID)
+		if err != nil {
+			return err
+		}
+		if err := bookCopy.Ship(); err != nil {
+			return err
+		}
+
+		book, err := uc.getBook(ctx, transfer.BookID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.copyRepo.Update(ctx, bookCopy); err != nil {
+			return err
+		}
+		if err := uc.transferRepo.Update(ctx, transfer); err != nil {
+			return err
+		}
+		if err := uc.recordTransfer(ctx, entity.AuditTransferShipped, before, transfer); err != nil {
+			return err
+		}
+		return syncCopyCounts(ctx, uc.copyRepo, uc.bookRepo, book)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// Receive books the copy in at the destination branch, where it serves the
+// hold queue before it reaches the shelf.
+func (uc *transferUseCase) Receive(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	var transfer *entity.Transfer
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		var err error
+		transfer, err = uc.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		before := transferAudit(transfer)
+		if err := transfer.Receive(); err != nil {
+			return err
+		}
+
+		bookCopy, err := uc.getCopy(ctx, transfer.CopyID)
+		if err != nil {
+			return err
+		}
+		bookCopy.MoveTo(transfer.ToBranchID)
+
+		book, err := uc.getBook(ctx, transfer.BookID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.transferRepo.Update(ctx, transfer); err != nil {
+			return err
+		}
+		if err := uc.recordTransfer(ctx, entity.AuditTransferReceived, before, transfer); err != nil {
+			return err
+		}
+		return releaseCopy(ctx, uc.holdRepo, uc.copyRepo, uc.bookRepo, uc.events, uc.audit, book, bookCopy, uc.pickupWindow)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+func (uc *transferUseCase) Cancel(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	var transfer *entity.Transfer
+
+	err := withRetry(ctx, uc.txManager, func(ctx context.Context) error {
+		var err error
+		transfer, err = uc.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		before := transferAudit(transfer)
+		if err := transfer.Cancel(); err != nil {
+			return err
+		}
+		if err := uc.transferRepo.Update(ctx, transfer); err != nil {
+			return err
+		}
+		return uc.recordTransfer(ctx, entity.AuditTransferCancelled, before, transfer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// recordTransfer records the change to transfer from before, or its
+// creation when before is nil, in the audit log.
+func (uc *transferUseCase) recordTransfer(ctx context.Context, action string, before *transferAuditState, transfer *entity.Transfer) error {
+	return uc.audit.Record(ctx, AuditRecord{
+		Action:     action,
+		EntityType: entity.AuditEntityTransfer,
+		EntityID:   transfer.ID,
+		Before:     before,
+		After:      transferAudit(transfer),
+	})
+}
+
+func (uc *transferUseCase) getCopy(ctx context.Context, copyID uuid.UUID) (*entity.BookCopy, error) {
+	bookCopy, err := uc.copyRepo.GetByID(ctx, copyID)
+	if err != nil {
+		return nil, err
+	}
+	if bookCopy == nil {
+		return nil, entity.ErrBookCopyNotFound
+	}
+	return bookCopy, nil
+}
+
+func (uc *transferUseCase) getBook(ctx context.Context, bookID uuid.UUID) (*entity.Book, error) {
+	book, err := uc.bookRepo.GetByID(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	if book == nil {
+		return nil, entity.ErrBookNotFound
+	}
+	return book, nil
+}
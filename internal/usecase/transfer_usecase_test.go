@@ -0,0 +1,291 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type mockTransferRepository struct {
+	transfers map[uuid.UUID]*entity.Transfer
+	order     []uuid.UUID
+}
+
+func newMockTransferRepository() *mockTransferRepository {
+	return &mockTransferRepository{
+		transfers: make(map[uuid.UUID]*entity.Transfer),
+	}
+}
+
+func (m *mockTransferRepository) Create(ctx context.Context, transfer *entity.Transfer) error {
+	m.transfers[transfer.ID] = transfer
+	m.order = append(m.order, transfer.ID)
+	return nil
+}
+
+func (m *mockTransferRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	if transfer, exists := m.transfers[id]; exists {
+		return transfer, nil
+	}
+	return nil, nil
+}
+
+func (m *mockTransferRepository) GetOpenByCopy(ctx context.Context, copyID uuid.UUID) (*entity.Transfer, error) {
+	for _, transfer := range m.transfers {
+		if transfer.CopyID == copyID && transfer.IsOpen() {
+			return transfer, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockTransferRepository) List(ctx context.Context, page, limit int, filter repository.TransferFilter) ([]*entity.Transfer, int, error) {
+	transfers := make([]*entity.Transfer, 0)
+	for _, id := range m.order {
+		transfer := m.transfers[id]
+		if filter.BranchID != nil && transfer.FromBranchID != *filter.BranchID && transfer.ToBranchID != *filter.BranchID {
+			continue
+		}
+		if filter.Status != nil && transfer.Status != *filter.Status {
+			continue
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, len(transfers), nil
+}
+
+func (m *mockTransferRepository) Update(ctx context.Context, transfer *entity.Transfer) error {
+	m.transfers[transfer.ID] = transfer
+	return nil
+}
+
+type transferTestData struct {
+	transferUC TransferUseCase
+	bookRepo   *mockBookRepository
+	copyRepo   *mockBookCopyRepository
+	holdRepo   *mockHoldRepository
+	book       *entity.Book
+	copy       *entity.BookCopy
+	north      *entity.Branch
+}
+
+// newTransferTestData creates a single-copy book shelved at the default
+// branch and a second branch to send it to.
+func newTransferTestData(t *testing.T) *transferTestData {
+	ctx := context.Background()
+
+	bookRepo := newMockBookRepository()
+	copyRepo := newMockBookCopyRepository()
+	branchRepo := newMockBranchRepository()
+	holdRepo := newMockHoldRepository()
+	txManager := newMockTxManager()
+
+	book, err := NewBookUseCase(bookRepo, copyRepo, branchRepo, newMockLoanRepository(), newMockHoldRepository(), txManager, newMockEventEmitter(), newMockAuditor(), time.Hour).Create(ctx, CreateBookInput{
+		Title:         "Clean Code",
+		Author:        "Robert C. Martin",
+		ISBN:          "9780132350884",
+		PublishedYear: 2008,
+		TotalCopies:   1,
+	})
+	if err != nil {
+		t.Fatalf("BookUseCase.Create() unexpected error = %v", err)
+	}
+	copies, _ := copyRepo.ListByBook(ctx, book.ID)
+
+	north, _ := NewBranchUseCase(branchRepo, copyRepo, newMockTransferRepository(), txManager, newMockAuditor()).Create(ctx, CreateBranchInput{
+		Code: "NORTH",
+		Name: "North Branch",
+	})
+
+	return &transferTestData{
+		transferUC: NewTransferUseCase(newMockTransferRepository(), copyRepo, bookRepo, branchRepo, holdRepo, txManager, newMockEventEmitter(), newMockAuditor(), testLoanRules.HoldPickupWindow),
+		bookRepo:   bookRepo,
+		copyRepo:   copyRepo,
+		holdRepo:   holdRepo,
+		book:       book,
+		copy:       copies[0],
+		north:      north,
+	}
+}
+
+func TestTransferUseCase_Request(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("copy stays on the shelf until shipped", func(t *testing.T) {
+		data := newTransferTestData(t)
+
+		transfer, err := data.transferUC.Request(ctx, RequestTransferInput{CopyID: data.copy.ID, ToBranchID: data.north.ID})
+		if err != nil {
+			t.Fatalf("TransferUseCase.Request() unexpected error = %v", err)
+		}
+
+		if transfer.Status != entity.TransferStatusRequested {
+			t.Errorf("TransferUseCase.Request() status = %v, want %v", transfer.Status, entity.TransferStatusRequested)
+		}
+		if transfer.FromBranchID != data.copy.BranchID {
+			t.Errorf("TransferUseCase.Request() from branch = %v, want %v", transfer.FromBranchID, data.copy.BranchID)
+		}
+		if data.copy.Status != entity.CopyStatusAvailable {
+			t.Errorf("TransferUseCase.Request() copy status = %v, want %v", data.copy.Status, entity.CopyStatusAvailable)
+		}
+	})
+
+	t.Run("copy already has an open transfer", func(t *testing.T) {
+		data := newTransferTestData(t)
+		input := RequestTransferInput{CopyID: data.copy.ID, ToBranchID: data.north.ID}
+
+		_, _ = data.transferUC.Request(ctx, input)
+		_, err := data.transferUC.Request(ctx, input)
+		if err != entity.ErrCopyHasOpenTransfer {
+			t.Errorf("TransferUseCase.Request() error = %v, want %v", err, entity.ErrCopyHasOpenTransfer)
+		}
+	})
+
+	t.Run("copy already at the destination", func(t *testing.T) {
+		data := newTransferTestData(t)
+
+		_, err := data.transferUC.Request(ctx, RequestTransferInput{CopyID: data.copy.ID, ToBranchID: data.copy.BranchID})
+		if err != entity.ErrTransferToSameBranch {
+			t.Errorf("TransferUseCase.Request() error = %v, want %v", err, entity.ErrTransferToSameBranch)
+		}
+	})
+
+	t.Run("unknown destination", func(t *testing.T) {
+		data := newTransferTestData(t)
+
+		_, err := data.transferUC.Request(ctx, RequestTransferInput{CopyID: data.copy.ID, ToBranchID: uuid.New()})
+		if err != entity.ErrBranchNotFound {
+			t.Errorf("TransferUseCase.Request() error = %v, want %v", err, entity.ErrBranchNotFound)
+		}
+	})
+}
+
+func TestTransferUseCase_Ship(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("copy leaves the available count", func(t *testing.T) {
+		data := newTransferTestData(t)
+		transfer, _ := data.transferUC.Request(ctx, RequestTransferInput{CopyID: data.copy.ID, ToBranchID: data.north.ID})
+
+		shipped, err := data.transferUC.Ship(ctx, transfer.ID)
+		if err != nil {
+			t.Fatalf("TransferUseCase.Ship() unexpected error = %v", err)
+		}
+
+		if shipped.Status != entity.TransferStatusInTransit {
+			t.Errorf("TransferUseCase.Ship() status = %v, want %v", shipped.Status, entity.TransferStatusInTransit)
+		}
+		if data.copy.Status != entity.CopyStatusInTransit {
+			t.Errorf("TransferUseCase.Ship() copy status = %v, want %v", data.copy.Status, entity.CopyStatusInTransit)
+		}
+		if data.book.AvailableCopies != 0 {
+			t.Errorf("TransferUseCase.Ship() available copies = %v, want %v", data.book.AvailableCopies, 0)
+		}
+	})
+
+	t.Run("copy no longer on the shelf", func(t *testing.T) {
+		data := newTransferTestData(t)
+		transfer, _ := data.transferUC.Request(ctx, RequestTransferInput{CopyID: data.copy.ID, ToBranchID: data.north.ID})
+		data.copy.SetAside()
+
+		_, err := data.transferUC.Ship(ctx, transfer.ID)
+		if err != entity.ErrCopyNotAvailable {
+			t.Errorf("TransferUseCase.Ship() error = %v, want %v", err, entity.ErrCopyNotAvailable)
+		}
+	})
+}
+
+func TestTransferUseCase_Receive(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("copy joins the destination shelf", func(t *testing.T) {
+		data := newTransferTestData(t)
+		transfer, _ := data.transferUC.Request(ctx, RequestTransferInput{CopyID: data.copy.ID, ToBranchID: data.north.ID})
+		_, _ = data.transferUC.Ship(ctx, transfer.ID)
+
+		received, err := data.transferUC.Receive(ctx, transfer.ID)
+		if err != nil {
+			t.Fatalf("TransferUseCase.Receive() unexpected error = %v", err)
+		}
+
+		if received.Status != entity.TransferStatusReceived {
+			t.Errorf("TransferUseCase.Receive() status = %v, want %v", received.Status, entity.TransferStatusReceived)
+		}
+		if data.copy.BranchID != data.north.ID {
+			t.Errorf("TransferUseCase.Receive() copy branch = %v, want %v", data.copy.BranchID, data.north.ID)
+		}
+		if data.copy.Status != entity.CopyStatusAvailable {
+			t.Errorf("TransferUseCase.Receive() copy status = %v, want %v", data.copy.Status, entity.CopyStatusAvailable)
+		}
+		if data.book.AvailableCopies != 1 {
+			t.Errorf("TransferUseCase.Receive() available copies = %v, want %v", data.book.AvailableCopies, 1)
+		}
+	})
+
+	t.Run("copy serves the hold queue first", func(t *testing.T) {
+		data := newTransferTestData(t)
+		transfer, _ := data.transferUC.Request(ctx, RequestTransferInput{CopyID: data.copy.ID, ToBranchID: data.north.ID})
+		_, _ = data.transferUC.Ship(ctx, transfer.ID)
+		hold := entity.NewHold(uuid.New(), data.book.ID)
+		_ = data.holdRepo.Create(ctx, hold)
+
+		_, err := data.transferUC.Receive(ctx, transfer.ID)
+		if err != nil {
+			t.Fatalf("TransferUseCase.Receive() unexpected error = %v", err)
+		}
+
+		if data.copy.Status != entity.CopyStatusOnHold {
+			t.Errorf("TransferUseCase.Receive() copy status = %v, want %v", data.copy.Status, entity.CopyStatusOnHold)
+		}
+		if hold.Status != entity.HoldStatusReady {
+			t.Errorf("TransferUseCase.Receive() hold status = %v, want %v", hold.Status, entity.HoldStatusReady)
+		}
+	})
+
+	t.Run("transfer not shipped", func(t *testing.T) {
+		data := newTransferTestData(t)
+		transfer, _ := data.transferUC.Request(ctx, RequestTransferInput{CopyID: data.copy.ID, ToBranchID: data.north.ID})
+
+		_, err := data.transferUC.Receive(ctx, transfer.ID)
+		if err != entity.ErrTransferNotInTransit {
+			t.Errorf("TransferUseCase.Receive() error = %v, want %v", err, entity.ErrTransferNotInTransit)
+		}
+	})
+}
+
+func TestTransferUseCase_Cancel(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requested transfer", func(t *testing.T) {
+		data := newTransferTestData(t)
+		transfer, _ := data.transferUC.Request(ctx, RequestTransferInput{CopyID: data.copy.ID, ToBranchID: data.north.ID})
+
+		cancelled, err := data.transferUC.Cancel(ctx, transfer.ID)
+		if err != nil {
+			t.Fatalf("TransferUseCase.Cancel() unexpected error = %v", err)
+		}
+
+		if cancelled.Status != entity.TransferStatusCancelled {
+			t.Errorf("TransferUseCase.Cancel() status = %v, want %v", cancelled.Status, entity.TransferStatusCancelled)
+		}
+		if _, err := data.transferUC.Request(ctx, RequestTransferInput{CopyID: data.copy.ID, ToBranchID: data.north.ID}); err != nil {
+			t.Errorf("TransferUseCase.Request() after cancel unexpected error = %v", err)
+		}
+	})
+
+	t.Run("shipped transfer", func(t *testing.T) {
+		data := newTransferTestData(t)
+		transfer, _ := data.transferUC.Request(ctx, RequestTransferInput{CopyID: data.copy.ID, ToBranchID: data.north.ID})
+		_, _ = data.transferUC.Ship(ctx, transfer.ID)
+
+		_, err := data.transferUC.Cancel(ctx, transfer.ID)
+		if err != entity.ErrTransferNotRequested {
+			t.Errorf("TransferUseCase.Cancel() error = %v, want %v", err, entity.ErrTransferNotRequested)
+		}
+	})
+}
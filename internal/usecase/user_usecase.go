@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"strings"
 
 	"bookhub/internal/domain/entity"
 	"bookhub/internal/domain/repository"
@@ -14,30 +15,62 @@ type UserUseCase interface {
 	Create(ctx context.Context, input CreateUserInput) (*entity.User, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	GetByEmail(ctx context.Context, email string) (*entity.User, error)
-	List(ctx context.Context, page, limit int) ([]*entity.User, int, error)
+	List(ctx context.Context, page, limit int, filter repository.UserFilter) ([]*entity.User, int, error)
 	Update(ctx context.Context, id uuid.UUID, input UpdateUserInput) (*entity.User, error)
 	Disable(ctx context.Context, id uuid.UUID) error
+	// Unblock lets a user blocked by the overdue escalation ladder borrow
+	// again.
+	Unblock(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	ValidateCredentials(ctx context.Context, email, password string) (*entity.User, error)
+	GetProfile(ctx context.Context) (*entity.User, error)
+	UpdateProfile(ctx context.Context, input UpdateProfileInput) (*entity.User, error)
+	ChangePassword(ctx context.Context, input ChangePasswordInput) error
 }
 
 type CreateUserInput struct {
 	Name     string
 	Email    string
 	Password string
+	// Role defaults to entity.RoleMember when empty
+	Role string
+	// Category defaults to entity.DefaultPatronCategory when empty
+	Category string
+	// CardNumber is generated when empty
+	CardNumber string
 }
 
 type UpdateUserInput struct {
+	Name       *string
+	Email      *string
+	Role       *string
+	Category   *string
+	CardNumber *string
+}
+
+// UpdateProfileInput holds the fields users may change on their own account.
+type UpdateProfileInput struct {
 	Name  *string
 	Email *string
 }
 
+type ChangePasswordInput struct {
+	CurrentPassword string
+	NewPassword     string
+}
+
 type userUseCase struct {
-	userRepo repository.UserRepository
+	userRepo  repository.UserRepository
+	txManager repository.TxManager
+	events    EventEmitter
+	audit     Auditor
 }
 
-func NewUserUseCase(userRepo repository.UserRepository) UserUseCase {
+func NewUserUseCase(userRepo repository.UserRepository, txManager repository.TxManager, events EventEmitter, audit Auditor) UserUseCase {
 	return &userUseCase{
-		userRepo: userRepo,
+		userRepo:  userRepo,
+		txManager: txManager,
+		events:    events,
+		audit:     audit,
 	}
 }
 
@@ -57,7 +90,44 @@ func (uc *userUseCase) Create(ctx context.Context, input CreateUserInput) (*enti
 		return nil, err
 	}
 
-	if err := uc.userRepo.Create(ctx, user); err != nil {
+	if input.Role != "" {
+		if err := user.ChangeRole(input.Role); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Category != "" {
+		if err := user.ChangeCategory(input.Category); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.CardNumber != "" {
+		if err := uc.ensureCardNumberFree(ctx, input.CardNumber); err != nil {
+			return nil, err
+		}
+		if err := user.ChangeCardNumber(input.CardNumber); err != nil {
+			return nil, err
+		}
+	} else if err := uc.assignFreeCardNumber(ctx, user); err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Create(ctx, user); err != nil {
+			return err
+		}
+		if err := uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditUserCreated,
+			EntityType: entity.AuditEntityUser,
+			EntityID:   user.ID,
+			After:      userAudit(user),
+		}); err != nil {
+			return err
+		}
+		return uc.events.Emit(ctx, userEvent(entity.EventUserCreated, user))
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -86,7 +156,7 @@ func (uc *userUseCase) GetByEmail(ctx context.Context, email string) (*entity.Us
 	return user, nil
 }
 
-func (uc *userUseCase) List(ctx context.Context, page, limit int) ([]*entity.User, int, error) {
+func (uc *userUseCase) List(ctx context.Context, page, limit int, filter repository.UserFilter) ([]*entity.User, int, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -96,7 +166,8 @@ func (uc *userUseCase) List(ctx context.Context, page, limit int) ([]*entity.Use
 	if limit > 100 {
 		limit = 100
 	}
-	return uc.userRepo.List(ctx, page, limit)
+	filter.Query = strings.TrimSpace(filter.Query)
+	return uc.userRepo.List(ctx, page, limit, filter)
 }
 
 func (uc *userUseCase) Update(ctx context.Context, id uuid.UUID, input UpdateUserInput) (*entity.User, error) {
@@ -108,6 +179,8 @@ func (uc *userUseCase) Update(ctx context.Context, id uuid.UUID, input UpdateUse
 		return nil, entity.ErrUserNotFound
 	}
 
+	before := userAudit(user)
+
 	if input.Email != nil && *input.Email != user.Email {
 		existingUser, err := uc.userRepo.GetByEmail(ctx, *input.Email)
 		if err == nil && existingUser != nil {
@@ -129,27 +202,121 @@ func (uc *userUseCase) Update(ctx context.Context, id uuid.UUID, input UpdateUse
 		return nil, err
 	}
 
-	if err := uc.userRepo.Update(ctx, user); err != nil {
+	if input.Role != nil {
+		if err := user.ChangeRole(*input.Role); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Category != nil {
+		if err := user.ChangeCategory(*input.Category); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.CardNumber != nil && *input.CardNumber != user.CardNumber {
+		if err := uc.ensureCardNumberFree(ctx, *input.CardNumber); err != nil {
+			return nil, err
+		}
+		if err := user.ChangeCardNumber(*input.CardNumber); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.save(ctx, user, entity.AuditUserUpdated, entity.EventUserUpdated, before); err != nil {
 		return nil, err
 	}
 
 	return user, nil
 }
 
+// save updates the user, records the change from before in the audit log
+// and emits eventType, together. Changes nobody outside needs to hear about
+// pass an empty eventType.
+func (uc *userUseCase) save(ctx context.Context, user *entity.User, action string, eventType entity.EventType, before *userAuditState) error {
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return err
+		}
+		if err := uc.audit.Record(ctx, AuditRecord{
+			Action:     action,
+			EntityType: entity.AuditEntityUser,
+			EntityID:   user.ID,
+			Before:     before,
+			After:      userAudit(user),
+		}); err != nil {
+			return err
+		}
+		if eventType == "" {
+			return nil
+		}
+		return uc.events.Emit(ctx, userEvent(eventType, user))
+	})
+}
+
+func (uc *userUseCase) ensureCardNumberFree(ctx context.Context, cardNumber string) error {
+	existingUser, err := uc.userRepo.GetByCardNumber(ctx, cardNumber)
+	if err != nil {
+		return err
+	}
+	if existingUser != nil {
+		return entity.ErrCardNumberAlreadyExists
+	}
+	return nil
+}
+
+// assignFreeCardNumber redraws the generated card number until it no longer
+// collides with another patron's card.
+func (uc *userUseCase) assignFreeCardNumber(ctx context.Context, user *entity.User) error {
+	const maxAttempts = 5
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		err := uc.ensureCardNumberFree(ctx, user.CardNumber)
+		if err != entity.ErrCardNumberAlreadyExists {
+			return err
+		}
+		user.CardNumber = entity.NewCardNumber()
+	}
+	return entity.ErrCardNumberAlreadyExists
+}
+
 func (uc *userUseCase) Disable(ctx context.Context, id uuid.UUID) error {
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		user, err := uc.userRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return entity.ErrUserNotFound
+		}
+
+		before := userAudit(user)
+		if err := user.Disable(); err != nil {
+			return err
+		}
+
+		return uc.save(ctx, user, entity.AuditUserDisabled, entity.EventUserDisabled, before)
+	})
+}
+
+func (uc *userUseCase) Unblock(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	user, err := uc.userRepo.GetByID(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if user == nil {
-		return entity.ErrUserNotFound
+		return nil, entity.ErrUserNotFound
 	}
 
-	if err := user.Disable(); err != nil {
-		return err
+	before := userAudit(user)
+	if err := user.Unblock(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.save(ctx, user, entity.AuditUserUnblocked, entity.EventUserUnblocked, before); err != nil {
+		return nil, err
 	}
 
-	return uc.userRepo.Update(ctx, user)
+	return user, nil
 }
 
 func (uc *userUseCase) ValidateCredentials(ctx context.Context, email, password string) (*entity.User, error) {
@@ -171,3 +338,55 @@ func (uc *userUseCase) ValidateCredentials(ctx context.Context, email, password
 
 	return user, nil
 }
+
+func (uc *userUseCase) GetProfile(ctx context.Context) (*entity.User, error) {
+	caller, ok := CallerFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return uc.GetByID(ctx, caller.UserID)
+}
+
+func (uc *userUseCase) UpdateProfile(ctx context.Context, input UpdateProfileInput) (*entity.User, error) {
+	caller, ok := CallerFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return uc.Update(ctx, caller.UserID, UpdateUserInput{
+		Name:  input.Name,
+		Email: input.Email,
+	})
+}
+
+func (uc *userUseCase) ChangePassword(ctx context.Context, input ChangePasswordInput) error {
+	caller, ok := CallerFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+
+	user, err := uc.GetByID(ctx, caller.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(input.CurrentPassword)); err != nil {
+		return entity.ErrInvalidCurrentPassword
+	}
+
+	if len(input.NewPassword) < 6 {
+		return entity.ErrInvalidUserPassword
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	before := userAudit(user)
+	if err := user.ChangePassword(string(hashedPassword)); err != nil {
+		return err
+	}
+
+	// The hash is not audited, so the entry records only that it changed.
+	return uc.save(ctx, user, entity.AuditUserPasswordChanged, "", before)
+}
@@ -2,9 +2,12 @@ package usecase
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"testing"
 
 	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
@@ -41,9 +44,24 @@ func (m *mockUserRepository) GetByEmail(ctx context.Context, email string) (*ent
 	return nil, nil
 }
 
-func (m *mockUserRepository) List(ctx context.Context, page, limit int) ([]*entity.User, int, error) {
+func (m *mockUserRepository) GetByCardNumber(ctx context.Context, cardNumber string) (*entity.User, error) {
+	for _, user := range m.users {
+		if user.CardNumber == cardNumber {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockUserRepository) List(ctx context.Context, page, limit int, filter repository.UserFilter) ([]*entity.User, int, error) {
 	users := make([]*entity.User, 0, len(m.users))
 	for _, user := range m.users {
+		if filter.Active != nil && user.Active != *filter.Active {
+			continue
+		}
+		if filter.Query != "" && !strings.Contains(user.Name, filter.Query) && !strings.Contains(user.Email, filter.Query) {
+			continue
+		}
 		users = append(users, user)
 	}
 	return users, len(users), nil
@@ -62,7 +80,7 @@ func (m *mockUserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 func TestUserUseCase_Create(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockUserRepository()
-	uc := NewUserUseCase(repo)
+	uc := NewUserUseCase(repo, newMockTxManager(), newMockEventEmitter(), newMockAuditor())
 
 	t.Run("create valid user", func(t *testing.T) {
 		input := CreateUserInput{
@@ -117,12 +135,70 @@ func TestUserUseCase_Create(t *testing.T) {
 			t.Errorf("UserUseCase.Create() error = %v, wantErr %v", err, entity.ErrInvalidUserName)
 		}
 	})
+
+	t.Run("create librarian", func(t *testing.T) {
+		user, err := uc.Create(ctx, CreateUserInput{
+			Name:     "Librarian",
+			Email:    "librarian@example.com",
+			Password: "password123",
+			Role:     entity.RoleLibrarian,
+		})
+		if err != nil {
+			t.Errorf("UserUseCase.Create() unexpected error = %v", err)
+			return
+		}
+
+		if user.Role != entity.RoleLibrarian {
+			t.Errorf("UserUseCase.Create() role = %v, want %v", user.Role, entity.RoleLibrarian)
+		}
+	})
+
+	t.Run("create user with invalid role", func(t *testing.T) {
+		_, err := uc.Create(ctx, CreateUserInput{
+			Name:     "Someone",
+			Email:    "someone@example.com",
+			Password: "password123",
+			Role:     "superuser",
+		})
+		if err != entity.ErrInvalidUserRole {
+			t.Errorf("UserUseCase.Create() error = %v, wantErr %v", err, entity.ErrInvalidUserRole)
+		}
+	})
+
+	t.Run("create user with card number", func(t *testing.T) {
+		user, err := uc.Create(ctx, CreateUserInput{
+			Name:       "Card Holder",
+			Email:      "holder@example.com",
+			Password:   "password123",
+			CardNumber: "CARD-0001",
+		})
+		if err != nil {
+			t.Errorf("UserUseCase.Create() unexpected error = %v", err)
+			return
+		}
+
+		if user.CardNumber != "CARD-0001" {
+			t.Errorf("UserUseCase.Create() card number = %v, want %v", user.CardNumber, "CARD-0001")
+		}
+	})
+
+	t.Run("create user with duplicate card number", func(t *testing.T) {
+		_, err := uc.Create(ctx, CreateUserInput{
+			Name:       "Another Holder",
+			Email:      "another@example.com",
+			Password:   "password123",
+			CardNumber: "CARD-0001",
+		})
+		if err != entity.ErrCardNumberAlreadyExists {
+			t.Errorf("UserUseCase.Create() error = %v, wantErr %v", err, entity.ErrCardNumberAlreadyExists)
+		}
+	})
 }
 
 func TestUserUseCase_GetByID(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockUserRepository()
-	uc := NewUserUseCase(repo)
+	uc := NewUserUseCase(repo, newMockTxManager(), newMockEventEmitter(), newMockAuditor())
 
 	user, _ := uc.Create(ctx, CreateUserInput{
 		Name:     "John Doe",
@@ -150,10 +226,52 @@ func TestUserUseCase_GetByID(t *testing.T) {
 	})
 }
 
+func TestUserUseCase_List(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockUserRepository()
+	uc := NewUserUseCase(repo, newMockTxManager(), newMockEventEmitter(), newMockAuditor())
+
+	_, _ = uc.Create(ctx, CreateUserInput{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+	jane, _ := uc.Create(ctx, CreateUserInput{
+		Name:     "Jane Roe",
+		Email:    "jane@example.com",
+		Password: "password123",
+	})
+	jane.Active = false
+
+	t.Run("filters by trimmed query", func(t *testing.T) {
+		users, total, err := uc.List(ctx, 1, 10, repository.UserFilter{Query: "  John "})
+		if err != nil {
+			t.Errorf("UserUseCase.List() unexpected error = %v", err)
+			return
+		}
+		if total != 1 || users[0].Name != "John Doe" {
+			t.Errorf("UserUseCase.List() = %v users, want only John Doe", total)
+		}
+	})
+
+	t.Run("filters by active flag", func(t *testing.T) {
+		active := false
+		users, total, err := uc.List(ctx, 1, 10, repository.UserFilter{Active: &active})
+		if err != nil {
+			t.Errorf("UserUseCase.List() unexpected error = %v", err)
+			return
+		}
+		if total != 1 || users[0].ID != jane.ID {
+			t.Errorf("UserUseCase.List() = %v users, want only Jane Roe", total)
+		}
+	})
+}
+
 func TestUserUseCase_Update(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockUserRepository()
-	uc := NewUserUseCase(repo)
+	events, outbox := newTestOutbox()
+	uc := NewUserUseCase(repo, newMockTxManager(), events, newMockAuditor())
 
 	user, _ := uc.Create(ctx, CreateUserInput{
 		Name:     "John Doe",
@@ -174,6 +292,41 @@ func TestUserUseCase_Update(t *testing.T) {
 		if updated.Name != newName {
 			t.Errorf("UserUseCase.Update() name = %v, want %v", updated.Name, newName)
 		}
+		want := []entity.EventType{entity.EventUserCreated, entity.EventUserUpdated}
+		if got := outbox.types(); !slices.Equal(got, want) {
+			t.Errorf("UserUseCase.Update() outbox = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("update user role", func(t *testing.T) {
+		role := entity.RoleAdmin
+		updated, err := uc.Update(ctx, user.ID, UpdateUserInput{
+			Role: &role,
+		})
+		if err != nil {
+			t.Errorf("UserUseCase.Update() unexpected error = %v", err)
+			return
+		}
+
+		if updated.Role != role {
+			t.Errorf("UserUseCase.Update() role = %v, want %v", updated.Role, role)
+		}
+	})
+
+	t.Run("update card number taken by another user", func(t *testing.T) {
+		other, _ := uc.Create(ctx, CreateUserInput{
+			Name:       "Jane Doe",
+			Email:      "jane@example.com",
+			Password:   "password123",
+			CardNumber: "CARD-0002",
+		})
+
+		_, err := uc.Update(ctx, user.ID, UpdateUserInput{
+			CardNumber: &other.CardNumber,
+		})
+		if err != entity.ErrCardNumberAlreadyExists {
+			t.Errorf("UserUseCase.Update() error = %v, wantErr %v", err, entity.ErrCardNumberAlreadyExists)
+		}
 	})
 
 	t.Run("update non-existing user", func(t *testing.T) {
@@ -190,7 +343,9 @@ func TestUserUseCase_Update(t *testing.T) {
 func TestUserUseCase_Disable(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockUserRepository()
-	uc := NewUserUseCase(repo)
+	events := newMockEventEmitter()
+	audit := newMockAuditor()
+	uc := NewUserUseCase(repo, newMockTxManager(), events, audit)
 
 	user, _ := uc.Create(ctx, CreateUserInput{
 		Name:     "John Doe",
@@ -208,6 +363,19 @@ func TestUserUseCase_Disable(t *testing.T) {
 		if found.Active {
 			t.Error("UserUseCase.Disable() user should be disabled")
 		}
+		wantEvents := []entity.EventType{entity.EventUserCreated, entity.EventUserDisabled}
+		if got := events.types(); !slices.Equal(got, wantEvents) {
+			t.Errorf("UserUseCase.Disable() events = %v, want %v", got, wantEvents)
+		}
+
+		want := []string{entity.AuditUserCreated, entity.AuditUserDisabled}
+		if got := audit.actions(); !slices.Equal(got, want) {
+			t.Fatalf("UserUseCase audit actions = %v, want %v", got, want)
+		}
+		changes, _ := entity.DiffAuditStates(audit.records[1].Before, audit.records[1].After)
+		if len(changes) != 1 || changes["active"] != (entity.AuditChange{Before: true, After: false}) {
+			t.Errorf("UserUseCase.Disable() audited changes = %v, want only active", changes)
+		}
 	})
 
 	t.Run("disable non-existing user", func(t *testing.T) {
@@ -218,10 +386,45 @@ func TestUserUseCase_Disable(t *testing.T) {
 	})
 }
 
+func TestUserUseCase_Unblock(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockUserRepository()
+	events := newMockEventEmitter()
+	uc := NewUserUseCase(repo, newMockTxManager(), events, newMockAuditor())
+
+	user, _ := uc.Create(ctx, CreateUserInput{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+
+	t.Run("unblock blocked user", func(t *testing.T) {
+		user.Block()
+
+		unblocked, err := uc.Unblock(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("UserUseCase.Unblock() unexpected error = %v", err)
+		}
+		if unblocked.Blocked {
+			t.Error("UserUseCase.Unblock() user should not be blocked")
+		}
+		if got := events.types(); got[len(got)-1] != entity.EventUserUnblocked {
+			t.Errorf("UserUseCase.Unblock() events = %v, want %v last", got, entity.EventUserUnblocked)
+		}
+	})
+
+	t.Run("user not blocked", func(t *testing.T) {
+		_, err := uc.Unblock(ctx, user.ID)
+		if err != entity.ErrUserNotBlocked {
+			t.Errorf("UserUseCase.Unblock() error = %v, wantErr %v", err, entity.ErrUserNotBlocked)
+		}
+	})
+}
+
 func TestUserUseCase_ValidateCredentials(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockUserRepository()
-	uc := NewUserUseCase(repo)
+	uc := NewUserUseCase(repo, newMockTxManager(), newMockEventEmitter(), newMockAuditor())
 
 	_, _ = uc.Create(ctx, CreateUserInput{
 		Name:     "John Doe",
@@ -255,3 +458,111 @@ func TestUserUseCase_ValidateCredentials(t *testing.T) {
 		}
 	})
 }
+
+func TestUserUseCase_Profile(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockUserRepository()
+	uc := NewUserUseCase(repo, newMockTxManager(), newMockEventEmitter(), newMockAuditor())
+
+	user, _ := uc.Create(ctx, CreateUserInput{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+	callerCtx := ContextWithCaller(ctx, Caller{UserID: user.ID, Role: user.Role})
+
+	t.Run("get profile", func(t *testing.T) {
+		found, err := uc.GetProfile(callerCtx)
+		if err != nil {
+			t.Errorf("UserUseCase.GetProfile() unexpected error = %v", err)
+			return
+		}
+
+		if found.ID != user.ID {
+			t.Errorf("UserUseCase.GetProfile() id = %v, want %v", found.ID, user.ID)
+		}
+	})
+
+	t.Run("get profile without caller", func(t *testing.T) {
+		_, err := uc.GetProfile(ctx)
+		if err != ErrUnauthenticated {
+			t.Errorf("UserUseCase.GetProfile() error = %v, wantErr %v", err, ErrUnauthenticated)
+		}
+	})
+
+	t.Run("update profile", func(t *testing.T) {
+		newName := "John Updated"
+		updated, err := uc.UpdateProfile(callerCtx, UpdateProfileInput{
+			Name: &newName,
+		})
+		if err != nil {
+			t.Errorf("UserUseCase.UpdateProfile() unexpected error = %v", err)
+			return
+		}
+
+		if updated.Name != newName {
+			t.Errorf("UserUseCase.UpdateProfile() name = %v, want %v", updated.Name, newName)
+		}
+	})
+}
+
+func TestUserUseCase_ChangePassword(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockUserRepository()
+	uc := NewUserUseCase(repo, newMockTxManager(), newMockEventEmitter(), newMockAuditor())
+
+	user, _ := uc.Create(ctx, CreateUserInput{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+	callerCtx := ContextWithCaller(ctx, Caller{UserID: user.ID, Role: user.Role})
+
+	t.Run("wrong current password", func(t *testing.T) {
+		err := uc.ChangePassword(callerCtx, ChangePasswordInput{
+			CurrentPassword: "wrongpassword",
+			NewPassword:     "newpassword123",
+		})
+		if err != entity.ErrInvalidCurrentPassword {
+			t.Errorf("UserUseCase.ChangePassword() error = %v, wantErr %v", err, entity.ErrInvalidCurrentPassword)
+		}
+	})
+
+	t.Run("new password too short", func(t *testing.T) {
+		err := uc.ChangePassword(callerCtx, ChangePasswordInput{
+			CurrentPassword: "password123",
+			NewPassword:     "123",
+		})
+		if err != entity.ErrInvalidUserPassword {
+			t.Errorf("UserUseCase.ChangePassword() error = %v, wantErr %v", err, entity.ErrInvalidUserPassword)
+		}
+	})
+
+	t.Run("change password", func(t *testing.T) {
+		err := uc.ChangePassword(callerCtx, ChangePasswordInput{
+			CurrentPassword: "password123",
+			NewPassword:     "newpassword123",
+		})
+		if err != nil {
+			t.Errorf("UserUseCase.ChangePassword() unexpected error = %v", err)
+			return
+		}
+
+		if _, err := uc.ValidateCredentials(ctx, "john@example.com", "newpassword123"); err != nil {
+			t.Errorf("UserUseCase.ValidateCredentials() with new password error = %v", err)
+		}
+		if _, err := uc.ValidateCredentials(ctx, "john@example.com", "password123"); err == nil {
+			t.Error("UserUseCase.ValidateCredentials() old password should be rejected")
+		}
+	})
+
+	t.Run("change password without caller", func(t *testing.T) {
+		err := uc.ChangePassword(ctx, ChangePasswordInput{
+			CurrentPassword: "newpassword123",
+			NewPassword:     "anotherpassword",
+		})
+		if err != ErrUnauthenticated {
+			t.Errorf("UserUseCase.ChangePassword() error = %v, wantErr %v", err, ErrUnauthenticated)
+		}
+	})
+}
@@ -0,0 +1,331 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"bookhub/internal/domain/entity"
+	"bookhub/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+type WebhookUseCase interface {
+	EventPublisher
+
+	CreateSubscription(ctx context.Context, input CreateWebhookInput) (*entity.WebhookSubscription, error)
+	GetSubscription(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, id uuid.UUID, input UpdateWebhookInput) (*entity.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+	ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, page, limit int) ([]*entity.WebhookDelivery, int, error)
+	// Redeliver queues the event of a past delivery for the subscription
+	// once more and returns the new delivery.
+	Redeliver(ctx context.Context, subscriptionID, deliveryID uuid.UUID) (*entity.WebhookDelivery, error)
+	// DeliverPending posts the deliveries whose next attempt is due and
+	// returns how many were attempted.
+	DeliverPending(ctx context.Context) (int, error)
+}
+
+type CreateWebhookInput struct {
+	URL    string
+	Events []entity.EventType
+	Secret string
+}
+
+// UpdateWebhookInput changes the settings left non-nil.
+type UpdateWebhookInput struct {
+	URL    *string
+	Events []entity.EventType
+	Secret *string
+	Active *bool
+}
+
+// WebhookSender posts a delivery's payload to the subscription's URL. It
+// returns the status the receiver answered with, zero when no answer came
+// back, and an error unless the status is 2xx.
+type WebhookSender interface {
+	Send(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) (int, error)
+}
+
+// WebhookRules holds the configurable delivery limits.
+type WebhookRules struct {
+	Retry entity.WebhookRetryPolicy
+	// Timeout bounds each attempt.
+	Timeout time.Duration
+	// BatchSize is how many due deliveries one DeliverPending run posts.
+	BatchSize int
+}
+
+// webhookPayload is the JSON body posted to subscribers.
+type webhookPayload struct {
+	ID         uuid.UUID        `json:"id"`
+	Type       entity.EventType `json:"type"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	Data       any              `json:"data"`
+}
+
+type webhookUseCase struct {
+	webhookRepo repository.WebhookRepository
+	txManager   repository.TxManager
+	audit       Auditor
+	sender      WebhookSender
+	rules       WebhookRules
+}
+
+func NewWebhookUseCase(
+	webhookRepo repository.WebhookRepository,
+	txManager repository.TxManager,
+	audit Auditor,
+	sender WebhookSender,
+	rules WebhookRules,
+) WebhookUseCase {
+	return &webhookUseCase{
+		webhookRepo: webhookRepo,
+		txManager:   txManager,
+		audit:       audit,
+		sender:      sender,
+		rules:       rules,
+	}
+}
+
+// Publish queues the event for every active subscription to its type, to be
+// posted by DeliverPending.
+func (uc *webhookUseCase) Publish(ctx context.Context, event *entity.Event) error {
+	subscriptions, err := uc.webhookRepo.ListSubscriptionsForEvent(ctx, event.Type)
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(webhookPayload{
+		ID:         event.ID,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+		Data:       event.Data,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		delivery := entity.NewWebhookDelivery(subscription.ID, event.ID, event.Type, payload)
+		if err := uc.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *webhookUseCase) CreateSubscription(ctx context.Context, input CreateWebhookInput) (*entity.WebhookSubscription, error) {
+	subscription, err := entity.NewWebhookSubscription(input.URL, input.Events, input.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.webhookRepo.CreateSubscription(ctx, subscription); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditWebhookCreated,
+			EntityType: entity.AuditEntityWebhook,
+			EntityID:   subscription.ID,
+			After:      webhookAudit(subscription),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (uc *webhookUseCase) GetSubscription(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	subscription, err := uc.webhookRepo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil {
+		return nil, entity.ErrWebhookNotFound
+	}
+	return subscription, nil
+}
+
+func (uc *webhookUseCase) ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	return uc.webhookRepo.ListSubscriptions(ctx)
+}
+
+func (uc *webhookUseCase) UpdateSubscription(ctx context.Context, id uuid.UUID, input UpdateWebhookInput) (*entity.WebhookSubscription, error) {
+	var subscription *entity.WebhookSubscription
+
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		subscription, err = uc.GetSubscription(ctx, id)
+		if err != nil {
+			return err
+		}
+		before := webhookAudit(subscription)
+
+		rawURL, events, secret, active := subscription.URL, subscription.Events, subscription.Secret, subscription.Active
+		if input.URL != nil {
+			rawURL = *input.URL
+		}
+		if input.Events != nil {
+			events = input.Events
+		}
+		if input.Secret != nil {
+			secret = *input.Secret
+		}
+		if input.Active != nil {
+			active = *input.Active
+		}
+
+		if err := subscription.Update(rawURL, events, secret, active); err != nil {
+			return err
+		}
+
+		if err := uc.webhookRepo.UpdateSubscription(ctx, subscription); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditWebhookUpdated,
+			EntityType: entity.AuditEntityWebhook,
+			EntityID:   subscription.ID,
+			Before:     before,
+			After:      webhookAudit(subscription),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (uc *webhookUseCase) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		subscription, err := uc.GetSubscription(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.webhookRepo.DeleteSubscription(ctx, id); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditWebhookDeleted,
+			EntityType: entity.AuditEntityWebhook,
+			EntityID:   subscription.ID,
+			Before:     webhookAudit(subscription),
+		})
+	})
+}
+
+func (uc *webhookUseCase) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, page, limit int) ([]*entity.WebhookDelivery, int, error) {
+	if _, err := uc.GetSubscription(ctx, subscriptionID); err != nil {
+		return nil, 0, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	return uc.webhookRepo.ListDeliveries(ctx, subscriptionID, page, limit)
+}
+
+func (uc *webhookUseCase) Redeliver(ctx context.Context, subscriptionID, deliveryID uuid.UUID) (*entity.WebhookDelivery, error) {
+	if _, err := uc.GetSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	delivery, err := uc.webhookRepo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery == nil || delivery.SubscriptionID != subscriptionID {
+		return nil, entity.ErrWebhookDeliveryNotFound
+	}
+
+	again := delivery.Redeliver()
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.webhookRepo.CreateDelivery(ctx, again); err != nil {
+			return err
+		}
+		return uc.audit.Record(ctx, AuditRecord{
+			Action:     entity.AuditWebhookRedelivered,
+			EntityType: entity.AuditEntityWebhook,
+			EntityID:   subscriptionID,
+			After:      webhookRedeliveryAudit(delivery, again),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return again, nil
+}
+
+func (uc *webhookUseCase) DeliverPending(ctx context.Context) (int, error) {
+	// The lease covers posting the whole batch, one attempt after another.
+	now := time.Now()
+	leaseUntil := now.Add(time.Duration(uc.rules.BatchSize+1) * uc.rules.Timeout)
+	deliveries, err := uc.webhookRepo.ClaimDueDeliveries(ctx, now, leaseUntil, uc.rules.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptions := make(map[uuid.UUID]*entity.WebhookSubscription)
+	var errs []error
+	for _, delivery := range deliveries {
+		subscription, cached := subscriptions[delivery.SubscriptionID]
+		if !cached {
+			subscription, err = uc.webhookRepo.GetSubscription(ctx, delivery.SubscriptionID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+		if subscription == nil {
+			// Deleted since the claim; its deliveries went with it.
+			continue
+		}
+
+		uc.attempt(ctx, subscription, delivery)
+		if err := uc.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return len(deliveries), errors.Join(errs...)
+}
+
+// attempt posts the delivery once and records the outcome on it. Deliveries
+// of a deactivated subscription wait, as failed attempts, for it to be
+// activated again.
+func (uc *webhookUseCase) attempt(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) {
+	if !subscription.Active {
+		delivery.Fail(0, "webhook subscription is inactive", time.Now(), uc.rules.Retry)
+		return
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, uc.rules.Timeout)
+	defer cancel()
+
+	status, err := uc.sender.Send(sendCtx, subscription, delivery)
+	if err != nil {
+		delivery.Fail(status, err.Error(), time.Now(), uc.rules.Retry)
+		return
+	}
+	delivery.Succeed(status, time.Now())
+}
@@ -0,0 +1,324 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"bookhub/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type mockWebhookRepository struct {
+	subscriptions map[uuid.UUID]*entity.WebhookSubscription
+	deliveries    map[uuid.UUID]*entity.WebhookDelivery
+}
+
+func newMockWebhookRepository() *mockWebhookRepository {
+	return &mockWebhookRepository{
+		subscriptions: make(map[uuid.UUID]*entity.WebhookSubscription),
+		deliveries:    make(map[uuid.UUID]*entity.WebhookDelivery),
+	}
+}
+
+func (m *mockWebhookRepository) CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	m.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (m *mockWebhookRepository) GetSubscription(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	if subscription, exists := m.subscriptions[id]; exists {
+		return subscription, nil
+	}
+	return nil, nil
+}
+
+func (m *mockWebhookRepository) ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	subscriptions := make([]*entity.WebhookSubscription, 0, len(m.subscriptions))
+	for _, subscription := range m.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	slices.SortFunc(subscriptions, func(a, b *entity.WebhookSubscription) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return subscriptions, nil
+}
+
+func (m *mockWebhookRepository) ListSubscriptionsForEvent(ctx context.Context, eventType entity.EventType) ([]*entity.WebhookSubscription, error) {
+	subscriptions := make([]*entity.WebhookSubscription, 0)
+	for _, subscription := range m.subscriptions {
+		if subscription.Subscribes(eventType) {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	return subscriptions, nil
+}
+
+func (m *mockWebhookRepository) UpdateSubscription(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	m.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (m *mockWebhookRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	delete(m.subscriptions, id)
+	for deliveryID, delivery := range m.deliveries {
+		if delivery.SubscriptionID == id {
+			delete(m.deliveries, deliveryID)
+		}
+	}
+	return nil
+}
+
+func (m *mockWebhookRepository) CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.deliveries[delivery.ID] = delivery
+	return nil
+}
+
+func (m *mockWebhookRepository) GetDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	if delivery, exists := m.deliveries[id]; exists {
+		return delivery, nil
+	}
+	return nil, nil
+}
+
+func (m *mockWebhookRepository) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, page, limit int) ([]*entity.WebhookDelivery, int, error) {
+	deliveries := make([]*entity.WebhookDelivery, 0)
+	for _, delivery := range m.deliveries {
+		if delivery.SubscriptionID == subscriptionID {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	return deliveries, len(deliveries), nil
+}
+
+func (m *mockWebhookRepository) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	deliveries := make([]*entity.WebhookDelivery, 0)
+	for _, delivery := range m.deliveries {
+		if len(deliveries) == limit {
+			break
+		}
+		if delivery.Status != entity.WebhookDeliveryPending || delivery.NextAttemptAt.After(now) {
+			continue
+		}
+		delivery.NextAttemptAt = &leaseUntil
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+func (m *mockWebhookRepository) UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.deliveries[delivery.ID] = delivery
+	return nil
+}
+
+// mockWebhookSender answers every delivery with status, failing unless it
+// is 2xx.
+type mockWebhookSender struct {
+	status int
+	sent   []*entity.WebhookDelivery
+}
+
+func (m *mockWebhookSender) Send(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) (int, error) {
+	m.sent = append(m.sent, delivery)
+	if m.status < 200 || m.status >= 300 {
+		return m.status, errors.New("receiver refused the delivery")
+	}
+	return m.status, nil
+}
+
+var testWebhookRules = WebhookRules{
+	Retry:     entity.WebhookRetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour},
+	Timeout:   time.Second,
+	BatchSize: 10,
+}
+
+const testWebhookSecret = "0123456789abcdef"
+
+func TestWebhookUseCase_Subscriptions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create, update and delete", func(t *testing.T) {
+		uc := NewWebhookUseCase(newMockWebhookRepository(), newMockTxManager(), newMockAuditor(), &mockWebhookSender{}, testWebhookRules)
+
+		subscription, err := uc.CreateSubscription(ctx, CreateWebhookInput{
+			URL:    "https://example.com/hooks",
+			Events: []entity.EventType{entity.EventLoanBorrowed},
+			Secret: testWebhookSecret,
+		})
+		if err != nil {
+			t.Fatalf("WebhookUseCase.CreateSubscription() unexpected error = %v", err)
+		}
+
+		active := false
+		updated, err := uc.UpdateSubscription(ctx, subscription.ID, UpdateWebhookInput{
+			Events: []entity.EventType{entity.EventLoanBorrowed, entity.EventLoanReturned},
+			Active: &active,
+		})
+		if err != nil {
+			t.Fatalf("WebhookUseCase.UpdateSubscription() unexpected error = %v", err)
+		}
+		if updated.Active || len(updated.Events) != 2 || updated.URL != "https://example.com/hooks" {
+			t.Errorf("WebhookUseCase.UpdateSubscription() = %+v, want inactive with two events and the same URL", updated)
+		}
+
+		if err := uc.DeleteSubscription(ctx, subscription.ID); err != nil {
+			t.Fatalf("WebhookUseCase.DeleteSubscription() unexpected error = %v", err)
+		}
+		if _, err := uc.GetSubscription(ctx, subscription.ID); err != entity.ErrWebhookNotFound {
+			t.Errorf("WebhookUseCase.GetSubscription() error = %v, want %v", err, entity.ErrWebhookNotFound)
+		}
+	})
+
+	t.Run("invalid update keeps the subscription", func(t *testing.T) {
+		uc := NewWebhookUseCase(newMockWebhookRepository(), newMockTxManager(), newMockAuditor(), &mockWebhookSender{}, testWebhookRules)
+		subscription, _ := uc.CreateSubscription(ctx, CreateWebhookInput{
+			URL:    "https://example.com/hooks",
+			Events: []entity.EventType{entity.EventLoanBorrowed},
+			Secret: testWebhookSecret,
+		})
+
+		short := "short"
+		_, err := uc.UpdateSubscription(ctx, subscription.ID, UpdateWebhookInput{Secret: &short})
+		if err != entity.ErrInvalidWebhookSecret {
+			t.Errorf("WebhookUseCase.UpdateSubscription() error = %v, want %v", err, entity.ErrInvalidWebhookSecret)
+		}
+		if subscription.Secret != testWebhookSecret {
+			t.Errorf("WebhookUseCase.UpdateSubscription() secret = %v, want it unchanged", subscription.Secret)
+		}
+	})
+}
+
+func TestWebhookUseCase_Publish(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockWebhookRepository()
+	uc := NewWebhookUseCase(repo, newMockTxManager(), newMockAuditor(), &mockWebhookSender{}, testWebhookRules)
+
+	loans, _ := uc.CreateSubscription(ctx, CreateWebhookInput{
+		URL:    "https://example.com/loans",
+		Events: []entity.EventType{entity.EventLoanBorrowed, entity.EventLoanReturned},
+		Secret: testWebhookSecret,
+	})
+	_, _ = uc.CreateSubscription(ctx, CreateWebhookInput{
+		URL:    "https://example.com/books",
+		Events: []entity.EventType{entity.EventBookCreated},
+		Secret: testWebhookSecret,
+	})
+
+	loan, _ := entity.NewLoan(uuid.New(), uuid.New(), nil)
+	event := loanEvent(entity.EventLoanBorrowed, loan)
+	if err := uc.Publish(ctx, event); err != nil {
+		t.Fatalf("WebhookUseCase.Publish() unexpected error = %v", err)
+	}
+
+	if len(repo.deliveries) != 1 {
+		t.Fatalf("WebhookUseCase.Publish() deliveries = %v, want 1", len(repo.deliveries))
+	}
+	for _, delivery := range repo.deliveries {
+		if delivery.SubscriptionID != loans.ID || delivery.EventID != event.ID {
+			t.Errorf("WebhookUseCase.Publish() delivery = %+v, want event %v for %v", delivery, event.ID, loans.ID)
+		}
+
+		var payload struct {
+			ID   uuid.UUID        `json:"id"`
+			Type entity.EventType `json:"type"`
+			Data struct {
+				ID     uuid.UUID `json:"id"`
+				UserID uuid.UUID `json:"user_id"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(delivery.Payload, &payload); err != nil {
+			t.Fatalf("WebhookUseCase.Publish() payload is not JSON: %v", err)
+		}
+		if payload.ID != event.ID || payload.Type != entity.EventLoanBorrowed || payload.Data.ID != loan.ID || payload.Data.UserID != loan.UserID {
+			t.Errorf("WebhookUseCase.Publish() payload = %s", delivery.Payload)
+		}
+	}
+}
+
+func TestWebhookUseCase_DeliverPending(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(status int) (WebhookUseCase, *mockWebhookRepository, *mockWebhookSender, *entity.WebhookSubscription) {
+		repo := newMockWebhookRepository()
+		sender := &mockWebhookSender{status: status}
+		uc := NewWebhookUseCase(repo, newMockTxManager(), newMockAuditor(), sender, testWebhookRules)
+		subscription, _ := uc.CreateSubscription(ctx, CreateWebhookInput{
+			URL:    "https://example.com/hooks",
+			Events: []entity.EventType{entity.EventBookCreated},
+			Secret: testWebhookSecret,
+		})
+		book, _ := entity.NewBook("Clean Code", "Robert C. Martin", "9780132350884", 2008, 1)
+		_ = uc.Publish(ctx, bookEvent(entity.EventBookCreated, book))
+		return uc, repo, sender, subscription
+	}
+
+	t.Run("delivered", func(t *testing.T) {
+		uc, repo, sender, _ := setup(204)
+
+		n, err := uc.DeliverPending(ctx)
+		if err != nil || n != 1 {
+			t.Fatalf("WebhookUseCase.DeliverPending() = %v, %v, want 1, nil", n, err)
+		}
+		delivery := sender.sent[0]
+		if repo.deliveries[delivery.ID].Status != entity.WebhookDeliveryDelivered {
+			t.Errorf("WebhookUseCase.DeliverPending() status = %v, want %v", delivery.Status, entity.WebhookDeliveryDelivered)
+		}
+
+		if n, _ := uc.DeliverPending(ctx); n != 0 {
+			t.Errorf("WebhookUseCase.DeliverPending() second run = %v, want 0", n)
+		}
+	})
+
+	t.Run("refused delivery backs off", func(t *testing.T) {
+		uc, repo, _, _ := setup(500)
+
+		before := time.Now()
+		_, _ = uc.DeliverPending(ctx)
+
+		for _, delivery := range repo.deliveries {
+			if delivery.Status != entity.WebhookDeliveryPending || delivery.Attempts != 1 {
+				t.Fatalf("WebhookUseCase.DeliverPending() status = %v after %d attempts, want pending after 1", delivery.Status, delivery.Attempts)
+			}
+			if delivery.NextAttemptAt.Before(before.Add(time.Minute)) {
+				t.Errorf("WebhookUseCase.DeliverPending() next attempt = %v, want a minute later", delivery.NextAttemptAt)
+			}
+			if delivery.ResponseStatus == nil || *delivery.ResponseStatus != 500 {
+				t.Errorf("WebhookUseCase.DeliverPending() response status = %v, want 500", delivery.ResponseStatus)
+			}
+		}
+
+		if n, _ := uc.DeliverPending(ctx); n != 0 {
+			t.Errorf("WebhookUseCase.DeliverPending() before the backoff = %v, want 0", n)
+		}
+	})
+
+	t.Run("redeliver", func(t *testing.T) {
+		uc, repo, _, subscription := setup(204)
+		_, _ = uc.DeliverPending(ctx)
+
+		deliveries, _, _ := uc.ListDeliveries(ctx, subscription.ID, 1, 10)
+		again, err := uc.Redeliver(ctx, subscription.ID, deliveries[0].ID)
+		if err != nil {
+			t.Fatalf("WebhookUseCase.Redeliver() unexpected error = %v", err)
+		}
+		if again.Status != entity.WebhookDeliveryPending || len(repo.deliveries) != 2 {
+			t.Errorf("WebhookUseCase.Redeliver() status = %v with %d deliveries, want pending with 2", again.Status, len(repo.deliveries))
+		}
+
+		if _, err := uc.Redeliver(ctx, uuid.New(), deliveries[0].ID); err != entity.ErrWebhookNotFound {
+			t.Errorf("WebhookUseCase.Redeliver() error = %v, want %v", err, entity.ErrWebhookNotFound)
+		}
+		other, _ := uc.CreateSubscription(ctx, CreateWebhookInput{
+			URL:    "https://example.com/other",
+			Events: []entity.EventType{entity.EventBookCreated},
+			Secret: testWebhookSecret,
+		})
+		if _, err := uc.Redeliver(ctx, other.ID, deliveries[0].ID); err != entity.ErrWebhookDeliveryNotFound {
+			t.Errorf("WebhookUseCase.Redeliver() error = %v, want %v", err, entity.ErrWebhookDeliveryNotFound)
+		}
+	})
+}
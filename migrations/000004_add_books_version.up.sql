@@ -0,0 +1,2 @@
+-- Optimistic concurrency control: every update must present the version it read
+ALTER TABLE books ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,9 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'member';
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_user_role;
+ALTER TABLE users ADD CONSTRAINT chk_user_role CHECK (role IN ('admin', 'librarian', 'member'));
+
+CREATE INDEX IF NOT EXISTS idx_users_role ON users(role);
+
+-- The default admin user manages the other accounts
+UPDATE users SET role = 'admin' WHERE email = 'admin@bookhub.com';
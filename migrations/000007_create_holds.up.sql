@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS holds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    book_id UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'waiting',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ready_at TIMESTAMP WITH TIME ZONE,
+    pickup_deadline TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_hold_status CHECK (status IN ('waiting', 'ready', 'fulfilled', 'cancelled', 'expired'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_holds_user_id ON holds(user_id);
+CREATE INDEX IF NOT EXISTS idx_holds_book_queue ON holds(book_id, created_at) WHERE status = 'waiting';
+CREATE INDEX IF NOT EXISTS idx_holds_pickup_deadline ON holds(pickup_deadline) WHERE status = 'ready';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_holds_user_book_active ON holds(user_id, book_id) WHERE status IN ('waiting', 'ready');
@@ -0,0 +1,7 @@
+UPDATE loans SET status = 'active' WHERE status = 'overdue';
+
+DROP INDEX IF EXISTS idx_loans_user_book_active;
+CREATE INDEX IF NOT EXISTS idx_loans_user_book_active ON loans(user_id, book_id) WHERE status = 'active';
+
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS chk_status;
+ALTER TABLE loans ADD CONSTRAINT chk_status CHECK (status IN ('active', 'returned'));
@@ -0,0 +1,8 @@
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS chk_status;
+ALTER TABLE loans ADD CONSTRAINT chk_status CHECK (status IN ('active', 'overdue', 'returned'));
+
+-- An overdue loan still holds the copy, so it keeps blocking a second loan
+DROP INDEX IF EXISTS idx_loans_user_book_active;
+CREATE INDEX IF NOT EXISTS idx_loans_user_book_active ON loans(user_id, book_id) WHERE status IN ('active', 'overdue');
+
+UPDATE loans SET status = 'overdue' WHERE status = 'active' AND due_date < NOW();
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS fines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    loan_id UUID NOT NULL REFERENCES loans(id) ON DELETE CASCADE,
+    reason VARCHAR(20) NOT NULL,
+    amount_cents BIGINT NOT NULL,
+    paid_cents BIGINT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_fine_status CHECK (status IN ('open', 'paid', 'waived')),
+    CONSTRAINT chk_fine_amounts CHECK (amount_cents > 0 AND paid_cents >= 0 AND paid_cents <= amount_cents)
+);
+
+CREATE INDEX IF NOT EXISTS idx_fines_user_id ON fines(user_id);
+CREATE INDEX IF NOT EXISTS idx_fines_loan_id ON fines(loan_id);
+CREATE INDEX IF NOT EXISTS idx_fines_user_open ON fines(user_id) WHERE status = 'open';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS loan_policies;
+
+ALTER TABLE books DROP COLUMN IF EXISTS category;
+ALTER TABLE users DROP COLUMN IF EXISTS category;
@@ -0,0 +1,17 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS category VARCHAR(50) NOT NULL DEFAULT 'standard';
+ALTER TABLE books ADD COLUMN IF NOT EXISTS category VARCHAR(50) NOT NULL DEFAULT 'general';
+
+CREATE TABLE IF NOT EXISTS loan_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    patron_category VARCHAR(50) NOT NULL,
+    item_category VARCHAR(50) NOT NULL,
+    max_loans INTEGER NOT NULL,
+    loan_days INTEGER NOT NULL,
+    max_renewals INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_loan_policy_limits CHECK (max_loans >= 0 AND loan_days >= 1 AND max_renewals >= 0)
+);
+
+-- '*' in either category matches any category
+CREATE UNIQUE INDEX IF NOT EXISTS idx_loan_policies_categories ON loan_policies(patron_category, item_category);
@@ -0,0 +1,2 @@
+ALTER TABLE loans DROP COLUMN IF EXISTS copy_id;
+DROP TABLE IF EXISTS book_copies;
@@ -0,0 +1,49 @@
+CREATE TABLE IF NOT EXISTS book_copies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    book_id UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    barcode VARCHAR(50) NOT NULL UNIQUE,
+    location VARCHAR(100) NOT NULL DEFAULT '',
+    condition VARCHAR(20) NOT NULL DEFAULT 'good',
+    status VARCHAR(20) NOT NULL DEFAULT 'available',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_copy_condition CHECK (condition IN ('new', 'good', 'fair', 'poor', 'damaged')),
+    CONSTRAINT chk_copy_status CHECK (status IN ('available', 'on_loan', 'on_hold', 'in_repair', 'withdrawn'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_book_copies_book_status ON book_copies(book_id, status);
+
+ALTER TABLE loans ADD COLUMN IF NOT EXISTS copy_id UUID REFERENCES book_copies(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_loans_copy_id ON loans(copy_id);
+
+-- Backfill one copy per total_copies, numbered <isbn>-001, <isbn>-002, ...
+-- The first copies go to open loans, the next ones to ready holds and the
+-- rest stay on the shelf.
+INSERT INTO book_copies (book_id, barcode, status)
+SELECT b.id,
+       b.isbn || '-' || lpad(n::text, greatest(3, length(n::text)), '0'),
+       CASE
+           WHEN n <= open_loans.count THEN 'on_loan'
+           WHEN n <= open_loans.count + ready_holds.count THEN 'on_hold'
+           ELSE 'available'
+       END
+FROM books b
+CROSS JOIN LATERAL generate_series(1, b.total_copies) AS n
+CROSS JOIN LATERAL (
+    SELECT COUNT(*) AS count FROM loans l WHERE l.book_id = b.id AND l.status IN ('active', 'overdue')
+) open_loans
+CROSS JOIN LATERAL (
+    SELECT COUNT(*) AS count FROM holds h WHERE h.book_id = b.id AND h.status = 'ready'
+) ready_holds
+WHERE NOT EXISTS (SELECT 1 FROM book_copies c WHERE c.book_id = b.id);
+
+UPDATE loans l
+SET copy_id = c.id
+FROM (
+    SELECT l2.id, b.isbn, ROW_NUMBER() OVER (PARTITION BY l2.book_id ORDER BY l2.borrowed_at, l2.id) AS n
+    FROM loans l2
+    JOIN books b ON b.id = l2.book_id
+    WHERE l2.status IN ('active', 'overdue')
+) ranked
+JOIN book_copies c ON c.barcode = ranked.isbn || '-' || lpad(ranked.n::text, greatest(3, length(ranked.n::text)), '0')
+WHERE l.id = ranked.id AND l.copy_id IS NULL AND c.status = 'on_loan';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_users_card_number;
+ALTER TABLE users DROP COLUMN IF EXISTS card_number;
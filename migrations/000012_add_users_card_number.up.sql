@@ -0,0 +1,15 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS card_number VARCHAR(20);
+
+-- Existing patrons get sequential card numbers; new ones are assigned random
+-- 10-digit numbers by the application.
+UPDATE users u
+SET card_number = lpad(numbered.n::text, 10, '0')
+FROM (
+    SELECT id, ROW_NUMBER() OVER (ORDER BY created_at, id) AS n
+    FROM users
+    WHERE card_number IS NULL
+) numbered
+WHERE u.id = numbered.id;
+
+ALTER TABLE users ALTER COLUMN card_number SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_card_number ON users(card_number);
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS transfers;
+
+-- Copies in transit are put back on the shelf.
+UPDATE book_copies SET status = 'available' WHERE status = 'in_transit';
+ALTER TABLE book_copies DROP CONSTRAINT IF EXISTS chk_copy_status;
+ALTER TABLE book_copies ADD CONSTRAINT chk_copy_status
+    CHECK (status IN ('available', 'on_loan', 'on_hold', 'in_repair', 'withdrawn'));
+
+ALTER TABLE book_copies DROP COLUMN IF EXISTS branch_id;
+DROP TABLE IF EXISTS branches;
@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS branches (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(20) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    address VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Copies that predate branches are kept at the main branch, which also
+-- receives new copies when no branch is given.
+INSERT INTO branches (code, name)
+VALUES ('MAIN', 'Main Library')
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE book_copies ADD COLUMN IF NOT EXISTS branch_id UUID REFERENCES branches(id) ON DELETE RESTRICT;
+UPDATE book_copies SET branch_id = (SELECT id FROM branches WHERE code = 'MAIN') WHERE branch_id IS NULL;
+ALTER TABLE book_copies ALTER COLUMN branch_id SET NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_book_copies_branch_book ON book_copies(branch_id, book_id);
+
+ALTER TABLE book_copies DROP CONSTRAINT IF EXISTS chk_copy_status;
+ALTER TABLE book_copies ADD CONSTRAINT chk_copy_status
+    CHECK (status IN ('available', 'on_loan', 'on_hold', 'in_transit', 'in_repair', 'withdrawn'));
+
+CREATE TABLE IF NOT EXISTS transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    copy_id UUID NOT NULL REFERENCES book_copies(id) ON DELETE CASCADE,
+    book_id UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    from_branch_id UUID NOT NULL REFERENCES branches(id) ON DELETE RESTRICT,
+    to_branch_id UUID NOT NULL REFERENCES branches(id) ON DELETE RESTRICT,
+    status VARCHAR(20) NOT NULL DEFAULT 'requested',
+    requested_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    shipped_at TIMESTAMP WITH TIME ZONE,
+    received_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_transfer_status CHECK (status IN ('requested', 'in_transit', 'received', 'cancelled')),
+    CONSTRAINT chk_transfer_branches CHECK (from_branch_id <> to_branch_id)
+);
+
+-- A copy has at most one open transfer
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transfers_open_copy ON transfers(copy_id) WHERE status IN ('requested', 'in_transit');
+CREATE INDEX IF NOT EXISTS idx_transfers_from_branch ON transfers(from_branch_id, status);
+CREATE INDEX IF NOT EXISTS idx_transfers_to_branch ON transfers(to_branch_id, status);
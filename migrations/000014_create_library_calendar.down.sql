@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS closed_dates;
+DROP TABLE IF EXISTS opening_hours;
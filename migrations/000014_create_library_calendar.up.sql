@@ -0,0 +1,21 @@
+-- Weekly schedule of each branch. Times are minutes after midnight in the
+-- library time zone; a weekday without a row is a day the branch is closed.
+CREATE TABLE IF NOT EXISTS opening_hours (
+    branch_id UUID NOT NULL REFERENCES branches(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL,
+    opens_at SMALLINT NOT NULL,
+    closes_at SMALLINT NOT NULL,
+    PRIMARY KEY (branch_id, weekday),
+    CONSTRAINT chk_opening_hours_weekday CHECK (weekday BETWEEN 0 AND 6),
+    CONSTRAINT chk_opening_hours_times CHECK (opens_at >= 0 AND closes_at < 1440 AND opens_at < closes_at)
+);
+
+-- Days a branch is closed outside its weekly schedule, such as holidays
+CREATE TABLE IF NOT EXISTS closed_dates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    branch_id UUID NOT NULL REFERENCES branches(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_closed_dates_branch_date UNIQUE (branch_id, date)
+);
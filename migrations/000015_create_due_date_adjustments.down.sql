@@ -0,0 +1 @@
+DROP TABLE IF EXISTS due_date_adjustments;
@@ -0,0 +1,17 @@
+-- Bulk changes of loan due dates, kept for auditing. Branch, book and user
+-- ids carry no foreign keys so the record outlives them.
+CREATE TABLE IF NOT EXISTS due_date_adjustments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    due_from DATE NOT NULL,
+    due_to DATE NOT NULL,
+    branch_id UUID,
+    book_id UUID,
+    shift_days INTEGER NOT NULL DEFAULT 0,
+    new_due_date DATE,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    loans_adjusted INTEGER NOT NULL DEFAULT 0,
+    adjusted_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_due_date_adjustment_range CHECK (due_from <= due_to),
+    CONSTRAINT chk_due_date_adjustment_change CHECK ((shift_days > 0) <> (new_due_date IS NOT NULL))
+);
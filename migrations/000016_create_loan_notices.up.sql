@@ -0,0 +1,11 @@
+-- Reminders and overdue notices sent to patrons. One row per loan, kind and
+-- due date keeps each notice from being sent twice.
+CREATE TABLE IF NOT EXISTS loan_notices (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    loan_id UUID NOT NULL REFERENCES loans(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL,
+    due_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_loan_notices_kind CHECK (kind IN ('due_reminder', 'overdue_notice')),
+    CONSTRAINT uq_loan_notices_loan_kind_due UNIQUE (loan_id, kind, due_date)
+);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS loan_escalations;
+
+UPDATE loans SET status = 'returned' WHERE status = 'lost';
+
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS chk_status;
+ALTER TABLE loans ADD CONSTRAINT chk_status CHECK (status IN ('active', 'overdue', 'returned'));
+
+ALTER TABLE users DROP COLUMN IF EXISTS blocked;
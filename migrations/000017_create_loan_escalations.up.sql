@@ -0,0 +1,21 @@
+-- Blocked patrons may not borrow until staff lift the block
+ALTER TABLE users ADD COLUMN IF NOT EXISTS blocked BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- A loan whose book will not come back is closed as lost
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS chk_status;
+ALTER TABLE loans ADD CONSTRAINT chk_status CHECK (status IN ('active', 'overdue', 'returned', 'lost'));
+
+-- Steps of the overdue escalation ladder taken against a loan. One row per
+-- loan and level keeps each step from being taken twice.
+CREATE TABLE IF NOT EXISTS loan_escalations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    loan_id UUID NOT NULL REFERENCES loans(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    level INTEGER NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    days_overdue INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_loan_escalations_level CHECK (level >= 1),
+    CONSTRAINT chk_loan_escalations_action CHECK (action IN ('notice', 'block', 'lost')),
+    CONSTRAINT uq_loan_escalations_loan_level UNIQUE (loan_id, level)
+);
@@ -0,0 +1,4 @@
+UPDATE loans SET status = 'returned' WHERE status = 'damaged';
+
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS chk_status;
+ALTER TABLE loans ADD CONSTRAINT chk_status CHECK (status IN ('active', 'overdue', 'returned', 'lost'));
@@ -0,0 +1,3 @@
+-- A book returned damaged closes its loan as damaged, and its copy goes to repair
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS chk_status;
+ALTER TABLE loans ADD CONSTRAINT chk_status CHECK (status IN ('active', 'overdue', 'returned', 'lost', 'damaged'));
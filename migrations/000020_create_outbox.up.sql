@@ -0,0 +1,17 @@
+-- Domain events, written in the transaction of the change that raised them
+-- and published afterwards by the relay, in position order per aggregate
+CREATE TABLE IF NOT EXISTS outbox_messages (
+    id UUID PRIMARY KEY,
+    position BIGSERIAL NOT NULL UNIQUE,
+    event_type VARCHAR(50) NOT NULL,
+    aggregate_id UUID NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    locked_until TIMESTAMP WITH TIME ZONE,
+    published_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_messages_pending ON outbox_messages(aggregate_id, position) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_messages_published ON outbox_messages(published_at) WHERE published_at IS NOT NULL;
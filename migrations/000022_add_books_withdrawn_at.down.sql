@@ -0,0 +1,8 @@
+ALTER TABLE holds DROP CONSTRAINT IF EXISTS holds_book_id_fkey;
+ALTER TABLE holds ADD CONSTRAINT holds_book_id_fkey FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE CASCADE;
+
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS loans_book_id_fkey;
+ALTER TABLE loans ADD CONSTRAINT loans_book_id_fkey FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_books_listed;
+ALTER TABLE books DROP COLUMN IF EXISTS withdrawn_at;
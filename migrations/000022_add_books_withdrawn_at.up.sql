@@ -0,0 +1,11 @@
+-- Books with loan or hold history are withdrawn instead of deleted
+ALTER TABLE books ADD COLUMN IF NOT EXISTS withdrawn_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_books_listed ON books(title) WHERE withdrawn_at IS NULL;
+
+-- Deleting a book must not take its loans and holds with it
+ALTER TABLE loans DROP CONSTRAINT IF EXISTS loans_book_id_fkey;
+ALTER TABLE loans ADD CONSTRAINT loans_book_id_fkey FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE RESTRICT;
+
+ALTER TABLE holds DROP CONSTRAINT IF EXISTS holds_book_id_fkey;
+ALTER TABLE holds ADD CONSTRAINT holds_book_id_fkey FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE RESTRICT;
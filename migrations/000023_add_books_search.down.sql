@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_books_search;
+DROP FUNCTION IF EXISTS books_search_vector(TEXT, TEXT, TEXT);
+DROP TEXT SEARCH CONFIGURATION IF EXISTS bookhub_portuguese;
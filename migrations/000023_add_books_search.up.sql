@@ -0,0 +1,25 @@
+-- Full-text catalog search over title, author and ISBN
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- Portuguese stemming after stripping accents, so "memórias" and "Memorias"
+-- index and query alike
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'bookhub_portuguese') THEN
+        CREATE TEXT SEARCH CONFIGURATION bookhub_portuguese (COPY = portuguese);
+        ALTER TEXT SEARCH CONFIGURATION bookhub_portuguese
+            ALTER MAPPING FOR hword, hword_part, word WITH unaccent, portuguese_stem;
+    END IF;
+END
+$$;
+
+-- Title and ISBN weigh more than the author. The ISBN is kept verbatim.
+CREATE OR REPLACE FUNCTION books_search_vector(title TEXT, author TEXT, isbn TEXT) RETURNS tsvector
+LANGUAGE sql IMMUTABLE PARALLEL SAFE
+AS $$
+    SELECT setweight(to_tsvector('bookhub_portuguese'::regconfig, title), 'A')
+        || setweight(to_tsvector('bookhub_portuguese'::regconfig, author), 'B')
+        || setweight(to_tsvector('simple'::regconfig, isbn), 'A')
+$$;
+
+CREATE INDEX IF NOT EXISTS idx_books_search ON books USING GIN (books_search_vector(title, author, isbn));
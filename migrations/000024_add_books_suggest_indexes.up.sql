@@ -0,0 +1,15 @@
+-- Typeahead suggestions match the start of titles and authors regardless of
+-- case and accents, served by trigram indexes
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent() is only STABLE since its dictionary could change; pinning the
+-- dictionary lets the folded text be indexed
+CREATE OR REPLACE FUNCTION books_fold(value TEXT) RETURNS TEXT
+LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT
+AS $$
+    SELECT lower(public.unaccent('public.unaccent'::regdictionary, value))
+$$;
+
+CREATE INDEX IF NOT EXISTS idx_books_title_trgm ON books USING GIN (books_fold(title) gin_trgm_ops) WHERE withdrawn_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_books_author_trgm ON books USING GIN (books_fold(author) gin_trgm_ops) WHERE withdrawn_at IS NULL;
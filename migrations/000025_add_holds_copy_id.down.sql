@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_holds_copy_id;
+ALTER TABLE holds DROP COLUMN IF EXISTS copy_id;
@@ -0,0 +1,21 @@
+-- Ready holds remember which copy was set aside for them
+ALTER TABLE holds ADD COLUMN IF NOT EXISTS copy_id UUID REFERENCES book_copies(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_holds_copy_id ON holds(copy_id);
+
+-- Pair existing ready holds with the book's on_hold copies in order
+UPDATE holds h
+SET copy_id = paired.copy_id
+FROM (
+    SELECT ranked_holds.id, ranked_copies.id AS copy_id
+    FROM (
+        SELECT id, book_id, ROW_NUMBER() OVER (PARTITION BY book_id ORDER BY ready_at, id) AS n
+        FROM holds
+        WHERE status = 'ready'
+    ) ranked_holds
+    JOIN (
+        SELECT id, book_id, ROW_NUMBER() OVER (PARTITION BY book_id ORDER BY barcode) AS n
+        FROM book_copies
+        WHERE status = 'on_hold'
+    ) ranked_copies ON ranked_copies.book_id = ranked_holds.book_id AND ranked_copies.n = ranked_holds.n
+) paired
+WHERE h.id = paired.id AND h.copy_id IS NULL;
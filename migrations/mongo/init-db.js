@@ -2,7 +2,7 @@
 db = db.getSiblingDB('bookhub');
 
 // Create users collection with schema validation
-// Field names match Go entity struct fields (lowercase): id, name, email, passwordhash, active, createdat, updatedat
+// Field names match Go entity struct fields (lowercase): id, name, email, passwordhash, role, category, cardnumber, active, blocked, createdat, updatedat
 db.createCollection('users', {
   validator: {
     $jsonSchema: {
@@ -25,10 +25,28 @@ db.createCollection('users', {
           bsonType: 'string',
           description: 'must be a string and is required'
         },
+        role: {
+          enum: ['admin', 'librarian', 'member'],
+          description: 'must be admin, librarian or member'
+        },
+        category: {
+          bsonType: 'string',
+          pattern: '^[a-z0-9_-]{1,50}$',
+          description: 'patron category used to select the loan policy'
+        },
+        cardnumber: {
+          bsonType: 'string',
+          pattern: '^[A-Za-z0-9-]{4,20}$',
+          description: 'library card number scanned at the circulation desk'
+        },
         active: {
           bsonType: 'bool',
           description: 'must be a boolean and is required'
         },
+        blocked: {
+          bsonType: 'bool',
+          description: 'whether the user is blocked from borrowing'
+        },
         createdat: {
           bsonType: 'date',
           description: 'must be a date and is required'
@@ -42,9 +60,12 @@ db.createCollection('users', {
   }
 });
 
-// Create unique index on email
+// Create unique indexes on id and email
+db.users.createIndex({ id: 1 }, { unique: true });
 db.users.createIndex({ email: 1 }, { unique: true });
 db.users.createIndex({ active: 1 });
+db.users.createIndex({ role: 1 });
+db.users.createIndex({ cardnumber: 1 }, { unique: true, sparse: true });
 
 // Insert default admin user (password: admin123) if not exists
 const adminExists = db.users.findOne({ email: 'admin@bookhub.com' });
@@ -54,17 +75,27 @@ if (!adminExists) {
     name: 'Admin',
     email: 'admin@bookhub.com',
     passwordhash: '$2a$10$otJUHlZifNL133mJxahlJuDq7w5xv1S3RDeYVCHgikFZ0FOtov2f6',
+    role: 'admin',
+    cardnumber: '0000000001',
     active: true,
     createdat: new Date(),
     updatedat: new Date()
   });
   print('Admin user created successfully');
 } else {
+  db.users.updateOne({ email: 'admin@bookhub.com', role: { $exists: false } }, { $set: { role: 'admin' } });
   print('Admin user already exists');
 }
 
+// Backfill sequential card numbers for users created before card numbers existed
+let cardSeq = db.users.countDocuments({ cardnumber: { $exists: true } });
+db.users.find({ cardnumber: { $exists: false } }).sort({ createdat: 1 }).forEach(function(user) {
+  cardSeq++;
+  db.users.updateOne({ _id: user._id }, { $set: { cardnumber: String(cardSeq).padStart(10, '0') } });
+});
+
 // Create books collection with schema validation
-// Field names match Go entity struct fields (lowercase): id, title, author, isbn, publishedyear, totalcopies, availablecopies, createdat, updatedat
+// Field names match Go entity struct fields (lowercase): id, title, author, isbn, publishedyear, category, totalcopies, availablecopies, createdat, updatedat, version, withdrawnat
 db.createCollection('books', {
   validator: {
     $jsonSchema: {
@@ -91,6 +122,11 @@ db.createCollection('books', {
           bsonType: 'int',
           description: 'must be an integer'
         },
+        category: {
+          bsonType: 'string',
+          pattern: '^[a-z0-9_-]{1,50}$',
+          description: 'item category used to select the loan policy'
+        },
         totalcopies: {
           bsonType: 'int',
           description: 'must be an integer and is required'
@@ -106,6 +142,14 @@ db.createCollection('books', {
         updatedat: {
           bsonType: 'date',
           description: 'must be a date and is required'
+        },
+        version: {
+          bsonType: 'int',
+          description: 'optimistic concurrency version, incremented on every update'
+        },
+        withdrawnat: {
+          bsonType: ['date', 'null'],
+          description: 'set once the book is withdrawn from the catalog; its history is kept'
         }
       }
     }
@@ -113,10 +157,31 @@ db.createCollection('books', {
 });
 
 // Create indexes for books
+db.books.createIndex({ id: 1 }, { unique: true });
 db.books.createIndex({ isbn: 1 }, { unique: true });
 db.books.createIndex({ title: 1 });
 db.books.createIndex({ author: 1 });
 db.books.createIndex({ availablecopies: 1 });
+// Full-text catalog search. Title and ISBN weigh more than the author; the
+// version 3 text index ignores case and accents.
+db.books.createIndex(
+  { title: 'text', author: 'text', isbn: 'text' },
+  {
+    name: 'books_text',
+    weights: { title: 10, isbn: 10, author: 5 },
+    default_language: 'portuguese'
+  }
+);
+// Typeahead suggestions: prefix ranges on title and author compared
+// ignoring case and accents
+db.books.createIndex(
+  { title: 1 },
+  { name: 'books_title_suggest', collation: { locale: 'pt', strength: 1 } }
+);
+db.books.createIndex(
+  { author: 1 },
+  { name: 'books_author_suggest', collation: { locale: 'pt', strength: 1 } }
+);
 
 // Insert sample books (only if they don't exist)
 const sampleBooks = [
@@ -174,7 +239,8 @@ sampleBooks.forEach(function(book) {
       totalcopies: NumberInt(book.totalcopies),
       availablecopies: NumberInt(book.availablecopies),
       createdat: new Date(),
-      updatedat: new Date()
+      updatedat: new Date(),
+      version: NumberInt(1)
     });
     print('Book "' + book.title + '" created successfully');
   } else {
@@ -183,7 +249,7 @@ sampleBooks.forEach(function(book) {
 });
 
 // Create loans collection with schema validation
-// Field names match Go entity struct fields (lowercase): id, userid, bookid, borrowedat, duedate, returnedat, status
+// Field names match Go entity struct fields (lowercase): id, userid, bookid, copyid, borrowedat, duedate, returnedat, status, renewalcount
 db.createCollection('loans', {
   validator: {
     $jsonSchema: {
@@ -202,6 +268,10 @@ db.createCollection('loans', {
           bsonType: 'binData',
           description: 'UUID stored as binary and is required'
         },
+        copyid: {
+          bsonType: ['binData', 'null'],
+          description: 'UUID of the copy lent out, null for loans recorded before copies were tracked'
+        },
         borrowedat: {
           bsonType: 'date',
           description: 'must be a date and is required'
@@ -215,8 +285,13 @@ db.createCollection('loans', {
           description: 'must be a date or null'
         },
         status: {
-          enum: ['active', 'returned'],
-          description: 'must be either active or returned'
+          enum: ['active', 'overdue', 'returned', 'lost', 'damaged'],
+          description: 'must be one of active, overdue, returned, lost or damaged'
+        },
+        renewalcount: {
+          bsonType: 'int',
+          minimum: 0,
+          description: 'number of times the loan was renewed'
         }
       }
     }
@@ -224,6 +299,7 @@ db.createCollection('loans', {
 });
 
 // Create indexes for loans
+db.loans.createIndex({ id: 1 }, { unique: true });
 db.loans.createIndex({ userid: 1 });
 db.loans.createIndex({ bookid: 1 });
 db.loans.createIndex({ status: 1 });
@@ -231,4 +307,977 @@ db.loans.createIndex({ duedate: 1 });
 db.loans.createIndex({ userid: 1, bookid: 1, status: 1 });
 
 print('Loans collection created successfully');
+
+// Create holds collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, userid, bookid, status, createdat, readyat, pickupdeadline, updatedat, copyid
+db.createCollection('holds', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['userid', 'bookid', 'status', 'createdat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        userid: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary and is required'
+        },
+        bookid: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary and is required'
+        },
+        status: {
+          enum: ['waiting', 'ready', 'fulfilled', 'cancelled', 'expired'],
+          description: 'must be one of waiting, ready, fulfilled, cancelled or expired'
+        },
+        createdat: {
+          bsonType: 'date',
+          description: 'must be a date and is required'
+        },
+        readyat: {
+          bsonType: ['date', 'null'],
+          description: 'must be a date or null'
+        },
+        pickupdeadline: {
+          bsonType: ['date', 'null'],
+          description: 'must be a date or null'
+        },
+        updatedat: {
+          bsonType: 'date',
+          description: 'must be a date'
+        },
+        copyid: {
+          bsonType: ['binData', 'null'],
+          description: 'UUID of the copy set aside for a ready hold, null while waiting'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for holds
+db.holds.createIndex({ id: 1 }, { unique: true });
+db.holds.createIndex({ userid: 1 });
+db.holds.createIndex({ bookid: 1, status: 1, createdat: 1 });
+db.holds.createIndex({ status: 1, pickupdeadline: 1 });
+db.holds.createIndex(
+  { userid: 1, bookid: 1 },
+  { unique: true, partialFilterExpression: { status: { $in: ['waiting', 'ready'] } } }
+);
+
+print('Holds collection created successfully');
+
+// Create fines collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, userid, loanid, reason, amountcents, paidcents, status, createdat, updatedat
+// Amounts are integer cents stored as 64-bit integers
+db.createCollection('fines', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['userid', 'loanid', 'reason', 'amountcents', 'paidcents', 'status', 'createdat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        userid: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary and is required'
+        },
+        loanid: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary and is required'
+        },
+        reason: {
+          enum: ['overdue', 'lost', 'damaged'],
+          description: 'why the fine was charged: late return, replacement of a lost book or repair of a damaged one'
+        },
+        amountcents: {
+          bsonType: 'long',
+          minimum: 1,
+          description: 'fine amount in cents'
+        },
+        paidcents: {
+          bsonType: 'long',
+          minimum: 0,
+          description: 'amount paid so far in cents'
+        },
+        status: {
+          enum: ['open', 'paid', 'waived'],
+          description: 'must be one of open, paid or waived'
+        },
+        createdat: {
+          bsonType: 'date',
+          description: 'must be a date and is required'
+        },
+        updatedat: {
+          bsonType: 'date',
+          description: 'must be a date'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for fines
+db.fines.createIndex({ id: 1 }, { unique: true });
+db.fines.createIndex({ userid: 1, status: 1 });
+db.fines.createIndex({ loanid: 1 });
+
+print('Fines collection created successfully');
+
+// Create loan_policies collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, patroncategory, itemcategory, maxloans, loandays, maxrenewals, createdat, updatedat
+// '*' in either category matches any category
+db.createCollection('loan_policies', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['patroncategory', 'itemcategory', 'maxloans', 'loandays', 'maxrenewals', 'createdat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        patroncategory: {
+          bsonType: 'string',
+          pattern: '^([a-z0-9_-]{1,50}|\\*)$',
+          description: 'patron category or * for any'
+        },
+        itemcategory: {
+          bsonType: 'string',
+          pattern: '^([a-z0-9_-]{1,50}|\\*)$',
+          description: 'item category or * for any'
+        },
+        maxloans: {
+          bsonType: 'int',
+          minimum: 0,
+          description: 'maximum concurrent loans of the patron'
+        },
+        loandays: {
+          bsonType: 'int',
+          minimum: 1,
+          description: 'loan period and renewal extension in days'
+        },
+        maxrenewals: {
+          bsonType: 'int',
+          minimum: 0,
+          description: 'maximum number of renewals'
+        },
+        createdat: {
+          bsonType: 'date',
+          description: 'must be a date and is required'
+        },
+        updatedat: {
+          bsonType: 'date',
+          description: 'must be a date'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for loan_policies
+db.loan_policies.createIndex({ id: 1 }, { unique: true });
+db.loan_policies.createIndex({ patroncategory: 1, itemcategory: 1 }, { unique: true });
+
+print('Loan policies collection created successfully');
+
+// Create branches collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, code, name, address, createdat, updatedat
+db.createCollection('branches', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['code', 'name', 'createdat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        code: {
+          bsonType: 'string',
+          pattern: '^[A-Z0-9-]{2,20}$',
+          description: 'must be 2 to 20 uppercase letters, digits or - and is required'
+        },
+        name: {
+          bsonType: 'string',
+          minLength: 2,
+          maxLength: 100,
+          description: 'must be a string between 2 and 100 characters and is required'
+        },
+        address: {
+          bsonType: 'string',
+          maxLength: 255,
+          description: 'street address of the branch'
+        },
+        createdat: {
+          bsonType: 'date',
+          description: 'must be a date and is required'
+        },
+        updatedat: {
+          bsonType: 'date',
+          description: 'must be a date'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for branches
+db.branches.createIndex({ id: 1 }, { unique: true });
+db.branches.createIndex({ code: 1 }, { unique: true });
+
+// Seed the default branch that receives copies when no branch is given
+if (!db.branches.findOne({ code: 'MAIN' })) {
+  db.branches.insertOne({
+    id: UUID(),
+    code: 'MAIN',
+    name: 'Main Library',
+    address: '',
+    createdat: new Date(),
+    updatedat: new Date()
+  });
+}
+const mainBranch = db.branches.findOne({ code: 'MAIN' });
+
+print('Branches collection created successfully');
+
+// Create book_copies collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, bookid, branchid, barcode, location, condition, status, createdat, updatedat
+db.createCollection('book_copies', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['bookid', 'branchid', 'barcode', 'condition', 'status', 'createdat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        bookid: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary and is required'
+        },
+        branchid: {
+          bsonType: 'binData',
+          description: 'UUID of the branch holding the copy and is required'
+        },
+        barcode: {
+          bsonType: 'string',
+          pattern: '^[A-Za-z0-9-]{1,50}$',
+          description: 'must be 1 to 50 letters, digits or - and is required'
+        },
+        location: {
+          bsonType: 'string',
+          maxLength: 100,
+          description: 'shelf the copy is kept on'
+        },
+        condition: {
+          enum: ['new', 'good', 'fair', 'poor', 'damaged'],
+          description: 'must be one of new, good, fair, poor or damaged'
+        },
+        status: {
+          enum: ['available', 'on_loan', 'on_hold', 'in_transit', 'in_repair', 'withdrawn'],
+          description: 'must be one of available, on_loan, on_hold, in_transit, in_repair or withdrawn'
+        },
+        createdat: {
+          bsonType: 'date',
+          description: 'must be a date and is required'
+        },
+        updatedat: {
+          bsonType: 'date',
+          description: 'must be a date'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for book_copies
+db.book_copies.createIndex({ id: 1 }, { unique: true });
+db.book_copies.createIndex({ barcode: 1 }, { unique: true });
+db.book_copies.createIndex({ bookid: 1, status: 1 });
+db.book_copies.createIndex({ branchid: 1, bookid: 1 });
+
+// Backfill one copy per totalcopies for books that have none, numbered
+// <isbn>-001, <isbn>-002, ...
+db.books.find().forEach(function(book) {
+  if (db.book_copies.findOne({ bookid: book.id })) {
+    return;
+  }
+  for (let n = 1; n <= book.totalcopies; n++) {
+    db.book_copies.insertOne({
+      id: UUID(),
+      bookid: book.id,
+      branchid: mainBranch.id,
+      barcode: book.isbn + '-' + String(n).padStart(3, '0'),
+      location: '',
+      condition: 'good',
+      status: 'available',
+      createdat: new Date(),
+      updatedat: new Date()
+    });
+  }
+});
+
+print('Book copies collection created successfully');
+
+// Create transfers collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, copyid, bookid, frombranchid, tobranchid, status, requestedat, shippedat, receivedat, updatedat
+db.createCollection('transfers', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['copyid', 'bookid', 'frombranchid', 'tobranchid', 'status', 'requestedat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        copyid: {
+          bsonType: 'binData',
+          description: 'UUID of the copy being moved and is required'
+        },
+        bookid: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary and is required'
+        },
+        frombranchid: {
+          bsonType: 'binData',
+          description: 'UUID of the sending branch and is required'
+        },
+        tobranchid: {
+          bsonType: 'binData',
+          description: 'UUID of the destination branch and is required'
+        },
+        status: {
+          enum: ['requested', 'in_transit', 'received', 'cancelled'],
+          description: 'must be one of requested, in_transit, received or cancelled'
+        },
+        requestedat: {
+          bsonType: 'date',
+          description: 'must be a date and is required'
+        },
+        shippedat: {
+          bsonType: ['date', 'null'],
+          description: 'set when the copy leaves the sending branch'
+        },
+        receivedat: {
+          bsonType: ['date', 'null'],
+          description: 'set when the copy arrives at the destination branch'
+        },
+        updatedat: {
+          bsonType: 'date',
+          description: 'must be a date'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for transfers
+// Only one requested or in-transit transfer per copy
+db.transfers.createIndex({ id: 1 }, { unique: true });
+db.transfers.createIndex(
+  { copyid: 1 },
+  { unique: true, partialFilterExpression: { status: { $in: ['requested', 'in_transit'] } } }
+);
+db.transfers.createIndex({ frombranchid: 1, status: 1 });
+db.transfers.createIndex({ tobranchid: 1, status: 1 });
+
+print('Transfers collection created successfully');
+
+// Create opening_hours collection with schema validation
+// Field names match Go entity struct fields (lowercase): branchid, weekday, opensat, closesat
+// Times are minutes after midnight in the library time zone; a weekday
+// without a document is a day the branch is closed
+db.createCollection('opening_hours', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['branchid', 'weekday', 'opensat', 'closesat'],
+      properties: {
+        branchid: {
+          bsonType: 'binData',
+          description: 'UUID of the branch and is required'
+        },
+        weekday: {
+          bsonType: 'int',
+          minimum: 0,
+          maximum: 6,
+          description: 'day of the week, 0 for Sunday, and is required'
+        },
+        opensat: {
+          bsonType: 'int',
+          minimum: 0,
+          maximum: 1439,
+          description: 'opening time in minutes after midnight and is required'
+        },
+        closesat: {
+          bsonType: 'int',
+          minimum: 1,
+          maximum: 1439,
+          description: 'closing time in minutes after midnight and is required'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for opening_hours
+db.opening_hours.createIndex({ branchid: 1, weekday: 1 }, { unique: true });
+
+print('Opening hours collection created successfully');
+
+// Create closed_dates collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, branchid, date, reason, createdat
+db.createCollection('closed_dates', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['branchid', 'date', 'createdat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        branchid: {
+          bsonType: 'binData',
+          description: 'UUID of the branch and is required'
+        },
+        date: {
+          bsonType: 'date',
+          description: 'the closed day at midnight UTC and is required'
+        },
+        reason: {
+          bsonType: 'string',
+          maxLength: 255,
+          description: 'why the branch is closed, such as a holiday'
+        },
+        createdat: {
+          bsonType: 'date',
+          description: 'must be a date and is required'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for closed_dates
+db.closed_dates.createIndex({ id: 1 }, { unique: true });
+db.closed_dates.createIndex({ branchid: 1, date: 1 }, { unique: true });
+
+print('Closed dates collection created successfully');
+
+// Create due_date_adjustments collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, duefrom, dueto, branchid, bookid, shiftdays, newduedate, reason, loansadjusted, adjustedby, createdat
+db.createCollection('due_date_adjustments', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['duefrom', 'dueto', 'shiftdays', 'loansadjusted', 'adjustedby', 'createdat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        duefrom: {
+          bsonType: 'date',
+          description: 'first day the adjusted loans were due, at midnight UTC'
+        },
+        dueto: {
+          bsonType: 'date',
+          description: 'last day the adjusted loans were due, at midnight UTC'
+        },
+        branchid: {
+          bsonType: ['binData', 'null'],
+          description: 'UUID of the branch the adjustment was limited to, if any'
+        },
+        bookid: {
+          bsonType: ['binData', 'null'],
+          description: 'UUID of the book the adjustment was limited to, if any'
+        },
+        shiftdays: {
+          bsonType: 'int',
+          minimum: 0,
+          description: 'days each due date was pushed out, 0 when a new due date was set'
+        },
+        newduedate: {
+          bsonType: ['date', 'null'],
+          description: 'day the adjusted loans are now due, null for shifts'
+        },
+        reason: {
+          bsonType: 'string',
+          maxLength: 255,
+          description: 'why the due dates were adjusted'
+        },
+        loansadjusted: {
+          bsonType: 'int',
+          minimum: 0,
+          description: 'number of loans whose due date changed'
+        },
+        adjustedby: {
+          bsonType: 'binData',
+          description: 'UUID of the admin who made the adjustment'
+        },
+        createdat: {
+          bsonType: 'date',
+          description: 'must be a date and is required'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for due_date_adjustments
+db.due_date_adjustments.createIndex({ id: 1 }, { unique: true });
+
+print('Due date adjustments collection created successfully');
+
+// Create loan_notices collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, loanid, kind, duedate, sentat
+db.createCollection('loan_notices', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['loanid', 'kind', 'duedate', 'sentat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        loanid: {
+          bsonType: 'binData',
+          description: 'UUID of the loan the notice was sent for'
+        },
+        kind: {
+          enum: ['due_reminder', 'overdue_notice'],
+          description: 'due date reminder or overdue notice'
+        },
+        duedate: {
+          bsonType: 'date',
+          description: 'due date of the loan when the notice was sent'
+        },
+        sentat: {
+          bsonType: 'date',
+          description: 'when the notice was sent'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for loan_notices
+db.loan_notices.createIndex({ id: 1 }, { unique: true });
+// One notice of each kind per loan and due date
+db.loan_notices.createIndex({ loanid: 1, kind: 1, duedate: 1 }, { unique: true });
+
+print('Loan notices collection created successfully');
+
+// Create loan_escalations collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, loanid, userid, level, action, daysoverdue, createdat
+db.createCollection('loan_escalations', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['loanid', 'userid', 'level', 'action', 'daysoverdue', 'createdat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        loanid: {
+          bsonType: 'binData',
+          description: 'UUID of the overdue loan'
+        },
+        userid: {
+          bsonType: 'binData',
+          description: 'UUID of the patron holding the loan'
+        },
+        level: {
+          bsonType: 'int',
+          minimum: 1,
+          description: 'position of the step in the escalation ladder'
+        },
+        action: {
+          enum: ['notice', 'block', 'lost'],
+          description: 'action taken at this step'
+        },
+        daysoverdue: {
+          bsonType: 'int',
+          minimum: 0,
+          description: 'days the loan was overdue when the step was taken'
+        },
+        createdat: {
+          bsonType: 'date',
+          description: 'when the step was taken'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for loan_escalations
+db.loan_escalations.createIndex({ id: 1 }, { unique: true });
+// Each step of the ladder is taken once per loan
+db.loan_escalations.createIndex({ loanid: 1, level: 1 }, { unique: true });
+
+print('Loan escalations collection created successfully');
+
+// Create webhook_subscriptions collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, url, events, secret, active, createdat, updatedat
+db.createCollection('webhook_subscriptions', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['url', 'events', 'secret', 'active', 'createdat', 'updatedat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        url: {
+          bsonType: 'string',
+          maxLength: 2048,
+          description: 'http or https URL the events are posted to'
+        },
+        events: {
+          bsonType: 'array',
+          minItems: 1,
+          items: {
+            enum: [
+              'loan.borrowed',
+              'loan.renewed',
+              'loan.returned',
+              'loan.damaged',
+              'loan.lost',
+              'loan.due_date_changed',
+              'loan.overdue',
+              'book.created',
+              'book.updated',
+              'book.withdrawn',
+              'book.deleted',
+              'user.created',
+              'user.updated',
+              'user.blocked',
+              'user.unblocked',
+              'user.disabled',
+              'hold.placed',
+              'hold.ready',
+              'hold.fulfilled',
+              'hold.cancelled',
+              'hold.expired',
+              'fine.assessed',
+              'fine.paid',
+              'fine.waived'
+            ]
+          },
+          description: 'event types the subscription receives'
+        },
+        secret: {
+          bsonType: 'string',
+          minLength: 16,
+          maxLength: 256,
+          description: 'key of the HMAC-SHA256 signature of each delivery'
+        },
+        active: {
+          bsonType: 'bool',
+          description: 'inactive subscriptions receive nothing'
+        },
+        createdat: {
+          bsonType: 'date',
+          description: 'creation timestamp'
+        },
+        updatedat: {
+          bsonType: 'date',
+          description: 'last update timestamp'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for webhook_subscriptions
+db.webhook_subscriptions.createIndex({ id: 1 }, { unique: true });
+db.webhook_subscriptions.createIndex({ events: 1, active: 1 });
+
+print('Webhook subscriptions collection created successfully');
+
+// Create webhook_deliveries collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, subscriptionid, eventid, eventtype, payload, status,
+// attempts, nextattemptat, responsestatus, lasterror, createdat, updatedat, deliveredat
+db.createCollection('webhook_deliveries', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['subscriptionid', 'eventid', 'eventtype', 'payload', 'status', 'attempts', 'createdat', 'updatedat'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID stored as binary'
+        },
+        subscriptionid: {
+          bsonType: 'binData',
+          description: 'UUID of the subscription the event is posted to'
+        },
+        eventid: {
+          bsonType: 'binData',
+          description: 'UUID of the event, shared by its redeliveries'
+        },
+        eventtype: {
+          enum: [
+            'loan.borrowed',
+            'loan.renewed',
+            'loan.returned',
+            'loan.damaged',
+            'loan.lost',
+            'loan.due_date_changed',
+            'loan.overdue',
+            'book.created',
+            'book.updated',
+            'book.withdrawn',
+            'book.deleted',
+            'user.created',
+            'user.updated',
+            'user.blocked',
+            'user.unblocked',
+            'user.disabled',
+            'hold.placed',
+            'hold.ready',
+            'hold.fulfilled',
+            'hold.cancelled',
+            'hold.expired',
+            'fine.assessed',
+            'fine.paid',
+            'fine.waived'
+          ],
+          description: 'type of the event'
+        },
+        payload: {
+          bsonType: 'string',
+          description: 'JSON body posted to the subscription'
+        },
+        status: {
+          enum: ['pending', 'delivered', 'failed'],
+          description: 'delivery status'
+        },
+        attempts: {
+          bsonType: 'int',
+          minimum: 0,
+          description: 'attempts made so far'
+        },
+        nextattemptat: {
+          bsonType: ['date', 'null'],
+          description: 'when a pending delivery is attempted next'
+        },
+        responsestatus: {
+          bsonType: ['int', 'null'],
+          description: 'HTTP status of the latest attempt, if one came back'
+        },
+        lasterror: {
+          bsonType: 'string',
+          description: 'why the latest attempt failed'
+        },
+        createdat: {
+          bsonType: 'date',
+          description: 'creation timestamp'
+        },
+        updatedat: {
+          bsonType: 'date',
+          description: 'last update timestamp'
+        },
+        deliveredat: {
+          bsonType: ['date', 'null'],
+          description: 'when the receiver accepted the delivery'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for webhook_deliveries
+db.webhook_deliveries.createIndex({ id: 1 }, { unique: true });
+db.webhook_deliveries.createIndex({ subscriptionid: 1, createdat: -1 });
+// Due deliveries are claimed in next attempt order
+db.webhook_deliveries.createIndex({ status: 1, nextattemptat: 1 });
+
+print('Webhook deliveries collection created successfully');
+
+// Create outbox_messages collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, position, eventtype, aggregateid, payload, occurredat,
+// attempts, lasterror, lockeduntil, publishedat
+db.createCollection('outbox_messages', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['id', 'position', 'eventtype', 'aggregateid', 'payload', 'occurredat', 'attempts'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID of the event, stored as binary'
+        },
+        position: {
+          bsonType: 'long',
+          minimum: 1,
+          description: 'order of the event within its aggregate'
+        },
+        eventtype: {
+          enum: [
+            'loan.borrowed',
+            'loan.renewed',
+            'loan.returned',
+            'loan.damaged',
+            'loan.lost',
+            'loan.due_date_changed',
+            'loan.overdue',
+            'book.created',
+            'book.updated',
+            'book.withdrawn',
+            'book.deleted',
+            'user.created',
+            'user.updated',
+            'user.blocked',
+            'user.unblocked',
+            'user.disabled',
+            'hold.placed',
+            'hold.ready',
+            'hold.fulfilled',
+            'hold.cancelled',
+            'hold.expired',
+            'fine.assessed',
+            'fine.paid',
+            'fine.waived'
+          ],
+          description: 'type of the event'
+        },
+        aggregateid: {
+          bsonType: 'binData',
+          description: 'UUID of the loan, book, user, hold or fine the event happened to'
+        },
+        payload: {
+          bsonType: 'string',
+          description: 'JSON snapshot of the aggregate'
+        },
+        occurredat: {
+          bsonType: 'date',
+          description: 'when the event happened'
+        },
+        attempts: {
+          bsonType: 'int',
+          minimum: 0,
+          description: 'publish attempts made so far'
+        },
+        lasterror: {
+          bsonType: 'string',
+          description: 'why the latest attempt failed'
+        },
+        lockeduntil: {
+          bsonType: ['date', 'null'],
+          description: 'until when a relay holds the aggregate'
+        },
+        publishedat: {
+          bsonType: ['date', 'null'],
+          description: 'when the event was published'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for outbox_messages
+db.outbox_messages.createIndex({ id: 1 }, { unique: true });
+// Positions are unique per aggregate, so concurrent appends cannot share one
+db.outbox_messages.createIndex({ aggregateid: 1, position: 1 }, { unique: true });
+db.outbox_messages.createIndex({ publishedat: 1 });
+
+print('Outbox messages collection created successfully');
+
+// Create audit_log collection with schema validation
+// Field names match Go entity struct fields (lowercase): id, sequence, actorid, action, entitytype, entityid, changes,
+// requestid, createdat, prevhash, hash
+db.createCollection('audit_log', {
+  validator: {
+    $jsonSchema: {
+      bsonType: 'object',
+      required: ['id', 'sequence', 'action', 'entitytype', 'entityid', 'changes', 'createdat', 'prevhash', 'hash'],
+      properties: {
+        id: {
+          bsonType: 'binData',
+          description: 'UUID of the entry, stored as binary'
+        },
+        sequence: {
+          bsonType: 'long',
+          minimum: 1,
+          description: 'position of the entry in the chain'
+        },
+        actorid: {
+          bsonType: ['binData', 'null'],
+          description: 'UUID of the user who made the change, null for background jobs'
+        },
+        action: {
+          bsonType: 'string',
+          description: 'what happened, e.g. user.disabled'
+        },
+        entitytype: {
+          enum: [
+            'user',
+            'book',
+            'copy',
+            'loan',
+            'hold',
+            'fine',
+            'loan_policy',
+            'branch',
+            'transfer',
+            'webhook',
+            'opening_hours',
+            'closed_date',
+            'due_date_adjustment'
+          ],
+          description: 'type of the changed entity'
+        },
+        entityid: {
+          bsonType: 'binData',
+          description: 'UUID of the changed entity'
+        },
+        changes: {
+          bsonType: 'string',
+          description: 'JSON object of the changed fields with their before and after values'
+        },
+        requestid: {
+          bsonType: 'string',
+          description: 'ID of the HTTP request that made the change'
+        },
+        createdat: {
+          bsonType: 'date',
+          description: 'when the change was made'
+        },
+        prevhash: {
+          bsonType: 'string',
+          description: 'hash of the previous entry, empty for the first'
+        },
+        hash: {
+          bsonType: 'string',
+          description: 'SHA-256 of the entry and prevhash'
+        }
+      }
+    }
+  }
+});
+
+// Create indexes for audit_log
+db.audit_log.createIndex({ id: 1 }, { unique: true });
+db.audit_log.createIndex({ sequence: 1 }, { unique: true });
+db.audit_log.createIndex({ actorid: 1, sequence: -1 });
+db.audit_log.createIndex({ entitytype: 1, entityid: 1, sequence: -1 });
+db.audit_log.createIndex({ createdat: 1 });
+
+// The head of the chain; every append moves it, which serializes appends
+db.createCollection('audit_chain');
+db.audit_chain.insertOne({ _id: 'head', sequence: NumberLong(0), hash: '' });
+
+print('Audit log collections created successfully');
 print('MongoDB initialization completed');